@@ -23,7 +23,10 @@ const (
 	// AnnotationKeyAbort is an annotation key that can be set on a Stage
 	// resource to abort the verification of its Freight. The value of the
 	// annotation must be set to the identifier of the verification to be
-	// aborted.
+	// aborted. It can also be set on a Promotion resource that has not yet
+	// reached a terminal phase to abort the Promotion. In that case, the value
+	// of the annotation may be any identifier, conventionally the name of the
+	// Promotion.
 	AnnotationKeyAbort = "kargo.akuity.io/abort"
 
 	// AnnotationKeyDescription is an annotation key that can be set on a
@@ -32,6 +35,17 @@ const (
 	// resource.
 	AnnotationKeyDescription = "kargo.akuity.io/description"
 
+	// AnnotationKeyRetryOf is an annotation key that is set on a Promotion
+	// created by retrying another, failed Promotion. The value of the annotation
+	// is the name of the Promotion that was retried.
+	AnnotationKeyRetryOf = "kargo.akuity.io/retry-of"
+
+	// AnnotationKeyRollbackOf is an annotation key that is set on a Promotion
+	// created to roll a Stage back to the last Freight it was known to be
+	// Healthy with. The value of the annotation is the name of the Freight that
+	// was being rolled back from.
+	AnnotationKeyRollbackOf = "kargo.akuity.io/rollback-of"
+
 	AnnotationValueTrue = "true"
 )
 
@@ -31,9 +31,12 @@ const (
 
 const (
 	EventReasonPromotionCreated                = "PromotionCreated"
+	EventReasonPromotionStarted                = "PromotionStarted"
 	EventReasonPromotionSucceeded              = "PromotionSucceeded"
 	EventReasonPromotionFailed                 = "PromotionFailed"
 	EventReasonPromotionErrored                = "PromotionErrored"
+	EventReasonPromotionForced                 = "PromotionForced"
+	EventReasonPromotionAborted                = "PromotionAborted"
 	EventReasonFreightApproved                 = "FreightApproved"
 	EventReasonFreightVerificationSucceeded    = "FreightVerificationSucceeded"
 	EventReasonFreightVerificationFailed       = "FreightVerificationFailed"
@@ -122,6 +125,9 @@ func NewPromotionEventAnnotations(
 	}
 
 	if f != nil {
+		// The Promotion may not name the Freight directly, so prefer the name
+		// of the Freight itself.
+		annotations[AnnotationKeyEventFreightName] = f.Name
 		annotations[AnnotationKeyEventFreightCreateTime] = f.CreationTimestamp.Format(time.RFC3339)
 		annotations[AnnotationKeyEventFreightAlias] = f.Alias
 		if len(f.Commits) > 0 {
@@ -1,9 +1,12 @@
 package v1alpha1
 
 import (
+	"cmp"
 	"crypto/sha1"
+	"encoding/json"
 	"fmt"
 	"path"
+	"slices"
 	"sort"
 	"strings"
 
@@ -44,6 +47,16 @@ type Freight struct {
 	Images []Image `json:"images,omitempty" protobuf:"bytes,4,rep,name=images"`
 	// Charts describes specific versions of specific Helm charts.
 	Charts []Chart `json:"charts,omitempty" protobuf:"bytes,5,rep,name=charts"`
+	// OCIArtifacts describes specific versions of specific OCI artifacts.
+	OCIArtifacts []OCIArtifact `json:"ociArtifacts,omitempty" protobuf:"bytes,9,rep,name=ociArtifacts"`
+	// HTTPArtifacts describes specific versions of artifacts served at HTTP/S
+	// URLs.
+	HTTPArtifacts []HTTPArtifact `json:"httpArtifacts,omitempty" protobuf:"bytes,11,rep,name=httpArtifacts"`
+	// TrackedMetadata describes labels and annotations that were incorporated
+	// into this Freight's ID. This is only set when the Warehouse that produced
+	// the Freight tracks changes to Freight metadata. Freight with identical
+	// artifacts, but different TrackedMetadata, are distinct.
+	TrackedMetadata *FreightMetadata `json:"trackedMetadata,omitempty" protobuf:"bytes,10,opt,name=trackedMetadata"`
 	// Status describes the current status of this Freight.
 	Status FreightStatus `json:"status,omitempty" protobuf:"bytes,6,opt,name=status"`
 }
@@ -53,9 +66,12 @@ func (f *Freight) GetStatus() *FreightStatus {
 }
 
 // GenerateID deterministically calculates a piece of Freight's ID based on its
-// contents and returns it.
+// contents, including any tracked metadata, and returns it. The ID depends
+// neither on the order in which artifacts appear nor on which Warehouse
+// discovered them or when, so identical content always yields an identical ID.
 func (f *Freight) GenerateID() string {
-	size := len(f.Commits) + len(f.Images) + len(f.Charts)
+	size := len(f.Commits) + len(f.Images) + len(f.Charts) + len(f.OCIArtifacts) +
+		len(f.HTTPArtifacts)
 	artifacts := make([]string, 0, size)
 	for _, commit := range f.Commits {
 		if commit.Tag != "" {
@@ -100,6 +116,31 @@ func (f *Freight) GenerateID() string {
 			),
 		)
 	}
+	for _, artifact := range f.OCIArtifacts {
+		artifacts = append(
+			artifacts,
+			// As with images, both tag and digest are incorporated so that a tag
+			// being moved to a different artifact, or an artifact being re-tagged,
+			// both result in new Freight.
+			fmt.Sprintf("%s:%s@%s", artifact.RepoURL, artifact.Tag, artifact.Digest),
+		)
+	}
+	for _, artifact := range f.HTTPArtifacts {
+		// The URL is quoted because, unlike the other artifacts' identifiers, it
+		// may itself contain colons.
+		artifacts = append(artifacts, fmt.Sprintf("http:%q:%s", artifact.URL, artifact.Version))
+	}
+	if f.TrackedMetadata != nil {
+		// Metadata entries are prefixed and quoted so they can never be mistaken
+		// for an artifact, or a label for an annotation, regardless of their
+		// contents.
+		for k, v := range f.TrackedMetadata.Labels {
+			artifacts = append(artifacts, fmt.Sprintf("label:%q=%q", k, v))
+		}
+		for k, v := range f.TrackedMetadata.Annotations {
+			artifacts = append(artifacts, fmt.Sprintf("annotation:%q=%q", k, v))
+		}
+	}
 	sort.Strings(artifacts)
 	return fmt.Sprintf(
 		"%x",
@@ -107,6 +148,114 @@ func (f *Freight) GenerateID() string {
 	)
 }
 
+// CanonicalJSON returns a canonical JSON representation of the artifacts and
+// tracked metadata that identify a piece of Freight. Only the fields that
+// contribute to the Freight's ID are included, URLs are normalized the same
+// way GenerateID normalizes them, and every list is sorted, so two pieces of
+// Freight with the same ID always have identical representations. Nil and
+// empty lists or maps are both omitted. This is useful to external tooling
+// that needs a stable representation of Freight for hashing or comparison.
+func (f *Freight) CanonicalJSON() ([]byte, error) {
+	type canonicalCommit struct {
+		RepoURL string `json:"repoURL"`
+		Tag     string `json:"tag,omitempty"`
+		ID      string `json:"id"`
+	}
+	type canonicalArtifact struct {
+		RepoURL string `json:"repoURL"`
+		Tag     string `json:"tag,omitempty"`
+		Digest  string `json:"digest,omitempty"`
+	}
+	type canonicalHTTPArtifact struct {
+		URL     string `json:"url"`
+		Version string `json:"version"`
+	}
+	type canonicalChart struct {
+		RepoURL string `json:"repoURL"`
+		Name    string `json:"name,omitempty"`
+		Version string `json:"version"`
+	}
+	canonical := struct {
+		Commits       []canonicalCommit       `json:"commits,omitempty"`
+		Images        []canonicalArtifact     `json:"images,omitempty"`
+		Charts        []canonicalChart        `json:"charts,omitempty"`
+		OCIArtifacts  []canonicalArtifact     `json:"ociArtifacts,omitempty"`
+		HTTPArtifacts []canonicalHTTPArtifact `json:"httpArtifacts,omitempty"`
+		Labels        map[string]string       `json:"labels,omitempty"`
+		Annotations   map[string]string       `json:"annotations,omitempty"`
+	}{}
+	for _, commit := range f.Commits {
+		canonical.Commits = append(canonical.Commits, canonicalCommit{
+			RepoURL: git.NormalizeURL(commit.RepoURL),
+			Tag:     commit.Tag,
+			ID:      commit.ID,
+		})
+	}
+	slices.SortFunc(canonical.Commits, func(a, b canonicalCommit) int {
+		return cmp.Or(
+			cmp.Compare(a.RepoURL, b.RepoURL),
+			cmp.Compare(a.Tag, b.Tag),
+			cmp.Compare(a.ID, b.ID),
+		)
+	})
+	for _, image := range f.Images {
+		canonical.Images = append(canonical.Images, canonicalArtifact{
+			RepoURL: image.RepoURL,
+			Tag:     image.Tag,
+			Digest:  image.Digest,
+		})
+	}
+	for _, chart := range f.Charts {
+		canonical.Charts = append(canonical.Charts, canonicalChart{
+			RepoURL: helm.NormalizeChartRepositoryURL(chart.RepoURL),
+			Name:    chart.Name,
+			Version: chart.Version,
+		})
+	}
+	slices.SortFunc(canonical.Charts, func(a, b canonicalChart) int {
+		return cmp.Or(
+			cmp.Compare(a.RepoURL, b.RepoURL),
+			cmp.Compare(a.Name, b.Name),
+			cmp.Compare(a.Version, b.Version),
+		)
+	})
+	for _, artifact := range f.OCIArtifacts {
+		canonical.OCIArtifacts = append(canonical.OCIArtifacts, canonicalArtifact{
+			RepoURL: artifact.RepoURL,
+			Tag:     artifact.Tag,
+			Digest:  artifact.Digest,
+		})
+	}
+	compareArtifacts := func(a, b canonicalArtifact) int {
+		return cmp.Or(
+			cmp.Compare(a.RepoURL, b.RepoURL),
+			cmp.Compare(a.Tag, b.Tag),
+			cmp.Compare(a.Digest, b.Digest),
+		)
+	}
+	slices.SortFunc(canonical.Images, compareArtifacts)
+	slices.SortFunc(canonical.OCIArtifacts, compareArtifacts)
+	for _, artifact := range f.HTTPArtifacts {
+		canonical.HTTPArtifacts = append(canonical.HTTPArtifacts, canonicalHTTPArtifact{
+			URL:     artifact.URL,
+			Version: artifact.Version,
+		})
+	}
+	slices.SortFunc(canonical.HTTPArtifacts, func(a, b canonicalHTTPArtifact) int {
+		return cmp.Or(
+			cmp.Compare(a.URL, b.URL),
+			cmp.Compare(a.Version, b.Version),
+		)
+	})
+	if f.TrackedMetadata != nil {
+		// encoding/json always marshals map keys in sorted order, so the maps
+		// need no further treatment.
+		canonical.Labels = f.TrackedMetadata.Labels
+		canonical.Annotations = f.TrackedMetadata.Annotations
+	}
+	return json.Marshal(canonical)
+}
+
 // GitCommit describes a specific commit from a specific Git repository.
 type GitCommit struct {
 	// RepoURL is the URL of a Git repository.
@@ -125,12 +274,17 @@ type GitCommit struct {
 	// associated with the Stage are or are not synced to this commit. Note that
 	// there are cases (as in that of Kargo Render being utilized as a promotion
 	// mechanism) wherein the value of this field may differ from the commit ID
-	// found in the ID field.
+	// found in the ID field. Freight that references commits from multiple
+	// repositories carries a distinct value for each, and each Argo CD
+	// Application is checked only against the commit from the repository it
+	// sources from.
 	HealthCheckCommit string `json:"healthCheckCommit,omitempty" protobuf:"bytes,5,opt,name=healthCheckCommit"`
 	// Message is the git commit message
 	Message string `json:"message,omitempty" protobuf:"bytes,6,opt,name=message"`
 	// Author is the git commit author
 	Author string `json:"author,omitempty" protobuf:"bytes,7,opt,name=author"`
+	// CommitDate is the time at which the commit was made.
+	CommitDate *metav1.Time `json:"commitDate,omitempty" protobuf:"bytes,8,opt,name=commitDate"`
 }
 
 // FreightStatus describes a piece of Freight's most recently observed state.
@@ -150,7 +304,13 @@ type VerifiedStage struct{}
 
 // ApprovedStage describes a Stage for which Freight has been (manually)
 // approved.
-type ApprovedStage struct{}
+type ApprovedStage struct {
+	// ApprovedBy identifies the user who approved the Freight for the Stage, if
+	// known.
+	ApprovedBy string `json:"approvedBy,omitempty" protobuf:"bytes,1,opt,name=approvedBy"`
+	// ApprovedAt is the time at which the Freight was approved for the Stage.
+	ApprovedAt *metav1.Time `json:"approvedAt,omitempty" protobuf:"bytes,2,opt,name=approvedAt"`
+}
 
 // +kubebuilder:object:root=true
 
@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -106,4 +107,159 @@ func TestFreightGenerateID(t *testing.T) {
 	// Changing anything should change the result
 	freight.Commits[0].ID = "a-different-fake-commit"
 	require.NotEqual(t, expected, freight.GenerateID())
+	// Adding an OCI artifact should change the result
+	expected = freight.GenerateID()
+	freight.OCIArtifacts = []OCIArtifact{
+		{
+			RepoURL: "fake-artifact-repo",
+			Tag:     "fake-artifact-tag",
+			Digest:  "fake-artifact-digest",
+		},
+	}
+	require.NotEqual(t, expected, freight.GenerateID())
+	// And so should a tag being moved to a different artifact
+	expected = freight.GenerateID()
+	freight.OCIArtifacts[0].Digest = "a-different-fake-artifact-digest"
+	require.NotEqual(t, expected, freight.GenerateID())
+	// Adding an HTTP artifact should change the result
+	expected = freight.GenerateID()
+	freight.HTTPArtifacts = []HTTPArtifact{
+		{
+			URL:     "https://example.com/release.json",
+			Version: "v1.0.0",
+		},
+	}
+	require.NotEqual(t, expected, freight.GenerateID())
+	// And so should a change to its version
+	expected = freight.GenerateID()
+	freight.HTTPArtifacts[0].Version = "v1.0.1"
+	require.NotEqual(t, expected, freight.GenerateID())
+	// Untracked labels and annotations should not change the result
+	expected = freight.GenerateID()
+	freight.Labels = map[string]string{"foo": "bar"}
+	freight.Annotations = map[string]string{"bat": "baz"}
+	require.Equal(t, expected, freight.GenerateID())
+	// Tracked labels and annotations should change the result
+	freight.TrackedMetadata = &FreightMetadata{
+		Labels: map[string]string{"foo": "bar"},
+	}
+	require.NotEqual(t, expected, freight.GenerateID())
+	expected = freight.GenerateID()
+	freight.TrackedMetadata.Labels["foo"] = "baz"
+	require.NotEqual(t, expected, freight.GenerateID())
+	// A label and an annotation with the same key and value are distinct
+	expected = freight.GenerateID()
+	freight.TrackedMetadata = &FreightMetadata{
+		Annotations: map[string]string{"foo": "baz"},
+	}
+	require.NotEqual(t, expected, freight.GenerateID())
+}
+
+func TestFreightGenerateIDIgnoresOrder(t *testing.T) {
+	commits := []GitCommit{
+		{RepoURL: "fake-git-repo", ID: "fake-commit-id"},
+		{RepoURL: "another-fake-git-repo", ID: "another-fake-commit-id", Tag: "v1.0.0"},
+	}
+	images := []Image{
+		{RepoURL: "fake-image-repo", Tag: "fake-image-tag", Digest: "fake-digest"},
+		{RepoURL: "another-fake-image-repo", Tag: "another-fake-image-tag"},
+	}
+	charts := []Chart{
+		{RepoURL: "fake-chart-repo", Name: "fake-chart", Version: "1.0.0"},
+		{RepoURL: "oci://another-fake-chart-repo/another-fake-chart", Version: "2.0.0"},
+	}
+	freight := Freight{
+		Warehouse: "fake-warehouse",
+		Commits:   commits,
+		Images:    images,
+		Charts:    charts,
+	}
+	expected := freight.GenerateID()
+
+	reordered := Freight{
+		// Which Warehouse discovered the content is irrelevant
+		Warehouse: "another-fake-warehouse",
+		Commits:   []GitCommit{commits[1], commits[0]},
+		Images:    []Image{images[1], images[0]},
+		Charts:    []Chart{charts[1], charts[0]},
+	}
+	require.Equal(t, expected, reordered.GenerateID())
+
+	// Moving an artifact into a different slot of the same slice must not make
+	// two different pieces of content look identical
+	reordered.Images[0].Tag, reordered.Images[1].Tag =
+		reordered.Images[1].Tag, reordered.Images[0].Tag
+	require.NotEqual(t, expected, reordered.GenerateID())
+}
+
+func TestFreightCanonicalJSON(t *testing.T) {
+	freight := Freight{
+		Commits: []GitCommit{
+			{
+				RepoURL: "https://github.com/example/repo-b.git",
+				ID:      "fake-commit-id-b",
+				Message: "not part of the canonical representation",
+			},
+			{
+				RepoURL: "https://github.com/example/repo-a",
+				ID:      "fake-commit-id-a",
+				Tag:     "v1.0.0",
+			},
+		},
+		Images: []Image{
+			{
+				RepoURL: "fake-image-repo-b",
+				Tag:     "fake-image-tag",
+			},
+			{
+				RepoURL:    "fake-image-repo-a",
+				Tag:        "fake-image-tag",
+				Digest:     "fake-image-digest",
+				GitRepoURL: "not part of the canonical representation",
+			},
+		},
+		Charts: []Chart{
+			{
+				RepoURL: "https://fake-chart-repo",
+				Name:    "fake-chart",
+				Version: "1.0.0",
+			},
+		},
+		TrackedMetadata: &FreightMetadata{
+			Labels: map[string]string{"b": "2", "a": "1"},
+		},
+	}
+	expected := `{` +
+		`"commits":[` +
+		`{"repoURL":"https://github.com/example/repo-a","tag":"v1.0.0","id":"fake-commit-id-a"},` +
+		`{"repoURL":"https://github.com/example/repo-b","id":"fake-commit-id-b"}` +
+		`],` +
+		`"images":[` +
+		`{"repoURL":"fake-image-repo-a","tag":"fake-image-tag","digest":"fake-image-digest"},` +
+		`{"repoURL":"fake-image-repo-b","tag":"fake-image-tag"}` +
+		`],` +
+		`"charts":[{"repoURL":"https://fake-chart-repo","name":"fake-chart","version":"1.0.0"}],` +
+		`"labels":{"a":"1","b":"2"}` +
+		`}`
+	actual, err := freight.CanonicalJSON()
+	require.NoError(t, err)
+	require.Equal(t, expected, string(actual))
+
+	// The order in which artifacts are listed should not matter
+	slices.Reverse(freight.Commits)
+	slices.Reverse(freight.Images)
+	actual, err = freight.CanonicalJSON()
+	require.NoError(t, err)
+	require.Equal(t, expected, string(actual))
+
+	// Nil and empty lists and maps should be treated identically
+	empty, err := (&Freight{
+		Commits:         []GitCommit{},
+		TrackedMetadata: &FreightMetadata{Labels: map[string]string{}},
+	}).CanonicalJSON()
+	require.NoError(t, err)
+	zero, err := (&Freight{}).CanonicalJSON()
+	require.NoError(t, err)
+	require.Equal(t, "{}", string(zero))
+	require.Equal(t, string(zero), string(empty))
 }
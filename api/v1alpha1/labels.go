@@ -10,11 +10,12 @@ const (
 	CredentialTypeLabelValueImage = "image"
 
 	// Kargo core API
-	FreightLabelKey   = "kargo.akuity.io/freight"
-	ProjectLabelKey   = "kargo.akuity.io/project"
-	PromotionLabelKey = "kargo.akuity.io/promotion"
-	ShardLabelKey     = "kargo.akuity.io/shard"
-	StageLabelKey     = "kargo.akuity.io/stage"
+	FreightLabelKey      = "kargo.akuity.io/freight"
+	ProjectLabelKey      = "kargo.akuity.io/project"
+	PromotionLabelKey    = "kargo.akuity.io/promotion"
+	PromotionJobLabelKey = "kargo.akuity.io/promotion-job"
+	ShardLabelKey        = "kargo.akuity.io/shard"
+	StageLabelKey        = "kargo.akuity.io/stage"
 
 	LabelTrueValue = "true"
 
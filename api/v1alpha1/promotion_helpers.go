@@ -8,6 +8,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/akuity/kargo/internal/api/user"
 )
 
 // GetPromotion returns a pointer to the Promotion resource specified by the
@@ -53,3 +55,40 @@ func RefreshPromotion(
 	}
 	return promo, nil
 }
+
+// AbortPromotion requests that a Promotion be aborted by setting an
+// AnnotationKeyAbort annotation on the Promotion, causing the controller to
+// stop executing it and transition it to the Aborted phase. The annotation
+// value identifies the Promotion by name and carries the actor who made the
+// request, if known. Promotions that have already reached a terminal phase
+// are left untouched.
+func AbortPromotion(
+	ctx context.Context,
+	c client.Client,
+	namespacedName types.NamespacedName,
+) error {
+	promo, err := GetPromotion(ctx, c, namespacedName)
+	if err != nil || promo == nil {
+		if promo == nil && err == nil {
+			err = fmt.Errorf(
+				"Promotion %q in namespace %q not found",
+				namespacedName.Name,
+				namespacedName.Namespace,
+			)
+		}
+		return err
+	}
+	if promo.Status.Phase.IsTerminal() {
+		// The Promotion has already finished, so there is nothing to abort.
+		return nil
+	}
+
+	ar := VerificationRequest{
+		ID: promo.Name,
+	}
+	// Put actor information to track on the controller side
+	if u, ok := user.InfoFromContext(ctx); ok {
+		ar.Actor = FormatEventUserActor(u)
+	}
+	return patchAnnotation(ctx, c, promo, AnnotationKeyAbort, ar.String())
+}
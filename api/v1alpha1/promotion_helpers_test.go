@@ -62,3 +62,75 @@ func TestGetPromotion(t *testing.T) {
 		})
 	}
 }
+
+func TestAbortPromotion(t *testing.T) {
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, SchemeBuilder.AddToScheme(scheme))
+
+	t.Run("not found", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+		err := AbortPromotion(context.TODO(), c, types.NamespacedName{
+			Namespace: "fake-namespace",
+			Name:      "fake-promotion",
+		})
+		require.ErrorContains(t, err, "not found")
+	})
+
+	t.Run("promotion in terminal phase", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "fake-promotion",
+					Namespace: "fake-namespace",
+				},
+				Status: PromotionStatus{
+					Phase: PromotionPhaseSucceeded,
+				},
+			},
+		).Build()
+
+		err := AbortPromotion(context.TODO(), c, types.NamespacedName{
+			Namespace: "fake-namespace",
+			Name:      "fake-promotion",
+		})
+		require.NoError(t, err)
+
+		promo, err := GetPromotion(context.TODO(), c, types.NamespacedName{
+			Namespace: "fake-namespace",
+			Name:      "fake-promotion",
+		})
+		require.NoError(t, err)
+		_, ok := promo.Annotations[AnnotationKeyAbort]
+		require.False(t, ok)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "fake-promotion",
+					Namespace: "fake-namespace",
+				},
+				Status: PromotionStatus{
+					Phase: PromotionPhaseRunning,
+				},
+			},
+		).Build()
+
+		err := AbortPromotion(context.TODO(), c, types.NamespacedName{
+			Namespace: "fake-namespace",
+			Name:      "fake-promotion",
+		})
+		require.NoError(t, err)
+
+		promo, err := GetPromotion(context.TODO(), c, types.NamespacedName{
+			Namespace: "fake-namespace",
+			Name:      "fake-promotion",
+		})
+		require.NoError(t, err)
+		require.Equal(t, (&VerificationRequest{
+			ID: "fake-promotion",
+		}).String(), promo.Annotations[AnnotationKeyAbort])
+	})
+}
@@ -9,7 +9,9 @@ type PromotionPhase string
 const (
 	// PromotionPhasePending denotes a Promotion that has not been executed yet.
 	// i.e. It is currently waiting in a queue. Queues are stage-specific and
-	// prioritized by Promotion creation time.
+	// prioritized by Promotion priority, then by creation time. A Pending
+	// Promotion is not terminal. It moves to PromotionPhaseRunning once the
+	// controller begins executing it.
 	PromotionPhasePending PromotionPhase = "Pending"
 	// PromotionPhaseRunning denotes a Promotion that is actively being executed.
 	//
@@ -26,12 +28,22 @@ const (
 	// reasons. Further information about the failure can be found in the
 	// Promotion's status.
 	PromotionPhaseErrored PromotionPhase = "Errored"
+	// PromotionPhaseAborted denotes a Promotion that was aborted at a user's
+	// request before it could complete. Any changes already applied by its
+	// promotion mechanisms are not reverted.
+	PromotionPhaseAborted PromotionPhase = "Aborted"
 )
 
+// PromotionFreightLatest may be used in place of the name of a piece of Freight
+// to request that a Promotion promote the newest Freight available to its
+// Stage at the time the Promotion begins running.
+const PromotionFreightLatest = "latest"
+
 // IsTerminal returns true if the PromotionPhase is a terminal one.
 func (p *PromotionPhase) IsTerminal() bool {
 	switch *p {
-	case PromotionPhaseSucceeded, PromotionPhaseFailed, PromotionPhaseErrored:
+	case PromotionPhaseSucceeded, PromotionPhaseFailed, PromotionPhaseErrored,
+		PromotionPhaseAborted:
 		return true
 	default:
 		return false
@@ -77,10 +89,70 @@ type PromotionSpec struct {
 	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$
 	Stage string `json:"stage" protobuf:"bytes,1,opt,name=stage"`
 	// Freight specifies the piece of Freight to be promoted into the Stage
-	// referenced by the Stage field.
+	// referenced by the Stage field. The value "latest" may be used instead to
+	// promote whichever Freight is the newest available to the Stage when the
+	// Promotion begins running. The name of the Freight that was chosen is
+	// recorded in the Promotion's status.
 	//
 	// +kubebuilder:validation:MinLength=1
 	Freight string `json:"freight" protobuf:"bytes,2,opt,name=freight"`
+	// Timeout is the maximum amount of time permitted for this Promotion to run,
+	// measured from the time it leaves the queue and begins executing. A
+	// Promotion that has not completed within this time is marked as Errored.
+	// Any timeouts specified by the Stage's individual promotion mechanisms
+	// continue to apply. This field is optional. When left unspecified, the
+	// Promotion may run indefinitely, subject only to those mechanism-level
+	// timeouts.
+	//
+	// +kubebuilder:validation:Optional
+	Timeout *metav1.Duration `json:"timeout,omitempty" protobuf:"bytes,3,opt,name=timeout"`
+	// Force indicates that the Promotion should proceed even if the Freight
+	// has neither been verified in any of the Stage's upstream Stages nor been
+	// approved for the Stage. This is intended for emergencies, such as pushing
+	// a fix while upstream Stages are unhealthy. Promotions that make use of
+	// this are marked as forced in their status and result in a Warning event.
+	//
+	// +kubebuilder:validation:Optional
+	Force bool `json:"force,omitempty" protobuf:"varint,4,opt,name=force"`
+	// DryRun indicates that the Promotion should only work out what changes
+	// the Stage's promotion mechanisms would make, without writing to any Git
+	// repository, updating any Argo CD Application, or running any Job. The
+	// planned changes are recorded in the Promotion's status and the Stage
+	// itself is left untouched.
+	//
+	// +kubebuilder:validation:Optional
+	DryRun bool `json:"dryRun,omitempty" protobuf:"varint,5,opt,name=dryRun"`
+	// RetryPolicy describes how the Promotion is retried if it fails or
+	// errors. This field is optional. When left unspecified, the Promotion is
+	// attempted only once.
+	//
+	// +kubebuilder:validation:Optional
+	RetryPolicy *PromotionRetryPolicy `json:"retryPolicy,omitempty" protobuf:"bytes,6,opt,name=retryPolicy"`
+	// Priority determines the order in which Promotions waiting to run against
+	// the same Stage are started. Promotions with a higher priority are started
+	// first. Promotions of equal priority are started in the order in which
+	// they were created. This field is optional and defaults to zero. Negative
+	// values are permitted.
+	//
+	// +kubebuilder:validation:Optional
+	Priority int32 `json:"priority,omitempty" protobuf:"varint,7,opt,name=priority"`
+}
+
+// PromotionRetryPolicy describes how a Promotion that fails or errors is
+// retried.
+type PromotionRetryPolicy struct {
+	// MaxAttempts is the maximum number of times the Promotion is attempted,
+	// including the first attempt.
+	//
+	// +kubebuilder:validation:Minimum=1
+	MaxAttempts int32 `json:"maxAttempts" protobuf:"varint,1,opt,name=maxAttempts"`
+	// Backoff is how long to wait after the first failed attempt before trying
+	// again. The wait is doubled after each further failed attempt, up to a
+	// maximum of five minutes. When left unspecified, it defaults to ten
+	// seconds.
+	//
+	// +kubebuilder:validation:Optional
+	Backoff *metav1.Duration `json:"backoff,omitempty" protobuf:"bytes,2,opt,name=backoff"`
 }
 
 // PromotionStatus describes the current state of the transition represented by
@@ -103,6 +175,55 @@ type PromotionStatus struct {
 	Metadata map[string]string `json:"metadata,omitempty" protobuf:"bytes,3,rep,name=metadata" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// Freight is the detail of the piece of freight that was referenced by this promotion.
 	Freight *FreightReference `json:"freight,omitempty" protobuf:"bytes,5,opt,name=freight"`
+	// StartedAt is the time at which the Promotion left the queue and began
+	// executing.
+	StartedAt *metav1.Time `json:"startedAt,omitempty" protobuf:"bytes,6,opt,name=startedAt"`
+	// Forced indicates that the Promotion bypassed the checks that would
+	// ordinarily have prevented the Freight from being promoted to the Stage.
+	Forced bool `json:"forced,omitempty" protobuf:"varint,7,opt,name=forced"`
+	// PlannedChanges describes, in human-readable form, the changes that the
+	// Stage's promotion mechanisms would have made. It is only populated for
+	// Promotions with DryRun enabled.
+	PlannedChanges []string `json:"plannedChanges,omitempty" protobuf:"bytes,8,rep,name=plannedChanges"`
+	// Mechanisms holds the result of each individual update carried out by the
+	// Stage's promotion mechanisms, in the order in which they were executed.
+	// Updates that were not reached are absent.
+	Mechanisms []MechanismResult `json:"mechanisms,omitempty" protobuf:"bytes,9,rep,name=mechanisms"`
+	// Attempts is the number of times the Promotion has been attempted,
+	// including the current attempt. It only exceeds one for Promotions that
+	// specify a RetryPolicy.
+	Attempts int32 `json:"attempts,omitempty" protobuf:"varint,10,opt,name=attempts"`
+	// NextAttemptAfter is the time before which a Promotion that is due to be
+	// retried must not be attempted again. It is only set while a Promotion is
+	// backing off between attempts.
+	NextAttemptAfter *metav1.Time `json:"nextAttemptAfter,omitempty" protobuf:"bytes,11,opt,name=nextAttemptAfter"`
+}
+
+// MechanismType identifies the kind of update a promotion mechanism carried
+// out.
+type MechanismType string
+
+const (
+	MechanismTypeGitRepoUpdate   MechanismType = "GitRepoUpdate"
+	MechanismTypeArgoCDAppUpdate MechanismType = "ArgoCDAppUpdate"
+	MechanismTypeJob             MechanismType = "Job"
+)
+
+// MechanismResult describes the outcome of a single update carried out by a
+// promotion mechanism.
+type MechanismResult struct {
+	// Type is the kind of update that was carried out.
+	Type MechanismType `json:"type" protobuf:"bytes,1,opt,name=type"`
+	// Target identifies what was updated. For a GitRepoUpdate, this is the URL
+	// of the repository and the branch written to. For an ArgoCDAppUpdate, this
+	// is the namespace and name of the Application, prefixed with the name of
+	// the Argo CD instance managing it if that is not the default instance. For
+	// a Job, this is the name of the Job.
+	Target string `json:"target" protobuf:"bytes,2,opt,name=target"`
+	// Phase describes where the update is in its lifecycle.
+	Phase PromotionPhase `json:"phase" protobuf:"bytes,3,opt,name=phase"`
+	// Message explains why the update failed or errored, if it did.
+	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
 }
 
 // WithPhase returns a copy of PromotionStatus with the given phase
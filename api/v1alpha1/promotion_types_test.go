@@ -0,0 +1,27 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPromotionPhase_IsTerminal(t *testing.T) {
+	testCases := []struct {
+		phase    PromotionPhase
+		terminal bool
+	}{
+		{phase: "", terminal: false},
+		{phase: PromotionPhasePending, terminal: false},
+		{phase: PromotionPhaseRunning, terminal: false},
+		{phase: PromotionPhaseSucceeded, terminal: true},
+		{phase: PromotionPhaseFailed, terminal: true},
+		{phase: PromotionPhaseErrored, terminal: true},
+		{phase: PromotionPhaseAborted, terminal: true},
+	}
+	for _, testCase := range testCases {
+		t.Run(string(testCase.phase), func(t *testing.T) {
+			require.Equal(t, testCase.terminal, testCase.phase.IsTerminal())
+		})
+	}
+}
@@ -1,6 +1,11 @@
 package v1alpha1
 
 import (
+	"fmt"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -69,13 +74,55 @@ const (
 	ImageUpdateValueTypeDigest         ImageUpdateValueType = "Digest"
 )
 
+// ImageUpdateValueTypes is the complete list of supported
+// ImageUpdateValueTypes.
+var ImageUpdateValueTypes = []ImageUpdateValueType{
+	ImageUpdateValueTypeImageAndTag,
+	ImageUpdateValueTypeTag,
+	ImageUpdateValueTypeImageAndDigest,
+	ImageUpdateValueTypeDigest,
+}
+
+// IsValid returns true if the ImageUpdateValueType is one of the supported
+// ImageUpdateValueTypes.
+func (i ImageUpdateValueType) IsValid() bool {
+	for _, valueType := range ImageUpdateValueTypes {
+		if i == valueType {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseImageUpdateValueType converts the provided string to an
+// ImageUpdateValueType. An error is returned if the string does not match any
+// of the supported ImageUpdateValueTypes.
+func ParseImageUpdateValueType(s string) (ImageUpdateValueType, error) {
+	if i := ImageUpdateValueType(s); i.IsValid() {
+		return i, nil
+	}
+	return "", fmt.Errorf(
+		"unsupported image update value type %q; supported values are %v",
+		s,
+		ImageUpdateValueTypes,
+	)
+}
+
 type HealthState string
 
 const (
-	HealthStateHealthy     HealthState = "Healthy"
-	HealthStateUnhealthy   HealthState = "Unhealthy"
+	// HealthStateHealthy denotes a Stage whose current Freight is fully rolled
+	// out and healthy.
+	HealthStateHealthy HealthState = "Healthy"
+	// HealthStateUnhealthy denotes a Stage that is known to be in a bad state.
+	HealthStateUnhealthy HealthState = "Unhealthy"
+	// HealthStateProgressing denotes a Stage whose current Freight is still
+	// being rolled out, e.g. because an Argo CD Application is syncing or its
+	// resources are not yet ready. It is expected to resolve on its own.
 	HealthStateProgressing HealthState = "Progressing"
-	HealthStateUnknown     HealthState = "Unknown"
+	// HealthStateUnknown denotes a Stage whose health could not be assessed,
+	// e.g. because an Argo CD Application could not be found.
+	HealthStateUnknown HealthState = "Unknown"
 )
 
 var stateOrder = map[HealthState]int{
@@ -161,6 +208,81 @@ type StageSpec struct {
 	// Verification describes how to verify a Stage's current Freight is fit for
 	// promotion downstream.
 	Verification *Verification `json:"verification,omitempty" protobuf:"bytes,3,opt,name=verification"`
+	// HealthCheck describes how the health of the Stage's current Freight is
+	// assessed. This is an optional field. When not specified, health is
+	// reassessed every five minutes and may remain unresolved indefinitely.
+	HealthCheck *HealthCheck `json:"healthCheck,omitempty" protobuf:"bytes,5,opt,name=healthCheck"`
+	// MaxConcurrentPromotions is the maximum number of Promotions to this Stage
+	// that may run at once. Additional Promotions remain Pending until a running
+	// one completes. Values greater than one are only advisable when the Stage's
+	// promotion mechanisms update targets that are independent of one another,
+	// e.g. separate Argo CD Applications. This field is optional. When left
+	// unspecified, the field is implicitly treated as if its value were 1.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	// +kubebuilder:default=1
+	MaxConcurrentPromotions int32 `json:"maxConcurrentPromotions,omitempty" protobuf:"varint,6,opt,name=maxConcurrentPromotions"`
+	// HistoryRetention describes how much of the Stage's Freight history is
+	// retained. This field is optional. When left unspecified, the ten most
+	// recent Freight are retained, regardless of age.
+	HistoryRetention *HistoryRetention `json:"historyRetention,omitempty" protobuf:"bytes,7,opt,name=historyRetention"`
+}
+
+// HistoryRetention describes how much of a Stage's Freight history is
+// retained. The Stage's current Freight is always retained.
+type HistoryRetention struct {
+	// MaxLength is the maximum number of Freight retained in the Stage's
+	// history. This field is optional. When left unspecified, the field is
+	// implicitly treated as if its value were 10.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	MaxLength int32 `json:"maxLength,omitempty" protobuf:"varint,1,opt,name=maxLength"`
+	// MaxAge is how long after its promotion to the Stage Freight is retained in
+	// the Stage's history. Freight whose time of promotion is unknown is retained
+	// regardless of age. This field is optional. When left unspecified, Freight
+	// is retained regardless of age.
+	MaxAge *metav1.Duration `json:"maxAge,omitempty" protobuf:"bytes,2,opt,name=maxAge"`
+}
+
+// HealthCheck describes how the health of a Stage's current Freight is
+// assessed.
+type HealthCheck struct {
+	// Timeout is how long the health of the Stage may remain unresolved, i.e.
+	// Progressing or Unknown, before the Stage is considered Unhealthy. This is
+	// useful for catching Argo CD Applications that never finish syncing or
+	// never become healthy. If not specified, there is no timeout.
+	Timeout *metav1.Duration `json:"timeout,omitempty" protobuf:"bytes,1,opt,name=timeout"`
+	// Interval is how often the health of the Stage is reassessed in the
+	// absence of any other changes. If not specified, this defaults to five
+	// minutes.
+	Interval *metav1.Duration `json:"interval,omitempty" protobuf:"bytes,2,opt,name=interval"`
+	// HTTP describes an optional HTTP endpoint, such as a smoke test, that is
+	// polled as part of assessing the health of the Stage. Its result is
+	// combined with the health of any Argo CD Applications the Stage updates.
+	HTTP *HTTPHealthCheck `json:"http,omitempty" protobuf:"bytes,3,opt,name=http"`
+}
+
+// HTTPHealthCheck describes an HTTP endpoint that is polled as part of
+// assessing the health of a Stage. If credentials of type http are found for
+// the URL, they are used for basic authentication.
+type HTTPHealthCheck struct {
+	// URL is the address of the endpoint. This is a required field.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^https?://.+$`
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// ExpectedStatus is the HTTP status code the endpoint is expected to respond
+	// with when the Stage is healthy. If not specified, this defaults to 200.
+	//
+	// +kubebuilder:validation:Minimum=100
+	// +kubebuilder:validation:Maximum=599
+	// +optional
+	ExpectedStatus int32 `json:"expectedStatus,omitempty" protobuf:"varint,2,opt,name=expectedStatus"`
+	// Timeout is how long to wait for the endpoint to respond. It may not
+	// exceed one minute. If not specified, this defaults to ten seconds.
+	Timeout *metav1.Duration `json:"timeout,omitempty" protobuf:"bytes,3,opt,name=timeout"`
 }
 
 // Subscriptions describes a Stage's sources of Freight.
@@ -194,6 +316,80 @@ type PromotionMechanisms struct {
 	// updates specified by the GitRepoUpdates field, if any, are applied BEFORE
 	// these.
 	ArgoCDAppUpdates []ArgoCDAppUpdate `json:"argoCDAppUpdates,omitempty" protobuf:"bytes,2,rep,name=argoCDAppUpdates"`
+	// Jobs describes containers that should be run, as Kubernetes Jobs in the
+	// Stage's namespace, to incorporate Freight into the Stage. Jobs are run one
+	// at a time, in the order listed, and each must exit with a zero exit code
+	// before the next is started. This field is optional, as such actions are
+	// not required in all cases. Note that all updates specified by the
+	// GitRepoUpdates field, if any, are applied BEFORE these and all updates
+	// specified by the ArgoCDAppUpdates field, if any, are applied AFTER these.
+	//
+	// The order described above can be overridden by naming individual steps
+	// and listing, in the DependsOn field of any step, the names of the steps
+	// that must succeed before it is started. When any step specifies
+	// dependencies, all steps are carried out one at a time, in an order that
+	// honors those dependencies and otherwise preserves the order described
+	// above.
+	Jobs []PromotionJob `json:"jobs,omitempty" protobuf:"bytes,3,rep,name=jobs"`
+}
+
+// PromotionJob describes a container that should be run, as a Kubernetes Job,
+// to incorporate Freight into a Stage. The Job succeeds only if the container
+// exits with a zero exit code. Details of the Freight being promoted are made
+// available to the container through the following environment variables:
+// KARGO_PROJECT, KARGO_STAGE, KARGO_PROMOTION, KARGO_FREIGHT (the Freight's
+// ID) and KARGO_FREIGHT_JSON (a JSON representation of the Freight's
+// artifacts).
+type PromotionJob struct {
+	// Name uniquely identifies this Job among all Jobs of the Stage. This is a
+	// required field.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=40
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Image is the container image to run. This is a required field.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Image string `json:"image" protobuf:"bytes,2,opt,name=image"`
+	// Command overrides the image's entrypoint. This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	Command []string `json:"command,omitempty" protobuf:"bytes,3,rep,name=command"`
+	// Args are the arguments passed to the entrypoint. This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	Args []string `json:"args,omitempty" protobuf:"bytes,4,rep,name=args"`
+	// Env lists additional environment variables to set in the container.
+	// Variables set by Kargo cannot be overridden. This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	Env []corev1.EnvVar `json:"env,omitempty" protobuf:"bytes,5,rep,name=env"`
+	// Resources describes the compute resources required by the container. This
+	// field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty" protobuf:"bytes,6,opt,name=resources"`
+	// ServiceAccountName is the name of a ServiceAccount in the Stage's
+	// namespace to run the container as. This field is optional. When left
+	// unspecified, the namespace's default ServiceAccount is used. The
+	// ServiceAccount's token is not mounted into the container, and whoever
+	// adds or changes a Job must be permitted to impersonate the
+	// ServiceAccount.
+	//
+	// +kubebuilder:validation:Optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty" protobuf:"bytes,7,opt,name=serviceAccountName"`
+	// Timeout is the maximum amount of time the Job may run for. If the
+	// container has not exited once this time has elapsed, it is terminated and
+	// the Promotion fails. When left unspecified, no timeout is enforced.
+	//
+	// +kubebuilder:validation:Optional
+	Timeout *metav1.Duration `json:"timeout,omitempty" protobuf:"bytes,8,opt,name=timeout"`
+	// DependsOn lists the names of other steps of the Stage's promotion
+	// mechanisms that must succeed before this Job is started.
+	//
+	// +kubebuilder:validation:Optional
+	DependsOn []string `json:"dependsOn,omitempty" protobuf:"bytes,9,rep,name=dependsOn"`
 }
 
 // GitRepoUpdate describes updates that should be applied to a Git repository
@@ -230,14 +426,61 @@ type GitRepoUpdate struct {
 	// PullRequest will generate a pull request instead of making the commit directly
 	PullRequest *PullRequestPromotionMechanism `json:"pullRequest,omitempty" protobuf:"bytes,5,opt,name=pullRequest"`
 	// Render describes how to use Kargo Render to incorporate Freight into the
-	// Stage. This is mutually exclusive with the Kustomize and Helm fields.
+	// Stage. This is mutually exclusive with the Kustomize, Helm, and YAML
+	// fields.
 	Render *KargoRenderPromotionMechanism `json:"render,omitempty" protobuf:"bytes,6,opt,name=render"`
 	// Kustomize describes how to use Kustomize to incorporate Freight into the
-	// Stage. This is mutually exclusive with the Render and Helm fields.
+	// Stage. This is mutually exclusive with the Render, Helm, and YAML fields.
 	Kustomize *KustomizePromotionMechanism `json:"kustomize,omitempty" protobuf:"bytes,7,opt,name=kustomize"`
 	// Helm describes how to use Helm to incorporate Freight into the Stage. This
-	// is mutually exclusive with the Render and Kustomize fields.
+	// is mutually exclusive with the Render, Kustomize, and YAML fields.
 	Helm *HelmPromotionMechanism `json:"helm,omitempty" protobuf:"bytes,8,opt,name=helm"`
+	// Timeout is the maximum amount of time permitted for this update to be
+	// carried out in its entirety. This includes cloning the repository,
+	// applying configuration changes and pushing the resulting commit. If the
+	// update does not complete within this time, the Promotion fails. When left
+	// unspecified, no timeout is enforced.
+	//
+	// +kubebuilder:validation:Optional
+	Timeout *metav1.Duration `json:"timeout,omitempty" protobuf:"bytes,9,opt,name=timeout"`
+	// Name optionally identifies this update so that other steps of the Stage's
+	// promotion mechanisms can depend on it. When specified, it must be unique
+	// among the names of all such steps, including Jobs.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=40
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name,omitempty" protobuf:"bytes,10,opt,name=name"`
+	// DependsOn lists the names of other steps of the Stage's promotion
+	// mechanisms that must succeed before this update is carried out.
+	//
+	// +kubebuilder:validation:Optional
+	DependsOn []string `json:"dependsOn,omitempty" protobuf:"bytes,11,rep,name=dependsOn"`
+	// YAML describes how to incorporate Freight into the Stage by directly
+	// updating values in plain YAML files, such as Kubernetes manifests that are
+	// not managed by any configuration management tool. This is mutually
+	// exclusive with the Render, Kustomize, and Helm fields.
+	//
+	// +kubebuilder:validation:Optional
+	YAML *YAMLPromotionMechanism `json:"yaml,omitempty" protobuf:"bytes,12,opt,name=yaml"`
+	// CommitMessageTemplate is a Go text/template used to render the message of
+	// the commit made by this update. The template may refer to .Project,
+	// .Stage, .Freight (the Freight being promoted, including its .Commits,
+	// .Images, and .Charts), and .Changes (a list of summaries of the changes
+	// that were applied). When unspecified, a message summarizing the changes
+	// is generated.
+	//
+	// +kubebuilder:validation:Optional
+	CommitMessageTemplate string `json:"commitMessageTemplate,omitempty" protobuf:"bytes,13,opt,name=commitMessageTemplate"`
+	// SignCommits specifies whether commits made by this update, whether
+	// pushed directly to the WriteBranch or to a pull request branch, should be
+	// GPG-signed. The ASCII-armored private key is read from the signingKey
+	// field of the Secret holding credentials for the repository, and the
+	// update fails if no such key is found. The controller's Git author name
+	// and email should match the key's identity.
+	//
+	// +kubebuilder:validation:Optional
+	SignCommits bool `json:"signCommits,omitempty" protobuf:"varint,14,opt,name=signCommits"`
 }
 
 // PullRequestPromotionMechanism describes how to generate a pull request against the write branch during promotion
@@ -247,6 +490,24 @@ type PullRequestPromotionMechanism struct {
 	GitHub *GitHubPullRequest `json:"github,omitempty" protobuf:"bytes,1,opt,name=github"`
 	// GitLab indicates git provider is GitLab
 	GitLab *GitLabPullRequest `json:"gitlab,omitempty" protobuf:"bytes,2,opt,name=gitlab"`
+	// TitleTemplate is a Go text/template used to render the title of the pull
+	// request. The template may refer to .Project, .Stage, .Freight (the
+	// Freight being promoted), and .CommitMessage (the subject of the commit
+	// that was made to the pull request's branch). When unspecified, the
+	// commit message is used as the title.
+	//
+	// +kubebuilder:validation:Optional
+	TitleTemplate string `json:"titleTemplate,omitempty" protobuf:"bytes,3,opt,name=titleTemplate"`
+	// DescriptionTemplate is a Go text/template used to render the description
+	// of the pull request. It may refer to the same values as TitleTemplate.
+	// When unspecified, the title is used as the description.
+	//
+	// +kubebuilder:validation:Optional
+	DescriptionTemplate string `json:"descriptionTemplate,omitempty" protobuf:"bytes,4,opt,name=descriptionTemplate"`
+	// Labels are applied to the pull request when it is opened.
+	//
+	// +kubebuilder:validation:Optional
+	Labels []string `json:"labels,omitempty" protobuf:"bytes,5,rep,name=labels"`
 }
 
 type GitHubPullRequest struct {
@@ -265,6 +526,25 @@ type KargoRenderPromotionMechanism struct {
 	//
 	// +kubebuilder:validation:Optional
 	Images []KargoRenderImageUpdate `json:"images,omitempty" protobuf:"bytes,1,rep,name=images"`
+	// TargetBranch specifies the branch whose branch-specific configuration
+	// Kargo Render should use when rendering manifests. When unspecified, the
+	// WriteBranch of the enclosing GitRepoUpdate is used.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^(\w+([-/]\w+)*)?$`
+	TargetBranch string `json:"targetBranch,omitempty" protobuf:"bytes,2,opt,name=targetBranch"`
+	// UseDigests specifies whether, when the Images field is omitted, images
+	// should be passed to Kargo Render in the form <image name>@<digest>
+	// instead of <image name>:<tag>. This is mutually exclusive with the Images
+	// field.
+	//
+	// +kubebuilder:validation:Optional
+	UseDigests bool `json:"useDigests,omitempty" protobuf:"varint,3,opt,name=useDigests"`
+	// AllowEmpty specifies whether rendering is permitted to produce no
+	// manifests at all. By default, Kargo Render treats this as an error.
+	//
+	// +kubebuilder:validation:Optional
+	AllowEmpty bool `json:"allowEmpty,omitempty" protobuf:"varint,4,opt,name=allowEmpty"`
 }
 
 // KargoRenderImageUpdate describes how an image can be incorporated into a
@@ -294,10 +574,13 @@ type KustomizePromotionMechanism struct {
 // KustomizeImageUpdate describes how to run `kustomize edit set image`
 // for a given image.
 type KustomizeImageUpdate struct {
-	// Image specifies a container image (without tag). This is a required field.
+	// Image specifies a container image (without tag) whose version, as
+	// recorded in the Freight, is to be used. When omitted, NewName is used in
+	// its place, or, failing that, Name. At least one of Image or Name must be
+	// specified.
 	//
-	// +kubebuilder:validation:MinLength=1
-	Image string `json:"image" protobuf:"bytes,1,opt,name=image"`
+	// +kubebuilder:validation:Optional
+	Image string `json:"image,omitempty" protobuf:"bytes,1,opt,name=image"`
 	// Path specifies a path in which the `kustomize edit set image` command
 	// should be executed. This is a required field.
 	//
@@ -309,6 +592,18 @@ type KustomizeImageUpdate struct {
 	//
 	// +kubebuilder:validation:Optional
 	UseDigest bool `json:"useDigest" protobuf:"varint,3,opt,name=useDigest"`
+	// Name specifies the image name, as referenced by the manifests in Path,
+	// that is to be replaced. This is only needed when manifests reference an
+	// image under a name that differs from the one in the Freight. When
+	// omitted, Image is used in its place.
+	//
+	// +kubebuilder:validation:Optional
+	Name string `json:"name,omitempty" protobuf:"bytes,4,opt,name=name"`
+	// NewName specifies the image name that references to Name should be
+	// replaced with. When omitted, Image is used in its place.
+	//
+	// +kubebuilder:validation:Optional
+	NewName string `json:"newName,omitempty" protobuf:"bytes,5,opt,name=newName"`
 }
 
 // HelmPromotionMechanism describes how to use Helm to incorporate Freight into
@@ -320,6 +615,9 @@ type HelmPromotionMechanism struct {
 	// Charts describes how specific chart versions can be incorporated into an
 	// umbrella chart.
 	Charts []HelmChartDependencyUpdate `json:"charts,omitempty" protobuf:"bytes,2,rep,name=charts"`
+	// OCIArtifacts describes how specific OCI artifact versions can be
+	// incorporated into Helm values files.
+	OCIArtifacts []HelmOCIArtifactUpdate `json:"ociArtifacts,omitempty" protobuf:"bytes,3,rep,name=ociArtifacts"`
 }
 
 // HelmImageUpdate describes how a specific image version can be incorporated
@@ -336,8 +634,9 @@ type HelmImageUpdate struct {
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:Pattern=^[\w-\.]+(/[\w-\.]+)*$
 	ValuesFilePath string `json:"valuesFilePath" protobuf:"bytes,2,opt,name=valuesFilePath"`
-	// Key specifies a key within the Helm values file that is to be updated. This
-	// is a required field.
+	// Key specifies a key within the Helm values file that is to be updated.
+	// Nested keys are separated by dots and elements of sequences are selected
+	// by index, e.g. images[0].tag or images.0.tag. This is a required field.
 	//
 	// +kubebuilder:validation:MinLength=1
 	Key string `json:"key" protobuf:"bytes,3,opt,name=key"`
@@ -355,6 +654,41 @@ type HelmImageUpdate struct {
 	Value ImageUpdateValueType `json:"value" protobuf:"bytes,4,opt,name=value"`
 }
 
+// HelmOCIArtifactUpdate describes how a specific OCI artifact version can be
+// incorporated into a specific Helm values file.
+type HelmOCIArtifactUpdate struct {
+	// RepoURL specifies an OCI artifact repository (without tag or digest). This
+	// is a required field.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^(\w+([\.-]\w+)*(:[\d]+)?/)?(\w+([\.-]\w+)*)(/\w+([\.-]\w+)*)*$`
+	RepoURL string `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
+	// ValuesFilePath specifies a path to the Helm values file that is to be
+	// updated. This is a required field.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=^[\w-\.]+(/[\w-\.]+)*$
+	ValuesFilePath string `json:"valuesFilePath" protobuf:"bytes,2,opt,name=valuesFilePath"`
+	// Key specifies a key within the Helm values file that is to be updated.
+	// Nested keys are separated by dots and elements of sequences are selected
+	// by index, e.g. images[0].tag or images.0.tag. This is a required field.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key" protobuf:"bytes,3,opt,name=key"`
+	// Value specifies the new value for the specified key in the specified Helm
+	// values file. Valid values are:
+	//
+	// - ImageAndTag: Replaces the value of the specified key with
+	//   <repo URL>:<tag>
+	// - Tag: Replaces the value of the specified key with just the new tag
+	// - ImageAndDigest: Replaces the value of the specified key with
+	//   <repo URL>@<digest>
+	// - Digest: Replaces the value of the specified key with just the new digest.
+	//
+	// This is a required field.
+	Value ImageUpdateValueType `json:"value" protobuf:"bytes,4,opt,name=value"`
+}
+
 // HelmChartDependencyUpdate describes how a specific Helm chart that is used
 // as a subchart of an umbrella chart can be updated.
 type HelmChartDependencyUpdate struct {
@@ -384,6 +718,51 @@ type HelmChartDependencyUpdate struct {
 	ChartPath string `json:"chartPath" protobuf:"bytes,3,opt,name=chartPath"`
 }
 
+// YAMLPromotionMechanism describes how to incorporate Freight into a Stage by
+// directly updating values in plain YAML files.
+type YAMLPromotionMechanism struct {
+	// Images describes how specific image versions can be incorporated into
+	// YAML files.
+	//
+	// +kubebuilder:validation:MinItems=1
+	Images []YAMLImageUpdate `json:"images" protobuf:"bytes,1,rep,name=images"`
+}
+
+// YAMLImageUpdate describes how a specific image version can be incorporated
+// into a specific YAML file.
+type YAMLImageUpdate struct {
+	// Image specifies a container image (without tag). This is a required field.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^(\w+([\.-]\w+)*(:[\d]+)?/)?(\w+([\.-]\w+)*)(/\w+([\.-]\w+)*)*$`
+	Image string `json:"image" protobuf:"bytes,1,opt,name=image"`
+	// FilePath specifies a path to the YAML file that is to be updated. This is
+	// a required field.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=^[\w-\.]+(/[\w-\.]+)*$
+	FilePath string `json:"filePath" protobuf:"bytes,2,opt,name=filePath"`
+	// Key specifies a key within the YAML file that is to be updated. Nested
+	// keys are separated by dots and elements of sequences are selected by
+	// index, e.g. spec.template.spec.containers[0].image. This is a required
+	// field.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key" protobuf:"bytes,3,opt,name=key"`
+	// Value specifies the new value for the specified key in the specified YAML
+	// file. Valid values are:
+	//
+	// - ImageAndTag: Replaces the value of the specified key with
+	//   <image name>:<tag>
+	// - Tag: Replaces the value of the specified key with just the new tag
+	// - ImageAndDigest: Replaces the value of the specified key with
+	//   <image name>@<digest>
+	// - Digest: Replaces the value of the specified key with just the new digest.
+	//
+	// This is a required field.
+	Value ImageUpdateValueType `json:"value" protobuf:"bytes,4,opt,name=value"`
+}
+
 // ArgoCDAppUpdate describes updates that should be applied to an Argo CD
 // Application resources to incorporate Freight into a Stage.
 type ArgoCDAppUpdate struct {
@@ -403,6 +782,80 @@ type ArgoCDAppUpdate struct {
 	// SourceUpdates describes updates to be applied to various sources of the
 	// specified Argo CD Application resource.
 	SourceUpdates []ArgoCDSourceUpdate `json:"sourceUpdates,omitempty" protobuf:"bytes,3,rep,name=sourceUpdates"`
+	// Timeout is the maximum amount of time to wait for the sync operation
+	// initiated for the specified Argo CD Application resource to complete. If
+	// the operation is still running once this time has elapsed, the Promotion
+	// fails. When WaitForHealthy is true, the time spent waiting for the
+	// Application to become Synced and Healthy also counts toward this timeout.
+	// When left unspecified, no timeout is enforced.
+	//
+	// +kubebuilder:validation:Optional
+	Timeout *metav1.Duration `json:"timeout,omitempty" protobuf:"bytes,4,opt,name=timeout"`
+	// Name optionally identifies this update so that other steps of the Stage's
+	// promotion mechanisms can depend on it. When specified, it must be unique
+	// among the names of all such steps, including Jobs.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=40
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name,omitempty" protobuf:"bytes,5,opt,name=name"`
+	// DependsOn lists the names of other steps of the Stage's promotion
+	// mechanisms that must succeed before this update is carried out.
+	//
+	// +kubebuilder:validation:Optional
+	DependsOn []string `json:"dependsOn,omitempty" protobuf:"bytes,6,rep,name=dependsOn"`
+	// Sync describes how the sync operation initiated for the specified Argo CD
+	// Application resource is to be carried out. When left unspecified, the
+	// sync uses the options of the Application's sync policy and the Promotion
+	// waits for it to complete.
+	//
+	// +kubebuilder:validation:Optional
+	Sync *ArgoCDAppSync `json:"sync,omitempty" protobuf:"bytes,7,opt,name=sync"`
+	// WaitForHealthy specifies whether, once the sync operation initiated for
+	// the specified Argo CD Application resource has succeeded, the Promotion
+	// also waits for the Application to be Synced and Healthy before it is
+	// considered successful. If Timeout elapses first, the Promotion fails.
+	// Timeout must be specified when this is true.
+	//
+	// +kubebuilder:validation:Optional
+	WaitForHealthy bool `json:"waitForHealthy,omitempty" protobuf:"varint,8,opt,name=waitForHealthy"`
+	// Instance specifies the name of the Argo CD instance that manages the
+	// specified Argo CD Application resource. It must match the name of one of
+	// the additional Argo CD instances the controller has been configured to
+	// connect to. If left unspecified, the controller's default Argo CD instance
+	// is used.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Instance string `json:"instance,omitempty" protobuf:"bytes,9,opt,name=instance"`
+}
+
+// ArgoCDAppSync describes how the sync operation initiated for an Argo CD
+// Application resource as part of a Promotion is to be carried out.
+type ArgoCDAppSync struct {
+	// Prune specifies whether the sync deletes resources that are no longer
+	// defined by the Application's sources.
+	Prune bool `json:"prune,omitempty" protobuf:"varint,1,opt,name=prune"`
+	// SelfHeal, when specified, enables or disables self-healing in the
+	// Application's automated sync policy before the sync is initiated. It may
+	// only be specified for Applications that have an automated sync policy.
+	//
+	// +kubebuilder:validation:Optional
+	SelfHeal *bool `json:"selfHeal,omitempty" protobuf:"varint,2,opt,name=selfHeal"`
+	// Options are sync options, in the form <key>=<value>, that are applied to
+	// the sync in addition to those of the Application's sync policy. Where both
+	// specify an option with the same key, the value specified here wins. e.g.
+	// PruneLast=true or ServerSideApply=true.
+	//
+	// +kubebuilder:validation:Optional
+	Options []string `json:"options,omitempty" protobuf:"bytes,3,rep,name=options"`
+	// Wait specifies whether the Promotion waits for the sync to complete
+	// successfully before it is considered successful itself. When false, the
+	// Promotion proceeds as soon as the sync has been initiated.
+	//
+	// +kubebuilder:default=true
+	Wait *bool `json:"wait,omitempty" protobuf:"varint,4,opt,name=wait"`
 }
 
 // ArgoCDSourceUpdate describes updates that should be applied to one of an Argo
@@ -438,6 +891,15 @@ type ArgoCDSourceUpdate struct {
 	Kustomize *ArgoCDKustomize `json:"kustomize,omitempty" protobuf:"bytes,4,opt,name=kustomize"`
 	// Helm describes updates to the source's Helm-specific attributes.
 	Helm *ArgoCDHelm `json:"helm,omitempty" protobuf:"bytes,5,opt,name=helm"`
+	// Ref optionally narrows down which of an Argo CD Application's sources this
+	// update is intended for to the one source whose own ref field has this
+	// value. This is useful when an Application has multiple sources that
+	// reference the same repository. When specified, exactly one of the
+	// Application's sources must have this ref and that source must also match
+	// RepoURL and Chart, otherwise the Promotion fails.
+	//
+	// +kubebuilder:validation:Optional
+	Ref string `json:"ref,omitempty" protobuf:"bytes,6,opt,name=ref"`
 }
 
 // ArgoCDKustomize describes updates to an Argo CD Application source's
@@ -529,6 +991,11 @@ type StageStatus struct {
 	CurrentPromotion *PromotionInfo `json:"currentPromotion,omitempty" protobuf:"bytes,7,opt,name=currentPromotion"`
 	// LastPromotion is a reference to the last completed promotion.
 	LastPromotion *PromotionInfo `json:"lastPromotion,omitempty" protobuf:"bytes,10,opt,name=lastPromotion"`
+	// PromotionHistory is a stack of records describing the outcomes of recent
+	// Promotions to this Stage. By default, the last ten records are stored.
+	// Unlike Promotion resources, which may be garbage collected, these records
+	// persist for as long as the Stage does.
+	PromotionHistory PromotionRecordStack `json:"promotionHistory,omitempty" protobuf:"bytes,12,rep,name=promotionHistory"`
 }
 
 // FreightReference is a simplified representation of a piece of Freight -- not
@@ -538,20 +1005,53 @@ type FreightReference struct {
 	// the contents of the Freight. i.e. Two pieces of Freight can be compared for
 	// equality by comparing their Names.
 	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
-	// Warehouse is the name of the Warehouse that created this Freight.
+	// Warehouse is the name of the Warehouse that created this Freight. It is
+	// retained for backward compatibility. New consumers should prefer
+	// Provenance, which also records this.
 	Warehouse string `json:"warehouse,omitempty" protobuf:"bytes,6,opt,name=warehouse"`
+	// Provenance describes where this Freight came from before it was promoted
+	// to the Stage.
+	Provenance *FreightProvenance `json:"provenance,omitempty" protobuf:"bytes,9,opt,name=provenance"`
 	// Commits describes specific Git repository commits.
 	Commits []GitCommit `json:"commits,omitempty" protobuf:"bytes,2,rep,name=commits"`
 	// Images describes specific versions of specific container images.
 	Images []Image `json:"images,omitempty" protobuf:"bytes,3,rep,name=images"`
 	// Charts describes specific versions of specific Helm charts.
 	Charts []Chart `json:"charts,omitempty" protobuf:"bytes,4,rep,name=charts"`
+	// OCIArtifacts describes specific versions of specific OCI artifacts.
+	OCIArtifacts []OCIArtifact `json:"ociArtifacts,omitempty" protobuf:"bytes,8,rep,name=ociArtifacts"`
+	// HTTPArtifacts describes specific versions of artifacts served at HTTP/S
+	// URLs.
+	HTTPArtifacts []HTTPArtifact `json:"httpArtifacts,omitempty" protobuf:"bytes,10,rep,name=httpArtifacts"`
 	// VerificationInfo is information about any verification process that was
 	// associated with this Freight for this Stage.
 	VerificationInfo *VerificationInfo `json:"verificationInfo,omitempty" protobuf:"bytes,5,opt,name=verificationInfo"`
 	// VerificationHistory is a stack of recent VerificationInfo. By default,
 	// the last ten VerificationInfo are stored.
 	VerificationHistory VerificationInfoStack `json:"verificationHistory,omitempty" protobuf:"bytes,7,rep,name=verificationHistory"`
+	// HealthyAt is the time at which the Stage was first observed to be
+	// Healthy, or to have no health checks to perform, while this was its
+	// current Freight. It is nil if the Stage was never observed to be Healthy
+	// with this Freight.
+	HealthyAt *metav1.Time `json:"healthyAt,omitempty" protobuf:"bytes,11,opt,name=healthyAt"`
+}
+
+// FreightProvenance describes the lineage of Freight that has been promoted to
+// a Stage.
+type FreightProvenance struct {
+	// Warehouse is the name of the Warehouse that created the Freight.
+	Warehouse string `json:"warehouse,omitempty" protobuf:"bytes,1,opt,name=warehouse"`
+	// UpstreamStage is the name of the upstream Stage in which the Freight had
+	// been verified when it was promoted. It is empty if the Freight was
+	// promoted directly from the Warehouse, or was promoted only because it had
+	// been manually approved or the Promotion was forced.
+	UpstreamStage string `json:"upstreamStage,omitempty" protobuf:"bytes,2,opt,name=upstreamStage"`
+	// PromotedAt is the time at which promotion of the Freight to the Stage
+	// began.
+	PromotedAt *metav1.Time `json:"promotedAt,omitempty" protobuf:"bytes,3,opt,name=promotedAt"`
+	// Promotion is the name of the Promotion that promoted the Freight to the
+	// Stage.
+	Promotion string `json:"promotion,omitempty" protobuf:"bytes,4,opt,name=promotion"`
 }
 
 type FreightReferenceStack []FreightReference
@@ -581,10 +1081,77 @@ func (f *FreightReferenceStack) UpdateOrPush(freight ...FreightReference) {
 
 	*f = append(newStack, *f...)
 
-	const maxSize = 10
-	if len(*f) > maxSize {
-		*f = (*f)[:maxSize]
+	if len(*f) > maxFreightHistoryLength {
+		*f = (*f)[:maxFreightHistoryLength]
+	}
+}
+
+// LastHealthy returns the most recent FreightReference in the stack that the
+// Stage was known to be Healthy with, skipping any FreightReference with the
+// provided name. This is the Freight a Stage can be rolled back to if its
+// current Freight, whose name is provided, proves to be bad. It returns nil if
+// there is no such FreightReference.
+func (f FreightReferenceStack) LastHealthy(current string) *FreightReference {
+	for i := range f {
+		if f[i].Name != current && f[i].HealthyAt != nil {
+			return &f[i]
+		}
+	}
+	return nil
+}
+
+// maxFreightHistoryLength is the maximum number of items in a
+// FreightReferenceStack.
+const maxFreightHistoryLength = 10
+
+// Prune removes items from the stack that the provided HistoryRetention policy
+// does not permit retaining as of the provided time. The item whose name is
+// current, if any, is never removed, but does count toward the policy's
+// MaxLength. The order of the remaining items is preserved.
+func (f *FreightReferenceStack) Prune(
+	retention *HistoryRetention,
+	current string,
+	now time.Time,
+) {
+	if retention == nil {
+		return
+	}
+	maxLength := maxFreightHistoryLength
+	if retention.MaxLength > 0 && int(retention.MaxLength) < maxLength {
+		maxLength = int(retention.MaxLength)
+	}
+	isCurrent := func(item FreightReference) bool {
+		return current != "" && item.Name == current
+	}
+	// Room is reserved for the current item so that retaining it never causes
+	// the stack to exceed maxLength.
+	budget := maxLength
+	if slices.ContainsFunc(*f, isCurrent) {
+		budget--
+	}
+	pruned := make(FreightReferenceStack, 0, len(*f))
+	for _, item := range *f {
+		if isCurrent(item) {
+			pruned = append(pruned, item)
+			continue
+		}
+		if budget <= 0 || item.promotedBefore(retention.MaxAge, now) {
+			continue
+		}
+		pruned = append(pruned, item)
+		budget--
 	}
+	*f = pruned
+}
+
+// promotedBefore returns true if the FreightReference is known to have been
+// promoted more than the provided maximum age before the provided time. It
+// returns false if maxAge is nil.
+func (f FreightReference) promotedBefore(maxAge *metav1.Duration, now time.Time) bool {
+	if maxAge == nil || f.Provenance == nil || f.Provenance.PromotedAt == nil {
+		return false
+	}
+	return now.Sub(f.Provenance.PromotedAt.Time) > maxAge.Duration
 }
 
 // Image describes a specific version of a container image.
@@ -618,6 +1185,30 @@ type Chart struct {
 	Version string `json:"version,omitempty" protobuf:"bytes,3,opt,name=version"`
 }
 
+// OCIArtifact describes a specific version of an arbitrary artifact stored in
+// an OCI registry.
+type OCIArtifact struct {
+	// RepoURL describes the repository in which the artifact can be found.
+	RepoURL string `json:"repoURL,omitempty" protobuf:"bytes,1,opt,name=repoURL"`
+	// Tag is the tag that referenced the artifact when it was discovered, if
+	// any.
+	Tag string `json:"tag,omitempty" protobuf:"bytes,2,opt,name=tag"`
+	// Digest is the digest of the artifact's manifest. It identifies a specific
+	// version of the artifact in the repository specified by RepoURL.
+	Digest string `json:"digest,omitempty" protobuf:"bytes,3,opt,name=digest"`
+}
+
+// HTTPArtifact describes a specific version of an artifact served at an
+// HTTP/S URL.
+type HTTPArtifact struct {
+	// URL is the URL at which the artifact is served.
+	URL string `json:"url,omitempty" protobuf:"bytes,1,opt,name=url"`
+	// Version identifies the version of the artifact that was served at URL
+	// when it was discovered. It is either a value extracted from the response
+	// or, if no extraction was configured, the SHA-256 digest of the response.
+	Version string `json:"version,omitempty" protobuf:"bytes,2,opt,name=version"`
+}
+
 // Equals returns a bool indicating whether two GitCommits are equivalent.
 func (g *GitCommit) Equals(rhs *GitCommit) bool {
 	if g == nil && rhs == nil {
@@ -639,6 +1230,10 @@ type Health struct {
 	Issues []string `json:"issues,omitempty" protobuf:"bytes,2,rep,name=issues"`
 	// ArgoCDApps describes the current state of any related ArgoCD Applications.
 	ArgoCDApps []ArgoCDAppStatus `json:"argoCDApps,omitempty" protobuf:"bytes,3,rep,name=argoCDApps"`
+	// UnresolvedSince is the time at which the Stage's health was first found
+	// to be Progressing or Unknown. It is used to enforce the timeout of the
+	// Stage's health check and is cleared once its health is resolved.
+	UnresolvedSince *metav1.Time `json:"unresolvedSince,omitempty" protobuf:"bytes,4,opt,name=unresolvedSince"`
 }
 
 // ArgoCDAppStatus describes the current state of a single ArgoCD Application.
@@ -684,6 +1279,57 @@ type PromotionInfo struct {
 	Status *PromotionStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
 }
 
+// PromotionRecord is a condensed record of a concluded Promotion.
+type PromotionRecord struct {
+	// Name is the name of the Promotion.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Freight is the name of the Freight that was promoted.
+	Freight string `json:"freight" protobuf:"bytes,2,opt,name=freight"`
+	// Actor identifies who requested the Promotion, if known.
+	Actor string `json:"actor,omitempty" protobuf:"bytes,3,opt,name=actor"`
+	// Phase is the terminal phase the Promotion concluded in.
+	Phase PromotionPhase `json:"phase" protobuf:"bytes,4,opt,name=phase"`
+	// Message is the message the Promotion concluded with, if any.
+	Message string `json:"message,omitempty" protobuf:"bytes,5,opt,name=message"`
+	// StartedAt is the time at which the Promotion started running.
+	StartedAt *metav1.Time `json:"startedAt,omitempty" protobuf:"bytes,6,opt,name=startedAt"`
+	// FinishedAt is the time at which the Promotion concluded.
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,7,opt,name=finishedAt"`
+}
+
+type PromotionRecordStack []PromotionRecord
+
+// UpdateOrPush updates the PromotionRecord with the same name as the provided
+// PromotionRecord or appends the provided PromotionRecord to the stack if no
+// such PromotionRecord is found.
+//
+// The order of existing items in the stack is preserved, and new items without
+// a matching name are appended to the top of the stack. If the stack grows
+// beyond 10 items, the bottom items are removed.
+func (p *PromotionRecordStack) UpdateOrPush(records ...PromotionRecord) {
+	var newStack PromotionRecordStack
+	for _, r := range records {
+		var found bool
+		for pi, item := range *p {
+			if r.Name == item.Name {
+				(*p)[pi] = r
+				found = true
+				break
+			}
+		}
+		if !found {
+			newStack = append(newStack, r)
+		}
+	}
+
+	*p = append(newStack, *p...)
+
+	const maxSize = 10
+	if len(*p) > maxSize {
+		*p = (*p)[:maxSize]
+	}
+}
+
 // Verification describes how to verify that a Promotion has been successful
 // using Argo Rollouts AnalysisTemplates.
 type Verification struct {
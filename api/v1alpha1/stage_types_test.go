@@ -2,10 +2,140 @@ package v1alpha1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// TestStageProtoRoundTrip verifies that a fully populated Stage survives
+// serialization to and from its protobuf representation, as it does whenever
+// it is sent to or received from the API server.
+func TestStageProtoRoundTrip(t *testing.T) {
+	// Protobuf timestamps are decoded in the local time zone
+	testTime := metav1.NewTime(time.Unix(1700000000, 0))
+	disabled := false
+	freight := FreightReference{
+		Name:      "fake-freight",
+		Warehouse: "fake-warehouse",
+		Commits: []GitCommit{{
+			RepoURL: "https://github.com/akuity/kargo",
+			ID:      "fake-commit",
+			Branch:  "main",
+		}},
+		Images: []Image{{
+			RepoURL: "ghcr.io/akuity/kargo",
+			Tag:     "v1.0.0",
+			Digest:  "sha256:fake",
+		}},
+		Charts: []Chart{{
+			RepoURL: "oci://ghcr.io/akuity/charts",
+			Name:    "kargo",
+			Version: "1.0.0",
+		}},
+		HealthyAt: &testTime,
+	}
+	stage := &Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "fake-stage",
+			Namespace:   "fake-project",
+			Labels:      map[string]string{"fake-label": "fake-value"},
+			Annotations: map[string]string{"fake-annotation": "fake-value"},
+		},
+		Spec: StageSpec{
+			Shard: "fake-shard",
+			Subscriptions: Subscriptions{
+				UpstreamStages: []StageSubscription{{Name: "upstream"}},
+			},
+			PromotionMechanisms: &PromotionMechanisms{
+				GitRepoUpdates: []GitRepoUpdate{
+					{
+						RepoURL:     "https://github.com/akuity/kargo",
+						WriteBranch: "main",
+					},
+					{
+						RepoURL:     "https://github.com/akuity/kargo",
+						WriteBranch: "stage/fake",
+						YAML: &YAMLPromotionMechanism{
+							Images: []YAMLImageUpdate{{
+								Image:    "ghcr.io/akuity/kargo",
+								FilePath: "manifests/deployment.yaml",
+								Key:      "spec.template.spec.containers[0].image",
+								Value:    ImageUpdateValueTypeImageAndDigest,
+							}},
+						},
+					},
+				},
+				ArgoCDAppUpdates: []ArgoCDAppUpdate{
+					{
+						AppName:        "fake-app",
+						AppNamespace:   "argocd",
+						Timeout:        &metav1.Duration{Duration: 5 * time.Minute},
+						WaitForHealthy: true,
+					},
+					{
+						AppName:  "fake-synced-app",
+						Instance: "fake-instance",
+						Sync: &ArgoCDAppSync{
+							Prune: true,
+							// False must survive the round trip as distinct from unset
+							SelfHeal: &disabled,
+							Options:  []string{"PruneLast=true"},
+							Wait:     &disabled,
+						},
+					},
+				},
+			},
+			HealthCheck: &HealthCheck{
+				Timeout: &metav1.Duration{Duration: 10 * time.Minute},
+				HTTP: &HTTPHealthCheck{
+					URL:            "https://example.com/healthz",
+					ExpectedStatus: 204,
+				},
+			},
+			HistoryRetention: &HistoryRetention{
+				MaxLength: 5,
+				MaxAge:    &metav1.Duration{Duration: 7 * 24 * time.Hour},
+			},
+		},
+		Status: StageStatus{
+			Phase:          StagePhaseSteady,
+			CurrentFreight: &freight,
+			History:        FreightReferenceStack{freight},
+			Health: &Health{
+				Status:          HealthStateProgressing,
+				Issues:          []string{"fake-issue"},
+				UnresolvedSince: &testTime,
+			},
+			Message:            "fake-message",
+			ObservedGeneration: 2,
+			LastPromotion: &PromotionInfo{
+				Name:    "fake-promotion",
+				Freight: freight,
+			},
+		},
+	}
+
+	data, err := stage.Marshal()
+	require.NoError(t, err)
+	roundTripped := &Stage{}
+	require.NoError(t, roundTripped.Unmarshal(data))
+	require.Equal(t, stage, roundTripped)
+
+	// A minimal Stage, with no optional sub-messages, also survives the trip
+	minimal := &Stage{
+		ObjectMeta: metav1.ObjectMeta{Name: "fake-stage"},
+		Spec: StageSpec{
+			Subscriptions: Subscriptions{Warehouse: "fake-warehouse"},
+		},
+	}
+	data, err = minimal.Marshal()
+	require.NoError(t, err)
+	roundTripped = &Stage{}
+	require.NoError(t, roundTripped.Unmarshal(data))
+	require.Equal(t, minimal, roundTripped)
+}
+
 func TestVerificationInfo_HasAnalysisRun(t *testing.T) {
 	testCases := []struct {
 		name           string
@@ -86,6 +216,200 @@ func TestFreightReferenceStackUpdateOrPush(t *testing.T) {
 	}
 }
 
+func TestFreightReferenceStackPrune(t *testing.T) {
+	now := time.Date(2024, time.January, 10, 0, 0, 0, 0, time.UTC)
+	promotedDaysAgo := func(name string, days int) FreightReference {
+		return FreightReference{
+			Name: name,
+			Provenance: &FreightProvenance{
+				PromotedAt: &metav1.Time{Time: now.Add(-time.Duration(days) * 24 * time.Hour)},
+			},
+		}
+	}
+	testCases := []struct {
+		name          string
+		stack         FreightReferenceStack
+		retention     *HistoryRetention
+		current       string
+		expectedStack FreightReferenceStack
+	}{
+		{
+			name:          "no retention policy",
+			stack:         FreightReferenceStack{{Name: "a"}, {Name: "b"}},
+			expectedStack: FreightReferenceStack{{Name: "a"}, {Name: "b"}},
+		},
+		{
+			name:          "count-based",
+			stack:         FreightReferenceStack{{Name: "a"}, {Name: "b"}, {Name: "c"}},
+			retention:     &HistoryRetention{MaxLength: 2},
+			current:       "a",
+			expectedStack: FreightReferenceStack{{Name: "a"}, {Name: "b"}},
+		},
+		{
+			name:          "count-based never prunes current",
+			stack:         FreightReferenceStack{{Name: "a"}, {Name: "b"}, {Name: "c"}},
+			retention:     &HistoryRetention{MaxLength: 2},
+			current:       "c",
+			expectedStack: FreightReferenceStack{{Name: "a"}, {Name: "c"}},
+		},
+		{
+			name:          "count-based with max length exceeding default",
+			stack:         FreightReferenceStack{{}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}},
+			retention:     &HistoryRetention{MaxLength: 20},
+			expectedStack: FreightReferenceStack{{}, {}, {}, {}, {}, {}, {}, {}, {}, {}},
+		},
+		{
+			name: "age-based",
+			stack: FreightReferenceStack{
+				promotedDaysAgo("a", 1),
+				promotedDaysAgo("b", 3),
+				{Name: "c"}, // Time of promotion unknown
+				promotedDaysAgo("d", 8),
+			},
+			retention: &HistoryRetention{
+				MaxAge: &metav1.Duration{Duration: 7 * 24 * time.Hour},
+			},
+			current: "a",
+			expectedStack: FreightReferenceStack{
+				promotedDaysAgo("a", 1),
+				promotedDaysAgo("b", 3),
+				{Name: "c"},
+			},
+		},
+		{
+			name: "age-based never prunes current",
+			stack: FreightReferenceStack{
+				promotedDaysAgo("a", 10),
+				promotedDaysAgo("b", 20),
+			},
+			retention: &HistoryRetention{
+				MaxAge: &metav1.Duration{Duration: 7 * 24 * time.Hour},
+			},
+			current:       "a",
+			expectedStack: FreightReferenceStack{promotedDaysAgo("a", 10)},
+		},
+		{
+			name: "count- and age-based",
+			stack: FreightReferenceStack{
+				promotedDaysAgo("a", 1),
+				promotedDaysAgo("b", 8),
+				promotedDaysAgo("c", 2),
+				promotedDaysAgo("d", 3),
+			},
+			retention: &HistoryRetention{
+				MaxLength: 2,
+				MaxAge:    &metav1.Duration{Duration: 7 * 24 * time.Hour},
+			},
+			current: "a",
+			expectedStack: FreightReferenceStack{
+				promotedDaysAgo("a", 1),
+				promotedDaysAgo("c", 2),
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.stack.Prune(testCase.retention, testCase.current, now)
+			require.Equal(t, testCase.expectedStack, testCase.stack)
+		})
+	}
+}
+
+func TestFreightReferenceStackLastHealthy(t *testing.T) {
+	healthyAt := &metav1.Time{Time: time.Date(2024, time.January, 10, 0, 0, 0, 0, time.UTC)}
+	testCases := []struct {
+		name     string
+		stack    FreightReferenceStack
+		current  string
+		expected *FreightReference
+	}{
+		{
+			name:    "empty stack",
+			current: "a",
+		},
+		{
+			name:    "only the current Freight was healthy",
+			stack:   FreightReferenceStack{{Name: "a", HealthyAt: healthyAt}, {Name: "b"}},
+			current: "a",
+		},
+		{
+			name: "most recent healthy Freight is selected",
+			stack: FreightReferenceStack{
+				{Name: "a"},
+				{Name: "b"},
+				{Name: "c", HealthyAt: healthyAt},
+				{Name: "d", HealthyAt: healthyAt},
+			},
+			current:  "a",
+			expected: &FreightReference{Name: "c", HealthyAt: healthyAt},
+		},
+		{
+			name: "current Freight is skipped wherever it is",
+			stack: FreightReferenceStack{
+				{Name: "b", HealthyAt: healthyAt},
+				{Name: "a", HealthyAt: healthyAt},
+			},
+			current:  "b",
+			expected: &FreightReference{Name: "a", HealthyAt: healthyAt},
+		},
+		{
+			name:     "no current Freight",
+			stack:    FreightReferenceStack{{Name: "a", HealthyAt: healthyAt}},
+			expected: &FreightReference{Name: "a", HealthyAt: healthyAt},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, testCase.stack.LastHealthy(testCase.current))
+		})
+	}
+}
+
+func TestPromotionRecordStackUpdateOrPush(t *testing.T) {
+	testCases := []struct {
+		name          string
+		stack         PromotionRecordStack
+		newRecords    []PromotionRecord
+		expectedStack PromotionRecordStack
+	}{
+		{
+			name:          "initial stack is nil",
+			stack:         nil,
+			newRecords:    []PromotionRecord{{Name: "foo"}, {Name: "bar"}},
+			expectedStack: PromotionRecordStack{{Name: "foo"}, {Name: "bar"}},
+		},
+		{
+			name:  "initial stack has matching names",
+			stack: PromotionRecordStack{{Name: "foo"}, {Name: "bar"}},
+			newRecords: []PromotionRecord{
+				{Name: "bar", Phase: PromotionPhaseSucceeded},
+				{Name: "baz"},
+			},
+			expectedStack: PromotionRecordStack{
+				{Name: "baz"},
+				{Name: "foo"},
+				{Name: "bar", Phase: PromotionPhaseSucceeded},
+			},
+		},
+		{
+			name: "initial stack is full",
+			stack: PromotionRecordStack{
+				{}, {}, {}, {}, {}, {}, {}, {}, {}, {},
+			},
+			newRecords: []PromotionRecord{{Name: "foo"}},
+			expectedStack: PromotionRecordStack{
+				{Name: "foo"}, {}, {}, {}, {}, {}, {}, {}, {}, {},
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.stack.UpdateOrPush(testCase.newRecords...)
+			require.Equal(t, testCase.expectedStack, testCase.stack)
+		})
+	}
+}
+
 func TestVerificationInfoStack_Current(t *testing.T) {
 	testCases := []struct {
 		name           string
@@ -174,3 +498,51 @@ func TestVerificationInfoStack_UpdateOrPush(t *testing.T) {
 		})
 	}
 }
+
+func TestParseImageUpdateValueType(t *testing.T) {
+	testCases := []struct {
+		name        string
+		value       string
+		expected    ImageUpdateValueType
+		expectedErr bool
+	}{
+		{
+			name:     "ImageAndTag",
+			value:    "ImageAndTag",
+			expected: ImageUpdateValueTypeImageAndTag,
+		},
+		{
+			name:     "Tag",
+			value:    "Tag",
+			expected: ImageUpdateValueTypeTag,
+		},
+		{
+			name:     "ImageAndDigest",
+			value:    "ImageAndDigest",
+			expected: ImageUpdateValueTypeImageAndDigest,
+		},
+		{
+			name:     "Digest",
+			value:    "Digest",
+			expected: ImageUpdateValueTypeDigest,
+		},
+		{
+			name:        "unsupported value",
+			value:       "tag",
+			expectedErr: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			valueType, err := ParseImageUpdateValueType(testCase.value)
+			if testCase.expectedErr {
+				require.ErrorContains(t, err, "unsupported image update value type")
+				require.False(t, ImageUpdateValueType(testCase.value).IsValid())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, valueType)
+			require.True(t, valueType.IsValid())
+		})
+	}
+}
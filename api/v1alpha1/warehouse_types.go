@@ -22,9 +22,32 @@ const (
 	ImageSelectionStrategySemVer      ImageSelectionStrategy = "SemVer"
 )
 
+// SelectionMode specifies which of the artifacts that satisfy all of a
+// subscription's other constraints should be selected.
+//
+// +kubebuilder:validation:Enum={Newest,Oldest}
+type SelectionMode string
+
+const (
+	// SelectionModeNewest selects the newest of the eligible artifacts.
+	SelectionModeNewest SelectionMode = "Newest"
+	// SelectionModeOldest selects the oldest of the eligible artifacts.
+	SelectionModeOldest SelectionMode = "Oldest"
+)
+
+const (
+	// WarehouseConditionTypeChartDowngradePrevented is the type of a Warehouse
+	// condition that is True when Freight production was withheld because the
+	// newest suitable version of a chart was older than the version referenced
+	// by the Warehouse's most recently produced Freight.
+	WarehouseConditionTypeChartDowngradePrevented = "ChartDowngradePrevented"
+)
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name=Shard,type=string,JSONPath=`.spec.shard`
+// +kubebuilder:printcolumn:name=Last Freight,type=string,JSONPath=`.status.lastFreight.name`
+// +kubebuilder:printcolumn:name=Last Freight Age,type=date,JSONPath=`.status.lastFreightTime`
 // +kubebuilder:printcolumn:name=Age,type=date,JSONPath=`.metadata.creationTimestamp`
 
 // Warehouse is a source of Freight.
@@ -60,6 +83,39 @@ type WarehouseSpec struct {
 	//
 	// +kubebuilder:validation:MinItems=1
 	Subscriptions []RepoSubscription `json:"subscriptions" protobuf:"bytes,1,rep,name=subscriptions"`
+	// FreightMetadata describes labels and annotations to be applied to each
+	// piece of Freight produced by this Warehouse. This is an optional field.
+	FreightMetadata *FreightMetadata `json:"freightMetadata,omitempty" protobuf:"bytes,3,opt,name=freightMetadata"`
+	// TrackFreightMetadata indicates whether the labels and annotations
+	// described by the FreightMetadata field should be incorporated into the ID
+	// of each piece of Freight produced by this Warehouse. When true, a change to
+	// that metadata alone results in new Freight being produced, even if the
+	// artifacts it references are unchanged. When false (the default), only
+	// changes to artifacts result in new Freight and the metadata is applied only
+	// at the time Freight is created.
+	TrackFreightMetadata bool `json:"trackFreightMetadata,omitempty" protobuf:"varint,4,opt,name=trackFreightMetadata"`
+	// PollingInterval is how often the Warehouse's subscriptions are checked
+	// for new artifacts. This is useful for slowing down polling of
+	// repositories that aggressively rate limit clients. This is an optional
+	// field. If not specified, the controller's default of five minutes applies.
+	// The interval may not be shorter than 30 seconds.
+	PollingInterval *metav1.Duration `json:"pollingInterval,omitempty" protobuf:"bytes,5,opt,name=pollingInterval"`
+	// RegistryTimeout bounds how long any single request to an image registry
+	// or chart repository, made while checking the Warehouse's subscriptions,
+	// may take. A registry that does not respond in time fails the check
+	// instead of stalling it indefinitely. This is an optional field. If not
+	// specified, the controller's default of one minute applies.
+	RegistryTimeout *metav1.Duration `json:"registryTimeout,omitempty" protobuf:"bytes,6,opt,name=registryTimeout"`
+}
+
+// FreightMetadata describes labels and annotations to be applied to Freight.
+type FreightMetadata struct {
+	// Labels is a map of labels to be applied to Freight. Keys using the
+	// kargo.akuity.io/ prefix are reserved and may not be used.
+	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,1,rep,name=labels"`
+	// Annotations is a map of annotations to be applied to Freight. Keys using
+	// the kargo.akuity.io/ prefix are reserved and may not be used.
+	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,2,rep,name=annotations"`
 }
 
 // RepoSubscription describes a subscription to ONE OF a Git repository, a
@@ -71,6 +127,13 @@ type RepoSubscription struct {
 	Image *ImageSubscription `json:"image,omitempty" protobuf:"bytes,2,opt,name=image"`
 	// Chart describes a subscription to a Helm chart repository.
 	Chart *ChartSubscription `json:"chart,omitempty" protobuf:"bytes,3,opt,name=chart"`
+	// OCIArtifact describes a subscription to a repository within an OCI
+	// registry that contains arbitrary artifacts (e.g. packaged configuration)
+	// rather than container images or Helm charts.
+	OCIArtifact *OCIArtifactSubscription `json:"ociArtifact,omitempty" protobuf:"bytes,4,opt,name=ociArtifact"`
+	// HTTPArtifact describes a subscription to an artifact served at an HTTP/S
+	// URL, such as a release manifest or a checksum file.
+	HTTPArtifact *HTTPArtifactSubscription `json:"httpArtifact,omitempty" protobuf:"bytes,5,opt,name=httpArtifact"`
 }
 
 // GitSubscription defines a subscription to a Git repository.
@@ -87,12 +150,13 @@ type GitSubscription struct {
 	//
 	// +kubebuilder:default=NewestFromBranch
 	CommitSelectionStrategy CommitSelectionStrategy `json:"commitSelectionStrategy,omitempty" protobuf:"bytes,2,opt,name=commitSelectionStrategy"`
-	// Branch references a particular branch of the repository. The value in this
-	// field only has any effect when the CommitSelectionStrategy is
-	// NewestFromBranch or left unspecified (which is implicitly the same as
-	// NewestFromBranch). This field is optional. When left unspecified, (and the
-	// CommitSelectionStrategy is NewestFromBranch or unspecified), the
-	// subscription is implicitly to the repository's default branch.
+	// Branch references a particular branch of the repository. When the
+	// CommitSelectionStrategy is NewestFromBranch or left unspecified (which is
+	// implicitly the same as NewestFromBranch), the newest commit on this branch
+	// is selected. With other strategies, commits are selected by tag, but the
+	// repository is still cloned from this branch. This field is optional. When
+	// left unspecified, the subscription is implicitly to the repository's
+	// default branch.
 	//
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:Pattern=`^\w+([-/]\w+)*$`
@@ -216,10 +280,158 @@ type ImageSubscription struct {
 	//
 	// +kubebuilder:validation:Optional
 	Platform string `json:"platform,omitempty" protobuf:"bytes,7,opt,name=platform"`
+	// Platforms is a list of strings of the form <os>/<arch>[/<variant>]. When
+	// specified, only images that are available for ALL of the listed platforms
+	// will be considered. The first entry is used to select an image in the same
+	// manner as the Platform field. This field is optional and is mutually
+	// exclusive with the Platform field.
+	//
+	// +kubebuilder:validation:Optional
+	Platforms []string `json:"platforms,omitempty" protobuf:"bytes,14,rep,name=platforms"`
 	// InsecureSkipTLSVerify specifies whether certificate verification errors
 	// should be ignored when connecting to the repository. This should be enabled
 	// only with great caution.
 	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty" protobuf:"varint,8,opt,name=insecureSkipTLSVerify"`
+	// CABundle optionally references a bundle of CA certificates that are
+	// trusted, in addition to the system's root certificates, when connecting
+	// to the registry. This is useful for registries that use self-signed
+	// certificates. It only affects connections made on behalf of this
+	// subscription and has no effect if InsecureSkipTLSVerify is true. This
+	// field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	CABundle *CABundle `json:"caBundle,omitempty" protobuf:"bytes,16,opt,name=caBundle"`
+	// DigestAllowlist optionally references a list of image digests that are
+	// permitted to be selected from the repository. When specified, images whose
+	// digests do not appear in the list are skipped, even if they would
+	// otherwise have been selected. This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	DigestAllowlist *DigestAllowlist `json:"digestAllowlist,omitempty" protobuf:"bytes,9,opt,name=digestAllowlist"`
+	// ExcludePlatforms is a list of strings of the form <os>/<arch>[/<variant>]
+	// that rules out tags whose images are available for any of the listed
+	// platforms. For a tag that references a multi-platform manifest list or
+	// index, the tag is ruled out if the list or index includes any listed
+	// platform, even if the platform specified by the Platform field is also
+	// available. An entry that omits the variant matches all variants of its
+	// OS/architecture. This is useful for avoiding tags known to publish a
+	// broken variant for some platform. This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	ExcludePlatforms []string `json:"excludePlatforms,omitempty" protobuf:"bytes,10,rep,name=excludePlatforms"`
+	// SelectionMode specifies whether the newest or the oldest of the images
+	// that satisfy all other constraints (SemverConstraint, AllowTags,
+	// IgnoreTags, etc.) should be selected. What "newest" and "oldest" mean is
+	// determined by the ImageSelectionStrategy. e.g. For SemVer, Oldest selects
+	// the semantically lowest eligible version, while for NewestBuild, it selects
+	// the eligible image that was built least recently. Oldest may not be
+	// specified when the ImageSelectionStrategy is Digest. This field is
+	// optional. When left unspecified, the field is implicitly treated as if its
+	// value were "Newest".
+	//
+	// +kubebuilder:default=Newest
+	SelectionMode SelectionMode `json:"selectionMode,omitempty" protobuf:"bytes,11,opt,name=selectionMode"`
+	// Discovery optionally enables discovery of image repositories within a
+	// registry. When specified, the RepoURL field is interpreted as a registry
+	// hostname, optionally followed by a path prefix (e.g. "ghcr.io/example"),
+	// instead of as the URL of a single image repository. The registry's catalog
+	// is then listed and every repository found therein that falls beneath that
+	// prefix and satisfies the criteria specified by this field is subscribed to
+	// using the remaining fields of this ImageSubscription. Note that many
+	// registries, including Docker Hub, do not support listing their catalogs.
+	// This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	Discovery *ImageRepositoryDiscovery `json:"discovery,omitempty" protobuf:"bytes,12,opt,name=discovery"`
+	// Digest optionally pins this subscription to the image with the specified
+	// digest, so that a mutable tag being moved can never change what is
+	// selected. This field may only be used when the ImageSelectionStrategy is
+	// Digest, in which case it takes precedence over the SemverConstraint field
+	// and the selected image is identified by its digest alone. This field is
+	// optional.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]+([+._-][a-z0-9]+)*:[a-zA-Z0-9=_-]+$`
+	Digest string `json:"digest,omitempty" protobuf:"bytes,13,opt,name=digest"`
+	// SignatureVerification optionally references a public key with which
+	// cosign signatures of candidate images must verify. When specified, images
+	// that are unsigned or whose signatures do not verify are skipped, even if
+	// they would otherwise have been selected. This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	SignatureVerification *ImageSignatureVerification `json:"signatureVerification,omitempty" protobuf:"bytes,15,opt,name=signatureVerification"`
+}
+
+// ImageRepositoryDiscovery describes how image repositories are to be
+// discovered within a registry.
+type ImageRepositoryDiscovery struct {
+	// RepoPattern is a regular expression that can optionally be used to limit
+	// the discovered repositories to those whose names match it. Names are
+	// matched in full, without the registry hostname, e.g. "example/api". This
+	// field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	RepoPattern string `json:"repoPattern,omitempty" protobuf:"bytes,1,opt,name=repoPattern"`
+	// MaxRepositories is the maximum number of repositories that may be
+	// discovered. Repositories beyond this limit are ignored. This field is
+	// optional. When left unspecified, the field is implicitly treated as if
+	// its value were 20.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=20
+	MaxRepositories int32 `json:"maxRepositories,omitempty" protobuf:"varint,2,opt,name=maxRepositories"`
+}
+
+// DigestAllowlist references a key within a ConfigMap whose value is a
+// newline-delimited list of image digests.
+type DigestAllowlist struct {
+	// ConfigMapName is the name of a ConfigMap in the Warehouse's namespace. This
+	// field is required.
+	//
+	// +kubebuilder:validation:MinLength=1
+	ConfigMapName string `json:"configMapName" protobuf:"bytes,1,opt,name=configMapName"`
+	// Key is the key within the ConfigMap's data whose value is the list of
+	// permitted digests. Blank lines and lines beginning with '#' are ignored.
+	// This field is optional. When left unspecified, it is implicitly treated as
+	// if its value were "digests".
+	//
+	// +kubebuilder:default=digests
+	Key string `json:"key,omitempty" protobuf:"bytes,2,opt,name=key"`
+}
+
+// ImageSignatureVerification references a key within a ConfigMap whose value
+// is a PEM-encoded public key with which cosign signatures of images must
+// verify.
+type ImageSignatureVerification struct {
+	// ConfigMapName is the name of a ConfigMap in the Warehouse's namespace. This
+	// field is required.
+	//
+	// +kubebuilder:validation:MinLength=1
+	ConfigMapName string `json:"configMapName" protobuf:"bytes,1,opt,name=configMapName"`
+	// Key is the key within the ConfigMap's data whose value is the public key.
+	// ECDSA, RSA, and Ed25519 keys are supported. This field is optional. When
+	// left unspecified, it is implicitly treated as if its value were
+	// "cosign.pub".
+	//
+	// +kubebuilder:default=cosign.pub
+	Key string `json:"key,omitempty" protobuf:"bytes,2,opt,name=key"`
+}
+
+// CABundle references a key within a ConfigMap whose value is a bundle of
+// PEM-encoded CA certificates.
+type CABundle struct {
+	// ConfigMapName is the name of a ConfigMap in the Warehouse's namespace. This
+	// field is required.
+	//
+	// +kubebuilder:validation:MinLength=1
+	ConfigMapName string `json:"configMapName" protobuf:"bytes,1,opt,name=configMapName"`
+	// Key is the key within the ConfigMap's data whose value is the CA bundle.
+	// This field is optional. When left unspecified, it is implicitly treated as
+	// if its value were "ca.crt".
+	//
+	// +kubebuilder:default=ca.crt
+	Key string `json:"key,omitempty" protobuf:"bytes,2,opt,name=key"`
 }
 
 // ChartSubscription defines a subscription to a Helm chart repository.
@@ -250,6 +462,115 @@ type ChartSubscription struct {
 	//
 	// +kubebuilder:validation:Optional
 	SemverConstraint string `json:"semverConstraint,omitempty" protobuf:"bytes,3,opt,name=semverConstraint"`
+	// AllowPrereleases specifies whether prerelease versions of the chart (e.g.
+	// 1.3.0-rc.1) may satisfy the SemverConstraint. By default, a constraint
+	// never matches a prerelease unless the constraint itself includes one. When
+	// enabled, a prerelease is checked against the constraint according to its
+	// semver ordering, in which it sorts below the release it precedes. This
+	// field has no effect when the SemverConstraint field is left unspecified.
+	// This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	AllowPrereleases bool `json:"allowPrereleases,omitempty" protobuf:"varint,6,opt,name=allowPrereleases"`
+	// AllowVersions is a regular expression that can optionally be used to limit
+	// the chart versions that are considered in determining the newest (or
+	// oldest) suitable version of the chart. It is applied to version strings
+	// exactly as they appear in the repository, before the SemverConstraint is
+	// checked. This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	AllowVersions string `json:"allowVersions,omitempty" protobuf:"bytes,7,opt,name=allowVersions"`
+	// IgnoreVersions is a list of regular expressions matching chart versions
+	// that must be ignored when determining the newest (or oldest) suitable
+	// version of the chart. A version matching any of these expressions is
+	// ignored even if it satisfies the SemverConstraint. This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	IgnoreVersions []string `json:"ignoreVersions,omitempty" protobuf:"bytes,8,rep,name=ignoreVersions"`
+	// NewerVersionsOnly specifies whether the Warehouse should refrain from
+	// producing new Freight when the newest suitable version of the chart is
+	// older than the version referenced by the Warehouse's most recently produced
+	// Freight. This guards against unintended downgrades, for instance, after a
+	// chart version has been yanked from the repository. This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	NewerVersionsOnly bool `json:"newerVersionsOnly,omitempty" protobuf:"varint,4,opt,name=newerVersionsOnly"`
+	// SelectionMode specifies whether the semantically greatest (Newest) or
+	// least (Oldest) of the chart versions satisfying the SemverConstraint should
+	// be selected. This field is optional. When left unspecified, the field is
+	// implicitly treated as if its value were "Newest".
+	//
+	// +kubebuilder:default=Newest
+	SelectionMode SelectionMode `json:"selectionMode,omitempty" protobuf:"bytes,5,opt,name=selectionMode"`
+	// InsecureSkipTLSVerify specifies whether certificate verification errors
+	// should be ignored when connecting to the repository. This should be enabled
+	// only with great caution.
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty" protobuf:"varint,9,opt,name=insecureSkipTLSVerify"`
+	// CABundle optionally references a bundle of CA certificates that are
+	// trusted, in addition to the system's root certificates, when connecting
+	// to the repository. This is useful for repositories that use self-signed
+	// certificates. It only affects connections made on behalf of this
+	// subscription and has no effect if InsecureSkipTLSVerify is true. This
+	// field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	CABundle *CABundle `json:"caBundle,omitempty" protobuf:"bytes,10,opt,name=caBundle"`
+}
+
+// OCIArtifactSubscription defines a subscription to a repository within an OCI
+// registry that contains arbitrary artifacts. No assumptions are made about the
+// contents of the artifacts. An artifact is identified only by the digest of
+// its manifest.
+type OCIArtifactSubscription struct {
+	// RepoURL specifies the URL of the artifact repository to subscribe to. The
+	// value in this field MUST NOT include a tag or digest. This field is
+	// required.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^(\w+([\.-]\w+)*(:[\d]+)?/)?(\w+([\.-]\w+)*)(/\w+([\.-]\w+)*)*$`
+	RepoURL string `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
+	// Tag specifies a tag whose referenced artifact should be tracked. Whenever
+	// the tag is moved to reference a different artifact, new Freight will be
+	// produced. This field is optional and is ignored if the Digest field is
+	// specified. When both fields are left unspecified, the tag "latest" is
+	// tracked.
+	//
+	// +kubebuilder:validation:Optional
+	Tag string `json:"tag,omitempty" protobuf:"bytes,2,opt,name=tag"`
+	// Digest optionally pins the subscription to a specific artifact, identified
+	// by the digest of its manifest.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]+([+._-][a-z0-9]+)*:[a-zA-Z0-9=_-]+$`
+	Digest string `json:"digest,omitempty" protobuf:"bytes,3,opt,name=digest"`
+	// InsecureSkipTLSVerify specifies whether certificate verification errors
+	// should be ignored when connecting to the repository. This should be enabled
+	// only with great caution.
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty" protobuf:"varint,4,opt,name=insecureSkipTLSVerify"`
+}
+
+// HTTPArtifactSubscription defines a subscription to an artifact served at an
+// HTTP/S URL. The artifact is polled like any other subscription, and new
+// Freight is produced whenever its version changes.
+type HTTPArtifactSubscription struct {
+	// URL specifies the HTTP/S URL at which the artifact is served. This field
+	// is required.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^https?://.+$`
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// JSONPath optionally specifies a JSONPath expression, such as
+	// `{.tag_name}`, used to extract the artifact's version from a JSON
+	// response. If it is not specified, the version is the SHA-256 digest of
+	// the response body, so that any change to the content results in new
+	// Freight.
+	//
+	// +kubebuilder:validation:Optional
+	JSONPath string `json:"jsonPath,omitempty" protobuf:"bytes,2,opt,name=jsonPath"`
+	// InsecureSkipTLSVerify specifies whether certificate verification errors
+	// should be ignored when connecting to the server. This should be enabled
+	// only with great caution.
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty" protobuf:"varint,3,opt,name=insecureSkipTLSVerify"`
 }
 
 // WarehouseStatus describes a Warehouse's most recently observed state.
@@ -267,6 +588,49 @@ type WarehouseStatus struct {
 	ObservedGeneration int64 `json:"observedGeneration,omitempty" protobuf:"varint,4,opt,name=observedGeneration"`
 	// LastFreight refers to the last Freight produced by this Warehouse
 	LastFreight *FreightReference `json:"lastFreight,omitempty" protobuf:"bytes,5,opt,name=lastFreight"`
+	// LastFreightTime is the time at which the Freight referenced by the
+	// LastFreight field was produced. Together, the two fields indicate how
+	// fresh the Warehouse's output is.
+	LastFreightTime *metav1.Time `json:"lastFreightTime,omitempty" protobuf:"bytes,8,opt,name=lastFreightTime"`
+	// Conditions contains the last observations of the Warehouse's state.
+	//
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,7,rep,name=conditions"`
+	// Subscriptions describes the outcome of the most recent attempt to poll
+	// each of the Warehouse's subscriptions, in the order in which the
+	// subscriptions are specified. Unlike LastFreight, it is updated every time
+	// the subscriptions are polled, whether or not new Freight is produced.
+	//
+	// +listType=atomic
+	Subscriptions []SubscriptionStatus `json:"subscriptions,omitempty" protobuf:"bytes,9,rep,name=subscriptions"`
+}
+
+// SubscriptionStatus describes the outcome of the most recent attempt to poll
+// one of a Warehouse's subscriptions.
+type SubscriptionStatus struct {
+	// Kind is the kind of the subscription. It is one of git, image, chart,
+	// ociArtifact, or httpArtifact.
+	Kind string `json:"kind" protobuf:"bytes,1,opt,name=kind"`
+	// RepoURL is the URL of the repository the subscription refers to or, for an
+	// HTTP artifact subscription, the URL of the artifact.
+	RepoURL string `json:"repoURL" protobuf:"bytes,2,opt,name=repoURL"`
+	// Name is the name of the chart a subscription to a classic chart
+	// repository refers to. It is empty for all other subscriptions.
+	Name string `json:"name,omitempty" protobuf:"bytes,3,opt,name=name"`
+	// LastPollTime is the time at which the subscription was most recently
+	// polled.
+	LastPollTime *metav1.Time `json:"lastPollTime,omitempty" protobuf:"bytes,4,opt,name=lastPollTime"`
+	// LastError describes the error that caused the most recent poll of the
+	// subscription to fail. It is empty if that poll succeeded.
+	LastError string `json:"lastError,omitempty" protobuf:"bytes,5,opt,name=lastError"`
+	// LastResolvedValue is what the most recent successful poll of the
+	// subscription resolved to: a commit ID, image tag, chart version, OCI
+	// artifact digest, or HTTP artifact version. It is retained when a
+	// subsequent poll fails.
+	LastResolvedValue string `json:"lastResolvedValue,omitempty" protobuf:"bytes,6,opt,name=lastResolvedValue"`
 }
 
 // +kubebuilder:object:root=true
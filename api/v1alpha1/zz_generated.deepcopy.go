@@ -5,6 +5,8 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -85,6 +87,10 @@ func (in *AnalysisTemplateReference) DeepCopy() *AnalysisTemplateReference {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApprovedStage) DeepCopyInto(out *ApprovedStage) {
 	*out = *in
+	if in.ApprovedAt != nil {
+		in, out := &in.ApprovedAt, &out.ApprovedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovedStage.
@@ -129,6 +135,36 @@ func (in *ArgoCDAppStatus) DeepCopy() *ArgoCDAppStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArgoCDAppSync) DeepCopyInto(out *ArgoCDAppSync) {
+	*out = *in
+	if in.SelfHeal != nil {
+		in, out := &in.SelfHeal, &out.SelfHeal
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Wait != nil {
+		in, out := &in.Wait, &out.Wait
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArgoCDAppSync.
+func (in *ArgoCDAppSync) DeepCopy() *ArgoCDAppSync {
+	if in == nil {
+		return nil
+	}
+	out := new(ArgoCDAppSync)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArgoCDAppSyncStatus) DeepCopyInto(out *ArgoCDAppSyncStatus) {
 	*out = *in
@@ -159,6 +195,21 @@ func (in *ArgoCDAppUpdate) DeepCopyInto(out *ArgoCDAppUpdate) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Sync != nil {
+		in, out := &in.Sync, &out.Sync
+		*out = new(ArgoCDAppSync)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArgoCDAppUpdate.
@@ -266,6 +317,21 @@ func (in *ArgoCDSourceUpdate) DeepCopy() *ArgoCDSourceUpdate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CABundle) DeepCopyInto(out *CABundle) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CABundle.
+func (in *CABundle) DeepCopy() *CABundle {
+	if in == nil {
+		return nil
+	}
+	out := new(CABundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Chart) DeepCopyInto(out *Chart) {
 	*out = *in
@@ -284,6 +350,16 @@ func (in *Chart) DeepCopy() *Chart {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChartSubscription) DeepCopyInto(out *ChartSubscription) {
 	*out = *in
+	if in.IgnoreVersions != nil {
+		in, out := &in.IgnoreVersions, &out.IgnoreVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = new(CABundle)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChartSubscription.
@@ -296,6 +372,21 @@ func (in *ChartSubscription) DeepCopy() *ChartSubscription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DigestAllowlist) DeepCopyInto(out *DigestAllowlist) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DigestAllowlist.
+func (in *DigestAllowlist) DeepCopy() *DigestAllowlist {
+	if in == nil {
+		return nil
+	}
+	out := new(DigestAllowlist)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Freight) DeepCopyInto(out *Freight) {
 	*out = *in
@@ -304,7 +395,9 @@ func (in *Freight) DeepCopyInto(out *Freight) {
 	if in.Commits != nil {
 		in, out := &in.Commits, &out.Commits
 		*out = make([]GitCommit, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Images != nil {
 		in, out := &in.Images, &out.Images
@@ -316,6 +409,21 @@ func (in *Freight) DeepCopyInto(out *Freight) {
 		*out = make([]Chart, len(*in))
 		copy(*out, *in)
 	}
+	if in.OCIArtifacts != nil {
+		in, out := &in.OCIArtifacts, &out.OCIArtifacts
+		*out = make([]OCIArtifact, len(*in))
+		copy(*out, *in)
+	}
+	if in.HTTPArtifacts != nil {
+		in, out := &in.HTTPArtifacts, &out.HTTPArtifacts
+		*out = make([]HTTPArtifact, len(*in))
+		copy(*out, *in)
+	}
+	if in.TrackedMetadata != nil {
+		in, out := &in.TrackedMetadata, &out.TrackedMetadata
+		*out = new(FreightMetadata)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -369,13 +477,68 @@ func (in *FreightList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreightMetadata) DeepCopyInto(out *FreightMetadata) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreightMetadata.
+func (in *FreightMetadata) DeepCopy() *FreightMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(FreightMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreightProvenance) DeepCopyInto(out *FreightProvenance) {
+	*out = *in
+	if in.PromotedAt != nil {
+		in, out := &in.PromotedAt, &out.PromotedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreightProvenance.
+func (in *FreightProvenance) DeepCopy() *FreightProvenance {
+	if in == nil {
+		return nil
+	}
+	out := new(FreightProvenance)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FreightReference) DeepCopyInto(out *FreightReference) {
 	*out = *in
+	if in.Provenance != nil {
+		in, out := &in.Provenance, &out.Provenance
+		*out = new(FreightProvenance)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Commits != nil {
 		in, out := &in.Commits, &out.Commits
 		*out = make([]GitCommit, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Images != nil {
 		in, out := &in.Images, &out.Images
@@ -387,6 +550,16 @@ func (in *FreightReference) DeepCopyInto(out *FreightReference) {
 		*out = make([]Chart, len(*in))
 		copy(*out, *in)
 	}
+	if in.OCIArtifacts != nil {
+		in, out := &in.OCIArtifacts, &out.OCIArtifacts
+		*out = make([]OCIArtifact, len(*in))
+		copy(*out, *in)
+	}
+	if in.HTTPArtifacts != nil {
+		in, out := &in.HTTPArtifacts, &out.HTTPArtifacts
+		*out = make([]HTTPArtifact, len(*in))
+		copy(*out, *in)
+	}
 	if in.VerificationInfo != nil {
 		in, out := &in.VerificationInfo, &out.VerificationInfo
 		*out = new(VerificationInfo)
@@ -399,6 +572,10 @@ func (in *FreightReference) DeepCopyInto(out *FreightReference) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.HealthyAt != nil {
+		in, out := &in.HealthyAt, &out.HealthyAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreightReference.
@@ -446,7 +623,7 @@ func (in *FreightStatus) DeepCopyInto(out *FreightStatus) {
 		in, out := &in.ApprovedFor, &out.ApprovedFor
 		*out = make(map[string]ApprovedStage, len(*in))
 		for key, val := range *in {
-			(*out)[key] = val
+			(*out)[key] = *val.DeepCopy()
 		}
 	}
 }
@@ -464,6 +641,10 @@ func (in *FreightStatus) DeepCopy() *FreightStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GitCommit) DeepCopyInto(out *GitCommit) {
 	*out = *in
+	if in.CommitDate != nil {
+		in, out := &in.CommitDate, &out.CommitDate
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitCommit.
@@ -529,6 +710,21 @@ func (in *GitRepoUpdate) DeepCopyInto(out *GitRepoUpdate) {
 		*out = new(HelmPromotionMechanism)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.YAML != nil {
+		in, out := &in.YAML, &out.YAML
+		*out = new(YAMLPromotionMechanism)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitRepoUpdate.
@@ -571,6 +767,56 @@ func (in *GitSubscription) DeepCopy() *GitSubscription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPArtifact) DeepCopyInto(out *HTTPArtifact) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPArtifact.
+func (in *HTTPArtifact) DeepCopy() *HTTPArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPArtifactSubscription) DeepCopyInto(out *HTTPArtifactSubscription) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPArtifactSubscription.
+func (in *HTTPArtifactSubscription) DeepCopy() *HTTPArtifactSubscription {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPArtifactSubscription)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHealthCheck) DeepCopyInto(out *HTTPHealthCheck) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHealthCheck.
+func (in *HTTPHealthCheck) DeepCopy() *HTTPHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Health) DeepCopyInto(out *Health) {
 	*out = *in
@@ -586,6 +832,10 @@ func (in *Health) DeepCopyInto(out *Health) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.UnresolvedSince != nil {
+		in, out := &in.UnresolvedSince, &out.UnresolvedSince
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Health.
@@ -598,6 +848,36 @@ func (in *Health) DeepCopy() *Health {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheck) DeepCopyInto(out *HealthCheck) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheck.
+func (in *HealthCheck) DeepCopy() *HealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HelmChartDependencyUpdate) DeepCopyInto(out *HelmChartDependencyUpdate) {
 	*out = *in
@@ -628,6 +908,21 @@ func (in *HelmImageUpdate) DeepCopy() *HelmImageUpdate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmOCIArtifactUpdate) DeepCopyInto(out *HelmOCIArtifactUpdate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmOCIArtifactUpdate.
+func (in *HelmOCIArtifactUpdate) DeepCopy() *HelmOCIArtifactUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmOCIArtifactUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HelmPromotionMechanism) DeepCopyInto(out *HelmPromotionMechanism) {
 	*out = *in
@@ -641,6 +936,11 @@ func (in *HelmPromotionMechanism) DeepCopyInto(out *HelmPromotionMechanism) {
 		*out = make([]HelmChartDependencyUpdate, len(*in))
 		copy(*out, *in)
 	}
+	if in.OCIArtifacts != nil {
+		in, out := &in.OCIArtifacts, &out.OCIArtifacts
+		*out = make([]HelmOCIArtifactUpdate, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmPromotionMechanism.
@@ -653,6 +953,26 @@ func (in *HelmPromotionMechanism) DeepCopy() *HelmPromotionMechanism {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HistoryRetention) DeepCopyInto(out *HistoryRetention) {
+	*out = *in
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HistoryRetention.
+func (in *HistoryRetention) DeepCopy() *HistoryRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(HistoryRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Image) DeepCopyInto(out *Image) {
 	*out = *in
@@ -668,6 +988,36 @@ func (in *Image) DeepCopy() *Image {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageRepositoryDiscovery) DeepCopyInto(out *ImageRepositoryDiscovery) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageRepositoryDiscovery.
+func (in *ImageRepositoryDiscovery) DeepCopy() *ImageRepositoryDiscovery {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageRepositoryDiscovery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageSignatureVerification) DeepCopyInto(out *ImageSignatureVerification) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSignatureVerification.
+func (in *ImageSignatureVerification) DeepCopy() *ImageSignatureVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageSignatureVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageSubscription) DeepCopyInto(out *ImageSubscription) {
 	*out = *in
@@ -676,6 +1026,36 @@ func (in *ImageSubscription) DeepCopyInto(out *ImageSubscription) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Platforms != nil {
+		in, out := &in.Platforms, &out.Platforms
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = new(CABundle)
+		**out = **in
+	}
+	if in.DigestAllowlist != nil {
+		in, out := &in.DigestAllowlist, &out.DigestAllowlist
+		*out = new(DigestAllowlist)
+		**out = **in
+	}
+	if in.ExcludePlatforms != nil {
+		in, out := &in.ExcludePlatforms, &out.ExcludePlatforms
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Discovery != nil {
+		in, out := &in.Discovery, &out.Discovery
+		*out = new(ImageRepositoryDiscovery)
+		**out = **in
+	}
+	if in.SignatureVerification != nil {
+		in, out := &in.SignatureVerification, &out.SignatureVerification
+		*out = new(ImageSignatureVerification)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSubscription.
@@ -758,6 +1138,51 @@ func (in *KustomizePromotionMechanism) DeepCopy() *KustomizePromotionMechanism {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MechanismResult) DeepCopyInto(out *MechanismResult) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MechanismResult.
+func (in *MechanismResult) DeepCopy() *MechanismResult {
+	if in == nil {
+		return nil
+	}
+	out := new(MechanismResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIArtifact) DeepCopyInto(out *OCIArtifact) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIArtifact.
+func (in *OCIArtifact) DeepCopy() *OCIArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIArtifactSubscription) DeepCopyInto(out *OCIArtifactSubscription) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIArtifactSubscription.
+func (in *OCIArtifactSubscription) DeepCopy() *OCIArtifactSubscription {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIArtifactSubscription)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Project) DeepCopyInto(out *Project) {
 	*out = *in
@@ -861,7 +1286,7 @@ func (in *Promotion) DeepCopyInto(out *Promotion) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -904,6 +1329,49 @@ func (in *PromotionInfo) DeepCopy() *PromotionInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionJob) DeepCopyInto(out *PromotionJob) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]corev1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionJob.
+func (in *PromotionJob) DeepCopy() *PromotionJob {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PromotionList) DeepCopyInto(out *PromotionList) {
 	*out = *in
@@ -953,6 +1421,13 @@ func (in *PromotionMechanisms) DeepCopyInto(out *PromotionMechanisms) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Jobs != nil {
+		in, out := &in.Jobs, &out.Jobs
+		*out = make([]PromotionJob, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionMechanisms.
@@ -980,9 +1455,83 @@ func (in *PromotionPolicy) DeepCopy() *PromotionPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionRecord) DeepCopyInto(out *PromotionRecord) {
+	*out = *in
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionRecord.
+func (in *PromotionRecord) DeepCopy() *PromotionRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in PromotionRecordStack) DeepCopyInto(out *PromotionRecordStack) {
+	{
+		in := &in
+		*out = make(PromotionRecordStack, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionRecordStack.
+func (in PromotionRecordStack) DeepCopy() PromotionRecordStack {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionRecordStack)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionRetryPolicy) DeepCopyInto(out *PromotionRetryPolicy) {
+	*out = *in
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionRetryPolicy.
+func (in *PromotionRetryPolicy) DeepCopy() *PromotionRetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionRetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PromotionSpec) DeepCopyInto(out *PromotionSpec) {
 	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(PromotionRetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionSpec.
@@ -1010,6 +1559,24 @@ func (in *PromotionStatus) DeepCopyInto(out *PromotionStatus) {
 		*out = new(FreightReference)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.PlannedChanges != nil {
+		in, out := &in.PlannedChanges, &out.PlannedChanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Mechanisms != nil {
+		in, out := &in.Mechanisms, &out.Mechanisms
+		*out = make([]MechanismResult, len(*in))
+		copy(*out, *in)
+	}
+	if in.NextAttemptAfter != nil {
+		in, out := &in.NextAttemptAfter, &out.NextAttemptAfter
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionStatus.
@@ -1035,6 +1602,11 @@ func (in *PullRequestPromotionMechanism) DeepCopyInto(out *PullRequestPromotionM
 		*out = new(GitLabPullRequest)
 		**out = **in
 	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullRequestPromotionMechanism.
@@ -1063,6 +1635,16 @@ func (in *RepoSubscription) DeepCopyInto(out *RepoSubscription) {
 	if in.Chart != nil {
 		in, out := &in.Chart, &out.Chart
 		*out = new(ChartSubscription)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OCIArtifact != nil {
+		in, out := &in.OCIArtifact, &out.OCIArtifact
+		*out = new(OCIArtifactSubscription)
+		**out = **in
+	}
+	if in.HTTPArtifact != nil {
+		in, out := &in.HTTPArtifact, &out.HTTPArtifact
+		*out = new(HTTPArtifactSubscription)
 		**out = **in
 	}
 }
@@ -1150,6 +1732,16 @@ func (in *StageSpec) DeepCopyInto(out *StageSpec) {
 		*out = new(Verification)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(HealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HistoryRetention != nil {
+		in, out := &in.HistoryRetention, &out.HistoryRetention
+		*out = new(HistoryRetention)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StageSpec.
@@ -1192,6 +1784,13 @@ func (in *StageStatus) DeepCopyInto(out *StageStatus) {
 		*out = new(PromotionInfo)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PromotionHistory != nil {
+		in, out := &in.PromotionHistory, &out.PromotionHistory
+		*out = make(PromotionRecordStack, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StageStatus.
@@ -1219,6 +1818,25 @@ func (in *StageSubscription) DeepCopy() *StageSubscription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubscriptionStatus) DeepCopyInto(out *SubscriptionStatus) {
+	*out = *in
+	if in.LastPollTime != nil {
+		in, out := &in.LastPollTime, &out.LastPollTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubscriptionStatus.
+func (in *SubscriptionStatus) DeepCopy() *SubscriptionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SubscriptionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Subscriptions) DeepCopyInto(out *Subscriptions) {
 	*out = *in
@@ -1402,6 +2020,21 @@ func (in *WarehouseSpec) DeepCopyInto(out *WarehouseSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.FreightMetadata != nil {
+		in, out := &in.FreightMetadata, &out.FreightMetadata
+		*out = new(FreightMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PollingInterval != nil {
+		in, out := &in.PollingInterval, &out.PollingInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RegistryTimeout != nil {
+		in, out := &in.RegistryTimeout, &out.RegistryTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseSpec.
@@ -1422,6 +2055,24 @@ func (in *WarehouseStatus) DeepCopyInto(out *WarehouseStatus) {
 		*out = new(FreightReference)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LastFreightTime != nil {
+		in, out := &in.LastFreightTime, &out.LastFreightTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Subscriptions != nil {
+		in, out := &in.Subscriptions, &out.Subscriptions
+		*out = make([]SubscriptionStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseStatus.
@@ -1433,3 +2084,38 @@ func (in *WarehouseStatus) DeepCopy() *WarehouseStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *YAMLImageUpdate) DeepCopyInto(out *YAMLImageUpdate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YAMLImageUpdate.
+func (in *YAMLImageUpdate) DeepCopy() *YAMLImageUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(YAMLImageUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *YAMLPromotionMechanism) DeepCopyInto(out *YAMLPromotionMechanism) {
+	*out = *in
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]YAMLImageUpdate, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YAMLPromotionMechanism.
+func (in *YAMLPromotionMechanism) DeepCopy() *YAMLPromotionMechanism {
+	if in == nil {
+		return nil
+	}
+	out := new(YAMLPromotionMechanism)
+	in.DeepCopyInto(out)
+	return out
+}
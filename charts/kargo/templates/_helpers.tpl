@@ -75,6 +75,16 @@ app.kubernetes.io/component: api
 app.kubernetes.io/component: controller
 {{- end -}}
 
+{{/*
+Renders "true" if the controller needs to mount Secrets containing a CA bundle
+or token for accessing Argo CD resources. Renders nothing otherwise.
+*/}}
+{{- define "kargo.controller.argocdSecretsEnabled" -}}
+{{- if and .Values.controller.argocd.integrationEnabled (or .Values.controller.argocd.caSecret .Values.controller.argocd.tokenSecret) -}}
+true
+{{- end -}}
+{{- end -}}
+
 {{- define "kargo.dexServer.labels" -}}
 app.kubernetes.io/component: dex-server
 {{- end -}}
@@ -14,6 +14,7 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/akuity/kargo/internal/api/kubernetes"
 	"github.com/akuity/kargo/internal/api/rbac"
 	rollouts "github.com/akuity/kargo/internal/controller/rollouts/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
 	"github.com/akuity/kargo/internal/kubeclient"
 	libEvent "github.com/akuity/kargo/internal/kubernetes/event"
 	"github.com/akuity/kargo/internal/os"
@@ -74,7 +76,7 @@ func (o *apiOptions) run(ctx context.Context) error {
 
 	cfg := config.ServerConfigFromEnv()
 
-	clientCfg, internalClient, recorder, err := o.setupAPIClient(ctx)
+	clientCfg, internalClient, internalCache, recorder, err := o.setupAPIClient(ctx)
 	if err != nil {
 		return fmt.Errorf("error setting up internal Kubernetes API client: %w", err)
 	}
@@ -96,9 +98,21 @@ func (o *apiOptions) run(ctx context.Context) error {
 		o.Logger.Info("Argo Rollouts integration is enabled")
 	}
 
+	if cfg.GRPCReflectionEnabled {
+		o.Logger.Info("gRPC server reflection is enabled")
+	}
 	if cfg.AdminConfig != nil {
 		o.Logger.Info("admin account is enabled")
 	}
+	if cfg.WarehouseWebhookConfig != nil {
+		o.Logger.Info("Warehouse refresh webhook endpoint is enabled")
+	}
+	if cfg.GitWebhooksEnabled {
+		o.Logger.Info("GitHub and GitLab push webhook endpoints are enabled")
+	}
+	if cfg.ImageWebhooksEnabled {
+		o.Logger.Info("container registry push webhook endpoints are enabled")
+	}
 	if cfg.OIDCConfig != nil {
 		o.Logger.WithFields(log.Fields{
 			"issuerURL":   cfg.OIDCConfig.IssuerURL,
@@ -107,12 +121,22 @@ func (o *apiOptions) run(ctx context.Context) error {
 		}).Info("SSO via OpenID Connect is enabled")
 	}
 
+	credentialsDB, err := credentials.NewKubernetesDatabase(
+		internalClient,
+		credentials.KubernetesDatabaseConfigFromEnv(),
+	)
+	if err != nil {
+		return fmt.Errorf("error initializing credentials database: %w", err)
+	}
+
 	srv := api.NewServer(
 		cfg,
 		kubeClient,
 		internalClient,
 		rbac.NewKubernetesRolesDatabase(kubeClient),
+		credentialsDB,
 		recorder,
+		internalCache.WaitForCacheSync,
 	)
 	l, err := net.Listen("tcp", fmt.Sprintf("%s:%s", o.Host, o.Port))
 	if err != nil {
@@ -126,30 +150,36 @@ func (o *apiOptions) run(ctx context.Context) error {
 	return nil
 }
 
-func (o *apiOptions) setupAPIClient(ctx context.Context) (*rest.Config, client.Client, record.EventRecorder, error) {
+func (o *apiOptions) setupAPIClient(ctx context.Context) (
+	*rest.Config,
+	client.Client,
+	cache.Cache,
+	record.EventRecorder,
+	error,
+) {
 	restCfg, err := kubernetes.GetRestConfig(ctx, o.KubeConfig)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("get REST config: %w", err)
+		return nil, nil, nil, nil, fmt.Errorf("get REST config: %w", err)
 	}
 
 	scheme := runtime.NewScheme()
 	if err = kubescheme.AddToScheme(scheme); err != nil {
-		return nil, nil, nil, fmt.Errorf("error adding Kubernetes API to Kargo API manager scheme: %w", err)
+		return nil, nil, nil, nil, fmt.Errorf("error adding Kubernetes API to Kargo API manager scheme: %w", err)
 	}
 
 	if err = rbacv1.AddToScheme(scheme); err != nil {
-		return nil, nil, nil, fmt.Errorf(
+		return nil, nil, nil, nil, fmt.Errorf(
 			"error adding Kubernetes RBAC API to Kargo controller manager scheme: %w",
 			err,
 		)
 	}
 
 	if err = rollouts.AddToScheme(scheme); err != nil {
-		return nil, nil, nil, fmt.Errorf("error adding Argo Rollouts API to Kargo API manager scheme: %w", err)
+		return nil, nil, nil, nil, fmt.Errorf("error adding Argo Rollouts API to Kargo API manager scheme: %w", err)
 	}
 
 	if err = kargoapi.AddToScheme(scheme); err != nil {
-		return nil, nil, nil, fmt.Errorf("error adding Kargo API to Kargo API manager scheme: %w", err)
+		return nil, nil, nil, nil, fmt.Errorf("error adding Kargo API to Kargo API manager scheme: %w", err)
 	}
 
 	mgr, err := ctrl.NewManager(restCfg, ctrl.Options{
@@ -166,11 +196,11 @@ func (o *apiOptions) setupAPIClient(ctx context.Context) (*rest.Config, client.C
 		},
 	})
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("error initializing Kargo API manager: %w", err)
+		return nil, nil, nil, nil, fmt.Errorf("error initializing Kargo API manager: %w", err)
 	}
 
 	if err = registerKargoIndexers(ctx, mgr); err != nil {
-		return nil, nil, nil, fmt.Errorf("failed to register Kargo indexers: %w", err)
+		return nil, nil, nil, nil, fmt.Errorf("failed to register Kargo indexers: %w", err)
 	}
 
 	go func() {
@@ -179,7 +209,7 @@ func (o *apiOptions) setupAPIClient(ctx context.Context) (*rest.Config, client.C
 		}
 	}()
 
-	return restCfg, mgr.GetClient(), libEvent.NewRecorder(ctx, scheme, mgr.GetClient(), "api"), nil
+	return restCfg, mgr.GetClient(), mgr.GetCache(), libEvent.NewRecorder(ctx, scheme, mgr.GetClient(), "api"), nil
 }
 
 func registerKargoIndexers(ctx context.Context, mgr ctrl.Manager) error {
@@ -188,6 +218,11 @@ func registerKargoIndexers(ctx context.Context, mgr ctrl.Manager) error {
 		return fmt.Errorf("index Promotions by Stage: %w", err)
 	}
 
+	// Index Promotions by Argo CD Applications
+	if err := kubeclient.IndexPromotionsByArgoCDApplications(ctx, mgr); err != nil {
+		return fmt.Errorf("index Promotions by Argo CD Applications: %w", err)
+	}
+
 	// Index Freight by Warehouse
 	if err := kubeclient.IndexFreightByWarehouse(ctx, mgr); err != nil {
 		return fmt.Errorf("index Freight by Warehouse: %w", err)
@@ -3,13 +3,17 @@ package main
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -27,6 +31,7 @@ import (
 	"github.com/akuity/kargo/internal/controller/warehouses"
 	"github.com/akuity/kargo/internal/credentials"
 	"github.com/akuity/kargo/internal/os"
+	"github.com/akuity/kargo/internal/pprof"
 	"github.com/akuity/kargo/internal/types"
 	versionpkg "github.com/akuity/kargo/internal/version"
 
@@ -39,7 +44,13 @@ type controllerOptions struct {
 
 	ArgoCDEnabled       bool
 	ArgoCDKubeConfig    string
+	ArgoCDCAFile        string
+	ArgoCDTokenFile     string
 	ArgoCDNamespaceOnly bool
+	// ArgoCDInstanceKubeConfigs holds a comma-separated list of
+	// <instance name>=<kubeconfig path> pairs describing how to reach any Argo
+	// CD instances other than the default one.
+	ArgoCDInstanceKubeConfigs string
 
 	Logger *log.Logger
 }
@@ -69,7 +80,10 @@ func (o *controllerOptions) complete() {
 	o.KubeConfig = os.GetEnv("KUBECONFIG", "")
 	o.ArgoCDEnabled = types.MustParseBool(os.GetEnv("ARGOCD_INTEGRATION_ENABLED", "true"))
 	o.ArgoCDKubeConfig = os.GetEnv("ARGOCD_KUBECONFIG", "")
+	o.ArgoCDCAFile = os.GetEnv("ARGOCD_CA_FILE", "")
+	o.ArgoCDTokenFile = os.GetEnv("ARGOCD_TOKEN_FILE", "")
 	o.ArgoCDNamespaceOnly = types.MustParseBool(os.GetEnv("ARGOCD_WATCH_ARGOCD_NAMESPACE_ONLY", "false"))
+	o.ArgoCDInstanceKubeConfigs = os.GetEnv("ARGOCD_INSTANCE_KUBECONFIGS", "")
 }
 
 func (o *controllerOptions) run(ctx context.Context) error {
@@ -97,15 +111,27 @@ func (o *controllerOptions) run(ctx context.Context) error {
 		return fmt.Errorf("error initializing Argo CD Application controller manager: %w", err)
 	}
 
-	credentialsDB := credentials.NewKubernetesDatabase(
+	argocdInstanceMgrs, err := o.setupArgoCDInstanceManagers(ctx)
+	if err != nil {
+		return fmt.Errorf(
+			"error initializing Argo CD Application controller managers for additional instances: %w",
+			err,
+		)
+	}
+
+	credentialsDB, err := credentials.NewKubernetesDatabase(
 		kargoMgr.GetClient(),
 		credentials.KubernetesDatabaseConfigFromEnv(),
 	)
+	if err != nil {
+		return fmt.Errorf("error initializing credentials database: %w", err)
+	}
 
 	if err := o.setupReconcilers(
 		ctx,
 		kargoMgr,
 		argocdMgr,
+		argocdInstanceMgrs,
 		credentialsDB,
 		promotionsReconcilerCfg,
 		stagesReconcilerCfg,
@@ -113,7 +139,11 @@ func (o *controllerOptions) run(ctx context.Context) error {
 		return fmt.Errorf("error setting up reconcilers: %w", err)
 	}
 
-	return o.startManagers(ctx, kargoMgr, argocdMgr)
+	if err := o.setupProfilingServer(kargoMgr, pprof.ServerConfigFromEnv()); err != nil {
+		return fmt.Errorf("error setting up profiling server: %w", err)
+	}
+
+	return o.startManagers(ctx, kargoMgr, argocdMgr, argocdInstanceMgrs)
 }
 
 func (o *controllerOptions) setupKargoManager(
@@ -147,6 +177,12 @@ func (o *controllerOptions) setupKargoManager(
 			err,
 		)
 	}
+	if err = batchv1.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf(
+			"error adding Kubernetes batch API to Kargo controller manager scheme: %w",
+			err,
+		)
+	}
 	if stagesReconcilerCfg.RolloutsIntegrationEnabled {
 		if argoRolloutsExists(ctx, restCfg) {
 			log.Info("Argo Rollouts integration is enabled")
@@ -169,6 +205,15 @@ func (o *controllerOptions) setupKargoManager(
 		return nil, fmt.Errorf("error getting label requirement for credentials Secrets: %w", err)
 	}
 
+	jobReq, err := labels.NewRequirement(
+		kargoapi.PromotionJobLabelKey,
+		selection.Exists,
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error getting label requirement for Jobs: %w", err)
+	}
+
 	cacheOpts := cache.Options{
 		ByObject: map[client.Object]cache.ByObject{
 			// Only watch Secrets matching the label requirements
@@ -176,6 +221,10 @@ func (o *controllerOptions) setupKargoManager(
 			&corev1.Secret{}: {
 				Label: labels.NewSelector().Add(*secretReq),
 			},
+			// Only watch Jobs created by the Job promotion mechanism.
+			&batchv1.Job{}: {
+				Label: labels.NewSelector().Add(*jobReq),
+			},
 		},
 	}
 
@@ -211,6 +260,24 @@ func (o *controllerOptions) setupArgoCDManager(ctx context.Context) (manager.Man
 	}
 	restCfg.ContentType = runtime.ContentTypeJSON
 
+	// Hardened Argo CD installations may be fronted by an API server presenting
+	// a certificate signed by a private CA, or may require a dedicated token.
+	// Both are typically mounted from Secrets and override anything that came
+	// from the kubeconfig.
+	if o.ArgoCDCAFile != "" {
+		restCfg.TLSClientConfig.CAFile = o.ArgoCDCAFile
+		restCfg.TLSClientConfig.CAData = nil
+		restCfg.TLSClientConfig.Insecure = false
+	}
+	if o.ArgoCDTokenFile != "" {
+		// client-go periodically re-reads the token file, so rotated tokens are
+		// picked up without a restart.
+		restCfg.BearerTokenFile = o.ArgoCDTokenFile
+		restCfg.BearerToken = ""
+		restCfg.Username = ""
+		restCfg.Password = ""
+	}
+
 	argocdNamespace := libargocd.Namespace()
 
 	// There's a chance there is only permission to interact with Argo CD
@@ -226,14 +293,97 @@ func (o *controllerOptions) setupArgoCDManager(ctx context.Context) (manager.Man
 
 	o.Logger.Info("Argo CD integration is enabled")
 
+	return o.newArgoCDManager(restCfg)
+}
+
+// setupArgoCDInstanceManagers returns a manager for each Argo CD instance,
+// other than the default one, that the controller has been configured to
+// connect to, keyed by instance name.
+func (o *controllerOptions) setupArgoCDInstanceManagers(
+	ctx context.Context,
+) (map[string]manager.Manager, error) {
+	kubeConfigs, err := parseArgoCDInstanceKubeConfigs(o.ArgoCDInstanceKubeConfigs)
+	if err != nil {
+		return nil, err
+	}
+	if len(kubeConfigs) == 0 {
+		return nil, nil
+	}
+	if !o.ArgoCDEnabled {
+		o.Logger.Warn(
+			"Additional Argo CD instances were configured, but Argo CD integration " +
+				"is disabled. Proceeding without them.",
+		)
+		return nil, nil
+	}
+
+	mgrs := make(map[string]manager.Manager, len(kubeConfigs))
+	for instance, kubeConfig := range kubeConfigs {
+		restCfg, err := kubernetes.GetRestConfig(ctx, kubeConfig)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error loading REST config for Argo CD instance %q: %w",
+				instance,
+				err,
+			)
+		}
+		restCfg.ContentType = runtime.ContentTypeJSON
+
+		// Unlike the default instance, additional instances are configured
+		// explicitly, so the absence of Argo CD CRDs is an error.
+		if !argoCDExists(ctx, restCfg, libargocd.Namespace()) {
+			return nil, fmt.Errorf("no Argo CD CRDs were found for Argo CD instance %q", instance)
+		}
+
+		if mgrs[instance], err = o.newArgoCDManager(restCfg); err != nil {
+			return nil, fmt.Errorf(
+				"error initializing controller manager for Argo CD instance %q: %w",
+				instance,
+				err,
+			)
+		}
+		o.Logger.WithField("instance", instance).Info("Argo CD instance is enabled")
+	}
+	return mgrs, nil
+}
+
+// parseArgoCDInstanceKubeConfigs parses a comma-separated list of
+// <instance name>=<kubeconfig path> pairs into a map of kubeconfig paths keyed
+// by instance name.
+func parseArgoCDInstanceKubeConfigs(str string) (map[string]string, error) {
+	kubeConfigs := map[string]string{}
+	for _, pair := range strings.Split(str, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		instance, kubeConfig, ok := strings.Cut(pair, "=")
+		if !ok || instance == "" || kubeConfig == "" {
+			return nil, fmt.Errorf(
+				"invalid Argo CD instance kubeconfig %q; expected <instance name>=<kubeconfig path>",
+				pair,
+			)
+		}
+		if _, exists := kubeConfigs[instance]; exists {
+			return nil, fmt.Errorf("Argo CD instance %q is configured more than once", instance)
+		}
+		kubeConfigs[instance] = kubeConfig
+	}
+	return kubeConfigs, nil
+}
+
+// newArgoCDManager returns a manager for the Argo CD Application resources
+// reachable using the provided REST config.
+func (o *controllerOptions) newArgoCDManager(restCfg *rest.Config) (manager.Manager, error) {
+	argocdNamespace := libargocd.Namespace()
+
 	scheme := runtime.NewScheme()
-	if err = corev1.AddToScheme(scheme); err != nil {
+	if err := corev1.AddToScheme(scheme); err != nil {
 		return nil, fmt.Errorf(
 			"error adding Kubernetes core API to Argo CD controller manager scheme: %w",
 			err,
 		)
 	}
-	if err = argocd.AddToScheme(scheme); err != nil {
+	if err := argocd.AddToScheme(scheme); err != nil {
 		return nil, fmt.Errorf(
 			"error adding Argo CD API to Argo CD controller manager scheme: %w",
 			err,
@@ -261,6 +411,7 @@ func (o *controllerOptions) setupArgoCDManager(ctx context.Context) (manager.Man
 func (o *controllerOptions) setupReconcilers(
 	ctx context.Context,
 	kargoMgr, argocdMgr manager.Manager,
+	argocdInstanceMgrs map[string]manager.Manager,
 	credentialsDB credentials.Database,
 	promotionsReconcilerCfg promotions.ReconcilerConfig,
 	stagesReconcilerCfg stages.ReconcilerConfig,
@@ -269,6 +420,7 @@ func (o *controllerOptions) setupReconcilers(
 		ctx,
 		kargoMgr,
 		argocdMgr,
+		argocdInstanceMgrs,
 		credentialsDB,
 		promotionsReconcilerCfg,
 	); err != nil {
@@ -279,6 +431,8 @@ func (o *controllerOptions) setupReconcilers(
 		ctx,
 		kargoMgr,
 		argocdMgr,
+		argocdInstanceMgrs,
+		credentialsDB,
 		stagesReconcilerCfg,
 	); err != nil {
 		return fmt.Errorf("error setting up Stages reconciler: %w", err)
@@ -287,7 +441,7 @@ func (o *controllerOptions) setupReconcilers(
 	if err := warehouses.SetupReconcilerWithManager(
 		kargoMgr,
 		credentialsDB,
-		o.ShardName,
+		warehouses.ReconcilerConfigFromEnv(),
 	); err != nil {
 		return fmt.Errorf("error setting up Warehouses reconciler: %w", err)
 	}
@@ -295,7 +449,29 @@ func (o *controllerOptions) setupReconcilers(
 	return nil
 }
 
-func (o *controllerOptions) startManagers(ctx context.Context, kargoMgr, argocdMgr manager.Manager) error {
+func (o *controllerOptions) setupProfilingServer(
+	kargoMgr manager.Manager,
+	cfg pprof.ServerConfig,
+) error {
+	if !cfg.Enabled() {
+		return nil
+	}
+	srv, err := pprof.NewServer(cfg)
+	if err != nil {
+		return err
+	}
+	if err = kargoMgr.Add(srv); err != nil {
+		return fmt.Errorf("error adding profiling server to Kargo controller manager: %w", err)
+	}
+	o.Logger.WithField("address", cfg.BindAddress).Info("Profiling server is enabled")
+	return nil
+}
+
+func (o *controllerOptions) startManagers(
+	ctx context.Context,
+	kargoMgr, argocdMgr manager.Manager,
+	argocdInstanceMgrs map[string]manager.Manager,
+) error {
 	var (
 		errChan = make(chan error)
 		wg      = sync.WaitGroup{}
@@ -311,6 +487,16 @@ func (o *controllerOptions) startManagers(ctx context.Context, kargoMgr, argocdM
 		}()
 	}
 
+	for instance, mgr := range argocdInstanceMgrs {
+		wg.Add(1)
+		go func(instance string, mgr manager.Manager) {
+			defer wg.Done()
+			if err := mgr.Start(ctx); err != nil {
+				errChan <- fmt.Errorf("error starting argo cd manager for instance %q: %w", instance, err)
+			}
+		}(instance, mgr)
+	}
+
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
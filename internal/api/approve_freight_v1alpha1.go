@@ -7,6 +7,7 @@ import (
 
 	"connectrpc.com/connect"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -108,12 +109,6 @@ func (s *server) ApproveFreight(
 		return &connect.Response[svcv1alpha1.ApproveFreightResponse]{}, nil
 	}
 
-	newStatus.ApprovedFor[stageName] = kargoapi.ApprovedStage{}
-
-	if err := s.patchFreightStatusFn(ctx, freight, newStatus); err != nil {
-		return nil, fmt.Errorf("patch status: %w", err)
-	}
-
 	var actor string
 	eventMsg := fmt.Sprintf("Freight approved for Stage %q", stageName)
 	if u, ok := user.InfoFromContext(ctx); ok {
@@ -121,6 +116,16 @@ func (s *server) ApproveFreight(
 		eventMsg += fmt.Sprintf(" by %q", actor)
 	}
 
+	now := metav1.Now()
+	newStatus.ApprovedFor[stageName] = kargoapi.ApprovedStage{
+		ApprovedBy: actor,
+		ApprovedAt: &now,
+	}
+
+	if err := s.patchFreightStatusFn(ctx, freight, newStatus); err != nil {
+		return nil, fmt.Errorf("patch status: %w", err)
+	}
+
 	s.recorder.AnnotatedEventf(
 		freight,
 		kargoapi.NewFreightApprovedEventAnnotations(actor, freight, stageName),
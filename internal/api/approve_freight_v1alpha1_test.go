@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/stretchr/testify/require"
@@ -13,6 +14,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/user"
 	fakeevent "github.com/akuity/kargo/internal/kubernetes/event/fake"
 	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
 )
@@ -384,3 +386,66 @@ func TestApproveFreight(t *testing.T) {
 		})
 	}
 }
+
+func TestApproveFreightRecordsApproval(t *testing.T) {
+	var approval kargoapi.ApprovedStage
+	s := &server{
+		validateProjectExistsFn: func(context.Context, string) error {
+			return nil
+		},
+		getFreightByNameOrAliasFn: func(
+			context.Context,
+			client.Client,
+			string,
+			string,
+			string,
+		) (*kargoapi.Freight, error) {
+			return &kargoapi.Freight{}, nil
+		},
+		getStageFn: func(
+			context.Context,
+			client.Client,
+			types.NamespacedName,
+		) (*kargoapi.Stage, error) {
+			return &kargoapi.Stage{}, nil
+		},
+		authorizeFn: func(
+			context.Context,
+			string,
+			schema.GroupVersionResource,
+			string,
+			client.ObjectKey,
+		) error {
+			return nil
+		},
+		patchFreightStatusFn: func(
+			_ context.Context,
+			_ *kargoapi.Freight,
+			newStatus kargoapi.FreightStatus,
+		) error {
+			approval = newStatus.ApprovedFor["fake-stage"]
+			return nil
+		},
+		recorder: fakeevent.NewEventRecorder(1),
+	}
+	before := time.Now().Truncate(time.Second)
+	_, err := s.ApproveFreight(
+		user.ContextWithInfo(
+			context.Background(),
+			user.Info{Email: "tony@starkindustries.com"},
+		),
+		connect.NewRequest(&svcv1alpha1.ApproveFreightRequest{
+			Project: "fake-project",
+			Name:    "fake-freight",
+			Stage:   "fake-stage",
+		}),
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		kargoapi.EventActorEmailPrefix+"tony@starkindustries.com",
+		approval.ApprovedBy,
+	)
+	require.NotNil(t, approval.ApprovedAt)
+	require.False(t, approval.ApprovedAt.Time.Before(before))
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/yaml"
@@ -50,6 +51,23 @@ func splitYAML(
 	return projects, otherResources, nil
 }
 
+// sanitizeObjectMeta clears fields of the provided object's metadata that are
+// populated by the Kubernetes API server. Manifests submitted by users are
+// frequently derived from resources previously retrieved from the server
+// (e.g. using `kargo get -o yaml`) and carrying such fields into a write can
+// cause it to fail or, worse, be applied against stale state. Labels,
+// annotations, and other user-controlled metadata are left intact.
+func sanitizeObjectMeta(obj metav1.Object) {
+	obj.SetResourceVersion("")
+	obj.SetUID("")
+	obj.SetGeneration(0)
+	obj.SetCreationTimestamp(metav1.Time{})
+	obj.SetDeletionTimestamp(nil)
+	obj.SetDeletionGracePeriodSeconds(nil)
+	obj.SetManagedFields(nil)
+	obj.SetSelfLink("")
+}
+
 // objectOrRaw returns either the object or the raw representation of the object
 // based on the format.
 func objectOrRaw[T client.Object](obj T, format svcv1alpha1.RawFormat) (T, []byte, error) {
@@ -0,0 +1,79 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	sigyaml "sigs.k8s.io/yaml"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestSanitizeObjectMetaRoundTrip(t *testing.T) {
+	gracePeriod := int64(30)
+	deletionTimestamp := metav1.NewTime(time.Now())
+	stage := &kargoapi.Stage{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: kargoapi.GroupVersion.String(),
+			Kind:       "Stage",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:                       "fake-stage",
+			Namespace:                  "fake-project",
+			Labels:                     map[string]string{"fake-label": "fake-value"},
+			Annotations:                map[string]string{"fake-annotation": "fake-value"},
+			Finalizers:                 []string{"fake-finalizer"},
+			ResourceVersion:            "42",
+			UID:                        "fake-uid",
+			Generation:                 3,
+			CreationTimestamp:          metav1.NewTime(time.Now()),
+			DeletionTimestamp:          &deletionTimestamp,
+			DeletionGracePeriodSeconds: &gracePeriod,
+			ManagedFields: []metav1.ManagedFieldsEntry{
+				{Manager: "fake-manager"},
+			},
+		},
+		Spec: kargoapi.StageSpec{
+			Subscriptions: kargoapi.Subscriptions{
+				Warehouse: "fake-warehouse",
+			},
+		},
+	}
+
+	// Simulate a manifest obtained with `kargo get -o yaml` being submitted
+	manifest, err := sigyaml.Marshal(stage)
+	require.NoError(t, err)
+	_, resources, err := splitYAML(manifest)
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	obj := &resources[0]
+	require.Equal(t, "42", obj.GetResourceVersion())
+
+	sanitizeObjectMeta(obj)
+
+	// Server-populated fields are cleared
+	require.Empty(t, obj.GetResourceVersion())
+	require.Empty(t, obj.GetUID())
+	require.Zero(t, obj.GetGeneration())
+	require.True(t, obj.GetCreationTimestamp().Time.IsZero())
+	require.Nil(t, obj.GetDeletionTimestamp())
+	require.Nil(t, obj.GetDeletionGracePeriodSeconds())
+	require.Empty(t, obj.GetManagedFields())
+
+	// Everything else survives the round trip
+	roundTripped := &kargoapi.Stage{}
+	sanitizedManifest, err := sigyaml.Marshal(obj)
+	require.NoError(t, err)
+	require.NoError(t, sigyaml.Unmarshal(sanitizedManifest, roundTripped))
+	require.Equal(t, stage.Name, roundTripped.Name)
+	require.Equal(t, stage.Namespace, roundTripped.Namespace)
+	require.Equal(t, stage.Labels, roundTripped.Labels)
+	require.Equal(t, stage.Annotations, roundTripped.Annotations)
+	require.Equal(t, stage.Finalizers, roundTripped.Finalizers)
+	require.Equal(t, stage.Spec, roundTripped.Spec)
+	require.Empty(t, roundTripped.ResourceVersion)
+	require.Empty(t, roundTripped.UID)
+	require.True(t, roundTripped.CreationTimestamp.IsZero())
+}
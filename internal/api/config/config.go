@@ -9,6 +9,7 @@ import (
 
 	"github.com/akuity/kargo/internal/api/dex"
 	"github.com/akuity/kargo/internal/api/oidc"
+	"github.com/akuity/kargo/internal/credentials"
 	"github.com/akuity/kargo/internal/os"
 	"github.com/akuity/kargo/internal/types"
 )
@@ -27,6 +28,28 @@ type ServerConfig struct {
 	ArgoCDConfig                ArgoCDConfig
 	PermissiveCORSPolicyEnabled bool
 	RolloutsIntegrationEnabled  bool
+	// GRPCReflectionEnabled indicates whether the gRPC server reflection service
+	// should be exposed so that tools like grpcurl can introspect the API. This
+	// is best left disabled in production.
+	GRPCReflectionEnabled bool
+	// WarehouseWebhookConfig, if non-nil, enables the endpoint through which
+	// external systems, such as CI pipelines, can request that Warehouses
+	// subscribed to a particular repository be refreshed immediately.
+	WarehouseWebhookConfig *WarehouseWebhookConfig
+	// GitWebhooksEnabled indicates whether the endpoints that receive push
+	// webhooks from GitHub and GitLab should be exposed. Each push is
+	// authenticated using a webhook secret stored as credentials in the project
+	// of each Warehouse it would refresh.
+	GitWebhooksEnabled bool
+	// ImageWebhooksEnabled indicates whether the endpoints that receive push
+	// webhooks from container registries should be exposed. As with Git push
+	// webhooks, each push is authenticated using a webhook secret stored as
+	// credentials in the project of each Warehouse it would refresh.
+	ImageWebhooksEnabled bool
+	// CredentialsValidationConfig limits which addresses and token services
+	// the API server may reach when validating credentials on behalf of a
+	// caller.
+	CredentialsValidationConfig credentials.ValidationConfig
 }
 
 func ServerConfigFromEnv() ServerConfig {
@@ -53,6 +76,17 @@ func ServerConfigFromEnv() ServerConfig {
 		types.MustParseBool(os.GetEnv("PERMISSIVE_CORS_POLICY_ENABLED", "false"))
 	cfg.RolloutsIntegrationEnabled =
 		types.MustParseBool(os.GetEnv("ROLLOUTS_INTEGRATION_ENABLED", "true"))
+	cfg.GRPCReflectionEnabled =
+		types.MustParseBool(os.GetEnv("GRPC_REFLECTION_ENABLED", "false"))
+	if types.MustParseBool(os.GetEnv("WAREHOUSE_WEBHOOK_ENABLED", "false")) {
+		warehouseWebhookCfg := WarehouseWebhookConfigFromEnv()
+		cfg.WarehouseWebhookConfig = &warehouseWebhookCfg
+	}
+	cfg.GitWebhooksEnabled =
+		types.MustParseBool(os.GetEnv("GIT_WEBHOOKS_ENABLED", "false"))
+	cfg.ImageWebhooksEnabled =
+		types.MustParseBool(os.GetEnv("IMAGE_WEBHOOKS_ENABLED", "false"))
+	cfg.CredentialsValidationConfig = credentials.ValidationConfigFromEnv()
 	return cfg
 }
 
@@ -92,6 +126,26 @@ func AdminConfigFromEnv() AdminConfig {
 	return cfg
 }
 
+// WarehouseWebhookConfig represents configuration for the Warehouse refresh
+// webhook endpoint.
+type WarehouseWebhookConfig struct {
+	// Secret is the shared secret that callers of the endpoint must present in
+	// the X-Kargo-Webhook-Secret header.
+	Secret string `envconfig:"WAREHOUSE_WEBHOOK_SECRET" required:"true"`
+}
+
+// WarehouseWebhookConfigFromEnv returns a WarehouseWebhookConfig populated from
+// environment variables. It panics if the secret is empty, since the endpoint
+// would otherwise accept requests from anyone.
+func WarehouseWebhookConfigFromEnv() WarehouseWebhookConfig {
+	var cfg WarehouseWebhookConfig
+	envconfig.MustProcess("", &cfg)
+	if cfg.Secret == "" {
+		panic("WAREHOUSE_WEBHOOK_SECRET must not be empty")
+	}
+	return cfg
+}
+
 type ArgoCDURLMap map[string]string
 
 func (a *ArgoCDURLMap) Decode(value string) error {
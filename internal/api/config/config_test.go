@@ -69,3 +69,12 @@ func TestDecodeArgoCDURLs(t *testing.T) {
 		})
 	}
 }
+
+func TestWarehouseWebhookConfigFromEnv(t *testing.T) {
+	t.Setenv("WAREHOUSE_WEBHOOK_SECRET", "fake-secret")
+	require.Equal(t, "fake-secret", WarehouseWebhookConfigFromEnv().Secret)
+
+	// An empty secret would let anyone call the endpoint
+	t.Setenv("WAREHOUSE_WEBHOOK_SECRET", "")
+	require.Panics(t, func() { WarehouseWebhookConfigFromEnv() })
+}
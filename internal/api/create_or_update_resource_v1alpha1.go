@@ -42,6 +42,8 @@ func (s *server) createOrUpdateResource(
 	ctx context.Context,
 	obj *unstructured.Unstructured,
 ) (*svcv1alpha1.CreateOrUpdateResourceResult, error) {
+	sanitizeObjectMeta(obj)
+
 	// Note: It would be tempting to blindly attempt creating the resource and
 	// then update it instead if it already exists, but many resource types have
 	// defaulting and/or validating webhooks and what we do not want is for some
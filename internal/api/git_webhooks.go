@@ -0,0 +1,271 @@
+package api
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libCreds "github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/git"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+const (
+	// githubWebhookPath is the path at which push webhooks from GitHub are
+	// received.
+	githubWebhookPath = "/webhook/github"
+	// gitlabWebhookPath is the path at which push webhooks from GitLab are
+	// received.
+	gitlabWebhookPath = "/webhook/gitlab"
+)
+
+// gitPushEvent is the information extracted from a Git hosting provider's
+// push webhook that is needed to decide which Warehouses to refresh.
+type gitPushEvent struct {
+	// repoURLs are URLs by which the repository that was pushed to is known.
+	repoURLs []string
+	// ref is the full name of the ref that was pushed to. e.g. refs/heads/main
+	// or refs/tags/v1.0.0.
+	ref string
+	// defaultBranch is the name of the repository's default branch.
+	defaultBranch string
+}
+
+// gitWebhookProvider describes how push webhooks from a particular Git hosting
+// provider are recognized, authenticated, and parsed.
+type gitWebhookProvider struct {
+	// name is the name of the provider. It is used only for logging.
+	name string
+	// isPushEvent returns true if the webhook with the provided headers reports
+	// a push. Webhooks reporting anything else are ignored.
+	isPushEvent func(http.Header) bool
+	// verify returns true if the webhook with the provided headers and body was
+	// sent by the provider using the provided secret.
+	verify func(header http.Header, body []byte, secret string) bool
+	// parsePush extracts a gitPushEvent from the body of a push webhook.
+	parsePush func(body []byte) (gitPushEvent, error)
+}
+
+// githubWebhookProvider recognizes, authenticates, and parses push webhooks
+// from GitHub.
+var githubWebhookProvider = gitWebhookProvider{
+	name: "GitHub",
+	isPushEvent: func(header http.Header) bool {
+		return header.Get("X-GitHub-Event") == "push"
+	},
+	verify: verifyGitHubSignature,
+	parsePush: func(body []byte) (gitPushEvent, error) {
+		payload := struct {
+			Ref        string `json:"ref"`
+			Repository struct {
+				CloneURL      string `json:"clone_url"`
+				HTMLURL       string `json:"html_url"`
+				DefaultBranch string `json:"default_branch"`
+			} `json:"repository"`
+		}{}
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return gitPushEvent{}, err
+		}
+		return gitPushEvent{
+			repoURLs: []string{
+				payload.Repository.CloneURL,
+				payload.Repository.HTMLURL,
+			},
+			ref:           payload.Ref,
+			defaultBranch: payload.Repository.DefaultBranch,
+		}, nil
+	},
+}
+
+// gitlabWebhookProvider recognizes, authenticates, and parses push webhooks
+// from GitLab.
+var gitlabWebhookProvider = gitWebhookProvider{
+	name: "GitLab",
+	isPushEvent: func(header http.Header) bool {
+		switch header.Get("X-Gitlab-Event") {
+		case "Push Hook", "Tag Push Hook":
+			return true
+		}
+		return false
+	},
+	verify: verifyGitLabToken,
+	parsePush: func(body []byte) (gitPushEvent, error) {
+		payload := struct {
+			Ref     string `json:"ref"`
+			Project struct {
+				GitHTTPURL    string `json:"git_http_url"`
+				WebURL        string `json:"web_url"`
+				DefaultBranch string `json:"default_branch"`
+			} `json:"project"`
+		}{}
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return gitPushEvent{}, err
+		}
+		return gitPushEvent{
+			repoURLs: []string{
+				payload.Project.GitHTTPURL,
+				payload.Project.WebURL,
+			},
+			ref:           payload.Ref,
+			defaultBranch: payload.Project.DefaultBranch,
+		}, nil
+	},
+}
+
+// verifyGitHubSignature returns true if the X-Hub-Signature-256 header holds
+// the HMAC-SHA256 of the provided body, keyed with the provided secret.
+func verifyGitHubSignature(header http.Header, body []byte, secret string) bool {
+	sig, ok := strings.CutPrefix(header.Get("X-Hub-Signature-256"), "sha256=")
+	if !ok {
+		return false
+	}
+	sigBytes, err := hex.DecodeString(sig)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(body)
+	return hmac.Equal(sigBytes, mac.Sum(nil))
+}
+
+// verifyGitLabToken returns true if the X-Gitlab-Token header holds the
+// provided secret. Unlike GitHub, GitLab does not sign webhook payloads, but
+// sends the secret token configured for the webhook as-is.
+func verifyGitLabToken(header http.Header, _ []byte, secret string) bool {
+	return subtle.ConstantTimeCompare(
+		[]byte(header.Get("X-Gitlab-Token")),
+		[]byte(secret),
+	) == 1
+}
+
+// gitWebhookHandler returns a handler for push webhooks from the provided Git
+// hosting provider. Every Warehouse with a Git subscription that the push is
+// relevant to is refreshed, provided the webhook can be authenticated using
+// the webhook secret stored as credentials in the Warehouse's own project.
+func (s *server) gitWebhookHandler(provider gitWebhookProvider) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := logging.LoggerFromContext(r.Context()).
+			WithField("provider", provider.name)
+
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		body, err := io.ReadAll(
+			io.LimitReader(r.Body, maxWarehouseWebhookRequestBytes),
+		)
+		if err != nil {
+			http.Error(
+				w,
+				fmt.Sprintf("error reading request body: %s", err),
+				http.StatusBadRequest,
+			)
+			return
+		}
+
+		if !provider.isPushEvent(r.Header) {
+			// Nothing to do, but there's no reason to report a failure either.
+			writeWarehouseWebhookResponse(r.Context(), w, []string{})
+			return
+		}
+
+		event, err := provider.parsePush(body)
+		if err != nil {
+			http.Error(
+				w,
+				fmt.Sprintf("error decoding request body: %s", err),
+				http.StatusBadRequest,
+			)
+			return
+		}
+
+		refreshed, candidates, err := s.refreshAuthenticatedWarehouses(
+			r.Context(),
+			libCreds.TypeGitWebhook,
+			func(warehouse *kargoapi.Warehouse) (string, bool) {
+				return warehouseSubscribesToPush(warehouse, event)
+			},
+			func(secret string) bool {
+				return provider.verify(r.Header, body, secret)
+			},
+		)
+		if err != nil {
+			logger.Errorf("error refreshing Warehouses for push to %q: %s", event.ref, err)
+			http.Error(w, "error refreshing Warehouses", http.StatusInternalServerError)
+			return
+		}
+		// A push that could not be authenticated for any Warehouse it is
+		// relevant to is answered exactly as a push that is relevant to none.
+		// Otherwise, callers could learn which repositories are subscribed to.
+		if candidates > 0 && len(refreshed) == 0 {
+			logger.WithField("ref", event.ref).
+				Info("could not verify webhook signature for any matching Warehouse")
+		}
+		logger.WithField("ref", event.ref).
+			Debugf("refreshed %d Warehouse(s)", len(refreshed))
+
+		writeWarehouseWebhookResponse(r.Context(), w, refreshed)
+	}
+}
+
+// warehouseSubscribesToPush returns the URL of the first of the provided
+// Warehouse's Git subscriptions that the provided push event is relevant to
+// and true, or an empty string and false if there is no such subscription. A
+// push to a branch is relevant to subscriptions that select the newest commit
+// from that branch. A push of a tag is relevant to subscriptions that select
+// commits by tag.
+func warehouseSubscribesToPush(
+	warehouse *kargoapi.Warehouse,
+	event gitPushEvent,
+) (string, bool) {
+	for _, sub := range warehouse.Spec.Subscriptions {
+		if sub.Git == nil || !gitPushMatchesRef(sub.Git, event) {
+			continue
+		}
+		subURL := git.NormalizeURL(sub.Git.RepoURL)
+		for _, repoURL := range event.repoURLs {
+			if repoURL != "" && git.NormalizeURL(repoURL) == subURL {
+				return sub.Git.RepoURL, true
+			}
+		}
+	}
+	return "", false
+}
+
+// gitPushMatchesRef returns true if the ref that the provided push event
+// updated is one the provided Git subscription selects commits from.
+func gitPushMatchesRef(sub *kargoapi.GitSubscription, event gitPushEvent) bool {
+	if strings.HasPrefix(event.ref, "refs/tags/") {
+		switch sub.CommitSelectionStrategy {
+		case kargoapi.CommitSelectionStrategyLexical,
+			kargoapi.CommitSelectionStrategyNewestTag,
+			kargoapi.CommitSelectionStrategySemVer:
+			return true
+		}
+		return false
+	}
+	branch, ok := strings.CutPrefix(event.ref, "refs/heads/")
+	if !ok {
+		return false
+	}
+	switch sub.CommitSelectionStrategy {
+	case "", kargoapi.CommitSelectionStrategyNewestFromBranch:
+	default:
+		return false
+	}
+	if sub.Branch == "" {
+		// The subscription is to the default branch. If the provider didn't say
+		// which branch that is, err on the side of refreshing.
+		return event.defaultBranch == "" || branch == event.defaultBranch
+	}
+	return branch == sub.Branch
+}
@@ -0,0 +1,444 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libCreds "github.com/akuity/kargo/internal/credentials"
+)
+
+func signGitHubPayload(body []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestGitWebhookHandler(t *testing.T) {
+	githubPayload, err := testData.ReadFile("testdata/github-push.json")
+	require.NoError(t, err)
+	gitlabPayload, err := testData.ReadFile("testdata/gitlab-push.json")
+	require.NoError(t, err)
+
+	newWarehouse := func(namespace, repoURL, branch string) *kargoapi.Warehouse {
+		return &kargoapi.Warehouse{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      "fake-warehouse",
+			},
+			Spec: kargoapi.WarehouseSpec{
+				Subscriptions: []kargoapi.RepoSubscription{
+					{
+						Git: &kargoapi.GitSubscription{
+							RepoURL: repoURL,
+							Branch:  branch,
+						},
+					},
+				},
+			},
+		}
+	}
+	// Each project has its own webhook secret
+	webhookSecrets := map[string]string{
+		"project-a": "secret-a",
+		"project-b": "secret-b",
+		"project-c": "secret-c",
+		"project-d": "secret-d",
+	}
+	credentialsDB := &libCreds.FakeDB{
+		GetFn: func(
+			_ context.Context,
+			namespace string,
+			credType libCreds.Type,
+			_ string,
+		) (libCreds.Credentials, bool, error) {
+			if credType != libCreds.TypeGitWebhook {
+				return libCreds.Credentials{}, false, nil
+			}
+			secret, ok := webhookSecrets[namespace]
+			return libCreds.Credentials{WebhookSecret: secret}, ok, nil
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		provider      gitWebhookProvider
+		method        string
+		header        http.Header
+		body          []byte
+		credentialsDB libCreds.Database
+		assertions    func(*testing.T, *httptest.ResponseRecorder, client.Client)
+	}{
+		{
+			name:     "method not allowed",
+			provider: githubWebhookProvider,
+			method:   http.MethodGet,
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, _ client.Client) {
+				require.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+			},
+		},
+		{
+			name:     "event other than push",
+			provider: githubWebhookProvider,
+			header:   http.Header{"X-Github-Event": []string{"ping"}},
+			body:     []byte(`{"zen":"Keep it logically awesome."}`),
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, _ client.Client) {
+				require.Equal(t, http.StatusAccepted, rr.Code)
+				requireRefreshedWarehouses(t, rr)
+			},
+		},
+		{
+			name:     "invalid body",
+			provider: githubWebhookProvider,
+			header:   http.Header{"X-Github-Event": []string{"push"}},
+			body:     []byte(`{`),
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, _ client.Client) {
+				require.Equal(t, http.StatusBadRequest, rr.Code)
+			},
+		},
+		{
+			name:     "GitHub push with invalid signature",
+			provider: githubWebhookProvider,
+			header: http.Header{
+				"X-Github-Event":      []string{"push"},
+				"X-Hub-Signature-256": []string{signGitHubPayload(githubPayload, "wrong-secret")},
+			},
+			body: githubPayload,
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, c client.Client) {
+				// Indistinguishable from a push nobody subscribes to
+				require.Equal(t, http.StatusAccepted, rr.Code)
+				requireRefreshedWarehouses(t, rr)
+				requireWarehouseRefreshed(t, c, "project-a", false)
+			},
+		},
+		{
+			name:     "GitHub push with valid signature",
+			provider: githubWebhookProvider,
+			header: http.Header{
+				"X-Github-Event":      []string{"push"},
+				"X-Hub-Signature-256": []string{signGitHubPayload(githubPayload, "secret-a")},
+			},
+			body: githubPayload,
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, c client.Client) {
+				require.Equal(t, http.StatusAccepted, rr.Code)
+				// project-b subscribes to the same branch, but its secret was not
+				// the one used to sign the payload. project-c subscribes to a
+				// different branch. project-d subscribes to a different repository.
+				requireRefreshedWarehouses(t, rr, "project-a/fake-warehouse")
+				requireWarehouseRefreshed(t, c, "project-a", true)
+				requireWarehouseRefreshed(t, c, "project-b", false)
+				requireWarehouseRefreshed(t, c, "project-c", false)
+				requireWarehouseRefreshed(t, c, "project-d", false)
+			},
+		},
+		{
+			name:     "GitHub push to branch nobody subscribes to",
+			provider: githubWebhookProvider,
+			header: http.Header{
+				"X-Github-Event":      []string{"push"},
+				"X-Hub-Signature-256": []string{"sha256=deadbeef"},
+			},
+			body: []byte(`{
+				"ref": "refs/heads/feature",
+				"repository": {
+					"clone_url": "https://github.com/example/kargo-demo.git",
+					"default_branch": "main"
+				}
+			}`),
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, _ client.Client) {
+				require.Equal(t, http.StatusAccepted, rr.Code)
+				requireRefreshedWarehouses(t, rr)
+			},
+		},
+		{
+			name:     "error getting webhook secret",
+			provider: githubWebhookProvider,
+			header: http.Header{
+				"X-Github-Event":      []string{"push"},
+				"X-Hub-Signature-256": []string{signGitHubPayload(githubPayload, "secret-a")},
+			},
+			body: githubPayload,
+			credentialsDB: &libCreds.FakeDB{
+				GetFn: func(
+					context.Context,
+					string,
+					libCreds.Type,
+					string,
+				) (libCreds.Credentials, bool, error) {
+					return libCreds.Credentials{}, false, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, _ client.Client) {
+				require.Equal(t, http.StatusInternalServerError, rr.Code)
+			},
+		},
+		{
+			name:     "GitLab push with invalid token",
+			provider: gitlabWebhookProvider,
+			header: http.Header{
+				"X-Gitlab-Event": []string{"Push Hook"},
+				"X-Gitlab-Token": []string{"wrong-secret"},
+			},
+			body: gitlabPayload,
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, c client.Client) {
+				// Indistinguishable from a push nobody subscribes to
+				require.Equal(t, http.StatusAccepted, rr.Code)
+				requireRefreshedWarehouses(t, rr)
+				requireWarehouseRefreshed(t, c, "project-d", false)
+			},
+		},
+		{
+			name:     "GitLab push with valid token",
+			provider: gitlabWebhookProvider,
+			header: http.Header{
+				"X-Gitlab-Event": []string{"Push Hook"},
+				"X-Gitlab-Token": []string{"secret-d"},
+			},
+			body: gitlabPayload,
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, c client.Client) {
+				require.Equal(t, http.StatusAccepted, rr.Code)
+				requireRefreshedWarehouses(t, rr, "project-d/fake-warehouse")
+				requireWarehouseRefreshed(t, c, "project-a", false)
+				requireWarehouseRefreshed(t, c, "project-d", true)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().
+				WithScheme(mustNewScheme()).
+				WithObjects(
+					newWarehouse("project-a", "https://github.com/example/kargo-demo", ""),
+					newWarehouse("project-b", "https://github.com/example/kargo-demo.git", "main"),
+					newWarehouse("project-c", "https://github.com/example/kargo-demo", "dev"),
+					newWarehouse("project-d", "https://gitlab.com/example/kargo-demo", ""),
+				).
+				Build()
+			s := &server{
+				internalClient: c,
+				credentialsDB:  credentialsDB,
+			}
+			if testCase.credentialsDB != nil {
+				s.credentialsDB = testCase.credentialsDB
+			}
+			method := testCase.method
+			if method == "" {
+				method = http.MethodPost
+			}
+			req := httptest.NewRequest(method, "/", bytes.NewReader(testCase.body))
+			for key, values := range testCase.header {
+				for _, value := range values {
+					req.Header.Add(key, value)
+				}
+			}
+			rr := httptest.NewRecorder()
+			s.gitWebhookHandler(testCase.provider).ServeHTTP(rr, req)
+			testCase.assertions(t, rr, c)
+		})
+	}
+}
+
+func requireRefreshedWarehouses(
+	t *testing.T,
+	rr *httptest.ResponseRecorder,
+	expected ...string,
+) {
+	res := warehouseWebhookResponse{}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res))
+	if len(expected) == 0 {
+		require.Empty(t, res.Warehouses)
+		return
+	}
+	require.ElementsMatch(t, expected, res.Warehouses)
+}
+
+func requireWarehouseRefreshed(
+	t *testing.T,
+	c client.Client,
+	namespace string,
+	expected bool,
+) {
+	warehouse := &kargoapi.Warehouse{}
+	require.NoError(
+		t,
+		c.Get(
+			context.Background(),
+			client.ObjectKey{Namespace: namespace, Name: "fake-warehouse"},
+			warehouse,
+		),
+	)
+	_, ok := kargoapi.RefreshAnnotationValue(warehouse.Annotations)
+	require.Equal(t, expected, ok)
+}
+
+func TestGitWebhookProvidersParsePush(t *testing.T) {
+	githubPayload, err := testData.ReadFile("testdata/github-push.json")
+	require.NoError(t, err)
+	event, err := githubWebhookProvider.parsePush(githubPayload)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		gitPushEvent{
+			repoURLs: []string{
+				"https://github.com/example/kargo-demo.git",
+				"https://github.com/example/kargo-demo",
+			},
+			ref:           "refs/heads/main",
+			defaultBranch: "main",
+		},
+		event,
+	)
+
+	gitlabPayload, err := testData.ReadFile("testdata/gitlab-push.json")
+	require.NoError(t, err)
+	event, err = gitlabWebhookProvider.parsePush(gitlabPayload)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		gitPushEvent{
+			repoURLs: []string{
+				"https://gitlab.com/example/kargo-demo.git",
+				"https://gitlab.com/example/kargo-demo",
+			},
+			ref:           "refs/heads/main",
+			defaultBranch: "main",
+		},
+		event,
+	)
+}
+
+func TestVerifyGitHubSignature(t *testing.T) {
+	body := []byte(`{"ref":"refs/heads/main"}`)
+	testCases := []struct {
+		name      string
+		signature string
+		expected  bool
+	}{
+		{
+			name:     "missing signature",
+			expected: false,
+		},
+		{
+			name:      "missing algorithm prefix",
+			signature: signGitHubPayload(body, "fake-secret")[len("sha256="):],
+			expected:  false,
+		},
+		{
+			name:      "malformed signature",
+			signature: "sha256=not-hex",
+			expected:  false,
+		},
+		{
+			name:      "signed with a different secret",
+			signature: signGitHubPayload(body, "other-secret"),
+			expected:  false,
+		},
+		{
+			name:      "signature over a different body",
+			signature: signGitHubPayload([]byte(`{}`), "fake-secret"),
+			expected:  false,
+		},
+		{
+			name:      "valid signature",
+			signature: signGitHubPayload(body, "fake-secret"),
+			expected:  true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			header := http.Header{}
+			if testCase.signature != "" {
+				header.Set("X-Hub-Signature-256", testCase.signature)
+			}
+			require.Equal(
+				t,
+				testCase.expected,
+				verifyGitHubSignature(header, body, "fake-secret"),
+			)
+		})
+	}
+}
+
+func TestGitPushMatchesRef(t *testing.T) {
+	testCases := []struct {
+		name     string
+		sub      kargoapi.GitSubscription
+		event    gitPushEvent
+		expected bool
+	}{
+		{
+			name:     "push to subscribed branch",
+			sub:      kargoapi.GitSubscription{Branch: "release"},
+			event:    gitPushEvent{ref: "refs/heads/release", defaultBranch: "main"},
+			expected: true,
+		},
+		{
+			name:     "push to other branch",
+			sub:      kargoapi.GitSubscription{Branch: "release"},
+			event:    gitPushEvent{ref: "refs/heads/main", defaultBranch: "main"},
+			expected: false,
+		},
+		{
+			name:     "push to default branch",
+			sub:      kargoapi.GitSubscription{},
+			event:    gitPushEvent{ref: "refs/heads/main", defaultBranch: "main"},
+			expected: true,
+		},
+		{
+			name:     "push to non-default branch",
+			sub:      kargoapi.GitSubscription{},
+			event:    gitPushEvent{ref: "refs/heads/feature", defaultBranch: "main"},
+			expected: false,
+		},
+		{
+			name:     "push to branch with unknown default branch",
+			sub:      kargoapi.GitSubscription{},
+			event:    gitPushEvent{ref: "refs/heads/feature"},
+			expected: true,
+		},
+		{
+			name: "push to branch with tag-based subscription",
+			sub: kargoapi.GitSubscription{
+				CommitSelectionStrategy: kargoapi.CommitSelectionStrategySemVer,
+			},
+			event:    gitPushEvent{ref: "refs/heads/main", defaultBranch: "main"},
+			expected: false,
+		},
+		{
+			name: "tag push with tag-based subscription",
+			sub: kargoapi.GitSubscription{
+				CommitSelectionStrategy: kargoapi.CommitSelectionStrategyNewestTag,
+			},
+			event:    gitPushEvent{ref: "refs/tags/v1.0.0"},
+			expected: true,
+		},
+		{
+			name:     "tag push with branch subscription",
+			sub:      kargoapi.GitSubscription{},
+			event:    gitPushEvent{ref: "refs/tags/v1.0.0"},
+			expected: false,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				gitPushMatchesRef(&testCase.sub, testCase.event),
+			)
+		})
+	}
+}
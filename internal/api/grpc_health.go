@@ -1,13 +1,57 @@
 package api
 
 import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"connectrpc.com/connect"
 	"connectrpc.com/grpchealth"
 
 	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
 )
 
-func NewHealthChecker() grpchealth.Checker {
-	return grpchealth.NewStaticChecker(
-		svcv1alpha1connect.KargoServiceName,
-	)
+// NewHealthChecker returns a grpchealth.Checker that implements the gRPC health
+// checking protocol for the Kargo service. If waitForCacheSyncFn is non-nil,
+// the server and the Kargo service are reported as NOT_SERVING until it has
+// returned true, indicating that the caches the server depends upon have
+// synced. Otherwise, they are reported as SERVING right away.
+func NewHealthChecker(
+	ctx context.Context,
+	waitForCacheSyncFn func(context.Context) bool,
+) grpchealth.Checker {
+	h := &healthChecker{}
+	if waitForCacheSyncFn == nil {
+		h.serving.Store(true)
+		return h
+	}
+	go func() {
+		h.serving.Store(waitForCacheSyncFn(ctx))
+	}()
+	return h
+}
+
+// healthChecker is an implementation of grpchealth.Checker that reports on
+// both the server as a whole (identified by an empty service name) and the
+// Kargo service.
+type healthChecker struct {
+	serving atomic.Bool
+}
+
+func (h *healthChecker) Check(
+	_ context.Context,
+	req *grpchealth.CheckRequest,
+) (*grpchealth.CheckResponse, error) {
+	switch req.Service {
+	case "", svcv1alpha1connect.KargoServiceName:
+	default:
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			fmt.Errorf("unknown service %s", req.Service),
+		)
+	}
+	if !h.serving.Load() {
+		return &grpchealth.CheckResponse{Status: grpchealth.StatusNotServing}, nil
+	}
+	return &grpchealth.CheckResponse{Status: grpchealth.StatusServing}, nil
 }
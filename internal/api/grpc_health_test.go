@@ -0,0 +1,83 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"connectrpc.com/grpchealth"
+	"github.com/stretchr/testify/require"
+
+	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
+)
+
+func TestHealthChecker(t *testing.T) {
+	check := func(checker grpchealth.Checker, service string) grpchealth.Status {
+		res, err := checker.Check(
+			context.Background(),
+			&grpchealth.CheckRequest{Service: service},
+		)
+		require.NoError(t, err)
+		return res.Status
+	}
+
+	t.Run("no cache to wait for", func(t *testing.T) {
+		checker := NewHealthChecker(context.Background(), nil)
+		require.Equal(t, grpchealth.StatusServing, check(checker, ""))
+		require.Equal(
+			t,
+			grpchealth.StatusServing,
+			check(checker, svcv1alpha1connect.KargoServiceName),
+		)
+	})
+
+	t.Run("cache sync pending, then complete", func(t *testing.T) {
+		synced := make(chan struct{})
+		checker := NewHealthChecker(
+			context.Background(),
+			func(context.Context) bool {
+				<-synced
+				return true
+			},
+		)
+		require.Equal(t, grpchealth.StatusNotServing, check(checker, ""))
+		require.Equal(
+			t,
+			grpchealth.StatusNotServing,
+			check(checker, svcv1alpha1connect.KargoServiceName),
+		)
+		close(synced)
+		require.Eventually(
+			t,
+			func() bool {
+				return check(checker, "") == grpchealth.StatusServing
+			},
+			time.Second,
+			10*time.Millisecond,
+		)
+	})
+
+	t.Run("cache sync failed", func(t *testing.T) {
+		done := make(chan struct{})
+		checker := NewHealthChecker(
+			context.Background(),
+			func(context.Context) bool {
+				defer close(done)
+				return false
+			},
+		)
+		<-done
+		require.Equal(t, grpchealth.StatusNotServing, check(checker, ""))
+	})
+
+	t.Run("unknown service", func(t *testing.T) {
+		checker := NewHealthChecker(context.Background(), nil)
+		_, err := checker.Check(
+			context.Background(),
+			&grpchealth.CheckRequest{Service: "bogus"},
+		)
+		require.Error(t, err)
+		require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+}
@@ -0,0 +1,294 @@
+package api
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/distribution/distribution/v3/reference"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libCreds "github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/image"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+const (
+	// harborWebhookPath is the path at which push webhooks from Harbor are
+	// received.
+	harborWebhookPath = "/webhook/harbor"
+	// dockerHubWebhookPath is the path at which push webhooks from Docker Hub
+	// are received.
+	dockerHubWebhookPath = "/webhook/dockerhub"
+	// distributionWebhookPath is the path at which notifications in the format
+	// used by the CNCF Distribution registry, and by other OCI registries that
+	// emulate it, are received.
+	distributionWebhookPath = "/webhook/distribution"
+	// dockerHubWebhookSecretParam is the query parameter in which the webhook
+	// secret must be included in the URL of a Docker Hub webhook.
+	dockerHubWebhookSecretParam = "secret"
+)
+
+// imagePush is the information extracted from a container registry's push
+// webhook that is needed to decide which Warehouses to refresh.
+type imagePush struct {
+	// repoURL is the URL of the image repository that was pushed to.
+	repoURL string
+	// tag is the tag that was pushed. It is empty if an image was pushed by
+	// digest only.
+	tag string
+}
+
+// imageWebhookProvider describes how push webhooks from a particular container
+// registry are authenticated and parsed.
+type imageWebhookProvider struct {
+	// name is the name of the provider. It is used only for logging.
+	name string
+	// verify returns true if the webhook request was sent by the provider using
+	// the provided secret.
+	verify func(r *http.Request, secret string) bool
+	// parsePushes extracts every push reported by the body of a webhook.
+	// Anything other than a push that is reported is ignored.
+	parsePushes func(body []byte) ([]imagePush, error)
+}
+
+// harborWebhookProvider authenticates and parses push webhooks from Harbor.
+var harborWebhookProvider = imageWebhookProvider{
+	name:   "Harbor",
+	verify: verifyAuthorizationHeader,
+	parsePushes: func(body []byte) ([]imagePush, error) {
+		payload := struct {
+			Type      string `json:"type"`
+			EventData struct {
+				Resources []struct {
+					Tag         string `json:"tag"`
+					ResourceURL string `json:"resource_url"`
+				} `json:"resources"`
+			} `json:"event_data"`
+		}{}
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return nil, err
+		}
+		if payload.Type != "PUSH_ARTIFACT" {
+			return nil, nil
+		}
+		pushes := make([]imagePush, 0, len(payload.EventData.Resources))
+		for _, resource := range payload.EventData.Resources {
+			// The resource URL is a complete reference to the artifact, including
+			// its tag or digest.
+			ref, err := reference.ParseNormalizedNamed(resource.ResourceURL)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"error parsing resource URL %q: %w",
+					resource.ResourceURL,
+					err,
+				)
+			}
+			pushes = append(pushes, imagePush{
+				repoURL: ref.Name(),
+				tag:     resource.Tag,
+			})
+		}
+		return pushes, nil
+	},
+}
+
+// dockerHubWebhookProvider authenticates and parses push webhooks from Docker
+// Hub.
+var dockerHubWebhookProvider = imageWebhookProvider{
+	name:   "Docker Hub",
+	verify: verifyDockerHubSecret,
+	parsePushes: func(body []byte) ([]imagePush, error) {
+		payload := struct {
+			PushData struct {
+				Tag string `json:"tag"`
+			} `json:"push_data"`
+			Repository struct {
+				RepoName string `json:"repo_name"`
+			} `json:"repository"`
+		}{}
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return nil, err
+		}
+		return []imagePush{{
+			repoURL: "docker.io/" + payload.Repository.RepoName,
+			tag:     payload.PushData.Tag,
+		}}, nil
+	},
+}
+
+// distributionWebhookProvider authenticates and parses notifications in the
+// format used by the CNCF Distribution registry.
+var distributionWebhookProvider = imageWebhookProvider{
+	name:   "Distribution",
+	verify: verifyAuthorizationHeader,
+	parsePushes: func(body []byte) ([]imagePush, error) {
+		payload := struct {
+			Events []struct {
+				Action string `json:"action"`
+				Target struct {
+					Repository string `json:"repository"`
+					URL        string `json:"url"`
+					Tag        string `json:"tag"`
+				} `json:"target"`
+				Request struct {
+					Host string `json:"host"`
+				} `json:"request"`
+			} `json:"events"`
+		}{}
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return nil, err
+		}
+		pushes := make([]imagePush, 0, len(payload.Events))
+		for _, event := range payload.Events {
+			if event.Action != "push" {
+				continue
+			}
+			host := event.Request.Host
+			if host == "" {
+				targetURL, err := url.Parse(event.Target.URL)
+				if err != nil {
+					return nil, fmt.Errorf("error parsing target URL %q: %w", event.Target.URL, err)
+				}
+				host = targetURL.Host
+			}
+			pushes = append(pushes, imagePush{
+				repoURL: fmt.Sprintf("%s/%s", host, event.Target.Repository),
+				tag:     event.Target.Tag,
+			})
+		}
+		return pushes, nil
+	},
+}
+
+// verifyAuthorizationHeader returns true if the Authorization header holds the
+// provided secret. Neither Harbor nor Distribution signs notifications, but
+// both can be configured to send a fixed Authorization header with each one.
+func verifyAuthorizationHeader(r *http.Request, secret string) bool {
+	return subtle.ConstantTimeCompare(
+		[]byte(r.Header.Get("Authorization")),
+		[]byte(secret),
+	) == 1
+}
+
+// verifyDockerHubSecret returns true if the secret query parameter holds the
+// provided secret. Docker Hub neither signs webhooks nor supports custom
+// headers, so the secret can only be conveyed in the webhook's URL.
+func verifyDockerHubSecret(r *http.Request, secret string) bool {
+	return subtle.ConstantTimeCompare(
+		[]byte(r.URL.Query().Get(dockerHubWebhookSecretParam)),
+		[]byte(secret),
+	) == 1
+}
+
+// imageWebhookHandler returns a handler for push webhooks from the provided
+// container registry. Every Warehouse with an image subscription that a push
+// is relevant to is refreshed, provided the webhook can be authenticated using
+// the webhook secret stored as credentials in the Warehouse's own project.
+func (s *server) imageWebhookHandler(provider imageWebhookProvider) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := logging.LoggerFromContext(r.Context()).
+			WithField("provider", provider.name)
+
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		body, err := io.ReadAll(
+			io.LimitReader(r.Body, maxWarehouseWebhookRequestBytes),
+		)
+		if err != nil {
+			http.Error(
+				w,
+				fmt.Sprintf("error reading request body: %s", err),
+				http.StatusBadRequest,
+			)
+			return
+		}
+
+		pushes, err := provider.parsePushes(body)
+		if err != nil {
+			http.Error(
+				w,
+				fmt.Sprintf("error decoding request body: %s", err),
+				http.StatusBadRequest,
+			)
+			return
+		}
+		if len(pushes) == 0 {
+			// Nothing to do, but there's no reason to report a failure either.
+			writeWarehouseWebhookResponse(r.Context(), w, []string{})
+			return
+		}
+
+		refreshed, candidates, err := s.refreshAuthenticatedWarehouses(
+			r.Context(),
+			libCreds.TypeImageWebhook,
+			func(warehouse *kargoapi.Warehouse) (string, bool) {
+				return warehouseSubscribesToImagePush(warehouse, pushes)
+			},
+			func(secret string) bool {
+				return provider.verify(r, secret)
+			},
+		)
+		if err != nil {
+			logger.Errorf("error refreshing Warehouses for image push: %s", err)
+			http.Error(w, "error refreshing Warehouses", http.StatusInternalServerError)
+			return
+		}
+		// A push that could not be authenticated for any Warehouse it is
+		// relevant to is answered exactly as a push that is relevant to none.
+		// Otherwise, callers could learn which repositories are subscribed to.
+		if candidates > 0 && len(refreshed) == 0 {
+			logger.Info("could not verify webhook secret for any matching Warehouse")
+		}
+		logger.Debugf("refreshed %d Warehouse(s)", len(refreshed))
+
+		writeWarehouseWebhookResponse(r.Context(), w, refreshed)
+	}
+}
+
+// warehouseSubscribesToImagePush returns the URL of the first of the provided
+// Warehouse's image subscriptions that any of the provided pushes is relevant
+// to and true, or an empty string and false if there is no such subscription.
+func warehouseSubscribesToImagePush(
+	warehouse *kargoapi.Warehouse,
+	pushes []imagePush,
+) (string, bool) {
+	for _, sub := range warehouse.Spec.Subscriptions {
+		if sub.Image == nil {
+			continue
+		}
+		subURL := image.NormalizeRepoURL(sub.Image.RepoURL)
+		for _, push := range pushes {
+			if image.NormalizeRepoURL(push.repoURL) == subURL &&
+				imagePushMatchesTag(sub.Image, push.tag) {
+				return sub.Image.RepoURL, true
+			}
+		}
+	}
+	return "", false
+}
+
+// imagePushMatchesTag returns true if the provided image subscription could
+// select an image with the provided tag. Subscriptions that select a tag's
+// digest only care about that one tag, while all others respect their
+// AllowTags and IgnoreTags fields. Pushes without a tag, and subscriptions
+// pinned to a digest, are never relevant.
+func imagePushMatchesTag(sub *kargoapi.ImageSubscription, tag string) bool {
+	if tag == "" {
+		return false
+	}
+	if sub.ImageSelectionStrategy == kargoapi.ImageSelectionStrategyDigest {
+		return sub.Digest == "" && tag == sub.SemverConstraint
+	}
+	allowed, err := image.TagAllowed(tag, sub.AllowTags, sub.IgnoreTags)
+	// A subscription with an invalid AllowTags cannot select anything until it
+	// is fixed, so there's no point in refreshing its Warehouse.
+	return err == nil && allowed
+}
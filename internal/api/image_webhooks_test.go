@@ -0,0 +1,396 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libCreds "github.com/akuity/kargo/internal/credentials"
+)
+
+func TestImageWebhookHandler(t *testing.T) {
+	harborPayload, err := testData.ReadFile("testdata/harbor-push.json")
+	require.NoError(t, err)
+	dockerHubPayload, err := testData.ReadFile("testdata/dockerhub-push.json")
+	require.NoError(t, err)
+	distributionPayload, err := testData.ReadFile("testdata/distribution-push.json")
+	require.NoError(t, err)
+
+	newWarehouse := func(namespace string, sub kargoapi.ImageSubscription) *kargoapi.Warehouse {
+		return &kargoapi.Warehouse{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      "fake-warehouse",
+			},
+			Spec: kargoapi.WarehouseSpec{
+				Subscriptions: []kargoapi.RepoSubscription{{Image: &sub}},
+			},
+		}
+	}
+	// Each project has its own webhook secret
+	webhookSecrets := map[string]string{
+		"project-a": "secret-a",
+		"project-b": "secret-b",
+		"project-c": "secret-c",
+		"project-d": "secret-d",
+		"project-e": "secret-e",
+	}
+	credentialsDB := &libCreds.FakeDB{
+		GetFn: func(
+			_ context.Context,
+			namespace string,
+			credType libCreds.Type,
+			_ string,
+		) (libCreds.Credentials, bool, error) {
+			if credType != libCreds.TypeImageWebhook {
+				return libCreds.Credentials{}, false, nil
+			}
+			secret, ok := webhookSecrets[namespace]
+			return libCreds.Credentials{WebhookSecret: secret}, ok, nil
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		provider      imageWebhookProvider
+		method        string
+		target        string
+		header        http.Header
+		body          []byte
+		credentialsDB libCreds.Database
+		assertions    func(*testing.T, *httptest.ResponseRecorder, client.Client)
+	}{
+		{
+			name:     "method not allowed",
+			provider: harborWebhookProvider,
+			method:   http.MethodGet,
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, _ client.Client) {
+				require.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+			},
+		},
+		{
+			name:     "invalid body",
+			provider: harborWebhookProvider,
+			body:     []byte(`{`),
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, _ client.Client) {
+				require.Equal(t, http.StatusBadRequest, rr.Code)
+			},
+		},
+		{
+			name:     "event other than push",
+			provider: harborWebhookProvider,
+			header:   http.Header{"Authorization": []string{"secret-a"}},
+			body:     []byte(`{"type":"DELETE_ARTIFACT","event_data":{"resources":[]}}`),
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, _ client.Client) {
+				require.Equal(t, http.StatusAccepted, rr.Code)
+				requireRefreshedWarehouses(t, rr)
+			},
+		},
+		{
+			name:     "Harbor push with invalid auth header",
+			provider: harborWebhookProvider,
+			header:   http.Header{"Authorization": []string{"wrong-secret"}},
+			body:     harborPayload,
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, c client.Client) {
+				// Indistinguishable from a push nobody subscribes to
+				require.Equal(t, http.StatusAccepted, rr.Code)
+				requireRefreshedWarehouses(t, rr)
+				requireWarehouseRefreshed(t, c, "project-a", false)
+			},
+		},
+		{
+			name:     "Harbor push with valid auth header",
+			provider: harborWebhookProvider,
+			header:   http.Header{"Authorization": []string{"secret-a"}},
+			body:     harborPayload,
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, c client.Client) {
+				require.Equal(t, http.StatusAccepted, rr.Code)
+				// project-b subscribes to the same repository, but ignores the
+				// pushed tag.
+				requireRefreshedWarehouses(t, rr, "project-a/fake-warehouse")
+				requireWarehouseRefreshed(t, c, "project-a", true)
+				requireWarehouseRefreshed(t, c, "project-b", false)
+			},
+		},
+		{
+			name:     "Harbor push to repository nobody subscribes to",
+			provider: harborWebhookProvider,
+			header:   http.Header{"Authorization": []string{"wrong-secret"}},
+			body: []byte(`{
+				"type": "PUSH_ARTIFACT",
+				"event_data": {
+					"resources": [{
+						"tag": "v1.2.0",
+						"resource_url": "harbor.example.com/kargo-demo/other:v1.2.0"
+					}]
+				}
+			}`),
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, _ client.Client) {
+				require.Equal(t, http.StatusAccepted, rr.Code)
+				requireRefreshedWarehouses(t, rr)
+			},
+		},
+		{
+			name:     "error getting webhook secret",
+			provider: harborWebhookProvider,
+			header:   http.Header{"Authorization": []string{"secret-a"}},
+			body:     harborPayload,
+			credentialsDB: &libCreds.FakeDB{
+				GetFn: func(
+					context.Context,
+					string,
+					libCreds.Type,
+					string,
+				) (libCreds.Credentials, bool, error) {
+					return libCreds.Credentials{}, false, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, _ client.Client) {
+				require.Equal(t, http.StatusInternalServerError, rr.Code)
+			},
+		},
+		{
+			name:     "Docker Hub push without secret",
+			provider: dockerHubWebhookProvider,
+			body:     dockerHubPayload,
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, c client.Client) {
+				// Indistinguishable from a push nobody subscribes to
+				require.Equal(t, http.StatusAccepted, rr.Code)
+				requireRefreshedWarehouses(t, rr)
+				requireWarehouseRefreshed(t, c, "project-c", false)
+			},
+		},
+		{
+			name:     "Docker Hub push with valid secret",
+			provider: dockerHubWebhookProvider,
+			target:   "/?secret=secret-c",
+			body:     dockerHubPayload,
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, c client.Client) {
+				require.Equal(t, http.StatusAccepted, rr.Code)
+				requireRefreshedWarehouses(t, rr, "project-c/fake-warehouse")
+				requireWarehouseRefreshed(t, c, "project-c", true)
+			},
+		},
+		{
+			name:     "Distribution push with valid auth header",
+			provider: distributionWebhookProvider,
+			header:   http.Header{"Authorization": []string{"secret-d"}},
+			body:     distributionPayload,
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, c client.Client) {
+				require.Equal(t, http.StatusAccepted, rr.Code)
+				// project-e subscribes to the digest of a different tag.
+				requireRefreshedWarehouses(t, rr, "project-d/fake-warehouse")
+				requireWarehouseRefreshed(t, c, "project-d", true)
+				requireWarehouseRefreshed(t, c, "project-e", false)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().
+				WithScheme(mustNewScheme()).
+				WithObjects(
+					newWarehouse("project-a", kargoapi.ImageSubscription{
+						RepoURL:   "harbor.example.com/kargo-demo/guestbook",
+						AllowTags: `^v\d+`,
+					}),
+					newWarehouse("project-b", kargoapi.ImageSubscription{
+						RepoURL:    "harbor.example.com/kargo-demo/guestbook",
+						IgnoreTags: []string{"v1.2.0"},
+					}),
+					newWarehouse("project-c", kargoapi.ImageSubscription{
+						RepoURL: "example/guestbook",
+					}),
+					newWarehouse("project-d", kargoapi.ImageSubscription{
+						RepoURL:                "registry.example.com:5000/example/guestbook",
+						ImageSelectionStrategy: kargoapi.ImageSelectionStrategyDigest,
+						SemverConstraint:       "v1.2.0",
+					}),
+					newWarehouse("project-e", kargoapi.ImageSubscription{
+						RepoURL:                "registry.example.com:5000/example/guestbook",
+						ImageSelectionStrategy: kargoapi.ImageSelectionStrategyDigest,
+						SemverConstraint:       "latest",
+					}),
+				).
+				Build()
+			s := &server{
+				internalClient: c,
+				credentialsDB:  credentialsDB,
+			}
+			if testCase.credentialsDB != nil {
+				s.credentialsDB = testCase.credentialsDB
+			}
+			method := testCase.method
+			if method == "" {
+				method = http.MethodPost
+			}
+			target := testCase.target
+			if target == "" {
+				target = "/"
+			}
+			req := httptest.NewRequest(method, target, bytes.NewReader(testCase.body))
+			for key, values := range testCase.header {
+				for _, value := range values {
+					req.Header.Add(key, value)
+				}
+			}
+			rr := httptest.NewRecorder()
+			s.imageWebhookHandler(testCase.provider).ServeHTTP(rr, req)
+			testCase.assertions(t, rr, c)
+		})
+	}
+}
+
+func TestImageWebhookProvidersParsePushes(t *testing.T) {
+	testCases := []struct {
+		name     string
+		provider imageWebhookProvider
+		payload  string
+		expected []imagePush
+	}{
+		{
+			name:     "Harbor",
+			provider: harborWebhookProvider,
+			payload:  "testdata/harbor-push.json",
+			expected: []imagePush{{
+				repoURL: "harbor.example.com/kargo-demo/guestbook",
+				tag:     "v1.2.0",
+			}},
+		},
+		{
+			name:     "Docker Hub",
+			provider: dockerHubWebhookProvider,
+			payload:  "testdata/dockerhub-push.json",
+			expected: []imagePush{{
+				repoURL: "docker.io/example/guestbook",
+				tag:     "v1.2.0",
+			}},
+		},
+		{
+			name:     "Distribution",
+			provider: distributionWebhookProvider,
+			payload:  "testdata/distribution-push.json",
+			// The blob push is reported without a tag and the pull is not
+			// reported at all.
+			expected: []imagePush{
+				{repoURL: "registry.example.com:5000/example/guestbook"},
+				{
+					repoURL: "registry.example.com:5000/example/guestbook",
+					tag:     "v1.2.0",
+				},
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			payload, err := testData.ReadFile(testCase.payload)
+			require.NoError(t, err)
+			pushes, err := testCase.provider.parsePushes(payload)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, pushes)
+		})
+	}
+}
+
+func TestImageWebhookVerifiers(t *testing.T) {
+	const testSecret = "fake-secret"
+
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	require.False(t, verifyAuthorizationHeader(req, testSecret))
+	req.Header.Set("Authorization", "wrong-secret")
+	require.False(t, verifyAuthorizationHeader(req, testSecret))
+	req.Header.Set("Authorization", testSecret)
+	require.True(t, verifyAuthorizationHeader(req, testSecret))
+
+	req = httptest.NewRequest(http.MethodPost, "/", nil)
+	require.False(t, verifyDockerHubSecret(req, testSecret))
+	req = httptest.NewRequest(http.MethodPost, "/?secret=wrong-secret", nil)
+	require.False(t, verifyDockerHubSecret(req, testSecret))
+	req = httptest.NewRequest(http.MethodPost, "/?secret="+testSecret, nil)
+	require.True(t, verifyDockerHubSecret(req, testSecret))
+}
+
+func TestImagePushMatchesTag(t *testing.T) {
+	testCases := []struct {
+		name     string
+		sub      kargoapi.ImageSubscription
+		tag      string
+		expected bool
+	}{
+		{
+			name:     "push without tag",
+			sub:      kargoapi.ImageSubscription{},
+			expected: false,
+		},
+		{
+			name:     "no tag criteria",
+			sub:      kargoapi.ImageSubscription{},
+			tag:      "latest",
+			expected: true,
+		},
+		{
+			name:     "tag not allowed",
+			sub:      kargoapi.ImageSubscription{AllowTags: `^v\d+`},
+			tag:      "latest",
+			expected: false,
+		},
+		{
+			name:     "invalid allowed tags",
+			sub:      kargoapi.ImageSubscription{AllowTags: `(`},
+			tag:      "latest",
+			expected: false,
+		},
+		{
+			name:     "tag ignored",
+			sub:      kargoapi.ImageSubscription{IgnoreTags: []string{"latest"}},
+			tag:      "latest",
+			expected: false,
+		},
+		{
+			name: "digest of the pushed tag",
+			sub: kargoapi.ImageSubscription{
+				ImageSelectionStrategy: kargoapi.ImageSelectionStrategyDigest,
+				SemverConstraint:       "latest",
+			},
+			tag:      "latest",
+			expected: true,
+		},
+		{
+			name: "digest of another tag",
+			sub: kargoapi.ImageSubscription{
+				ImageSelectionStrategy: kargoapi.ImageSelectionStrategyDigest,
+				SemverConstraint:       "stable",
+			},
+			tag:      "latest",
+			expected: false,
+		},
+		{
+			name: "pinned digest",
+			sub: kargoapi.ImageSubscription{
+				ImageSelectionStrategy: kargoapi.ImageSelectionStrategyDigest,
+				SemverConstraint:       "latest",
+				Digest:                 "sha256:8b6b4d2a2d0c1d2a3f4e5b6c7d8e9f0a1b2c3d4e5f6a7b8c9d0e1f2a3b4c5d6e",
+			},
+			tag:      "latest",
+			expected: false,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				imagePushMatchesTag(&testCase.sub, testCase.tag),
+			)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"connectrpc.com/connect"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libargocd "github.com/akuity/kargo/internal/argocd"
+	"github.com/akuity/kargo/internal/kubeclient"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func (s *server) ListPromotionsByArgoCDApplication(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.ListPromotionsByArgoCDApplicationRequest],
+) (*connect.Response[svcv1alpha1.ListPromotionsByArgoCDApplicationResponse], error) {
+	project := req.Msg.GetProject()
+	if err := validateFieldNotEmpty("project", project); err != nil {
+		return nil, err
+	}
+	appName := req.Msg.GetAppName()
+	if err := validateFieldNotEmpty("app_name", appName); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateProjectExists(ctx, project); err != nil {
+		return nil, err
+	}
+
+	appNamespace := req.Msg.GetAppNamespace()
+	if appNamespace == "" {
+		appNamespace = libargocd.Namespace()
+	}
+
+	var list kargoapi.PromotionList
+	if err := s.client.List(
+		ctx,
+		&list,
+		client.InNamespace(project),
+		client.MatchingFields{
+			kubeclient.PromotionsByArgoCDApplicationsIndexField: kubeclient.ArgoCDInstanceApplicationKey(
+				req.Msg.GetAppInstance(),
+				appNamespace,
+				appName,
+			),
+		},
+	); err != nil {
+		return nil, fmt.Errorf("list promotions: %w", err)
+	}
+
+	sort.Slice(list.Items, func(i, j int) bool {
+		return list.Items[i].CreationTimestamp.After(list.Items[j].CreationTimestamp.Time)
+	})
+
+	promotions := make([]*kargoapi.Promotion, 0, len(list.Items))
+	for idx := range list.Items {
+		if req.Msg.GetRunningOnly() &&
+			list.Items[idx].Status.Phase != kargoapi.PromotionPhaseRunning {
+			continue
+		}
+		promotions = append(promotions, &list.Items[idx])
+	}
+
+	return connect.NewResponse(&svcv1alpha1.ListPromotionsByArgoCDApplicationResponse{
+		Promotions: promotions,
+	}), nil
+}
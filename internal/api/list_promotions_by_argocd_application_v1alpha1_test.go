@@ -0,0 +1,274 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/user"
+	"github.com/akuity/kargo/internal/api/validation"
+	libargocd "github.com/akuity/kargo/internal/argocd"
+	rollouts "github.com/akuity/kargo/internal/controller/rollouts/api/v1alpha1"
+	"github.com/akuity/kargo/internal/kubeclient"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestListPromotionsByArgoCDApplication(t *testing.T) {
+	// appsByStage simulates the Argo CD Applications updated by each Stage.
+	appsByStage := map[string][]string{
+		"test-stage": {
+			kubeclient.ArgoCDApplicationKey("app-namespace", "test-app"),
+			kubeclient.ArgoCDApplicationKey(libargocd.Namespace(), "default-app"),
+		},
+		"other-stage": {
+			kubeclient.ArgoCDApplicationKey("app-namespace", "other-app"),
+			kubeclient.ArgoCDInstanceApplicationKey("other-instance", "app-namespace", "test-app"),
+		},
+	}
+
+	promotions := []client.Object{
+		mustNewObject[corev1.Namespace]("testdata/namespace.yaml"),
+		&kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "old-promotion",
+				Namespace:         "kargo-demo",
+				CreationTimestamp: metav1.NewTime(metav1.Now().Add(-1 * time.Hour)),
+			},
+			Spec: kargoapi.PromotionSpec{
+				Stage: "test-stage",
+			},
+			Status: kargoapi.PromotionStatus{
+				Phase: kargoapi.PromotionPhaseSucceeded,
+			},
+		},
+		&kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "new-promotion",
+				Namespace:         "kargo-demo",
+				CreationTimestamp: metav1.Now(),
+			},
+			Spec: kargoapi.PromotionSpec{
+				Stage: "test-stage",
+			},
+			Status: kargoapi.PromotionStatus{
+				Phase: kargoapi.PromotionPhaseRunning,
+			},
+		},
+		&kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "other-promotion",
+				Namespace: "kargo-demo",
+			},
+			Spec: kargoapi.PromotionSpec{
+				Stage: "other-stage",
+			},
+			Status: kargoapi.PromotionStatus{
+				Phase: kargoapi.PromotionPhaseRunning,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		req        *svcv1alpha1.ListPromotionsByArgoCDApplicationRequest
+		objects    []client.Object
+		assertions func(
+			*testing.T,
+			*connect.Response[svcv1alpha1.ListPromotionsByArgoCDApplicationResponse],
+			error,
+		)
+	}{
+		"empty project": {
+			req: &svcv1alpha1.ListPromotionsByArgoCDApplicationRequest{
+				AppName: "test-app",
+			},
+			assertions: func(
+				t *testing.T,
+				r *connect.Response[svcv1alpha1.ListPromotionsByArgoCDApplicationResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				require.Nil(t, r)
+			},
+		},
+		"empty app name": {
+			req: &svcv1alpha1.ListPromotionsByArgoCDApplicationRequest{
+				Project: "kargo-demo",
+			},
+			assertions: func(
+				t *testing.T,
+				r *connect.Response[svcv1alpha1.ListPromotionsByArgoCDApplicationResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				require.Nil(t, r)
+			},
+		},
+		"non-existing project": {
+			req: &svcv1alpha1.ListPromotionsByArgoCDApplicationRequest{
+				Project: "non-existing-project",
+				AppName: "test-app",
+			},
+			assertions: func(
+				t *testing.T,
+				r *connect.Response[svcv1alpha1.ListPromotionsByArgoCDApplicationResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+				require.Nil(t, r)
+			},
+		},
+		"all Promotions for Application": {
+			req: &svcv1alpha1.ListPromotionsByArgoCDApplicationRequest{
+				Project:      "kargo-demo",
+				AppNamespace: ptr.To("app-namespace"),
+				AppName:      "test-app",
+			},
+			objects: promotions,
+			assertions: func(
+				t *testing.T,
+				r *connect.Response[svcv1alpha1.ListPromotionsByArgoCDApplicationResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Len(t, r.Msg.GetPromotions(), 2)
+				require.Equal(t, "new-promotion", r.Msg.GetPromotions()[0].GetName())
+				require.Equal(t, "old-promotion", r.Msg.GetPromotions()[1].GetName())
+			},
+		},
+		"running Promotions for Application": {
+			req: &svcv1alpha1.ListPromotionsByArgoCDApplicationRequest{
+				Project:      "kargo-demo",
+				AppNamespace: ptr.To("app-namespace"),
+				AppName:      "test-app",
+				RunningOnly:  true,
+			},
+			objects: promotions,
+			assertions: func(
+				t *testing.T,
+				r *connect.Response[svcv1alpha1.ListPromotionsByArgoCDApplicationResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Len(t, r.Msg.GetPromotions(), 1)
+				require.Equal(t, "new-promotion", r.Msg.GetPromotions()[0].GetName())
+			},
+		},
+		"Application in default namespace": {
+			req: &svcv1alpha1.ListPromotionsByArgoCDApplicationRequest{
+				Project: "kargo-demo",
+				AppName: "default-app",
+			},
+			objects: promotions,
+			assertions: func(
+				t *testing.T,
+				r *connect.Response[svcv1alpha1.ListPromotionsByArgoCDApplicationResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Len(t, r.Msg.GetPromotions(), 2)
+			},
+		},
+		"Application on additional Argo CD instance": {
+			req: &svcv1alpha1.ListPromotionsByArgoCDApplicationRequest{
+				Project:      "kargo-demo",
+				AppInstance:  ptr.To("other-instance"),
+				AppNamespace: ptr.To("app-namespace"),
+				AppName:      "test-app",
+			},
+			objects: promotions,
+			assertions: func(
+				t *testing.T,
+				r *connect.Response[svcv1alpha1.ListPromotionsByArgoCDApplicationResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Len(t, r.Msg.GetPromotions(), 1)
+				require.Equal(t, "other-promotion", r.Msg.GetPromotions()[0].GetName())
+			},
+		},
+		"no Promotions for Application": {
+			req: &svcv1alpha1.ListPromotionsByArgoCDApplicationRequest{
+				Project:      "kargo-demo",
+				AppNamespace: ptr.To("app-namespace"),
+				AppName:      "unknown-app",
+			},
+			objects: promotions,
+			assertions: func(
+				t *testing.T,
+				r *connect.Response[svcv1alpha1.ListPromotionsByArgoCDApplicationResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Empty(t, r.Msg.GetPromotions())
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Simulate an admin user to prevent any authz issues with the authorizing
+			// client.
+			ctx := user.ContextWithInfo(
+				context.Background(),
+				user.Info{
+					IsAdmin: true,
+				},
+			)
+
+			client, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					NewInternalClient: func(
+						_ context.Context,
+						_ *rest.Config,
+						scheme *runtime.Scheme,
+					) (client.Client, error) {
+						if err := rollouts.AddToScheme(scheme); err != nil {
+							return nil, err
+						}
+
+						c := fake.NewClientBuilder().
+							WithScheme(scheme).
+							WithIndex(
+								&kargoapi.Promotion{},
+								kubeclient.PromotionsByArgoCDApplicationsIndexField,
+								func(obj client.Object) []string {
+									return appsByStage[obj.(*kargoapi.Promotion).Spec.Stage] // nolint: forcetypeassert
+								},
+							)
+						if len(testCase.objects) > 0 {
+							c.WithObjects(testCase.objects...)
+						}
+						return c.Build(), nil
+					},
+				},
+			)
+			require.NoError(t, err)
+
+			svr := &server{
+				client:                    client,
+				externalValidateProjectFn: validation.ValidateProject,
+			}
+			res, err := (svr).ListPromotionsByArgoCDApplication(ctx, connect.NewRequest(testCase.req))
+			testCase.assertions(t, res, err)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"connectrpc.com/connect"
@@ -19,6 +20,12 @@ func (s *server) ListStages(
 	if err := validateFieldNotEmpty("project", project); err != nil {
 		return nil, err
 	}
+	if req.Msg.HistoryLimit != nil && req.Msg.GetHistoryLimit() < 0 {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("history_limit must not be negative"),
+		)
+	}
 
 	if err := s.validateProjectExists(ctx, project); err != nil {
 		return nil, err
@@ -31,9 +38,25 @@ func (s *server) ListStages(
 
 	stages := make([]*kargoapi.Stage, len(list.Items))
 	for idx := range list.Items {
+		if req.Msg.HistoryLimit != nil {
+			limitStageHistory(&list.Items[idx], int(req.Msg.GetHistoryLimit()))
+		}
 		stages[idx] = &list.Items[idx]
 	}
 	return connect.NewResponse(&svcv1alpha1.ListStagesResponse{
 		Stages: stages,
 	}), nil
 }
+
+// limitStageHistory truncates the provided Stage's Freight history to at most
+// limit entries. The most recent entries, which are at the front of the stack,
+// are retained. A limit of zero drops the history entirely.
+func limitStageHistory(stage *kargoapi.Stage, limit int) {
+	if limit == 0 {
+		stage.Status.History = nil
+		return
+	}
+	if len(stage.Status.History) > limit {
+		stage.Status.History = stage.Status.History[:limit]
+	}
+}
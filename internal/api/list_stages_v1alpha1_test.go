@@ -9,6 +9,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -21,9 +22,10 @@ import (
 
 func TestListStages(t *testing.T) {
 	testSets := map[string]struct {
-		req          *svcv1alpha1.ListStagesRequest
-		errExpected  bool
-		expectedCode connect.Code
+		req                *svcv1alpha1.ListStagesRequest
+		errExpected        bool
+		expectedCode       connect.Code
+		expectedHistoryLen int
 	}{
 		"empty project": {
 			req: &svcv1alpha1.ListStagesRequest{
@@ -36,6 +38,44 @@ func TestListStages(t *testing.T) {
 			req: &svcv1alpha1.ListStagesRequest{
 				Project: "kargo-demo",
 			},
+			expectedHistoryLen: 3,
+		},
+		"negative history limit": {
+			req: &svcv1alpha1.ListStagesRequest{
+				Project:      "kargo-demo",
+				HistoryLimit: ptr.To[int32](-1),
+			},
+			errExpected:  true,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		"negative history limit in non-existing project": {
+			req: &svcv1alpha1.ListStagesRequest{
+				Project:      "non-existing-project",
+				HistoryLimit: ptr.To[int32](-1),
+			},
+			errExpected:  true,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		"history limit": {
+			req: &svcv1alpha1.ListStagesRequest{
+				Project:      "kargo-demo",
+				HistoryLimit: ptr.To[int32](2),
+			},
+			expectedHistoryLen: 2,
+		},
+		"history limit greater than history": {
+			req: &svcv1alpha1.ListStagesRequest{
+				Project:      "kargo-demo",
+				HistoryLimit: ptr.To[int32](5),
+			},
+			expectedHistoryLen: 3,
+		},
+		"zero history limit": {
+			req: &svcv1alpha1.ListStagesRequest{
+				Project:      "kargo-demo",
+				HistoryLimit: ptr.To[int32](0),
+			},
+			expectedHistoryLen: 0,
 		},
 		"non-existing project": {
 			req: &svcv1alpha1.ListStagesRequest{
@@ -52,6 +92,13 @@ func TestListStages(t *testing.T) {
 
 			// Simulate an admin user to prevent any authz issues with the authorizing
 			// client.
+			stage := mustNewObject[kargoapi.Stage]("testdata/stage.yaml")
+			stage.Status.History = kargoapi.FreightReferenceStack{
+				{Name: "third"},
+				{Name: "second"},
+				{Name: "first"},
+			}
+
 			ctx := user.ContextWithInfo(
 				context.Background(),
 				user.Info{
@@ -74,9 +121,7 @@ func TestListStages(t *testing.T) {
 								mustNewObject[corev1.Namespace]("testdata/namespace.yaml"),
 							).
 							WithLists(&kargoapi.StageList{
-								Items: []kargoapi.Stage{
-									*mustNewObject[kargoapi.Stage]("testdata/stage.yaml"),
-								},
+								Items: []kargoapi.Stage{*stage},
 							}).
 							Build(), nil
 					},
@@ -95,6 +140,11 @@ func TestListStages(t *testing.T) {
 				return
 			}
 			require.Len(t, res.Msg.GetStages(), 1)
+			history := res.Msg.GetStages()[0].Status.History
+			require.Len(t, history, ts.expectedHistoryLen)
+			if ts.expectedHistoryLen > 0 {
+				require.Equal(t, "third", history[0].Name)
+			}
 		})
 	}
 }
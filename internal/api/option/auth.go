@@ -30,6 +30,8 @@ const authHeaderKey = "Authorization"
 var exemptProcedures = map[string]struct{}{
 	"/grpc.health.v1.Health/Check":                                   {},
 	"/grpc.health.v1.Health/Watch":                                   {},
+	"/grpc.reflection.v1.ServerReflection/ServerReflectionInfo":      {},
+	"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo": {},
 	"/akuity.io.kargo.service.v1alpha1.KargoService/GetPublicConfig": {},
 	"/akuity.io.kargo.service.v1alpha1.KargoService/AdminLogin":      {},
 }
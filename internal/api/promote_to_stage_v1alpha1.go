@@ -90,7 +90,9 @@ func (s *server) PromoteToStage(
 	for i, upstreamStage := range stage.Spec.Subscriptions.UpstreamStages {
 		upstreamStages[i] = upstreamStage.Name
 	}
-	if !s.isFreightAvailableFn(freight, stage.Name, upstreamStages) {
+	// A forced Promotion bypasses this check, but the controller will still
+	// flag it as forced when it runs.
+	if !req.Msg.GetForce() && !s.isFreightAvailableFn(freight, stage.Name, upstreamStages) {
 		return nil, connect.NewError(
 			connect.CodeInvalidArgument,
 			fmt.Errorf(
@@ -119,6 +121,7 @@ func (s *server) PromoteToStage(
 	}
 
 	promotion := kargo.NewPromotion(ctx, *stage, freight.Name)
+	promotion.Spec.Force = req.Msg.GetForce()
 	if err := s.createPromotionFn(ctx, &promotion); err != nil {
 		return nil, fmt.Errorf("create promotion: %w", err)
 	}
@@ -165,29 +165,7 @@ func (s *server) getAvailableFreightForStage(
 			err,
 		)
 	}
-	if len(verifiedFreight) == 0 &&
-		len(approvedFreight.Items) == 0 {
-		return nil, nil
-	}
-	// De-dupe
-	availableFreightMap := make(
-		map[string]kargoapi.Freight,
-		len(verifiedFreight)+len(approvedFreight.Items),
-	)
-	for _, freight := range verifiedFreight {
-		availableFreightMap[freight.Name] = freight
-	}
-	for _, freight := range approvedFreight.Items {
-		availableFreightMap[freight.Name] = freight
-	}
-	// Turn the map to a list
-	availableFreight := make([]kargoapi.Freight, len(availableFreightMap))
-	var i int
-	for _, freight := range availableFreightMap {
-		availableFreight[i] = freight
-		i++
-	}
-	return availableFreight, nil
+	return mergeFreight(verifiedFreight, approvedFreight.Items), nil
 }
 
 func (s *server) getFreightFromWarehouse(
@@ -222,9 +200,9 @@ func (s *server) getVerifiedFreight(
 	project string,
 	stageSubs []kargoapi.StageSubscription,
 ) ([]kargoapi.Freight, error) {
-	// Start by building a de-duped map of Freight verified in any upstream
-	// Stage(s)
-	verifiedFreight := map[string]kargoapi.Freight{}
+	// Collect Freight verified in each upstream Stage. Upstream Stages may be
+	// fed by different Warehouses, or by the same one, so the lists may overlap.
+	verifiedFreight := make([][]kargoapi.Freight, 0, len(stageSubs))
 	for _, stageSub := range stageSubs {
 		var freight kargoapi.FreightList
 		if err := s.listFreightFn(
@@ -245,21 +223,43 @@ func (s *server) getVerifiedFreight(
 				err,
 			)
 		}
-		for _, freight := range freight.Items {
-			verifiedFreight[freight.Name] = freight
+		verifiedFreight = append(verifiedFreight, freight.Items)
+	}
+	return mergeFreight(verifiedFreight...), nil
+}
+
+// mergeFreight merges the provided lists of Freight into a single list in
+// which each piece of Freight, identified by name, appears only once. When the
+// same Freight appears in more than one list, the copy that was seen first
+// (i.e. the one with the earliest creation timestamp) is kept. The merged list
+// is ordered by creation timestamp, with ties broken by name, so the result
+// does not depend on the order of the lists or of their contents. If there is
+// no Freight at all, nil is returned.
+func mergeFreight(lists ...[]kargoapi.Freight) []kargoapi.Freight {
+	freightByName := map[string]kargoapi.Freight{}
+	for _, list := range lists {
+		for _, freight := range list {
+			if existing, ok := freightByName[freight.Name]; ok &&
+				!freight.CreationTimestamp.Before(&existing.CreationTimestamp) {
+				continue
+			}
+			freightByName[freight.Name] = freight
 		}
 	}
-	if len(verifiedFreight) == 0 {
-		return nil, nil
+	if len(freightByName) == 0 {
+		return nil
 	}
-	// Turn the map to a list
-	verifiedFreightList := make([]kargoapi.Freight, len(verifiedFreight))
-	i := 0
-	for _, freight := range verifiedFreight {
-		verifiedFreightList[i] = freight
-		i++
+	merged := make([]kargoapi.Freight, 0, len(freightByName))
+	for _, freight := range freightByName {
+		merged = append(merged, freight)
 	}
-	return verifiedFreightList, nil
+	sort.Slice(merged, func(i, j int) bool {
+		if !merged[i].CreationTimestamp.Equal(&merged[j].CreationTimestamp) {
+			return merged[i].CreationTimestamp.Before(&merged[j].CreationTimestamp)
+		}
+		return merged[i].Name < merged[j].Name
+	})
+	return merged
 }
 
 func groupByImageRepo(
@@ -339,7 +339,9 @@ func sortFreightGroups(orderBy string, reverse bool, groups map[string]*svcv1alp
 		if reverse {
 			dataToSort = sort.Reverse(dataToSort)
 		}
-		sort.Sort(dataToSort)
+		// A stable sort preserves the deterministic order in which available
+		// Freight was assembled whenever two pieces of Freight compare as equal.
+		sort.Stable(dataToSort)
 	}
 }
 
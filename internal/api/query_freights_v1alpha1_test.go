@@ -668,6 +668,82 @@ func TestGetVerifiedFreight(t *testing.T) {
 	}
 }
 
+func TestMergeFreight(t *testing.T) {
+	earlier := metav1.NewTime(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC))
+	later := metav1.NewTime(earlier.Add(time.Hour))
+	latest := metav1.NewTime(later.Add(time.Hour))
+
+	newFreight := func(name, warehouse string, created metav1.Time) kargoapi.Freight {
+		return kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				CreationTimestamp: created,
+			},
+			Warehouse: warehouse,
+		}
+	}
+
+	// Freight verified in an upstream Stage fed by one Warehouse
+	fromWarehouseA := []kargoapi.Freight{
+		newFreight("shared", "warehouse-a", later),
+		newFreight("a-only", "warehouse-a", latest),
+	}
+	// Freight verified in an upstream Stage fed by another Warehouse. The
+	// "shared" Freight appears here as well, but was seen earlier.
+	fromWarehouseB := []kargoapi.Freight{
+		newFreight("b-only", "warehouse-b", later),
+		newFreight("shared", "warehouse-b", earlier),
+	}
+
+	testCases := []struct {
+		name       string
+		lists      [][]kargoapi.Freight
+		assertions func(*testing.T, []kargoapi.Freight)
+	}{
+		{
+			name: "no Freight",
+			assertions: func(t *testing.T, merged []kargoapi.Freight) {
+				require.Nil(t, merged)
+			},
+		},
+		{
+			name:  "overlapping and distinct Freight from two Warehouses",
+			lists: [][]kargoapi.Freight{fromWarehouseA, fromWarehouseB},
+			assertions: func(t *testing.T, merged []kargoapi.Freight) {
+				require.Equal(
+					t,
+					[]kargoapi.Freight{
+						newFreight("shared", "warehouse-b", earlier),
+						newFreight("b-only", "warehouse-b", later),
+						newFreight("a-only", "warehouse-a", latest),
+					},
+					merged,
+				)
+			},
+		},
+		{
+			name:  "order of sources does not matter",
+			lists: [][]kargoapi.Freight{fromWarehouseB, fromWarehouseA},
+			assertions: func(t *testing.T, merged []kargoapi.Freight) {
+				require.Equal(
+					t,
+					[]kargoapi.Freight{
+						newFreight("shared", "warehouse-b", earlier),
+						newFreight("b-only", "warehouse-b", later),
+						newFreight("a-only", "warehouse-a", latest),
+					},
+					merged,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(t, mergeFreight(testCase.lists...))
+		})
+	}
+}
+
 func TestGroupByImageRepo(t *testing.T) {
 	testFreight := []kargoapi.Freight{
 		{Images: []kargoapi.Image{{RepoURL: "fake-repo-url"}}},
@@ -0,0 +1,168 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/kargo"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// RetryPromotion creates a new Promotion resource to transition the Stage
+// targeted by a failed or errored Promotion into the state represented by the
+// same Freight. The new Promotion has the same spec as the one it retries and
+// is annotated with its name.
+func (s *server) RetryPromotion(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.RetryPromotionRequest],
+) (*connect.Response[svcv1alpha1.RetryPromotionResponse], error) {
+	project := req.Msg.GetProject()
+	if err := validateFieldNotEmpty("project", project); err != nil {
+		return nil, err
+	}
+
+	name := req.Msg.GetName()
+	if err := validateFieldNotEmpty("name", name); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateProjectExistsFn(ctx, project); err != nil {
+		return nil, err
+	}
+
+	promo, err := s.getPromotionFn(
+		ctx,
+		s.client,
+		types.NamespacedName{
+			Namespace: project,
+			Name:      name,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get promotion: %w", err)
+	}
+	if promo == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			fmt.Errorf("Promotion %q not found in namespace %q", name, project),
+		)
+	}
+	switch promo.Status.Phase {
+	case kargoapi.PromotionPhaseFailed, kargoapi.PromotionPhaseErrored:
+	default:
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			fmt.Errorf(
+				"Promotion %q in namespace %q is in phase %q; only Promotions that "+
+					"have failed or errored can be retried",
+				name,
+				project,
+				promo.Status.Phase,
+			),
+		)
+	}
+
+	stage, err := s.getStageFn(
+		ctx,
+		s.client,
+		types.NamespacedName{
+			Namespace: project,
+			Name:      promo.Spec.Stage,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get stage: %w", err)
+	}
+	if stage == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			fmt.Errorf(
+				"Stage %q not found in namespace %q",
+				promo.Spec.Stage,
+				project,
+			),
+		)
+	}
+
+	// A Promotion of the latest Freight is retried with the Freight it
+	// resolved to, if it got that far.
+	freightName := promo.Spec.Freight
+	if freightName == kargoapi.PromotionFreightLatest &&
+		promo.Status.Freight != nil && promo.Status.Freight.Name != "" {
+		freightName = promo.Status.Freight.Name
+	}
+	freight, err := s.getFreightByNameOrAliasFn(
+		ctx,
+		s.client,
+		project,
+		freightName,
+		"",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get freight: %w", err)
+	}
+	if freight == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			fmt.Errorf(
+				"freight %q not found in namespace %q",
+				freightName,
+				project,
+			),
+		)
+	}
+
+	upstreamStages := make([]string, len(stage.Spec.Subscriptions.UpstreamStages))
+	for i, upstreamStage := range stage.Spec.Subscriptions.UpstreamStages {
+		upstreamStages[i] = upstreamStage.Name
+	}
+	// As when promoting, a forced Promotion bypasses this check, and so does
+	// its retry.
+	if !promo.Spec.Force && !s.isFreightAvailableFn(freight, stage.Name, upstreamStages) {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf(
+				"Freight %q is not available to Stage %q",
+				freightName,
+				stage.Name,
+			),
+		)
+	}
+
+	if err := s.authorizeFn(
+		ctx,
+		"promote",
+		schema.GroupVersionResource{
+			Group:    kargoapi.GroupVersion.Group,
+			Version:  kargoapi.GroupVersion.Version,
+			Resource: "stages",
+		},
+		"",
+		types.NamespacedName{
+			Namespace: project,
+			Name:      stage.Name,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	// The retry is carried out exactly as the original Promotion was, except
+	// that a Promotion of the latest Freight is pinned to the Freight found
+	// above.
+	newPromo := kargo.NewPromotion(ctx, *stage, freight.Name)
+	newPromo.Spec = *promo.Spec.DeepCopy()
+	newPromo.Spec.Freight = freight.Name
+	newPromo.Annotations[kargoapi.AnnotationKeyRetryOf] = promo.Name
+	if err := s.createPromotionFn(ctx, &newPromo); err != nil {
+		return nil, fmt.Errorf("create promotion: %w", err)
+	}
+	s.recordPromotionCreatedEvent(ctx, &newPromo, freight)
+	return connect.NewResponse(&svcv1alpha1.RetryPromotionResponse{
+		Promotion: &newPromo,
+	}), nil
+}
@@ -0,0 +1,125 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/kargo"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// RollbackStage creates a Promotion resource to transition a specified Stage
+// back into the state represented by the most recent Freight in its history
+// that it was known to be Healthy with, other than its current Freight. The
+// new Promotion is annotated with the name of the Freight being rolled back
+// from.
+func (s *server) RollbackStage(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.RollbackStageRequest],
+) (*connect.Response[svcv1alpha1.RollbackStageResponse], error) {
+	project := req.Msg.GetProject()
+	if err := validateFieldNotEmpty("project", project); err != nil {
+		return nil, err
+	}
+
+	stageName := req.Msg.GetStage()
+	if err := validateFieldNotEmpty("stage", stageName); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateProjectExistsFn(ctx, project); err != nil {
+		return nil, err
+	}
+
+	stage, err := s.getStageFn(
+		ctx,
+		s.client,
+		types.NamespacedName{
+			Namespace: project,
+			Name:      stageName,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get stage: %w", err)
+	}
+	if stage == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			fmt.Errorf(
+				"Stage %q not found in namespace %q",
+				stageName,
+				project,
+			),
+		)
+	}
+
+	var currentFreightName string
+	if stage.Status.CurrentFreight != nil {
+		currentFreightName = stage.Status.CurrentFreight.Name
+	}
+	target := stage.Status.History.LastHealthy(currentFreightName)
+	if target == nil {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			fmt.Errorf(
+				"Stage %q in namespace %q has no Freight in its history, other "+
+					"than its current Freight, that it was known to be Healthy with",
+				stageName,
+				project,
+			),
+		)
+	}
+
+	freight, err := s.getFreightByNameOrAliasFn(
+		ctx,
+		s.client,
+		project,
+		target.Name,
+		"",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get freight: %w", err)
+	}
+	if freight == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			fmt.Errorf(
+				"freight %q not found in namespace %q",
+				target.Name,
+				project,
+			),
+		)
+	}
+
+	if err := s.authorizeFn(
+		ctx,
+		"promote",
+		schema.GroupVersionResource{
+			Group:    kargoapi.GroupVersion.Group,
+			Version:  kargoapi.GroupVersion.Version,
+			Resource: "stages",
+		},
+		"",
+		types.NamespacedName{
+			Namespace: project,
+			Name:      stage.Name,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	promotion := kargo.NewPromotion(ctx, *stage, freight.Name)
+	promotion.Annotations[kargoapi.AnnotationKeyRollbackOf] = currentFreightName
+	if err := s.createPromotionFn(ctx, &promotion); err != nil {
+		return nil, fmt.Errorf("create promotion: %w", err)
+	}
+	s.recordPromotionCreatedEvent(ctx, &promotion, freight)
+	return connect.NewResponse(&svcv1alpha1.RollbackStageResponse{
+		Promotion: &promotion,
+	}), nil
+}
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"connectrpc.com/grpchealth"
+	"connectrpc.com/grpcreflect"
 	"github.com/rs/cors"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/http2"
@@ -31,6 +32,7 @@ import (
 	"github.com/akuity/kargo/internal/api/rbac"
 	"github.com/akuity/kargo/internal/api/validation"
 	rollouts "github.com/akuity/kargo/internal/controller/rollouts/api/v1alpha1"
+	libCreds "github.com/akuity/kargo/internal/credentials"
 	httputil "github.com/akuity/kargo/internal/http"
 	"github.com/akuity/kargo/internal/logging"
 	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
@@ -48,8 +50,14 @@ type server struct {
 	client         kubernetes.Client
 	internalClient client.Client
 	rolesDB        rbac.RolesDatabase
+	credentialsDB  libCreds.Database
 	recorder       record.EventRecorder
 
+	// waitForCacheSyncFn, if non-nil, blocks until the caches backing
+	// internalClient have synced. It is used to determine when the server
+	// should report itself as healthy.
+	waitForCacheSyncFn func(context.Context) bool
+
 	// The following behaviors are overridable for testing purposes:
 
 	// Common validations:
@@ -65,6 +73,11 @@ type server struct {
 	) error
 
 	// Common lookups:
+	getPromotionFn func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Promotion, error)
 	getStageFn func(
 		context.Context,
 		client.Client,
@@ -130,6 +143,15 @@ type server struct {
 		newStatus kargoapi.FreightStatus,
 	) error
 
+	// Credentials validation:
+	validateCredentialsFn func(
+		ctx context.Context,
+		db libCreds.Database,
+		namespace string,
+		credType libCreds.Type,
+		repoURL string,
+	) (libCreds.ValidationResult, error)
+
 	// Rollouts integration:
 	getAnalysisTemplateFn func(
 		context.Context,
@@ -162,18 +184,23 @@ func NewServer(
 	kubeClient kubernetes.Client,
 	internalClient client.Client,
 	rolesDB rbac.RolesDatabase,
+	credentialsDB libCreds.Database,
 	recorder record.EventRecorder,
+	waitForCacheSyncFn func(context.Context) bool,
 ) Server {
 	s := &server{
-		cfg:            cfg,
-		client:         kubeClient,
-		internalClient: internalClient,
-		rolesDB:        rolesDB,
-		recorder:       recorder,
+		cfg:                cfg,
+		client:             kubeClient,
+		internalClient:     internalClient,
+		rolesDB:            rolesDB,
+		credentialsDB:      credentialsDB,
+		recorder:           recorder,
+		waitForCacheSyncFn: waitForCacheSyncFn,
 	}
 
 	s.validateProjectExistsFn = s.validateProjectExists
 	s.externalValidateProjectFn = validation.ValidateProject
+	s.getPromotionFn = kargoapi.GetPromotion
 	s.getStageFn = kargoapi.GetStage
 	s.getFreightByNameOrAliasFn = kargoapi.GetFreightByNameOrAlias
 	s.isFreightAvailableFn = kargoapi.IsFreightAvailable
@@ -186,6 +213,8 @@ func NewServer(
 	s.patchFreightAliasFn = s.patchFreightAlias
 	s.patchFreightStatusFn = s.patchFreightStatus
 	s.authorizeFn = kubeClient.Authorize
+	s.validateCredentialsFn =
+		libCreds.NewValidator(cfg.CredentialsValidationConfig).Validate
 	s.getAnalysisTemplateFn = rollouts.GetAnalysisTemplate
 	s.getAnalysisRunFn = rollouts.GetAnalysisRun
 
@@ -200,14 +229,37 @@ func (s *server) Serve(ctx context.Context, l net.Listener) error {
 	if err != nil {
 		return fmt.Errorf("error initializing handler options: %w", err)
 	}
-	mux.Handle(grpchealth.NewHandler(NewHealthChecker(), opts))
+	mux.Handle(
+		grpchealth.NewHandler(NewHealthChecker(ctx, s.waitForCacheSyncFn), opts),
+	)
 	path, svcHandler := svcv1alpha1connect.NewKargoServiceHandler(s, opts)
 	mux.Handle(path, svcHandler)
+	if s.cfg.GRPCReflectionEnabled {
+		reflector := grpcreflect.NewStaticReflector(
+			svcv1alpha1connect.KargoServiceName,
+			grpchealth.HealthV1ServiceName,
+		)
+		mux.Handle(grpcreflect.NewHandlerV1(reflector, opts))
+		// Many tools still only speak the v1alpha version of the protocol
+		mux.Handle(grpcreflect.NewHandlerV1Alpha(reflector, opts))
+	}
 	dashboardHandler, err := newDashboardRequestHandler()
 	if err != nil {
 		return fmt.Errorf("error initializing dashboard handler: %w", err)
 	}
 	mux.Handle("/", dashboardHandler)
+	if s.cfg.WarehouseWebhookConfig != nil {
+		mux.HandleFunc(warehouseWebhookPath, s.handleWarehouseWebhook)
+	}
+	if s.cfg.GitWebhooksEnabled {
+		mux.Handle(githubWebhookPath, s.gitWebhookHandler(githubWebhookProvider))
+		mux.Handle(gitlabWebhookPath, s.gitWebhookHandler(gitlabWebhookProvider))
+	}
+	if s.cfg.ImageWebhooksEnabled {
+		mux.Handle(harborWebhookPath, s.imageWebhookHandler(harborWebhookProvider))
+		mux.Handle(dockerHubWebhookPath, s.imageWebhookHandler(dockerHubWebhookProvider))
+		mux.Handle(distributionWebhookPath, s.imageWebhookHandler(distributionWebhookProvider))
+	}
 	if s.cfg.DexProxyConfig != nil {
 		dexProxyCfg := dex.ProxyConfigFromEnv()
 		dexProxy, err := dex.NewProxy(dexProxyCfg)
@@ -248,7 +300,8 @@ func (s *server) Serve(ctx context.Context, l net.Listener) error {
 	}()
 
 	log.WithFields(logrus.Fields{
-		"tls": s.cfg.TLSConfig != nil,
+		"tls":        s.cfg.TLSConfig != nil,
+		"reflection": s.cfg.GRPCReflectionEnabled,
 	}).Infof("Server is listening on %q", l.Addr().String())
 
 	select {
@@ -13,6 +13,7 @@ import (
 	"github.com/akuity/kargo/internal/api/config"
 	"github.com/akuity/kargo/internal/api/kubernetes"
 	"github.com/akuity/kargo/internal/api/rbac"
+	libCreds "github.com/akuity/kargo/internal/credentials"
 	fakeevent "github.com/akuity/kargo/internal/kubernetes/event/fake"
 )
 
@@ -32,6 +33,7 @@ func TestNewServer(t *testing.T) {
 		},
 	)
 	require.NoError(t, err)
+	testCredentialsDB := &libCreds.FakeDB{}
 	testRecorder := fakeevent.NewEventRecorder(0)
 
 	s, ok := NewServer(
@@ -39,7 +41,9 @@ func TestNewServer(t *testing.T) {
 		testClient,
 		testClient,
 		rbac.NewKubernetesRolesDatabase(testClient),
+		testCredentialsDB,
 		testRecorder,
+		func(context.Context) bool { return true },
 	).(*server)
 
 	require.True(t, ok)
@@ -47,8 +51,10 @@ func TestNewServer(t *testing.T) {
 	require.Same(t, testClient, s.client)
 	require.Same(t, testClient, s.internalClient)
 	require.NotNil(t, testClient, s.rolesDB)
+	require.Same(t, testCredentialsDB, s.credentialsDB)
 	require.Same(t, testRecorder, s.recorder)
 	require.Equal(t, testServerConfig, s.cfg)
+	require.NotNil(t, s.waitForCacheSyncFn)
 	require.NotNil(t, s.validateProjectExistsFn)
 	require.NotNil(t, s.externalValidateProjectFn)
 	require.NotNil(t, s.getStageFn)
@@ -63,5 +69,6 @@ func TestNewServer(t *testing.T) {
 	require.NotNil(t, s.patchFreightAliasFn)
 	require.NotNil(t, s.patchFreightStatusFn)
 	require.NotNil(t, s.authorizeFn)
+	require.NotNil(t, s.validateCredentialsFn)
 	require.NotNil(t, s.getAnalysisRunFn)
 }
@@ -41,6 +41,8 @@ func (s *server) updateResource(
 	ctx context.Context,
 	obj *unstructured.Unstructured,
 ) (*svcv1alpha1.UpdateResourceResult, error) {
+	sanitizeObjectMeta(obj)
+
 	// Note: We don't blindly attempt updating the resource because many resources
 	// types have defaulting and/or validating webhooks and what we do not want is
 	// for some error from a webhook to obscure the fact that the resource does
@@ -0,0 +1,80 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libCreds "github.com/akuity/kargo/internal/credentials"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func (s *server) ValidateCredentials(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.ValidateCredentialsRequest],
+) (*connect.Response[svcv1alpha1.ValidateCredentialsResponse], error) {
+	project := req.Msg.GetProject()
+	if err := validateFieldNotEmpty("project", project); err != nil {
+		return nil, err
+	}
+
+	credType := req.Msg.GetType()
+	switch credType {
+	case kargoapi.CredentialTypeLabelValueGit,
+		kargoapi.CredentialTypeLabelValueHelm,
+		kargoapi.CredentialTypeLabelValueImage,
+		libCreds.TypeHTTP.String():
+	default:
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("type should be one of git, helm, image, or http"),
+		)
+	}
+
+	repoURL := req.Msg.GetRepoUrl()
+	if err := validateFieldNotEmpty("repoURL", repoURL); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateProjectExistsFn(ctx, project); err != nil {
+		return nil, err
+	}
+
+	// Credentials are looked up using the API server's own permissions, which
+	// may extend to global credentials namespaces, so make sure the caller is
+	// at least permitted to see credentials in the project.
+	if err := s.authorizeFn(
+		ctx,
+		"list",
+		schema.GroupVersionResource{
+			Version:  "v1",
+			Resource: "secrets",
+		},
+		"",
+		types.NamespacedName{Namespace: project},
+	); err != nil {
+		return nil, err
+	}
+
+	res, err := s.validateCredentialsFn(
+		ctx,
+		s.credentialsDB,
+		project,
+		libCreds.Type(credType),
+		repoURL,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("validate credentials: %w", err)
+	}
+
+	return connect.NewResponse(&svcv1alpha1.ValidateCredentialsResponse{
+		Status:  string(res.Status),
+		Message: res.Message,
+		Source:  res.Source,
+	}), nil
+}
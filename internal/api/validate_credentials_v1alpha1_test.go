@@ -0,0 +1,187 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	libCreds "github.com/akuity/kargo/internal/credentials"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestValidateCredentials(t *testing.T) {
+	validReq := &svcv1alpha1.ValidateCredentialsRequest{
+		Project: "fake-project",
+		Type:    "image",
+		RepoUrl: "ghcr.io/akuity/kargo",
+	}
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.ValidateCredentialsRequest
+		server     *server
+		assertions func(
+			*testing.T,
+			*connect.Response[svcv1alpha1.ValidateCredentialsResponse],
+			error,
+		)
+	}{
+		{
+			name:   "missing project",
+			req:    &svcv1alpha1.ValidateCredentialsRequest{},
+			server: &server{},
+			assertions: func(
+				t *testing.T,
+				_ *connect.Response[svcv1alpha1.ValidateCredentialsResponse],
+				err error,
+			) {
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodeInvalidArgument, connErr.Code())
+			},
+		},
+		{
+			name: "invalid type",
+			req: &svcv1alpha1.ValidateCredentialsRequest{
+				Project: "fake-project",
+				Type:    "bogus",
+				RepoUrl: "ghcr.io/akuity/kargo",
+			},
+			server: &server{},
+			assertions: func(
+				t *testing.T,
+				_ *connect.Response[svcv1alpha1.ValidateCredentialsResponse],
+				err error,
+			) {
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodeInvalidArgument, connErr.Code())
+				require.ErrorContains(t, err, "type should be one of")
+			},
+		},
+		{
+			name: "unauthorized",
+			req:  validReq,
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return connect.NewError(connect.CodePermissionDenied, errors.New("not allowed"))
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *connect.Response[svcv1alpha1.ValidateCredentialsResponse],
+				err error,
+			) {
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodePermissionDenied, connErr.Code())
+			},
+		},
+		{
+			name: "error validating credentials",
+			req:  validReq,
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				validateCredentialsFn: func(
+					context.Context,
+					libCreds.Database,
+					string,
+					libCreds.Type,
+					string,
+				) (libCreds.ValidationResult, error) {
+					return libCreds.ValidationResult{}, errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *connect.Response[svcv1alpha1.ValidateCredentialsResponse],
+				err error,
+			) {
+				require.ErrorContains(t, err, "validate credentials")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "success",
+			req:  validReq,
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				authorizeFn: func(
+					_ context.Context,
+					verb string,
+					gvr schema.GroupVersionResource,
+					_ string,
+					key client.ObjectKey,
+				) error {
+					if verb != "list" || gvr.Resource != "secrets" ||
+						key.Namespace != "fake-project" {
+						return errors.New("unexpected authorization")
+					}
+					return nil
+				},
+				validateCredentialsFn: func(
+					_ context.Context,
+					_ libCreds.Database,
+					namespace string,
+					credType libCreds.Type,
+					repoURL string,
+				) (libCreds.ValidationResult, error) {
+					if namespace != "fake-project" || credType != libCreds.TypeImage ||
+						repoURL != "ghcr.io/akuity/kargo" {
+						return libCreds.ValidationResult{}, errors.New("unexpected arguments")
+					}
+					return libCreds.ValidationResult{
+						Status:  libCreds.ValidationStatusAuthenticationFailed,
+						Message: "fake-message",
+						Source:  "fake-source",
+					}, nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				res *connect.Response[svcv1alpha1.ValidateCredentialsResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, "AuthenticationFailed", res.Msg.GetStatus())
+				require.Equal(t, "fake-message", res.Msg.GetMessage())
+				require.Equal(t, "fake-source", res.Msg.GetSource())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res, err := testCase.server.ValidateCredentials(
+				context.Background(),
+				connect.NewRequest(testCase.req),
+			)
+			testCase.assertions(t, res, err)
+		})
+	}
+}
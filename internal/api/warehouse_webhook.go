@@ -0,0 +1,253 @@
+package api
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libCreds "github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/git"
+	"github.com/akuity/kargo/internal/helm"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+const (
+	// warehouseWebhookPath is the path at which the Warehouse refresh webhook
+	// endpoint is served.
+	warehouseWebhookPath = "/webhook/warehouses/refresh"
+	// warehouseWebhookSecretHeader is the header in which callers of the
+	// Warehouse refresh webhook endpoint must present the shared secret.
+	warehouseWebhookSecretHeader = "X-Kargo-Webhook-Secret"
+	// maxWarehouseWebhookRequestBytes is the largest request body the Warehouse
+	// refresh webhook endpoint will read.
+	maxWarehouseWebhookRequestBytes = 1 << 20
+)
+
+// warehouseWebhookRequest is the body of a request to the Warehouse refresh
+// webhook endpoint.
+type warehouseWebhookRequest struct {
+	// RepoURL is the URL of the repository that has changed. Every Warehouse
+	// with a subscription to this repository is refreshed.
+	RepoURL string `json:"repoURL"`
+}
+
+// warehouseWebhookResponse is the body of a response from the Warehouse
+// refresh webhook endpoint.
+type warehouseWebhookResponse struct {
+	// Warehouses lists the Warehouses that were refreshed, each in the form
+	// <namespace>/<name>.
+	Warehouses []string `json:"warehouses"`
+}
+
+// handleWarehouseWebhook handles requests to the Warehouse refresh webhook
+// endpoint. Once the caller has presented the shared secret, every Warehouse
+// subscribed to the repository identified in the request is refreshed so that
+// it is reconciled immediately instead of at its next scheduled poll.
+func (s *server) handleWarehouseWebhook(w http.ResponseWriter, r *http.Request) {
+	logger := logging.LoggerFromContext(r.Context())
+
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if subtle.ConstantTimeCompare(
+		[]byte(r.Header.Get(warehouseWebhookSecretHeader)),
+		[]byte(s.cfg.WarehouseWebhookConfig.Secret),
+	) != 1 {
+		http.Error(w, "invalid webhook secret", http.StatusUnauthorized)
+		return
+	}
+
+	var req warehouseWebhookRequest
+	if err := json.NewDecoder(
+		io.LimitReader(r.Body, maxWarehouseWebhookRequestBytes),
+	).Decode(&req); err != nil {
+		http.Error(
+			w,
+			fmt.Sprintf("error decoding request body: %s", err),
+			http.StatusBadRequest,
+		)
+		return
+	}
+	if strings.TrimSpace(req.RepoURL) == "" {
+		http.Error(w, "repoURL must not be empty", http.StatusBadRequest)
+		return
+	}
+
+	refreshed, err := s.refreshWarehousesForRepo(r.Context(), req.RepoURL)
+	if err != nil {
+		logger.Errorf("error refreshing Warehouses for repository %q: %s", req.RepoURL, err)
+		http.Error(w, "error refreshing Warehouses", http.StatusInternalServerError)
+		return
+	}
+	logger.WithField("repoURL", req.RepoURL).
+		Debugf("refreshed %d Warehouse(s)", len(refreshed))
+
+	writeWarehouseWebhookResponse(r.Context(), w, refreshed)
+}
+
+// writeWarehouseWebhookResponse responds to a webhook request with 202 Accepted
+// and a list of the Warehouses that were refreshed.
+func writeWarehouseWebhookResponse(
+	ctx context.Context,
+	w http.ResponseWriter,
+	refreshed []string,
+) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	if err := json.NewEncoder(w).Encode(
+		warehouseWebhookResponse{Warehouses: refreshed},
+	); err != nil {
+		logging.LoggerFromContext(ctx).
+			Errorf("error writing Warehouse webhook response: %s", err)
+	}
+}
+
+// refreshWarehousesForRepo refreshes every Warehouse, in any namespace, with a
+// subscription to the specified repository. It returns the Warehouses that
+// were refreshed, each in the form <namespace>/<name>.
+func (s *server) refreshWarehousesForRepo(
+	ctx context.Context,
+	repoURL string,
+) ([]string, error) {
+	return s.refreshWarehouses(
+		ctx,
+		func(_ context.Context, warehouse *kargoapi.Warehouse) (bool, error) {
+			return warehouseSubscribesTo(warehouse, repoURL), nil
+		},
+	)
+}
+
+// refreshWarehouses refreshes every Warehouse, in any namespace, for which the
+// provided function returns true. It returns the Warehouses that were
+// refreshed, each in the form <namespace>/<name>.
+func (s *server) refreshWarehouses(
+	ctx context.Context,
+	shouldRefresh func(context.Context, *kargoapi.Warehouse) (bool, error),
+) ([]string, error) {
+	warehouses := kargoapi.WarehouseList{}
+	if err := s.internalClient.List(ctx, &warehouses); err != nil {
+		return nil, fmt.Errorf("error listing Warehouses: %w", err)
+	}
+	refreshed := []string{}
+	for i := range warehouses.Items {
+		warehouse := &warehouses.Items[i]
+		ok, err := shouldRefresh(ctx, warehouse)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		if _, err = kargoapi.RefreshWarehouse(
+			ctx,
+			s.internalClient,
+			client.ObjectKeyFromObject(warehouse),
+		); err != nil {
+			return nil, fmt.Errorf(
+				"error refreshing Warehouse %q in namespace %q: %w",
+				warehouse.Name,
+				warehouse.Namespace,
+				err,
+			)
+		}
+		refreshed = append(
+			refreshed,
+			fmt.Sprintf("%s/%s", warehouse.Namespace, warehouse.Name),
+		)
+	}
+	return refreshed, nil
+}
+
+// refreshAuthenticatedWarehouses refreshes every Warehouse, in any namespace,
+// for which the provided match function returns a repository URL and true,
+// provided the webhook being handled can be authenticated using the webhook
+// secret stored as credentials of the specified type for that repository in
+// the Warehouse's own project. This ensures that no project's Warehouses can
+// be refreshed using a secret that belongs to another project. In addition to
+// the Warehouses that were refreshed, it returns the number of Warehouses that
+// matched, regardless of whether the webhook could be authenticated for them.
+func (s *server) refreshAuthenticatedWarehouses(
+	ctx context.Context,
+	credType libCreds.Type,
+	match func(*kargoapi.Warehouse) (string, bool),
+	verify func(secret string) bool,
+) ([]string, int, error) {
+	var candidates int
+	verifiedByNamespace := map[string]bool{}
+	refreshed, err := s.refreshWarehouses(
+		ctx,
+		func(ctx context.Context, warehouse *kargoapi.Warehouse) (bool, error) {
+			repoURL, ok := match(warehouse)
+			if !ok {
+				return false, nil
+			}
+			candidates++
+			verified, checked := verifiedByNamespace[warehouse.Namespace]
+			if !checked {
+				creds, found, err := s.credentialsDB.Get(
+					ctx,
+					warehouse.Namespace,
+					credType,
+					repoURL,
+				)
+				if err != nil {
+					return false, fmt.Errorf(
+						"error getting webhook secret for repository %q in namespace %q: %w",
+						repoURL,
+						warehouse.Namespace,
+						err,
+					)
+				}
+				verified = found && creds.WebhookSecret != "" && verify(creds.WebhookSecret)
+				verifiedByNamespace[warehouse.Namespace] = verified
+			}
+			return verified, nil
+		},
+	)
+	return refreshed, candidates, err
+}
+
+// warehouseSubscribesTo returns true if any of the provided Warehouse's
+// subscriptions is to the specified repository. URLs are normalized as
+// appropriate for the kind of subscription before being compared.
+func warehouseSubscribesTo(warehouse *kargoapi.Warehouse, repoURL string) bool {
+	for _, sub := range warehouse.Spec.Subscriptions {
+		var matches bool
+		switch {
+		case sub.Git != nil:
+			matches = git.NormalizeURL(sub.Git.RepoURL) == git.NormalizeURL(repoURL)
+		case sub.Image != nil:
+			matches = normalizeRegistryURL(sub.Image.RepoURL) == normalizeRegistryURL(repoURL)
+		case sub.Chart != nil:
+			matches = helm.NormalizeChartRepositoryURL(sub.Chart.RepoURL) ==
+				helm.NormalizeChartRepositoryURL(repoURL)
+		case sub.OCIArtifact != nil:
+			matches = normalizeRegistryURL(sub.OCIArtifact.RepoURL) == normalizeRegistryURL(repoURL)
+		case sub.HTTPArtifact != nil:
+			matches = strings.TrimSpace(sub.HTTPArtifact.URL) == strings.TrimSpace(repoURL)
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeRegistryURL normalizes the URL of a container image or OCI artifact
+// repository for purposes of comparison.
+func normalizeRegistryURL(repoURL string) string {
+	return strings.TrimSuffix(
+		strings.TrimPrefix(strings.ToLower(strings.TrimSpace(repoURL)), "oci://"),
+		"/",
+	)
+}
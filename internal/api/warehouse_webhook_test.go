@@ -0,0 +1,230 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/config"
+)
+
+func TestHandleWarehouseWebhook(t *testing.T) {
+	const testSecret = "fake-secret"
+	newWarehouse := func(namespace, name, repoURL string) *kargoapi.Warehouse {
+		return &kargoapi.Warehouse{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      name,
+			},
+			Spec: kargoapi.WarehouseSpec{
+				Subscriptions: []kargoapi.RepoSubscription{
+					{
+						Git: &kargoapi.GitSubscription{
+							RepoURL: repoURL,
+						},
+					},
+				},
+			},
+		}
+	}
+	testCases := []struct {
+		name        string
+		method      string
+		secret      string
+		body        string
+		interceptor interceptor.Funcs
+		assertions  func(*testing.T, *httptest.ResponseRecorder, client.Client)
+	}{
+		{
+			name:   "method not allowed",
+			method: http.MethodGet,
+			secret: testSecret,
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, _ client.Client) {
+				require.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+			},
+		},
+		{
+			name: "missing secret",
+			body: `{"repoURL":"https://github.com/example/repo"}`,
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, _ client.Client) {
+				require.Equal(t, http.StatusUnauthorized, rr.Code)
+			},
+		},
+		{
+			name:   "invalid secret",
+			secret: "wrong-secret",
+			body:   `{"repoURL":"https://github.com/example/repo"}`,
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, _ client.Client) {
+				require.Equal(t, http.StatusUnauthorized, rr.Code)
+			},
+		},
+		{
+			name:   "invalid body",
+			secret: testSecret,
+			body:   `{`,
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, _ client.Client) {
+				require.Equal(t, http.StatusBadRequest, rr.Code)
+				require.Contains(t, rr.Body.String(), "error decoding request body")
+			},
+		},
+		{
+			name:   "missing repoURL",
+			secret: testSecret,
+			body:   `{}`,
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, _ client.Client) {
+				require.Equal(t, http.StatusBadRequest, rr.Code)
+				require.Contains(t, rr.Body.String(), "repoURL must not be empty")
+			},
+		},
+		{
+			name:   "error listing Warehouses",
+			secret: testSecret,
+			body:   `{"repoURL":"https://github.com/example/repo"}`,
+			interceptor: interceptor.Funcs{
+				List: func(context.Context, client.WithWatch, client.ObjectList, ...client.ListOption) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, _ client.Client) {
+				require.Equal(t, http.StatusInternalServerError, rr.Code)
+			},
+		},
+		{
+			name:   "success",
+			secret: testSecret,
+			body:   `{"repoURL":"https://github.com/Example/repo.git"}`,
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, c client.Client) {
+				require.Equal(t, http.StatusAccepted, rr.Code)
+				res := warehouseWebhookResponse{}
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res))
+				require.ElementsMatch(
+					t,
+					[]string{"project-a/matching", "project-b/matching"},
+					res.Warehouses,
+				)
+				for _, key := range []client.ObjectKey{
+					{Namespace: "project-a", Name: "matching"},
+					{Namespace: "project-b", Name: "matching"},
+				} {
+					warehouse := &kargoapi.Warehouse{}
+					require.NoError(t, c.Get(context.Background(), key, warehouse))
+					_, ok := kargoapi.RefreshAnnotationValue(warehouse.Annotations)
+					require.True(t, ok)
+				}
+				warehouse := &kargoapi.Warehouse{}
+				require.NoError(
+					t,
+					c.Get(
+						context.Background(),
+						client.ObjectKey{Namespace: "project-a", Name: "not-matching"},
+						warehouse,
+					),
+				)
+				_, ok := kargoapi.RefreshAnnotationValue(warehouse.Annotations)
+				require.False(t, ok)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().
+				WithScheme(mustNewScheme()).
+				WithObjects(
+					newWarehouse("project-a", "matching", "https://github.com/example/repo"),
+					newWarehouse("project-a", "not-matching", "https://github.com/example/other-repo"),
+					newWarehouse("project-b", "matching", "https://github.com/example/repo"),
+				).
+				WithInterceptorFuncs(testCase.interceptor).
+				Build()
+			s := &server{
+				cfg: config.ServerConfig{
+					WarehouseWebhookConfig: &config.WarehouseWebhookConfig{
+						Secret: testSecret,
+					},
+				},
+				internalClient: c,
+			}
+			method := testCase.method
+			if method == "" {
+				method = http.MethodPost
+			}
+			req := httptest.NewRequest(
+				method,
+				warehouseWebhookPath,
+				strings.NewReader(testCase.body),
+			)
+			if testCase.secret != "" {
+				req.Header.Set(warehouseWebhookSecretHeader, testCase.secret)
+			}
+			rr := httptest.NewRecorder()
+			s.handleWarehouseWebhook(rr, req)
+			testCase.assertions(t, rr, c)
+		})
+	}
+}
+
+func TestWarehouseSubscribesTo(t *testing.T) {
+	warehouse := &kargoapi.Warehouse{
+		Spec: kargoapi.WarehouseSpec{
+			Subscriptions: []kargoapi.RepoSubscription{
+				{
+					Git: &kargoapi.GitSubscription{
+						RepoURL: "https://github.com/example/repo",
+					},
+				},
+				{
+					Image: &kargoapi.ImageSubscription{
+						RepoURL: "ghcr.io/example/image",
+					},
+				},
+				{
+					Chart: &kargoapi.ChartSubscription{
+						RepoURL: "oci://ghcr.io/example/charts/chart",
+					},
+				},
+				{
+					OCIArtifact: &kargoapi.OCIArtifactSubscription{
+						RepoURL: "ghcr.io/example/artifact",
+					},
+				},
+				{
+					HTTPArtifact: &kargoapi.HTTPArtifactSubscription{
+						URL: "https://example.com/release.json",
+					},
+				},
+			},
+		},
+	}
+	testCases := []struct {
+		repoURL  string
+		expected bool
+	}{
+		{repoURL: "https://github.com/example/repo.git", expected: true},
+		{repoURL: "https://github.com/example/other-repo", expected: false},
+		{repoURL: "GHCR.io/example/image", expected: true},
+		{repoURL: "ghcr.io/example/charts/chart", expected: true},
+		{repoURL: "oci://ghcr.io/example/artifact", expected: true},
+		{repoURL: "https://example.com/release.json", expected: true},
+		{repoURL: "https://example.com/other.json", expected: false},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.repoURL, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				warehouseSubscribesTo(warehouse, testCase.repoURL),
+			)
+		})
+	}
+}
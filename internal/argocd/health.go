@@ -36,11 +36,22 @@ type ApplicationHealthEvaluator interface {
 // applicationHealth is an ApplicationHealthEvaluator implementation.
 type applicationHealth struct {
 	Client client.Client
+	// InstanceClients holds clients for Argo CD instances other than the
+	// default one, keyed by instance name.
+	InstanceClients map[string]client.Client
 }
 
-// NewApplicationHealthEvaluator returns a new ApplicationHealthEvaluator.
-func NewApplicationHealthEvaluator(c client.Client) ApplicationHealthEvaluator {
-	return &applicationHealth{Client: c}
+// NewApplicationHealthEvaluator returns a new ApplicationHealthEvaluator. The
+// provided client is used for Applications of the default Argo CD instance and
+// the provided map of clients for Applications of any additional instances.
+func NewApplicationHealthEvaluator(
+	c client.Client,
+	instanceClients map[string]client.Client,
+) ApplicationHealthEvaluator {
+	return &applicationHealth{
+		Client:          c,
+		InstanceClients: instanceClients,
+	}
 }
 
 // EvaluateHealth assesses the health of a set of Argo CD Applications.
@@ -79,7 +90,20 @@ func (h *applicationHealth) EvaluateHealth(
 			Name:      update.AppName,
 		}
 
-		state, healthStatus, syncStatus, err := h.GetApplicationHealth(ctx, types.NamespacedName{
+		instanceHealth, err := h.forInstance(update.Instance)
+		if err != nil {
+			health.Status = health.Status.Merge(kargoapi.HealthStateUnknown)
+			health.ArgoCDApps[i].HealthStatus = kargoapi.ArgoCDAppHealthStatus{
+				Status: kargoapi.ArgoCDAppHealthStateUnknown,
+			}
+			health.ArgoCDApps[i].SyncStatus = kargoapi.ArgoCDAppSyncStatus{
+				Status: kargoapi.ArgoCDAppSyncStateUnknown,
+			}
+			health.Issues = append(health.Issues, err.Error())
+			continue
+		}
+
+		state, healthStatus, syncStatus, err := instanceHealth.GetApplicationHealth(ctx, types.NamespacedName{
 			Namespace: health.ArgoCDApps[i].Namespace,
 			Name:      health.ArgoCDApps[i].Name,
 		}, freight)
@@ -102,6 +126,24 @@ func (h *applicationHealth) EvaluateHealth(
 	return &health
 }
 
+// forInstance returns an applicationHealth that assesses the health of
+// Applications of the named Argo CD instance. An empty name denotes the default
+// instance, for which the receiver itself is returned.
+func (h *applicationHealth) forInstance(instance string) (*applicationHealth, error) {
+	if instance == "" {
+		return h, nil
+	}
+	c, ok := h.InstanceClients[instance]
+	if !ok {
+		return nil, fmt.Errorf(
+			"Argo CD instance %q is not known to this controller; cannot assess "+
+				"the health or sync status of its Applications",
+			instance,
+		)
+	}
+	return &applicationHealth{Client: c}, nil
+}
+
 // GetApplicationHealth assesses the health of an Argo CD Application by looking
 // at its conditions, health status, and sync status. Based on these, it returns
 // an overall health state, the Argo CD Application's health status, and its sync
@@ -203,12 +245,17 @@ func stageHealthForAppSync(app *argocd.Application, revision string) (kargoapi.H
 			app.GetName(),
 			app.GetNamespace(),
 		)
-		return kargoapi.HealthStateUnknown, err
+		// The Application is still rolling out the desired revision, which is
+		// not the same as its health being unknown.
+		return kargoapi.HealthStateProgressing, err
 	case app.Status.Sync.Revision != revision:
 		err := fmt.Errorf(
-			"Argo CD Application %q in namespace %q is out of sync",
+			"Argo CD Application %q in namespace %q is out of sync; desired "+
+				"revision is %q, but synced revision is %q",
 			app.GetName(),
 			app.GetNamespace(),
+			revision,
+			app.Status.Sync.Revision,
 		)
 		return kargoapi.HealthStateUnhealthy, err
 	default:
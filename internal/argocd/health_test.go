@@ -233,6 +233,97 @@ func TestApplicationHealth_EvaluateHealth(t *testing.T) {
 				require.Contains(t, health.Issues[1], "InvalidSpecError")
 			},
 		},
+		{
+			name: "multiple repos with one lagging",
+			applications: []client.Object{
+				&argocd.Application{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "fake-name-1",
+					},
+					Spec: argocd.ApplicationSpec{
+						Source: &argocd.ApplicationSource{
+							RepoURL: "https://github.com/universe/42",
+						},
+					},
+					Status: argocd.ApplicationStatus{
+						Health: argocd.HealthStatus{
+							Status: argocd.HealthStatusHealthy,
+						},
+						Sync: argocd.SyncStatus{
+							Status:   argocd.SyncStatusCodeSynced,
+							Revision: "fake-health-check-commit-1",
+						},
+					},
+				},
+				&argocd.Application{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "fake-name-2",
+					},
+					Spec: argocd.ApplicationSpec{
+						Source: &argocd.ApplicationSource{
+							RepoURL: "https://github.com/universe/43",
+						},
+					},
+					Status: argocd.ApplicationStatus{
+						Health: argocd.HealthStatus{
+							Status: argocd.HealthStatusHealthy,
+						},
+						Sync: argocd.SyncStatus{
+							Status:   argocd.SyncStatusCodeSynced,
+							Revision: "fake-old-commit",
+						},
+					},
+				},
+			},
+			freight: kargoapi.FreightReference{
+				Commits: []kargoapi.GitCommit{
+					{
+						RepoURL:           "https://github.com/universe/42",
+						ID:                "fake-commit-1",
+						HealthCheckCommit: "fake-health-check-commit-1",
+					},
+					{
+						RepoURL:           "https://github.com/universe/43",
+						ID:                "fake-commit-2",
+						HealthCheckCommit: "fake-health-check-commit-2",
+					},
+				},
+			},
+			updates: []kargoapi.ArgoCDAppUpdate{
+				{
+					AppNamespace: "fake-namespace",
+					AppName:      "fake-name-1",
+				},
+				{
+					AppNamespace: "fake-namespace",
+					AppName:      "fake-name-2",
+				},
+			},
+			assertions: func(t *testing.T, health *kargoapi.Health) {
+				require.NotNil(t, health)
+
+				require.Equal(t, kargoapi.HealthStateUnhealthy, health.Status)
+				require.Len(t, health.Issues, 1)
+				require.Contains(t, health.Issues[0], `"fake-name-2"`)
+				require.Contains(t, health.Issues[0], "is out of sync")
+				require.Contains(t, health.Issues[0], `"fake-health-check-commit-2"`)
+				require.Contains(t, health.Issues[0], `"fake-old-commit"`)
+
+				require.Len(t, health.ArgoCDApps, 2)
+				require.Equal(
+					t,
+					"fake-health-check-commit-1",
+					health.ArgoCDApps[0].SyncStatus.Revision,
+				)
+				require.Equal(
+					t,
+					"fake-old-commit",
+					health.ArgoCDApps[1].SyncStatus.Revision,
+				)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -256,6 +347,170 @@ func TestApplicationHealth_EvaluateHealth(t *testing.T) {
 		require.Len(t, health.Issues, 1)
 		require.Contains(t, health.Issues[0], "Argo CD integration is disabled")
 	})
+
+	t.Run("Applications of multiple Argo CD instances", func(t *testing.T) {
+		newApp := func(health argocd.HealthStatusCode) *argocd.Application {
+			return &argocd.Application{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+					Name:      "fake-name",
+				},
+				Status: argocd.ApplicationStatus{
+					Health: argocd.HealthStatus{Status: health},
+					Sync:   argocd.SyncStatus{Status: argocd.SyncStatusCodeSynced},
+				},
+			}
+		}
+		h := NewApplicationHealthEvaluator(
+			fake.NewClientBuilder().WithScheme(scheme).
+				WithObjects(newApp(argocd.HealthStatusHealthy)).Build(),
+			map[string]client.Client{
+				"fake-instance": fake.NewClientBuilder().WithScheme(scheme).
+					WithObjects(newApp(argocd.HealthStatusDegraded)).Build(),
+			},
+		)
+		health := h.EvaluateHealth(
+			context.TODO(),
+			kargoapi.FreightReference{},
+			[]kargoapi.ArgoCDAppUpdate{
+				{
+					AppNamespace: "fake-namespace",
+					AppName:      "fake-name",
+				},
+				{
+					Instance:     "fake-instance",
+					AppNamespace: "fake-namespace",
+					AppName:      "fake-name",
+				},
+				{
+					Instance:     "unknown-instance",
+					AppNamespace: "fake-namespace",
+					AppName:      "fake-name",
+				},
+			},
+		)
+		require.NotNil(t, health)
+		require.Equal(t, kargoapi.HealthStateUnhealthy, health.Status)
+		require.Len(t, health.ArgoCDApps, 3)
+		require.Equal(
+			t,
+			kargoapi.ArgoCDAppHealthState(argocd.HealthStatusHealthy),
+			health.ArgoCDApps[0].HealthStatus.Status,
+		)
+		require.Equal(
+			t,
+			kargoapi.ArgoCDAppHealthState(argocd.HealthStatusDegraded),
+			health.ArgoCDApps[1].HealthStatus.Status,
+		)
+		require.Equal(
+			t,
+			kargoapi.ArgoCDAppHealthStateUnknown,
+			health.ArgoCDApps[2].HealthStatus.Status,
+		)
+		require.Len(t, health.Issues, 2)
+		require.Contains(t, health.Issues[1], `Argo CD instance "unknown-instance" is not known`)
+	})
+}
+
+func TestApplicationHealth_EvaluateHealthTransitions(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, argocd.AddToScheme(scheme))
+
+	freight := kargoapi.FreightReference{
+		Commits: []kargoapi.GitCommit{{
+			RepoURL: "https://github.com/example/repo.git",
+			ID:      "fake-commit",
+		}},
+	}
+	updates := []kargoapi.ArgoCDAppUpdate{{
+		AppNamespace: "fake-namespace",
+		AppName:      "fake-name",
+	}}
+	newApp := func(
+		operation *argocd.Operation,
+		revision string,
+		health argocd.HealthStatusCode,
+	) *argocd.Application {
+		return &argocd.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      "fake-name",
+			},
+			Spec: argocd.ApplicationSpec{
+				Source: &argocd.ApplicationSource{
+					RepoURL: "https://github.com/example/repo.git",
+				},
+			},
+			Operation: operation,
+			Status: argocd.ApplicationStatus{
+				Health: argocd.HealthStatus{Status: health},
+				Sync: argocd.SyncStatus{
+					Status:   argocd.SyncStatusCodeSynced,
+					Revision: revision,
+				},
+			},
+		}
+	}
+
+	// Each step describes the state of the same Application as a promotion
+	// rolls out, and the Stage health that state should yield.
+	steps := []struct {
+		name          string
+		app           *argocd.Application
+		expectedState kargoapi.HealthState
+		expectedIssue string
+	}{
+		{
+			name: "syncing",
+			app: newApp(
+				&argocd.Operation{Sync: &argocd.SyncOperation{}},
+				"old-commit",
+				argocd.HealthStatusHealthy,
+			),
+			expectedState: kargoapi.HealthStateProgressing,
+			expectedIssue: "is being synced",
+		},
+		{
+			name:          "synced but resources not yet ready",
+			app:           newApp(nil, "fake-commit", argocd.HealthStatusProgressing),
+			expectedState: kargoapi.HealthStateProgressing,
+			expectedIssue: "is progressing",
+		},
+		{
+			name:          "healthy",
+			app:           newApp(nil, "fake-commit", argocd.HealthStatusHealthy),
+			expectedState: kargoapi.HealthStateHealthy,
+		},
+		{
+			name:          "degraded",
+			app:           newApp(nil, "fake-commit", argocd.HealthStatusDegraded),
+			expectedState: kargoapi.HealthStateUnhealthy,
+			expectedIssue: "has health state",
+		},
+		{
+			name:          "missing",
+			expectedState: kargoapi.HealthStateUnknown,
+			expectedIssue: "unable to find Argo CD Application",
+		},
+	}
+	for _, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().WithScheme(scheme)
+			if step.app != nil {
+				c.WithObjects(step.app)
+			}
+			h := &applicationHealth{Client: c.Build()}
+			health := h.EvaluateHealth(context.TODO(), freight, updates)
+			require.NotNil(t, health)
+			require.Equal(t, step.expectedState, health.Status)
+			if step.expectedIssue == "" {
+				require.Empty(t, health.Issues)
+				return
+			}
+			require.Len(t, health.Issues, 1)
+			require.Contains(t, health.Issues[0], step.expectedIssue)
+		})
+	}
 }
 
 func TestApplicationHealth_GetApplicationHealth(t *testing.T) {
@@ -609,7 +864,7 @@ func Test_stageHealthForAppSync(t *testing.T) {
 			},
 			assertions: func(t *testing.T, state kargoapi.HealthState, err error) {
 				require.ErrorContains(t, err, "is being synced")
-				require.Equal(t, kargoapi.HealthStateUnknown, state)
+				require.Equal(t, kargoapi.HealthStateProgressing, state)
 			},
 		},
 		{
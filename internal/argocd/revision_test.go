@@ -103,6 +103,31 @@ func TestGetDesiredRevision(t *testing.T) {
 			},
 			want: "fake-revision",
 		},
+		{
+			name: "git source with health check commits from multiple repos",
+			app: &argocdapi.Application{
+				Spec: argocdapi.ApplicationSpec{
+					Source: &argocdapi.ApplicationSource{
+						RepoURL: "https://github.com/universe/42",
+					},
+				},
+			},
+			freight: kargoapi.FreightReference{
+				Commits: []kargoapi.GitCommit{
+					{
+						RepoURL:           "https://github.com/universe/41",
+						HealthCheckCommit: "bad-health-check-revision",
+						ID:                "bad-revision",
+					},
+					{
+						RepoURL:           "https://github.com/universe/42",
+						HealthCheckCommit: "fake-revision",
+						ID:                "other-bad-revision",
+					},
+				},
+			},
+			want: "fake-revision",
+		},
 	}
 
 	for _, testCase := range testCases {
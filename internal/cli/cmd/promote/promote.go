@@ -34,6 +34,7 @@ type promotionOptions struct {
 	FreightAlias  string
 	Stage         string
 	SubscribersOf string
+	Force         bool
 	Wait          bool
 }
 
@@ -56,6 +57,10 @@ kargo promote --project=my-project --freight=abc123 --stage=qa
 # Promote a piece of freight specified by alias to the QA stage
 kargo promote --project=my-project --freight-alias=wonky-wombat --stage=qa
 
+# Promote a piece of freight specified by name to the QA stage, even if it has
+# not been verified upstream of or approved for the QA stage
+kargo promote --project=my-project --freight=abc123 --stage=qa --force
+
 # Promote a piece of freight specified by name to subscribers of the QA stage
 kargo promote --project=my-project --freight=abc123 --subscribers-of=qa
 
@@ -121,6 +126,14 @@ func (o *promotionOptions) addFlags(cmd *cobra.Command) {
 			option.StageFlag,
 		),
 	)
+	option.Force(
+		cmd.Flags(), &o.Force,
+		fmt.Sprintf(
+			"Promote the freight even if it has not been verified upstream of or approved for the stage. "+
+				"Can only be used with --%s.",
+			option.StageFlag,
+		),
+	)
 	option.Wait(cmd.Flags(), &o.Wait, false, "Wait for the promotion(s) to complete.")
 
 	cmd.MarkFlagsOneRequired(option.FreightFlag, option.FreightAliasFlag)
@@ -128,6 +141,7 @@ func (o *promotionOptions) addFlags(cmd *cobra.Command) {
 
 	cmd.MarkFlagsOneRequired(option.StageFlag, option.SubscribersOfFlag)
 	cmd.MarkFlagsMutuallyExclusive(option.StageFlag, option.SubscribersOfFlag)
+	cmd.MarkFlagsMutuallyExclusive(option.ForceFlag, option.SubscribersOfFlag)
 }
 
 // validate performs validation of the options. If the options are invalid, an
@@ -176,6 +190,7 @@ func (o *promotionOptions) run(ctx context.Context) error {
 					Freight:      o.FreightName,
 					FreightAlias: o.FreightAlias,
 					Stage:        o.Stage,
+					Force:        o.Force,
 				},
 			),
 		)
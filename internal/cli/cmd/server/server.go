@@ -15,6 +15,7 @@ import (
 	"github.com/akuity/kargo/internal/api/rbac"
 	"github.com/akuity/kargo/internal/cli/option"
 	"github.com/akuity/kargo/internal/cli/templates"
+	"github.com/akuity/kargo/internal/credentials"
 	fakeevent "github.com/akuity/kargo/internal/kubernetes/event/fake"
 )
 
@@ -89,6 +90,14 @@ func (o *serverOptions) run(ctx context.Context) error {
 		return fmt.Errorf("error creating Kubernetes client: %w", err)
 	}
 
+	credentialsDB, err := credentials.NewKubernetesDatabase(
+		client,
+		credentials.KubernetesDatabaseConfigFromEnv(),
+	)
+	if err != nil {
+		return fmt.Errorf("error initializing credentials database: %w", err)
+	}
+
 	l, err := net.Listen("tcp", o.address)
 	if err != nil {
 		return fmt.Errorf("start local server: %w", err)
@@ -102,7 +111,10 @@ func (o *serverOptions) run(ctx context.Context) error {
 		client,
 		client,
 		rbac.NewKubernetesRolesDatabase(client),
+		credentialsDB,
 		&fakeevent.EventRecorder{},
+		// The client's cache has already synced by the time it is returned
+		nil,
 	)
 	if err := srv.Serve(ctx, l); err != nil {
 		return fmt.Errorf("serve error: %w", err)
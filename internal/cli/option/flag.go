@@ -27,6 +27,9 @@ const (
 	// FilenameShortFlag is the short flag name for the filename flag.
 	FilenameShortFlag = "f"
 
+	// ForceFlag is the flag name for the force flag.
+	ForceFlag = "force"
+
 	// FreightFlag is the flag name for the freight flag.
 	FreightFlag = "freight"
 
@@ -153,6 +156,11 @@ func Filenames(fs *pflag.FlagSet, filenames *[]string, usage string) {
 	fs.StringSliceVarP(filenames, FilenameFlag, FilenameShortFlag, nil, usage)
 }
 
+// Force adds the ForceFlag to the provided flag set.
+func Force(fs *pflag.FlagSet, force *bool, usage string) {
+	fs.BoolVar(force, ForceFlag, false, usage)
+}
+
 // Freight adds the FreightFlag to the provided flag set.
 func Freight(fs *pflag.FlagSet, freight *string, usage string) {
 	fs.StringVar(freight, FreightFlag, "", usage)
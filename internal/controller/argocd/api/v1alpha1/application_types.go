@@ -27,6 +27,7 @@ type ApplicationSource struct {
 	Helm           *ApplicationSourceHelm      `json:"helm,omitempty"`
 	Kustomize      *ApplicationSourceKustomize `json:"kustomize,omitempty"`
 	Chart          string                      `json:"chart,omitempty"`
+	Ref            string                      `json:"ref,omitempty"`
 }
 
 type ApplicationSources []ApplicationSource
@@ -76,6 +77,7 @@ type Operation struct {
 type SyncOperation struct {
 	SyncOptions SyncOptions `json:"syncOptions,omitempty"`
 	Revisions   []string    `json:"revisions,omitempty"`
+	Prune       bool        `json:"prune,omitempty"`
 }
 
 type Info struct {
@@ -86,8 +88,14 @@ type Info struct {
 type SyncOptions []string
 
 type SyncPolicy struct {
-	SyncOptions SyncOptions    `json:"syncOptions,omitempty"`
-	Retry       *RetryStrategy `json:"retry,omitempty"`
+	Automated   *SyncPolicyAutomated `json:"automated,omitempty"`
+	SyncOptions SyncOptions          `json:"syncOptions,omitempty"`
+	Retry       *RetryStrategy       `json:"retry,omitempty"`
+}
+
+type SyncPolicyAutomated struct {
+	Prune    bool `json:"prune,omitempty"`
+	SelfHeal bool `json:"selfHeal,omitempty"`
 }
 
 type RetryStrategy struct {
@@ -144,6 +152,7 @@ type OperationState struct {
 	Phase      OperationPhase       `json:"phase,omitempty"`
 	Message    string               `json:"message,omitempty"`
 	SyncResult *SyncOperationResult `json:"syncResult,omitempty"`
+	StartedAt  metav1.Time          `json:"startedAt"`
 }
 
 type SyncOperationResult struct {
@@ -379,6 +379,7 @@ func (in *OperationState) DeepCopyInto(out *OperationState) {
 		*out = new(SyncOperationResult)
 		**out = **in
 	}
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationState.
@@ -473,6 +474,11 @@ func (in SyncOptions) DeepCopy() SyncOptions {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncPolicy) DeepCopyInto(out *SyncPolicy) {
 	*out = *in
+	if in.Automated != nil {
+		in, out := &in.Automated, &out.Automated
+		*out = new(SyncPolicyAutomated)
+		**out = **in
+	}
 	if in.SyncOptions != nil {
 		in, out := &in.SyncOptions, &out.SyncOptions
 		*out = make(SyncOptions, len(*in))
@@ -495,6 +501,21 @@ func (in *SyncPolicy) DeepCopy() *SyncPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncPolicyAutomated) DeepCopyInto(out *SyncPolicyAutomated) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncPolicyAutomated.
+func (in *SyncPolicyAutomated) DeepCopy() *SyncPolicyAutomated {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncPolicyAutomated)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncStatus) DeepCopyInto(out *SyncStatus) {
 	*out = *in
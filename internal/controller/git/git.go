@@ -10,6 +10,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"time"
 
 	libExec "github.com/akuity/kargo/internal/exec"
 )
@@ -28,6 +29,10 @@ type RepoCredentials struct {
 	// field, can be used for both reading from and writing to some remote
 	// repository.
 	Password string `json:"password,omitempty"`
+	// SigningKey is an optional ASCII-armored GPG private key that can be used
+	// to sign commits made to the remote repository. It is never used for
+	// authentication.
+	SigningKey string `json:"-"`
 }
 
 type SigningKeyType string
@@ -47,6 +52,9 @@ type User struct {
 	// SigningKeyPath is an optional path referencing a signing key for
 	// signing git objects.
 	SigningKeyPath string
+	// SigningKey is optional signing key material for signing git objects. When
+	// specified, it takes precedence over SigningKeyPath.
+	SigningKey string
 }
 
 // CommitOptions represents options for committing changes to a git repository.
@@ -102,6 +110,11 @@ type Repo interface {
 	// CommitMessage returns the text of the most recent commit message associated
 	// with the specified commit ID.
 	CommitMessage(id string) (string, error)
+	// CommitAuthor returns the name of the author of the specified commit ID.
+	CommitAuthor(id string) (string, error)
+	// CommitDate returns the time at which the specified commit ID was
+	// committed.
+	CommitDate(id string) (time.Time, error)
 	// CommitMessages returns a slice of commit messages starting with id1 and
 	// ending with id2. The results exclude id1, but include id2.
 	CommitMessages(id1, id2 string) ([]string, error)
@@ -432,6 +445,30 @@ func (r *repo) CommitMessage(id string) (string, error) {
 	return string(msgBytes), nil
 }
 
+func (r *repo) CommitAuthor(id string) (string, error) {
+	authorBytes, err := libExec.Exec(
+		r.buildGitCommand("log", "-n", "1", "--pretty=format:%an", id),
+	)
+	if err != nil {
+		return "", fmt.Errorf("error obtaining author of commit %q: %w", id, err)
+	}
+	return strings.TrimSpace(string(authorBytes)), nil
+}
+
+func (r *repo) CommitDate(id string) (time.Time, error) {
+	dateBytes, err := libExec.Exec(
+		r.buildGitCommand("log", "-n", "1", "--pretty=format:%cI", id),
+	)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error obtaining date of commit %q: %w", id, err)
+	}
+	date, err := time.Parse(time.RFC3339, strings.TrimSpace(string(dateBytes)))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error parsing date of commit %q: %w", id, err)
+	}
+	return date, nil
+}
+
 func (r *repo) CommitMessages(id1, id2 string) ([]string, error) {
 	allMsgBytes, err := libExec.Exec(r.buildGitCommand(
 		"log",
@@ -557,6 +594,21 @@ func (r *repo) setupAuthor(author User) error {
 		return fmt.Errorf("error configuring git user email: %w", err)
 	}
 
+	if author.SigningKey != "" && author.SigningKeyType == SigningKeyTypeGPG {
+		author.SigningKeyPath = filepath.Join(r.homeDir, "signing-key.asc")
+		if err := os.WriteFile(
+			author.SigningKeyPath,
+			[]byte(author.SigningKey),
+			0600,
+		); err != nil {
+			return fmt.Errorf(
+				"error writing signing key to %q: %w",
+				author.SigningKeyPath,
+				err,
+			)
+		}
+	}
+
 	if author.SigningKeyPath != "" && author.SigningKeyType == SigningKeyTypeGPG {
 		cmd = r.buildGitCommand("config", "--global", "commit.gpgsign", "true")
 		cmd.Dir = r.homeDir // Override the cmd.Dir that's set by r.buildGitCommand()
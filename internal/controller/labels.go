@@ -72,6 +72,7 @@ func GetCredentialsRequirement() (*labels.Requirement, error) {
 		credentials.TypeGit.String(),
 		credentials.TypeHelm.String(),
 		credentials.TypeImage.String(),
+		credentials.TypeHTTP.String(),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("error creating credentials label selector: %w", err)
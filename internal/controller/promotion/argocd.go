@@ -5,7 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"path"
+	"reflect"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -25,24 +28,41 @@ const (
 	authorizedStageAnnotationKey = "kargo.akuity.io/authorized-stage"
 
 	applicationOperationInitiator = "kargo-controller"
+
+	// argoCDOperationPromotionInfo and argoCDOperationAttemptInfo are the names
+	// of the operation info entries that identify the Promotion attempt that
+	// initiated an operation.
+	argoCDOperationPromotionInfo = "Promotion"
+	argoCDOperationAttemptInfo   = "Attempt"
 )
 
 // argoCDMechanism is an implementation of the Mechanism interface that updates
 // Argo CD Application resources.
 type argoCDMechanism struct {
 	argocdClient client.Client
+	// instanceMechanisms holds the mechanisms that update Applications of
+	// additional Argo CD instances, keyed by instance name.
+	instanceMechanisms map[string]*argoCDMechanism
 	// These behaviors are overridable for testing purposes:
 	mustPerformUpdateFn func(
 		ctx context.Context,
+		promo *kargoapi.Promotion,
 		update kargoapi.ArgoCDAppUpdate,
 		newFreight kargoapi.FreightReference,
 	) (argocd.OperationPhase, bool, error)
 	doSingleUpdateFn func(
 		ctx context.Context,
 		stageMeta metav1.ObjectMeta,
+		promo *kargoapi.Promotion,
 		update kargoapi.ArgoCDAppUpdate,
 		newFreight kargoapi.FreightReference,
 	) error
+	planSingleUpdateFn func(
+		ctx context.Context,
+		stageMeta metav1.ObjectMeta,
+		update kargoapi.ArgoCDAppUpdate,
+		newFreight kargoapi.FreightReference,
+	) (string, error)
 	getArgoCDAppFn func(
 		ctx context.Context,
 		namespace string,
@@ -63,13 +83,25 @@ type argoCDMechanism struct {
 }
 
 // newArgoCDMechanism returns an implementation of the Mechanism interface that
-// updates Argo CD Application resources.
-func newArgoCDMechanism(argocdClient client.Client) Mechanism {
+// updates Argo CD Application resources. Applications of the default Argo CD
+// instance are updated using the provided argocdClient, while those of any
+// additional instance are updated using the client for that instance in the
+// provided map.
+func newArgoCDMechanism(
+	argocdClient client.Client,
+	instanceClients map[string]client.Client,
+) Mechanism {
 	a := &argoCDMechanism{
-		argocdClient: argocdClient,
+		argocdClient:       argocdClient,
+		instanceMechanisms: make(map[string]*argoCDMechanism, len(instanceClients)),
+	}
+	for instance, instanceClient := range instanceClients {
+		a.instanceMechanisms[instance] =
+			newArgoCDMechanism(instanceClient, nil).(*argoCDMechanism) // nolint: forcetypeassert
 	}
 	a.mustPerformUpdateFn = a.mustPerformUpdate
 	a.doSingleUpdateFn = a.doSingleUpdate
+	a.planSingleUpdateFn = a.planSingleUpdate
 	a.getArgoCDAppFn = getApplicationFn(argocdClient)
 	a.applyArgoCDSourceUpdateFn = applyArgoCDSourceUpdate
 	if argocdClient != nil {
@@ -91,7 +123,10 @@ func (a *argoCDMechanism) Promote(
 	promo *kargoapi.Promotion,
 	newFreight kargoapi.FreightReference,
 ) (*kargoapi.PromotionStatus, kargoapi.FreightReference, error) {
-	updates := stage.Spec.PromotionMechanisms.ArgoCDAppUpdates
+	var updates []kargoapi.ArgoCDAppUpdate
+	if stage.Spec.PromotionMechanisms != nil {
+		updates = stage.Spec.PromotionMechanisms.ArgoCDAppUpdates
+	}
 
 	if len(updates) == 0 {
 		return promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded), newFreight, nil
@@ -105,17 +140,65 @@ func (a *argoCDMechanism) Promote(
 			)
 	}
 
+	if promo.Spec.DryRun {
+		newStatus := promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded)
+		for _, update := range updates {
+			m, err := a.forInstance(update.Instance)
+			if err != nil {
+				return nil, newFreight, err
+			}
+			change, err := m.planSingleUpdateFn(ctx, stage.ObjectMeta, update, newFreight)
+			if err != nil {
+				return nil, newFreight, err
+			}
+			newStatus.PlannedChanges = append(newStatus.PlannedChanges, change)
+		}
+		return newStatus, newFreight, nil
+	}
+
 	logger := logging.LoggerFromContext(ctx)
 	logger.Debug("executing Argo CD-based promotion mechanisms")
 
+	// The Promotion only succeeds once ALL Applications have converged, so we
+	// keep track of the phase of each so it can be reported in the
+	// Promotion's status while we wait on the stragglers.
 	var updateResults = make([]argocd.OperationPhase, 0, len(updates))
+	appPhases := make(map[string]string, len(updates))
+	results := make([]kargoapi.MechanismResult, 0, len(updates))
+	var failureMsg string
 	for _, update := range updates {
+		appNamespace := update.AppNamespace
+		if appNamespace == "" {
+			appNamespace = libargocd.Namespace()
+		}
+		appKey := argoCDAppMetadataKey(appNamespace, update.AppName)
+		result := kargoapi.MechanismResult{
+			Type:   kargoapi.MechanismTypeArgoCDAppUpdate,
+			Target: fmt.Sprintf("%s/%s", appNamespace, update.AppName),
+		}
+		if update.Instance != "" {
+			result.Target = fmt.Sprintf("%s/%s", update.Instance, result.Target)
+		}
+
+		// Updates of Applications managed by other Argo CD instances are
+		// carried out using clients for those instances.
+		m, err := a.forInstance(update.Instance)
+		if err != nil {
+			return argoCDErroredStatus(promo, results, result, err), newFreight, err
+		}
+
 		// Check if the update needs to be performed and retrieve its phase.
-		phase, mustUpdate, err := a.mustPerformUpdateFn(ctx, update, newFreight)
+		phase, mustUpdate, err := m.mustPerformUpdateFn(ctx, promo, update, newFreight)
+		if !mustUpdate && phase != "" && !phase.Completed() && !waitsForSync(update) {
+			// The operation is still running, but the update does not wait for
+			// it to complete.
+			phase = argocd.OperationSucceeded
+		}
 
 		// If we have a phase, append it to the results.
 		if phase != "" {
 			updateResults = append(updateResults, phase)
+			appPhases[appKey] = string(phase)
 		}
 
 		// If we don't need to perform an update, further processing depends on
@@ -125,7 +208,7 @@ func (a *argoCDMechanism) Promote(
 				if phase == "" {
 					// If we do not have a phase, we cannot continue processing
 					// this update by waiting.
-					return nil, newFreight, err
+					return argoCDErroredStatus(promo, results, result, err), newFreight, err
 				}
 				// Log the error as a warning, but continue to the next update.
 				logger.Warn(err)
@@ -133,23 +216,47 @@ func (a *argoCDMechanism) Promote(
 			if phase.Failed() {
 				// If the update failed, we can short-circuit. This is
 				// effectively "fail fast" behavior.
+				failureMsg = fmt.Sprintf(
+					"operation on Argo CD Application %q in namespace %q %s",
+					update.AppName,
+					appNamespace,
+					strings.ToLower(string(phase)),
+				)
+				if err != nil {
+					failureMsg = fmt.Sprintf("%s: %s", failureMsg, err)
+				}
+				result.Phase = kargoapi.PromotionPhaseFailed
+				result.Message = failureMsg
+				results = append(results, result)
 				break
 			}
 			// If we get here, we can continue to the next update.
+			result.Phase = operationPhaseToPromotionPhase(phase)
+			results = append(results, result)
 			continue
 		}
 
 		// Perform the update.
-		if err := a.doSingleUpdateFn(
+		if err := m.doSingleUpdateFn(
 			ctx,
 			stage.ObjectMeta,
+			promo,
 			update,
 			newFreight,
 		); err != nil {
-			return nil, newFreight, err
+			return argoCDErroredStatus(promo, results, result, err), newFreight, err
+		}
+		appPhases[appKey] = string(argocd.OperationRunning)
+		if !waitsForSync(update) {
+			updateResults = append(updateResults, argocd.OperationSucceeded)
+			result.Phase = kargoapi.PromotionPhaseSucceeded
+			results = append(results, result)
+			continue
 		}
 		// As we have initiated an update, we should wait for it to complete.
 		updateResults = append(updateResults, argocd.OperationRunning)
+		result.Phase = kargoapi.PromotionPhaseRunning
+		results = append(results, result)
 	}
 
 	aggregatedPhase := operationPhaseToPromotionPhase(updateResults...)
@@ -161,11 +268,70 @@ func (a *argoCDMechanism) Promote(
 	}
 
 	logger.Debug("done executing Argo CD-based promotion mechanisms")
-	return promo.Status.WithPhase(aggregatedPhase), newFreight, nil
+	newStatus := promo.Status.WithPhase(aggregatedPhase)
+	if failureMsg != "" {
+		newStatus.Message = failureMsg
+	}
+	if newStatus.Metadata == nil {
+		newStatus.Metadata = make(map[string]string, len(appPhases))
+	}
+	for k, v := range appPhases {
+		newStatus.Metadata[k] = v
+	}
+	newStatus.Mechanisms = append(newStatus.Mechanisms, results...)
+	return newStatus, newFreight, nil
+}
+
+// forInstance returns the mechanism responsible for updating Applications of
+// the named Argo CD instance. An empty name denotes the default instance, for
+// which the receiver itself is responsible.
+func (a *argoCDMechanism) forInstance(instance string) (*argoCDMechanism, error) {
+	if instance == "" {
+		return a, nil
+	}
+	m, ok := a.instanceMechanisms[instance]
+	if !ok {
+		return nil, fmt.Errorf(
+			"Argo CD instance %q is not known to this controller",
+			instance,
+		)
+	}
+	return m, nil
+}
+
+// argoCDErroredStatus returns an Errored copy of the provided Promotion's
+// status that records the results of the updates that preceded the one that
+// errored, followed by the errored update itself.
+func argoCDErroredStatus(
+	promo *kargoapi.Promotion,
+	results []kargoapi.MechanismResult,
+	erroredResult kargoapi.MechanismResult,
+	err error,
+) *kargoapi.PromotionStatus {
+	newStatus := promo.Status.WithPhase(kargoapi.PromotionPhaseErrored)
+	erroredResult.Phase = kargoapi.PromotionPhaseErrored
+	erroredResult.Message = err.Error()
+	newStatus.Mechanisms = append(newStatus.Mechanisms, results...)
+	newStatus.Mechanisms = append(newStatus.Mechanisms, erroredResult)
+	return newStatus
+}
+
+// waitsForSync returns true if a Promotion carrying out the provided update
+// must wait for the sync operation it initiates to complete.
+func waitsForSync(update kargoapi.ArgoCDAppUpdate) bool {
+	return update.Sync == nil || update.Sync.Wait == nil || *update.Sync.Wait
+}
+
+// argoCDAppMetadataKey returns the key used to record the phase of the most
+// recent operation on the specified Argo CD Application in a Promotion's
+// status metadata.
+func argoCDAppMetadataKey(namespace, name string) string {
+	return fmt.Sprintf("argocd-app:%s/%s", namespace, name)
 }
 
 func (a *argoCDMechanism) mustPerformUpdate(
 	ctx context.Context,
+	promo *kargoapi.Promotion,
 	update kargoapi.ArgoCDAppUpdate,
 	newFreight kargoapi.FreightReference,
 ) (phase argocd.OperationPhase, mustUpdate bool, err error) {
@@ -214,10 +380,25 @@ func (a *argoCDMechanism) mustPerformUpdate(
 	}
 
 	if !status.Phase.Completed() {
-		// The operation is still running.
+		// The operation is still running. If it has been running for longer than
+		// the update permits, we give up on it.
+		if argoCDUpdateTimedOut(update, status) {
+			return argocd.OperationFailed, false, fmt.Errorf(
+				"timed out after %s waiting for operation on Argo CD Application %q in namespace %q to complete",
+				update.Timeout.Duration,
+				update.AppName,
+				namespace,
+			)
+		}
 		return status.Phase, false, nil
 	}
 
+	if promo.Status.Attempts > 1 && !isArgoCDOperationOfAttempt(status.Operation, promo) {
+		// The Promotion is being retried and the operation was initiated by an
+		// earlier attempt. The retry must perform an operation of its own.
+		return "", true, nil
+	}
+
 	// The operation has completed. Check if the desired revision was applied.
 	desiredRevision := libargocd.GetDesiredRevision(app, newFreight)
 	if status.SyncResult == nil {
@@ -234,74 +415,90 @@ func (a *argoCDMechanism) mustPerformUpdate(
 		)
 	}
 
+	if update.WaitForHealthy && status.Phase == argocd.OperationSucceeded {
+		// The operation has succeeded, but the update also requires the
+		// Application to have converged.
+		return waitForHealthyArgoCDApp(app, update, status)
+	}
+
 	// The operation has completed.
 	return status.Phase, false, nil
 }
 
+// waitForHealthyArgoCDApp returns the phase of an update that waits for the
+// provided Argo CD Application to be Synced and Healthy after the provided
+// operation has succeeded. The phase is Running until the Application is both
+// or, if it never becomes both, until the update's timeout elapses.
+func waitForHealthyArgoCDApp(
+	app *argocd.Application,
+	update kargoapi.ArgoCDAppUpdate,
+	status *argocd.OperationState,
+) (argocd.OperationPhase, bool, error) {
+	if app.Status.Sync.Status == argocd.SyncStatusCodeSynced &&
+		app.Status.Health.Status == argocd.HealthStatusHealthy {
+		return argocd.OperationSucceeded, false, nil
+	}
+	if argoCDUpdateTimedOut(update, status) {
+		return argocd.OperationFailed, false, fmt.Errorf(
+			"timed out after %s waiting for Argo CD Application %q in namespace %q "+
+				"to become Synced and Healthy; sync status is %q and health status is %q",
+			update.Timeout.Duration,
+			app.Name,
+			app.Namespace,
+			app.Status.Sync.Status,
+			app.Status.Health.Status,
+		)
+	}
+	return argocd.OperationRunning, false, nil
+}
+
+// argoCDUpdateTimedOut returns true if the provided update has a timeout and
+// more time than that has elapsed since the provided operation started.
+func argoCDUpdateTimedOut(
+	update kargoapi.ArgoCDAppUpdate,
+	status *argocd.OperationState,
+) bool {
+	return update.Timeout != nil && update.Timeout.Duration > 0 &&
+		!status.StartedAt.IsZero() &&
+		time.Since(status.StartedAt.Time) > update.Timeout.Duration
+}
+
+// isArgoCDOperationOfAttempt returns true if the provided operation was
+// initiated by the current attempt of the provided Promotion.
+func isArgoCDOperationOfAttempt(op argocd.Operation, promo *kargoapi.Promotion) bool {
+	var promoName, attempt string
+	for _, info := range op.Info {
+		if info == nil {
+			continue
+		}
+		switch info.Name {
+		case argoCDOperationPromotionInfo:
+			promoName = info.Value
+		case argoCDOperationAttemptInfo:
+			attempt = info.Value
+		}
+	}
+	return promoName == promo.Name &&
+		attempt == strconv.Itoa(int(promo.Status.Attempts))
+}
+
 func (a *argoCDMechanism) doSingleUpdate(
 	ctx context.Context,
 	stageMeta metav1.ObjectMeta,
+	promo *kargoapi.Promotion,
 	update kargoapi.ArgoCDAppUpdate,
 	newFreight kargoapi.FreightReference,
 ) error {
-	namespace := update.AppNamespace
-	if namespace == "" {
-		namespace = libargocd.Namespace()
-	}
-	app, err := a.getArgoCDAppFn(ctx, namespace, update.AppName)
+	app, err := a.getAuthorizedApp(ctx, stageMeta, update)
 	if err != nil {
-		return fmt.Errorf(
-			"error finding Argo CD Application %q in namespace %q: %w",
-			update.AppName,
-			namespace,
-			err,
-		)
-	}
-	if app == nil {
-		return fmt.Errorf(
-			"unable to find Argo CD Application %q in namespace %q: %w",
-			update.AppName,
-			namespace,
-			err,
-		)
-	}
-	// Make sure this is allowed!
-	if err = authorizeArgoCDAppUpdate(stageMeta, app.ObjectMeta); err != nil {
 		return err
 	}
 	patch := client.MergeFrom(app.DeepCopy())
-	for _, srcUpdate := range update.SourceUpdates {
-		if app.Spec.Source != nil {
-			var source argocd.ApplicationSource
-			if source, err = a.applyArgoCDSourceUpdateFn(
-				*app.Spec.Source,
-				newFreight,
-				srcUpdate,
-			); err != nil {
-				return fmt.Errorf(
-					"error updating source of Argo CD Application %q in namespace %q: %w",
-					update.AppName,
-					namespace,
-					err,
-				)
-			}
-			app.Spec.Source = &source
-		}
-		for i, source := range app.Spec.Sources {
-			if source, err = a.applyArgoCDSourceUpdateFn(
-				source,
-				newFreight,
-				srcUpdate,
-			); err != nil {
-				return fmt.Errorf(
-					"error updating source(s) of Argo CD Application %q in namespace %q: %w",
-					update.AppName,
-					namespace,
-					err,
-				)
-			}
-			app.Spec.Sources[i] = source
-		}
+	if err = a.applySourceUpdates(app, update, newFreight); err != nil {
+		return err
+	}
+	if err = applyArgoCDSelfHeal(app, update.Sync); err != nil {
+		return err
 	}
 	app.ObjectMeta.Annotations[argocd.AnnotationKeyRefresh] = string(argocd.RefreshTypeHard)
 	app.Operation = &argocd.Operation{
@@ -314,6 +511,14 @@ func (a *argoCDMechanism) doSingleUpdate(
 				Name:  "Reason",
 				Value: "Promotion triggered a sync of this Application resource.",
 			},
+			{
+				Name:  argoCDOperationPromotionInfo,
+				Value: promo.Name,
+			},
+			{
+				Name:  argoCDOperationAttemptInfo,
+				Value: strconv.Itoa(int(promo.Status.Attempts)),
+			},
 		},
 		Sync: &argocd.SyncOperation{
 			Revisions: []string{},
@@ -327,6 +532,13 @@ func (a *argoCDMechanism) doSingleUpdate(
 			app.Operation.Sync.SyncOptions = app.Spec.SyncPolicy.SyncOptions
 		}
 	}
+	if update.Sync != nil {
+		app.Operation.Sync.Prune = update.Sync.Prune
+		app.Operation.Sync.SyncOptions = mergeArgoCDSyncOptions(
+			app.Operation.Sync.SyncOptions,
+			update.Sync.Options,
+		)
+	}
 	if app.Spec.Source != nil {
 		app.Operation.Sync.Revisions = []string{app.Spec.Source.TargetRevision}
 	}
@@ -361,6 +573,188 @@ func (a *argoCDMechanism) doSingleUpdate(
 	return nil
 }
 
+// applyArgoCDSelfHeal enables or disables self-healing in the automated sync
+// policy of the provided Argo CD Application as the provided sync settings
+// specify. It returns an error if self-healing is to be configured but the
+// Application has no automated sync policy.
+func applyArgoCDSelfHeal(app *argocd.Application, sync *kargoapi.ArgoCDAppSync) error {
+	if sync == nil || sync.SelfHeal == nil {
+		return nil
+	}
+	if app.Spec.SyncPolicy == nil || app.Spec.SyncPolicy.Automated == nil {
+		return fmt.Errorf(
+			"cannot configure self-healing of Argo CD Application %q in namespace %q: "+
+				"it has no automated sync policy",
+			app.Name,
+			app.Namespace,
+		)
+	}
+	app.Spec.SyncPolicy.Automated.SelfHeal = *sync.SelfHeal
+	return nil
+}
+
+// mergeArgoCDSyncOptions returns the provided base sync options with the
+// provided overrides applied. An override replaces any base option with the
+// same key and is otherwise appended.
+func mergeArgoCDSyncOptions(base argocd.SyncOptions, overrides []string) argocd.SyncOptions {
+	if len(overrides) == 0 {
+		return base
+	}
+	merged := make(argocd.SyncOptions, 0, len(base)+len(overrides))
+	for _, opt := range base {
+		key, _, _ := strings.Cut(opt, "=")
+		if !slices.ContainsFunc(overrides, func(override string) bool {
+			overrideKey, _, _ := strings.Cut(override, "=")
+			return overrideKey == key
+		}) {
+			merged = append(merged, opt)
+		}
+	}
+	return append(merged, overrides...)
+}
+
+// planSingleUpdate describes, without making them, the changes that
+// doSingleUpdate would make to the Argo CD Application referenced by the
+// provided update.
+func (a *argoCDMechanism) planSingleUpdate(
+	ctx context.Context,
+	stageMeta metav1.ObjectMeta,
+	update kargoapi.ArgoCDAppUpdate,
+	newFreight kargoapi.FreightReference,
+) (string, error) {
+	app, err := a.getAuthorizedApp(ctx, stageMeta, update)
+	if err != nil {
+		return "", err
+	}
+	updatedApp := app.DeepCopy()
+	if err = a.applySourceUpdates(updatedApp, update, newFreight); err != nil {
+		return "", err
+	}
+	if err = applyArgoCDSelfHeal(updatedApp, update.Sync); err != nil {
+		return "", err
+	}
+	if reflect.DeepEqual(app.Spec.Source, updatedApp.Spec.Source) &&
+		reflect.DeepEqual(app.Spec.Sources, updatedApp.Spec.Sources) {
+		return fmt.Sprintf(
+			"sync Argo CD Application %q in namespace %q with no source changes",
+			app.Name,
+			app.Namespace,
+		), nil
+	}
+	return fmt.Sprintf(
+		"update source(s) of Argo CD Application %q in namespace %q and sync it",
+		app.Name,
+		app.Namespace,
+	), nil
+}
+
+// getAuthorizedApp retrieves the Argo CD Application referenced by the
+// provided update and verifies that the Stage with the provided metadata is
+// permitted to update it.
+func (a *argoCDMechanism) getAuthorizedApp(
+	ctx context.Context,
+	stageMeta metav1.ObjectMeta,
+	update kargoapi.ArgoCDAppUpdate,
+) (*argocd.Application, error) {
+	namespace := update.AppNamespace
+	if namespace == "" {
+		namespace = libargocd.Namespace()
+	}
+	app, err := a.getArgoCDAppFn(ctx, namespace, update.AppName)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error finding Argo CD Application %q in namespace %q: %w",
+			update.AppName,
+			namespace,
+			err,
+		)
+	}
+	if app == nil {
+		return nil, fmt.Errorf(
+			"unable to find Argo CD Application %q in namespace %q: %w",
+			update.AppName,
+			namespace,
+			err,
+		)
+	}
+	// Make sure this is allowed!
+	if err = authorizeArgoCDAppUpdate(stageMeta, app.ObjectMeta); err != nil {
+		return nil, err
+	}
+	return app, nil
+}
+
+// applySourceUpdates applies the source updates specified by the provided
+// update to the source(s) of the provided Argo CD Application in place.
+func (a *argoCDMechanism) applySourceUpdates(
+	app *argocd.Application,
+	update kargoapi.ArgoCDAppUpdate,
+	newFreight kargoapi.FreightReference,
+) error {
+	for _, srcUpdate := range update.SourceUpdates {
+		if srcUpdate.Ref != "" {
+			i, err := selectArgoCDSourceByRef(app, srcUpdate)
+			if err != nil {
+				return fmt.Errorf(
+					"error selecting source of Argo CD Application %q in namespace %q: %w",
+					app.Name,
+					app.Namespace,
+					err,
+				)
+			}
+			source, err := a.applyArgoCDSourceUpdateFn(
+				app.Spec.Sources[i],
+				newFreight,
+				srcUpdate,
+			)
+			if err != nil {
+				return fmt.Errorf(
+					"error updating source %d of Argo CD Application %q in namespace %q: %w",
+					i,
+					app.Name,
+					app.Namespace,
+					err,
+				)
+			}
+			app.Spec.Sources[i] = source
+			continue
+		}
+		if app.Spec.Source != nil {
+			source, err := a.applyArgoCDSourceUpdateFn(
+				*app.Spec.Source,
+				newFreight,
+				srcUpdate,
+			)
+			if err != nil {
+				return fmt.Errorf(
+					"error updating source of Argo CD Application %q in namespace %q: %w",
+					app.Name,
+					app.Namespace,
+					err,
+				)
+			}
+			app.Spec.Source = &source
+		}
+		for i, source := range app.Spec.Sources {
+			source, err := a.applyArgoCDSourceUpdateFn(
+				source,
+				newFreight,
+				srcUpdate,
+			)
+			if err != nil {
+				return fmt.Errorf(
+					"error updating source(s) of Argo CD Application %q in namespace %q: %w",
+					app.Name,
+					app.Namespace,
+					err,
+				)
+			}
+			app.Spec.Sources[i] = source
+		}
+	}
+	return nil
+}
+
 func (a *argoCDMechanism) logAppEvent(ctx context.Context, app *argocd.Application, user, reason, message string) {
 	logger := logging.LoggerFromContext(ctx).WithField("app", app.Name)
 
@@ -479,25 +873,79 @@ func authorizeArgoCDAppUpdate(
 	return nil
 }
 
+// selectArgoCDSourceByRef returns the index of the one source of the provided
+// Argo CD Application that the provided update, which must specify a Ref,
+// applies to. An error is returned if no source or more than one source has
+// the specified ref, or if the source with that ref does not match the update's
+// RepoURL and Chart.
+func selectArgoCDSourceByRef(
+	app *argocd.Application,
+	update kargoapi.ArgoCDSourceUpdate,
+) (int, error) {
+	// Argo CD ignores the ref of a lone source specified using the source
+	// field, so only the sources field is considered.
+	selected := -1
+	for i, source := range app.Spec.Sources {
+		if source.Ref != update.Ref {
+			continue
+		}
+		if selected >= 0 {
+			return -1, fmt.Errorf(
+				"sources %d and %d both have ref %q",
+				selected,
+				i,
+				update.Ref,
+			)
+		}
+		selected = i
+	}
+	if selected < 0 {
+		return -1, fmt.Errorf("no source has ref %q", update.Ref)
+	}
+	if !argoCDSourceMatches(app.Spec.Sources[selected], update) {
+		return -1, fmt.Errorf(
+			"source %d with ref %q does not match repoURL %q and chart %q",
+			selected,
+			update.Ref,
+			update.RepoURL,
+			update.Chart,
+		)
+	}
+	return selected, nil
+}
+
+// argoCDSourceMatches returns true if the provided update's RepoURL and Chart
+// identify the provided Argo CD ApplicationSource.
+func argoCDSourceMatches(
+	source argocd.ApplicationSource,
+	update kargoapi.ArgoCDSourceUpdate,
+) bool {
+	if source.Chart != "" || update.Chart != "" {
+		// Infer that we're dealing with a chart repo. No need to normalize the
+		// repo URL here.
+		//
+		// Kargo uses the "oci://" prefix, but Argo CD does not.
+		return source.RepoURL == strings.TrimPrefix(update.RepoURL, "oci://") &&
+			source.Chart == update.Chart
+	}
+	// We're dealing with a git repo, so we should normalize the repo URLs
+	// before comparing them.
+	return git.NormalizeURL(source.RepoURL) == git.NormalizeURL(update.RepoURL)
+}
+
 // applyArgoCDSourceUpdate updates a single Argo CD ApplicationSource.
 func applyArgoCDSourceUpdate(
 	source argocd.ApplicationSource,
 	newFreight kargoapi.FreightReference,
 	update kargoapi.ArgoCDSourceUpdate,
 ) (argocd.ApplicationSource, error) {
+	if !argoCDSourceMatches(source, update) {
+		return source, nil
+	}
+	// If we get to here, we have confirmed that this update is applicable to
+	// this source.
 	if source.Chart != "" || update.Chart != "" {
-		// Infer that we're dealing with a chart repo. No need to normalize the
-		// repo URL here.
-
-		// Kargo uses the "oci://" prefix, but Argo CD does not.
-		if source.RepoURL != strings.TrimPrefix(update.RepoURL, "oci://") || source.Chart != update.Chart {
-			return source, nil
-		}
-		// If we get to here, we have confirmed that this update is applicable to
-		// this source.
-		//
-		// Now find the chart in the new freight that corresponds to this
-		// source.
+		// Find the chart in the new freight that corresponds to this source.
 		for _, chart := range newFreight.Charts {
 			// path.Join accounts for the possibility that chart.Name is empty
 			//
@@ -508,16 +956,8 @@ func applyArgoCDSourceUpdate(
 			}
 		}
 	} else {
-		// We're dealing with a git repo, so we should normalize the repo URLs
-		// before comparing them.
+		// Find the commit in the new freight that corresponds to this source.
 		sourceRepoURL := git.NormalizeURL(source.RepoURL)
-		if sourceRepoURL != git.NormalizeURL(update.RepoURL) {
-			return source, nil
-		}
-		// If we get to here, we have confirmed that this update is applicable to
-		// this source.
-		//
-		// Now find the commit in the new freight that corresponds to this source.
 		for _, commit := range newFreight.Commits {
 			if git.NormalizeURL(commit.RepoURL) == sourceRepoURL {
 				if commit.Tag != "" {
@@ -531,13 +971,17 @@ func applyArgoCDSourceUpdate(
 	}
 
 	if update.Kustomize != nil && len(update.Kustomize.Images) > 0 {
-		if source.Kustomize == nil {
-			source.Kustomize = &argocd.ApplicationSourceKustomize{}
-		}
-		source.Kustomize.Images = buildKustomizeImagesForArgoCDAppSource(
+		images, err := buildKustomizeImagesForArgoCDAppSource(
 			newFreight.Images,
 			update.Kustomize.Images,
 		)
+		if err != nil {
+			return source, fmt.Errorf("error preparing changes to Kustomize images: %w", err)
+		}
+		if source.Kustomize == nil {
+			source.Kustomize = &argocd.ApplicationSourceKustomize{}
+		}
+		source.Kustomize.Images = images
 	}
 
 	if update.Helm != nil && len(update.Helm.Images) > 0 {
@@ -547,10 +991,13 @@ func applyArgoCDSourceUpdate(
 		if source.Helm.Parameters == nil {
 			source.Helm.Parameters = []argocd.HelmParameter{}
 		}
-		changes := buildHelmParamChangesForArgoCDAppSource(
+		changes, err := buildHelmParamChangesForArgoCDAppSource(
 			newFreight.Images,
 			update.Helm.Images,
 		)
+		if err != nil {
+			return source, fmt.Errorf("error preparing changes to Helm parameters: %w", err)
+		}
 	imageUpdateLoop:
 		for k, v := range changes {
 			newParam := argocd.HelmParameter{
@@ -573,7 +1020,7 @@ func applyArgoCDSourceUpdate(
 func buildKustomizeImagesForArgoCDAppSource(
 	images []kargoapi.Image,
 	imageUpdates []kargoapi.ArgoCDKustomizeImageUpdate,
-) argocd.KustomizeImages {
+) (argocd.KustomizeImages, error) {
 	tagsByImage := make(map[string]string, len(images))
 	digestsByImage := make(map[string]string, len(images))
 	for _, image := range images {
@@ -590,6 +1037,13 @@ func buildKustomizeImagesForArgoCDAppSource(
 		}
 		var kustomizeImageStr string
 		if imageUpdate.UseDigest {
+			if digest == "" {
+				return nil, fmt.Errorf(
+					"cannot update image %q using its digest: Freight does not "+
+						"specify a digest for this image",
+					imageUpdate.Image,
+				)
+			}
 			kustomizeImageStr =
 				fmt.Sprintf("%s=%s@%s", imageUpdate.Image, imageUpdate.Image, digest)
 		} else {
@@ -601,7 +1055,7 @@ func buildKustomizeImagesForArgoCDAppSource(
 			argocd.KustomizeImage(kustomizeImageStr),
 		)
 	}
-	return kustomizeImages
+	return kustomizeImages, nil
 }
 
 // buildHelmParamChangesForArgoCDAppSource takes a list of images and a list of
@@ -610,7 +1064,7 @@ func buildKustomizeImagesForArgoCDAppSource(
 func buildHelmParamChangesForArgoCDAppSource(
 	images []kargoapi.Image,
 	imageUpdates []kargoapi.ArgoCDHelmImageUpdate,
-) map[string]string {
+) (map[string]string, error) {
 	tagsByImage := make(map[string]string, len(images))
 	digestsByImage := make(map[string]string, len(images))
 	for _, image := range images {
@@ -619,12 +1073,7 @@ func buildHelmParamChangesForArgoCDAppSource(
 	}
 	changes := map[string]string{}
 	for _, imageUpdate := range imageUpdates {
-		switch imageUpdate.Value {
-		case kargoapi.ImageUpdateValueTypeImageAndTag,
-			kargoapi.ImageUpdateValueTypeTag,
-			kargoapi.ImageUpdateValueTypeImageAndDigest,
-			kargoapi.ImageUpdateValueTypeDigest:
-		default:
+		if !imageUpdate.Value.IsValid() {
 			// This really shouldn't happen, so we'll ignore it.
 			continue
 		}
@@ -634,6 +1083,9 @@ func buildHelmParamChangesForArgoCDAppSource(
 			// There's no change to make in this case.
 			continue
 		}
+		if err := validateImageDigest(imageUpdate.Image, digest, imageUpdate.Value); err != nil {
+			return nil, err
+		}
 		switch imageUpdate.Value {
 		case kargoapi.ImageUpdateValueTypeImageAndTag:
 			changes[imageUpdate.Key] = fmt.Sprintf("%s:%s", imageUpdate.Image, tag)
@@ -645,7 +1097,7 @@ func buildHelmParamChangesForArgoCDAppSource(
 			changes[imageUpdate.Key] = digest
 		}
 	}
-	return changes
+	return changes, nil
 }
 
 func operationPhaseToPromotionPhase(phases ...argocd.OperationPhase) kargoapi.PromotionPhase {
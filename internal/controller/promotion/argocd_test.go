@@ -6,17 +6,20 @@ import (
 	"fmt"
 	"io"
 	"testing"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libargocd "github.com/akuity/kargo/internal/argocd"
 	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
 	"github.com/akuity/kargo/internal/logging"
 )
@@ -24,6 +27,9 @@ import (
 func TestNewArgoCDMechanism(t *testing.T) {
 	pm := newArgoCDMechanism(
 		fake.NewClientBuilder().Build(),
+		map[string]client.Client{
+			"fake-instance": fake.NewClientBuilder().Build(),
+		},
 	)
 	apm, ok := pm.(*argoCDMechanism)
 	require.True(t, ok)
@@ -32,6 +38,8 @@ func TestNewArgoCDMechanism(t *testing.T) {
 	require.NotNil(t, apm.getArgoCDAppFn)
 	require.NotNil(t, apm.applyArgoCDSourceUpdateFn)
 	require.NotNil(t, apm.argoCDAppPatchFn)
+	require.Contains(t, apm.instanceMechanisms, "fake-instance")
+	require.NotNil(t, apm.instanceMechanisms["fake-instance"].argocdClient)
 }
 
 func TestArgoCDGetName(t *testing.T) {
@@ -71,6 +79,22 @@ func TestArgoCDPromote(t *testing.T) {
 				require.Equal(t, newFreightIn, newFreightOut)
 			},
 		},
+		{
+			name:      "no promotion mechanisms",
+			promoMech: &argoCDMechanism{},
+			stage:     &kargoapi.Stage{},
+			assertions: func(
+				t *testing.T,
+				newStatus *kargoapi.PromotionStatus,
+				newFreightIn kargoapi.FreightReference,
+				newFreightOut kargoapi.FreightReference,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, newStatus.Phase)
+				require.Equal(t, newFreightIn, newFreightOut)
+			},
+		},
 		{
 			name:      "argo cd integration disabled",
 			promoMech: &argoCDMechanism{},
@@ -101,6 +125,7 @@ func TestArgoCDPromote(t *testing.T) {
 				argocdClient: fake.NewClientBuilder().Build(),
 				mustPerformUpdateFn: func(
 					context.Context,
+					*kargoapi.Promotion,
 					kargoapi.ArgoCDAppUpdate,
 					kargoapi.FreightReference,
 				) (argocd.OperationPhase, bool, error) {
@@ -118,12 +143,17 @@ func TestArgoCDPromote(t *testing.T) {
 			},
 			assertions: func(
 				t *testing.T,
-				_ *kargoapi.PromotionStatus,
+				status *kargoapi.PromotionStatus,
 				newFreightIn kargoapi.FreightReference,
 				newFreightOut kargoapi.FreightReference,
 				err error,
 			) {
 				require.ErrorContains(t, err, "something went wrong")
+				require.Equal(t, kargoapi.PromotionPhaseErrored, status.Phase)
+				require.Len(t, status.Mechanisms, 1)
+				require.Equal(t, kargoapi.MechanismTypeArgoCDAppUpdate, status.Mechanisms[0].Type)
+				require.Equal(t, kargoapi.PromotionPhaseErrored, status.Mechanisms[0].Phase)
+				require.Equal(t, "something went wrong", status.Mechanisms[0].Message)
 				require.Equal(t, newFreightIn, newFreightOut)
 			},
 		},
@@ -133,6 +163,7 @@ func TestArgoCDPromote(t *testing.T) {
 				argocdClient: fake.NewClientBuilder().Build(),
 				mustPerformUpdateFn: func(
 					context.Context,
+					*kargoapi.Promotion,
 					kargoapi.ArgoCDAppUpdate,
 					kargoapi.FreightReference,
 				) (argocd.OperationPhase, bool, error) {
@@ -141,6 +172,7 @@ func TestArgoCDPromote(t *testing.T) {
 				doSingleUpdateFn: func(
 					context.Context,
 					metav1.ObjectMeta,
+					*kargoapi.Promotion,
 					kargoapi.ArgoCDAppUpdate,
 					kargoapi.FreightReference,
 				) error {
@@ -173,6 +205,7 @@ func TestArgoCDPromote(t *testing.T) {
 				argocdClient: fake.NewClientBuilder().Build(),
 				mustPerformUpdateFn: func(
 					context.Context,
+					*kargoapi.Promotion,
 					kargoapi.ArgoCDAppUpdate,
 					kargoapi.FreightReference,
 				) (argocd.OperationPhase, bool, error) {
@@ -200,12 +233,103 @@ func TestArgoCDPromote(t *testing.T) {
 				require.Equal(t, newFreightIn, newFreightOut)
 			},
 		},
+		{
+			name: "need not wait for update to complete",
+			promoMech: &argoCDMechanism{
+				argocdClient: fake.NewClientBuilder().Build(),
+				mustPerformUpdateFn: func(
+					context.Context,
+					*kargoapi.Promotion,
+					kargoapi.ArgoCDAppUpdate,
+					kargoapi.FreightReference,
+				) (argocd.OperationPhase, bool, error) {
+					return argocd.OperationRunning, false, nil
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+							{
+								AppName: "fake-app",
+								Sync:    &kargoapi.ArgoCDAppSync{Wait: ptr.To(false)},
+							},
+						},
+					},
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				status *kargoapi.PromotionStatus,
+				_ kargoapi.FreightReference,
+				_ kargoapi.FreightReference,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+				require.Len(t, status.Mechanisms, 1)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Mechanisms[0].Phase)
+			},
+		},
+		{
+			name: "need not wait for initiated update to complete",
+			promoMech: &argoCDMechanism{
+				argocdClient: fake.NewClientBuilder().Build(),
+				mustPerformUpdateFn: func(
+					context.Context,
+					*kargoapi.Promotion,
+					kargoapi.ArgoCDAppUpdate,
+					kargoapi.FreightReference,
+				) (argocd.OperationPhase, bool, error) {
+					return "", true, nil
+				},
+				doSingleUpdateFn: func(
+					context.Context,
+					metav1.ObjectMeta,
+					*kargoapi.Promotion,
+					kargoapi.ArgoCDAppUpdate,
+					kargoapi.FreightReference,
+				) error {
+					return nil
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+							{
+								AppName:      "fake-app",
+								AppNamespace: "fake-namespace",
+								Sync:         &kargoapi.ArgoCDAppSync{Wait: ptr.To(false)},
+							},
+						},
+					},
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				status *kargoapi.PromotionStatus,
+				_ kargoapi.FreightReference,
+				_ kargoapi.FreightReference,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+				// The operation itself is still known to be running
+				require.Equal(
+					t,
+					string(argocd.OperationRunning),
+					status.Metadata[argoCDAppMetadataKey("fake-namespace", "fake-app")],
+				)
+			},
+		},
 		{
 			name: "must wait for operation from different user to complete",
 			promoMech: &argoCDMechanism{
 				argocdClient: fake.NewClientBuilder().Build(),
 				mustPerformUpdateFn: func(
 					context.Context,
+					*kargoapi.Promotion,
 					kargoapi.ArgoCDAppUpdate,
 					kargoapi.FreightReference,
 				) (argocd.OperationPhase, bool, error) {
@@ -239,6 +363,7 @@ func TestArgoCDPromote(t *testing.T) {
 				argocdClient: fake.NewClientBuilder().Build(),
 				mustPerformUpdateFn: func(
 					context.Context,
+					*kargoapi.Promotion,
 					kargoapi.ArgoCDAppUpdate,
 					kargoapi.FreightReference,
 				) (argocd.OperationPhase, bool, error) {
@@ -247,6 +372,7 @@ func TestArgoCDPromote(t *testing.T) {
 				doSingleUpdateFn: func(
 					context.Context,
 					metav1.ObjectMeta,
+					*kargoapi.Promotion,
 					kargoapi.ArgoCDAppUpdate,
 					kargoapi.FreightReference,
 				) error {
@@ -284,12 +410,14 @@ func TestArgoCDPromote(t *testing.T) {
 				argocdClient: fake.NewClientBuilder().Build(),
 				mustPerformUpdateFn: func() func(
 					context.Context,
+					*kargoapi.Promotion,
 					kargoapi.ArgoCDAppUpdate,
 					kargoapi.FreightReference,
 				) (argocd.OperationPhase, bool, error) {
 					var count uint
 					return func(
 						context.Context,
+						*kargoapi.Promotion,
 						kargoapi.ArgoCDAppUpdate,
 						kargoapi.FreightReference,
 					) (argocd.OperationPhase, bool, error) {
@@ -303,6 +431,7 @@ func TestArgoCDPromote(t *testing.T) {
 				doSingleUpdateFn: func(
 					context.Context,
 					metav1.ObjectMeta,
+					*kargoapi.Promotion,
 					kargoapi.ArgoCDAppUpdate,
 					kargoapi.FreightReference,
 				) error {
@@ -313,8 +442,14 @@ func TestArgoCDPromote(t *testing.T) {
 				Spec: kargoapi.StageSpec{
 					PromotionMechanisms: &kargoapi.PromotionMechanisms{
 						ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
-							{},
-							{},
+							{
+								AppName:      "app-1",
+								AppNamespace: "fake-namespace",
+							},
+							{
+								AppName:      "app-2",
+								AppNamespace: "fake-namespace",
+							},
 						},
 					},
 				},
@@ -328,6 +463,88 @@ func TestArgoCDPromote(t *testing.T) {
 			) {
 				require.NoError(t, err)
 				require.Equal(t, kargoapi.PromotionPhaseFailed, status.Phase)
+				require.Equal(
+					t,
+					`operation on Argo CD Application "app-2" in namespace "fake-namespace" failed`,
+					status.Message,
+				)
+				require.Equal(
+					t,
+					map[string]string{
+						"argocd-app:fake-namespace/app-1": string(argocd.OperationRunning),
+						"argocd-app:fake-namespace/app-2": string(argocd.OperationFailed),
+					},
+					status.Metadata,
+				)
+				require.Equal(
+					t,
+					[]kargoapi.MechanismResult{
+						{
+							Type:   kargoapi.MechanismTypeArgoCDAppUpdate,
+							Target: "fake-namespace/app-1",
+							Phase:  kargoapi.PromotionPhaseRunning,
+						},
+						{
+							Type:    kargoapi.MechanismTypeArgoCDAppUpdate,
+							Target:  "fake-namespace/app-2",
+							Phase:   kargoapi.PromotionPhaseFailed,
+							Message: status.Message,
+						},
+					},
+					status.Mechanisms,
+				)
+				require.Equal(t, newFreightIn, newFreightOut)
+			},
+		},
+		{
+			name: "waits for all apps to converge",
+			promoMech: &argoCDMechanism{
+				argocdClient: fake.NewClientBuilder().Build(),
+				mustPerformUpdateFn: func(
+					_ context.Context,
+					_ *kargoapi.Promotion,
+					update kargoapi.ArgoCDAppUpdate,
+					_ kargoapi.FreightReference,
+				) (argocd.OperationPhase, bool, error) {
+					if update.AppName == "app-1" {
+						return argocd.OperationSucceeded, false, nil
+					}
+					return argocd.OperationRunning, false, nil
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+							{
+								AppName:      "app-1",
+								AppNamespace: "fake-namespace",
+							},
+							{
+								AppName:      "app-2",
+								AppNamespace: "fake-namespace",
+							},
+						},
+					},
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				status *kargoapi.PromotionStatus,
+				newFreightIn kargoapi.FreightReference,
+				newFreightOut kargoapi.FreightReference,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+				require.Equal(
+					t,
+					map[string]string{
+						"argocd-app:fake-namespace/app-1": string(argocd.OperationSucceeded),
+						"argocd-app:fake-namespace/app-2": string(argocd.OperationRunning),
+					},
+					status.Metadata,
+				)
 				require.Equal(t, newFreightIn, newFreightOut)
 			},
 		},
@@ -337,6 +554,7 @@ func TestArgoCDPromote(t *testing.T) {
 				argocdClient: fake.NewClientBuilder().Build(),
 				mustPerformUpdateFn: func(
 					context.Context,
+					*kargoapi.Promotion,
 					kargoapi.ArgoCDAppUpdate,
 					kargoapi.FreightReference,
 				) (argocd.OperationPhase, bool, error) {
@@ -369,6 +587,7 @@ func TestArgoCDPromote(t *testing.T) {
 				argocdClient: fake.NewClientBuilder().Build(),
 				mustPerformUpdateFn: func(
 					context.Context,
+					*kargoapi.Promotion,
 					kargoapi.ArgoCDAppUpdate,
 					kargoapi.FreightReference,
 				) (argocd.OperationPhase, bool, error) {
@@ -396,6 +615,105 @@ func TestArgoCDPromote(t *testing.T) {
 				require.Equal(t, newFreightIn, newFreightOut)
 			},
 		},
+		{
+			name: "unknown Argo CD instance",
+			promoMech: &argoCDMechanism{
+				argocdClient: fake.NewClientBuilder().Build(),
+			},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+							{
+								Instance: "fake-instance",
+								AppName:  "fake-app",
+							},
+						},
+					},
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				status *kargoapi.PromotionStatus,
+				_ kargoapi.FreightReference,
+				_ kargoapi.FreightReference,
+				err error,
+			) {
+				require.ErrorContains(t, err, `Argo CD instance "fake-instance" is not known`)
+				require.Equal(t, kargoapi.PromotionPhaseErrored, status.Phase)
+			},
+		},
+		{
+			name: "updates routed to the Argo CD instance managing each Application",
+			promoMech: &argoCDMechanism{
+				argocdClient: fake.NewClientBuilder().Build(),
+				mustPerformUpdateFn: func(
+					_ context.Context,
+					_ *kargoapi.Promotion,
+					update kargoapi.ArgoCDAppUpdate,
+					_ kargoapi.FreightReference,
+				) (argocd.OperationPhase, bool, error) {
+					require.Empty(t, update.Instance)
+					return argocd.OperationSucceeded, false, nil
+				},
+				instanceMechanisms: map[string]*argoCDMechanism{
+					"fake-instance": {
+						mustPerformUpdateFn: func(
+							_ context.Context,
+							_ *kargoapi.Promotion,
+							update kargoapi.ArgoCDAppUpdate,
+							_ kargoapi.FreightReference,
+						) (argocd.OperationPhase, bool, error) {
+							require.Equal(t, "fake-instance", update.Instance)
+							return "", true, nil
+						},
+						doSingleUpdateFn: func(
+							_ context.Context,
+							_ metav1.ObjectMeta,
+							_ *kargoapi.Promotion,
+							update kargoapi.ArgoCDAppUpdate,
+							_ kargoapi.FreightReference,
+						) error {
+							require.Equal(t, "fake-instance", update.Instance)
+							return nil
+						},
+					},
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+							{
+								AppName: "default-app",
+							},
+							{
+								Instance: "fake-instance",
+								AppName:  "other-app",
+							},
+						},
+					},
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				status *kargoapi.PromotionStatus,
+				_ kargoapi.FreightReference,
+				_ kargoapi.FreightReference,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+				require.Len(t, status.Mechanisms, 2)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Mechanisms[0].Phase)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, status.Mechanisms[1].Phase)
+				require.Equal(
+					t,
+					fmt.Sprintf("fake-instance/%s/other-app", libargocd.Namespace()),
+					status.Mechanisms[1].Target,
+				)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -417,6 +735,9 @@ func TestArgoCDMustPerformUpdate(t *testing.T) {
 	testCases := []struct {
 		name              string
 		modifyApplication func(*argocd.Application)
+		promo             *kargoapi.Promotion
+		timeout           *metav1.Duration
+		waitForHealthy    bool
 		newFreight        kargoapi.FreightReference
 		interceptor       interceptor.Funcs
 		assertions        func(t *testing.T, phase argocd.OperationPhase, mustUpdate bool, err error)
@@ -510,26 +831,213 @@ func TestArgoCDMustPerformUpdate(t *testing.T) {
 			},
 		},
 		{
-			name: "unable to determine desired revision",
+			name: "pending operation initiated by us within timeout",
+			modifyApplication: func(app *argocd.Application) {
+				app.Status.OperationState = &argocd.OperationState{
+					Phase: argocd.OperationRunning,
+					Operation: argocd.Operation{
+						InitiatedBy: argocd.OperationInitiator{
+							Username: applicationOperationInitiator,
+						},
+					},
+					StartedAt: metav1.NewTime(time.Now().Add(-time.Minute)),
+				}
+			},
+			timeout: &metav1.Duration{Duration: time.Hour},
+			assertions: func(t *testing.T, phase argocd.OperationPhase, mustUpdate bool, err error) {
+				require.NoError(t, err)
+				require.False(t, mustUpdate)
+				require.Equal(t, argocd.OperationRunning, phase)
+			},
+		},
+		{
+			name: "pending operation initiated by us exceeded timeout",
+			modifyApplication: func(app *argocd.Application) {
+				app.Status.OperationState = &argocd.OperationState{
+					Phase: argocd.OperationRunning,
+					Operation: argocd.Operation{
+						InitiatedBy: argocd.OperationInitiator{
+							Username: applicationOperationInitiator,
+						},
+					},
+					StartedAt: metav1.NewTime(time.Now().Add(-time.Hour)),
+				}
+			},
+			timeout: &metav1.Duration{Duration: time.Minute},
+			assertions: func(t *testing.T, phase argocd.OperationPhase, mustUpdate bool, err error) {
+				require.ErrorContains(t, err, "timed out after 1m0s waiting for operation")
+				require.False(t, mustUpdate)
+				require.Equal(t, argocd.OperationFailed, phase)
+			},
+		},
+		{
+			name: "unable to determine desired revision",
+			modifyApplication: func(app *argocd.Application) {
+				app.Status.OperationState = &argocd.OperationState{
+					Phase: argocd.OperationSucceeded,
+					Operation: argocd.Operation{
+						InitiatedBy: argocd.OperationInitiator{
+							Username: applicationOperationInitiator,
+						},
+					},
+					SyncResult: &argocd.SyncOperationResult{},
+				}
+			},
+			assertions: func(t *testing.T, phase argocd.OperationPhase, mustUpdate bool, err error) {
+				require.NoError(t, err)
+				require.Equal(t, argocd.OperationSucceeded, phase)
+				require.False(t, mustUpdate)
+			},
+		},
+		{
+			name: "no sync result",
+			modifyApplication: func(app *argocd.Application) {
+				app.Spec.Source = &argocd.ApplicationSource{
+					RepoURL: "https://github.com/universe/42",
+				}
+				app.Status.OperationState = &argocd.OperationState{
+					Phase: argocd.OperationSucceeded,
+					Operation: argocd.Operation{
+						InitiatedBy: argocd.OperationInitiator{
+							Username: applicationOperationInitiator,
+						},
+					},
+				}
+			},
+			newFreight: kargoapi.FreightReference{
+				Commits: []kargoapi.GitCommit{
+					{
+						RepoURL:           "https://github.com/universe/42",
+						HealthCheckCommit: "fake-revision",
+					},
+				},
+			},
+			assertions: func(t *testing.T, phase argocd.OperationPhase, mustUpdate bool, err error) {
+				require.ErrorContains(t, err, "operation completed without a sync result")
+				require.Empty(t, phase)
+				require.True(t, mustUpdate)
+			},
+		},
+		{
+			name: "desired revision does not match operation state",
+			modifyApplication: func(app *argocd.Application) {
+				app.Spec.Source = &argocd.ApplicationSource{
+					RepoURL: "https://github.com/universe/42",
+				}
+				app.Status.OperationState = &argocd.OperationState{
+					Phase: argocd.OperationSucceeded,
+					Operation: argocd.Operation{
+						InitiatedBy: argocd.OperationInitiator{
+							Username: applicationOperationInitiator,
+						},
+					},
+					SyncResult: &argocd.SyncOperationResult{
+						Revision: "other-fake-revision",
+					},
+				}
+			},
+			newFreight: kargoapi.FreightReference{
+				Commits: []kargoapi.GitCommit{
+					{
+						RepoURL: "https://github.com/universe/42",
+						ID:      "fake-revision",
+					},
+				},
+			},
+			assertions: func(t *testing.T, phase argocd.OperationPhase, mustUpdate bool, err error) {
+				require.ErrorContains(t, err, "does not match desired revision")
+				require.Empty(t, phase)
+				require.True(t, mustUpdate)
+			},
+		},
+		{
+			name: "operation completed",
+			modifyApplication: func(app *argocd.Application) {
+				app.Spec.Source = &argocd.ApplicationSource{
+					RepoURL: "https://github.com/universe/42",
+				}
+				app.Status.OperationState = &argocd.OperationState{
+					Phase: argocd.OperationSucceeded,
+					Operation: argocd.Operation{
+						InitiatedBy: argocd.OperationInitiator{
+							Username: applicationOperationInitiator,
+						},
+					},
+					SyncResult: &argocd.SyncOperationResult{
+						Revision: "fake-revision",
+					},
+				}
+			},
+			newFreight: kargoapi.FreightReference{
+				Commits: []kargoapi.GitCommit{
+					{
+						RepoURL: "https://github.com/universe/42",
+						ID:      "fake-revision",
+					},
+				},
+			},
+			assertions: func(t *testing.T, phase argocd.OperationPhase, mustUpdate bool, err error) {
+				require.NoError(t, err)
+				require.Equal(t, argocd.OperationSucceeded, phase)
+				require.False(t, mustUpdate)
+			},
+		},
+		{
+			name: "failed operation initiated by previous attempt",
+			modifyApplication: func(app *argocd.Application) {
+				app.Status.OperationState = &argocd.OperationState{
+					Phase: argocd.OperationFailed,
+					Operation: argocd.Operation{
+						InitiatedBy: argocd.OperationInitiator{
+							Username: applicationOperationInitiator,
+						},
+						Info: []*argocd.Info{
+							{Name: argoCDOperationPromotionInfo, Value: "fake-promotion"},
+							{Name: argoCDOperationAttemptInfo, Value: "1"},
+						},
+					},
+					SyncResult: &argocd.SyncOperationResult{},
+				}
+			},
+			promo: &kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{Name: "fake-promotion"},
+				Status:     kargoapi.PromotionStatus{Attempts: 2},
+			},
+			assertions: func(t *testing.T, phase argocd.OperationPhase, mustUpdate bool, err error) {
+				require.NoError(t, err)
+				require.Empty(t, phase)
+				require.True(t, mustUpdate)
+			},
+		},
+		{
+			name: "failed operation initiated by current attempt",
 			modifyApplication: func(app *argocd.Application) {
 				app.Status.OperationState = &argocd.OperationState{
-					Phase: argocd.OperationSucceeded,
+					Phase: argocd.OperationFailed,
 					Operation: argocd.Operation{
 						InitiatedBy: argocd.OperationInitiator{
 							Username: applicationOperationInitiator,
 						},
+						Info: []*argocd.Info{
+							{Name: argoCDOperationPromotionInfo, Value: "fake-promotion"},
+							{Name: argoCDOperationAttemptInfo, Value: "2"},
+						},
 					},
 					SyncResult: &argocd.SyncOperationResult{},
 				}
 			},
+			promo: &kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{Name: "fake-promotion"},
+				Status:     kargoapi.PromotionStatus{Attempts: 2},
+			},
 			assertions: func(t *testing.T, phase argocd.OperationPhase, mustUpdate bool, err error) {
 				require.NoError(t, err)
-				require.Equal(t, argocd.OperationSucceeded, phase)
+				require.Equal(t, argocd.OperationFailed, phase)
 				require.False(t, mustUpdate)
 			},
 		},
 		{
-			name: "no sync result",
+			name: "operation completed and Application is Synced and Healthy",
 			modifyApplication: func(app *argocd.Application) {
 				app.Spec.Source = &argocd.ApplicationSource{
 					RepoURL: "https://github.com/universe/42",
@@ -541,24 +1049,32 @@ func TestArgoCDMustPerformUpdate(t *testing.T) {
 							Username: applicationOperationInitiator,
 						},
 					},
+					SyncResult: &argocd.SyncOperationResult{
+						Revision: "fake-revision",
+					},
+					StartedAt: metav1.NewTime(time.Now().Add(-time.Minute)),
 				}
+				app.Status.Sync.Status = argocd.SyncStatusCodeSynced
+				app.Status.Health.Status = argocd.HealthStatusHealthy
 			},
+			timeout:        &metav1.Duration{Duration: time.Hour},
+			waitForHealthy: true,
 			newFreight: kargoapi.FreightReference{
 				Commits: []kargoapi.GitCommit{
 					{
-						RepoURL:           "https://github.com/universe/42",
-						HealthCheckCommit: "fake-revision",
+						RepoURL: "https://github.com/universe/42",
+						ID:      "fake-revision",
 					},
 				},
 			},
 			assertions: func(t *testing.T, phase argocd.OperationPhase, mustUpdate bool, err error) {
-				require.ErrorContains(t, err, "operation completed without a sync result")
-				require.Empty(t, phase)
-				require.True(t, mustUpdate)
+				require.NoError(t, err)
+				require.Equal(t, argocd.OperationSucceeded, phase)
+				require.False(t, mustUpdate)
 			},
 		},
 		{
-			name: "desired revision does not match operation state",
+			name: "operation completed and Application is still becoming Healthy",
 			modifyApplication: func(app *argocd.Application) {
 				app.Spec.Source = &argocd.ApplicationSource{
 					RepoURL: "https://github.com/universe/42",
@@ -571,10 +1087,15 @@ func TestArgoCDMustPerformUpdate(t *testing.T) {
 						},
 					},
 					SyncResult: &argocd.SyncOperationResult{
-						Revision: "other-fake-revision",
+						Revision: "fake-revision",
 					},
+					StartedAt: metav1.NewTime(time.Now().Add(-time.Minute)),
 				}
+				app.Status.Sync.Status = argocd.SyncStatusCodeSynced
+				app.Status.Health.Status = argocd.HealthStatusProgressing
 			},
+			timeout:        &metav1.Duration{Duration: time.Hour},
+			waitForHealthy: true,
 			newFreight: kargoapi.FreightReference{
 				Commits: []kargoapi.GitCommit{
 					{
@@ -584,13 +1105,13 @@ func TestArgoCDMustPerformUpdate(t *testing.T) {
 				},
 			},
 			assertions: func(t *testing.T, phase argocd.OperationPhase, mustUpdate bool, err error) {
-				require.ErrorContains(t, err, "does not match desired revision")
-				require.Empty(t, phase)
-				require.True(t, mustUpdate)
+				require.NoError(t, err)
+				require.Equal(t, argocd.OperationRunning, phase)
+				require.False(t, mustUpdate)
 			},
 		},
 		{
-			name: "operation completed",
+			name: "operation completed and Application did not become Healthy in time",
 			modifyApplication: func(app *argocd.Application) {
 				app.Spec.Source = &argocd.ApplicationSource{
 					RepoURL: "https://github.com/universe/42",
@@ -605,8 +1126,13 @@ func TestArgoCDMustPerformUpdate(t *testing.T) {
 					SyncResult: &argocd.SyncOperationResult{
 						Revision: "fake-revision",
 					},
+					StartedAt: metav1.NewTime(time.Now().Add(-time.Hour)),
 				}
+				app.Status.Sync.Status = argocd.SyncStatusCodeSynced
+				app.Status.Health.Status = argocd.HealthStatusDegraded
 			},
+			timeout:        &metav1.Duration{Duration: time.Minute},
+			waitForHealthy: true,
 			newFreight: kargoapi.FreightReference{
 				Commits: []kargoapi.GitCommit{
 					{
@@ -616,8 +1142,10 @@ func TestArgoCDMustPerformUpdate(t *testing.T) {
 				},
 			},
 			assertions: func(t *testing.T, phase argocd.OperationPhase, mustUpdate bool, err error) {
-				require.NoError(t, err)
-				require.Equal(t, argocd.OperationSucceeded, phase)
+				require.ErrorContains(t, err, "timed out after 1m0s waiting for Argo CD Application")
+				require.ErrorContains(t, err, "to become Synced and Healthy")
+				require.ErrorContains(t, err, `health status is "Degraded"`)
+				require.Equal(t, argocd.OperationFailed, phase)
 				require.False(t, mustUpdate)
 			},
 		},
@@ -644,15 +1172,22 @@ func TestArgoCDMustPerformUpdate(t *testing.T) {
 				WithInterceptorFuncs(testCase.interceptor).
 				Build()
 
-			mechanism := newArgoCDMechanism(c)
+			mechanism := newArgoCDMechanism(c, nil)
 			argocdMech, ok := mechanism.(*argoCDMechanism)
 			require.True(t, ok)
 
+			promo := testCase.promo
+			if promo == nil {
+				promo = &kargoapi.Promotion{}
+			}
 			phase, mustUpdate, err := argocdMech.mustPerformUpdate(
 				context.Background(),
+				promo,
 				kargoapi.ArgoCDAppUpdate{
-					AppName:      "fake-name",
-					AppNamespace: "fake-namespace",
+					AppName:        "fake-name",
+					AppNamespace:   "fake-namespace",
+					Timeout:        testCase.timeout,
+					WaitForHealthy: testCase.waitForHealthy,
 				},
 				testCase.newFreight,
 			)
@@ -885,6 +1420,114 @@ func TestArgoCDDoSingleUpdate(t *testing.T) {
 				require.NoError(t, err)
 			},
 		},
+		{
+			name: "self-healing configured without automated sync policy",
+			promoMech: &argoCDMechanism{
+				getArgoCDAppFn: func(
+					context.Context,
+					string,
+					string,
+				) (*argocd.Application, error) {
+					return &argocd.Application{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-name",
+							Namespace: "fake-namespace",
+							Annotations: map[string]string{
+								authorizedStageAnnotationKey: "fake-namespace:fake-name",
+							},
+						},
+					}, nil
+				},
+			},
+			stageMeta: metav1.ObjectMeta{
+				Name:      "fake-name",
+				Namespace: "fake-namespace",
+			},
+			update: kargoapi.ArgoCDAppUpdate{
+				Sync: &kargoapi.ArgoCDAppSync{SelfHeal: ptr.To(false)},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "it has no automated sync policy")
+			},
+		},
+		{
+			name: "success with sync settings",
+			promoMech: &argoCDMechanism{
+				getArgoCDAppFn: func(
+					context.Context,
+					string,
+					string,
+				) (*argocd.Application, error) {
+					return &argocd.Application{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-name",
+							Namespace: "fake-namespace",
+							Annotations: map[string]string{
+								authorizedStageAnnotationKey: "fake-namespace:fake-name",
+							},
+						},
+						Spec: argocd.ApplicationSpec{
+							SyncPolicy: &argocd.SyncPolicy{
+								Automated: &argocd.SyncPolicyAutomated{
+									SelfHeal: true,
+								},
+								SyncOptions: argocd.SyncOptions{
+									"CreateNamespace=true",
+									"PruneLast=false",
+								},
+							},
+						},
+					}, nil
+				},
+				argoCDAppPatchFn: func(
+					_ context.Context,
+					obj client.Object,
+					_ client.Patch,
+					_ ...client.PatchOption,
+				) error {
+					app, ok := obj.(*argocd.Application)
+					if !ok {
+						return errors.New("unexpected object")
+					}
+					if app.Spec.SyncPolicy.Automated.SelfHeal {
+						return errors.New("self-healing not disabled")
+					}
+					if !app.Operation.Sync.Prune {
+						return errors.New("pruning not enabled")
+					}
+					expectedOptions := argocd.SyncOptions{
+						"CreateNamespace=true",
+						"PruneLast=true",
+						"ServerSideApply=true",
+					}
+					if fmt.Sprint(app.Operation.Sync.SyncOptions) != fmt.Sprint(expectedOptions) {
+						return fmt.Errorf("unexpected sync options %v", app.Operation.Sync.SyncOptions)
+					}
+					if !isArgoCDOperationOfAttempt(*app.Operation, &kargoapi.Promotion{
+						ObjectMeta: metav1.ObjectMeta{Name: "fake-promotion"},
+						Status:     kargoapi.PromotionStatus{Attempts: 2},
+					}) {
+						return errors.New("operation not attributed to Promotion attempt")
+					}
+					return nil
+				},
+				logAppEventFn: func(context.Context, *argocd.Application, string, string, string) {},
+			},
+			stageMeta: metav1.ObjectMeta{
+				Name:      "fake-name",
+				Namespace: "fake-namespace",
+			},
+			update: kargoapi.ArgoCDAppUpdate{
+				Sync: &kargoapi.ArgoCDAppSync{
+					Prune:    true,
+					SelfHeal: ptr.To(false),
+					Options:  []string{"PruneLast=true", "ServerSideApply=true"},
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -893,6 +1536,10 @@ func TestArgoCDDoSingleUpdate(t *testing.T) {
 				testCase.promoMech.doSingleUpdate(
 					context.Background(),
 					testCase.stageMeta,
+					&kargoapi.Promotion{
+						ObjectMeta: metav1.ObjectMeta{Name: "fake-promotion"},
+						Status:     kargoapi.PromotionStatus{Attempts: 2},
+					},
 					testCase.update,
 					kargoapi.FreightReference{},
 				),
@@ -901,6 +1548,190 @@ func TestArgoCDDoSingleUpdate(t *testing.T) {
 	}
 }
 
+func TestMergeArgoCDSyncOptions(t *testing.T) {
+	testCases := []struct {
+		name      string
+		base      argocd.SyncOptions
+		overrides []string
+		expected  argocd.SyncOptions
+	}{
+		{
+			name:     "no overrides",
+			base:     argocd.SyncOptions{"CreateNamespace=true"},
+			expected: argocd.SyncOptions{"CreateNamespace=true"},
+		},
+		{
+			name:      "no base options",
+			overrides: []string{"PruneLast=true"},
+			expected:  argocd.SyncOptions{"PruneLast=true"},
+		},
+		{
+			name:      "overrides replace options with the same key",
+			base:      argocd.SyncOptions{"PruneLast=false", "CreateNamespace=true"},
+			overrides: []string{"PruneLast=true", "ServerSideApply=true"},
+			expected: argocd.SyncOptions{
+				"CreateNamespace=true",
+				"PruneLast=true",
+				"ServerSideApply=true",
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				mergeArgoCDSyncOptions(testCase.base, testCase.overrides),
+			)
+		})
+	}
+}
+
+func TestArgoCDPromoteDryRun(t *testing.T) {
+	promoMech := &argoCDMechanism{
+		argocdClient: fake.NewClientBuilder().Build(),
+		mustPerformUpdateFn: func(
+			context.Context,
+			*kargoapi.Promotion,
+			kargoapi.ArgoCDAppUpdate,
+			kargoapi.FreightReference,
+		) (argocd.OperationPhase, bool, error) {
+			require.Fail(t, "dry run must not check on Application operations")
+			return "", false, nil
+		},
+		doSingleUpdateFn: func(
+			context.Context,
+			metav1.ObjectMeta,
+			*kargoapi.Promotion,
+			kargoapi.ArgoCDAppUpdate,
+			kargoapi.FreightReference,
+		) error {
+			require.Fail(t, "dry run must not update Applications")
+			return nil
+		},
+		planSingleUpdateFn: func(
+			_ context.Context,
+			_ metav1.ObjectMeta,
+			update kargoapi.ArgoCDAppUpdate,
+			_ kargoapi.FreightReference,
+		) (string, error) {
+			return "planned " + update.AppName, nil
+		},
+	}
+	newStatus, _, err := promoMech.Promote(
+		context.Background(),
+		&kargoapi.Stage{
+			Spec: kargoapi.StageSpec{
+				PromotionMechanisms: &kargoapi.PromotionMechanisms{
+					ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+						{AppName: "app-1"},
+						{AppName: "app-2"},
+					},
+				},
+			},
+		},
+		&kargoapi.Promotion{
+			Spec: kargoapi.PromotionSpec{
+				DryRun: true,
+			},
+		},
+		kargoapi.FreightReference{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, kargoapi.PromotionPhaseSucceeded, newStatus.Phase)
+	require.Equal(t, []string{"planned app-1", "planned app-2"}, newStatus.PlannedChanges)
+}
+
+func TestArgoCDPlanSingleUpdate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		sourceFn   func(argocd.ApplicationSource) argocd.ApplicationSource
+		assertions func(*testing.T, string, error)
+	}{
+		{
+			name: "sources unchanged",
+			sourceFn: func(src argocd.ApplicationSource) argocd.ApplicationSource {
+				return src
+			},
+			assertions: func(t *testing.T, change string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					`sync Argo CD Application "fake-name" in namespace "fake-namespace" `+
+						"with no source changes",
+					change,
+				)
+			},
+		},
+		{
+			name: "sources changed",
+			sourceFn: func(src argocd.ApplicationSource) argocd.ApplicationSource {
+				src.TargetRevision = "new-revision"
+				return src
+			},
+			assertions: func(t *testing.T, change string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					`update source(s) of Argo CD Application "fake-name" in namespace `+
+						`"fake-namespace" and sync it`,
+					change,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			app := &argocd.Application{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "fake-name",
+					Namespace: "fake-namespace",
+					Annotations: map[string]string{
+						authorizedStageAnnotationKey: "fake-namespace:fake-name",
+					},
+				},
+				Spec: argocd.ApplicationSpec{
+					Source: &argocd.ApplicationSource{
+						RepoURL:        "fake-url",
+						TargetRevision: "old-revision",
+					},
+				},
+			}
+			promoMech := &argoCDMechanism{
+				getArgoCDAppFn: func(
+					context.Context,
+					string,
+					string,
+				) (*argocd.Application, error) {
+					return app, nil
+				},
+				applyArgoCDSourceUpdateFn: func(
+					src argocd.ApplicationSource,
+					_ kargoapi.FreightReference,
+					_ kargoapi.ArgoCDSourceUpdate,
+				) (argocd.ApplicationSource, error) {
+					return testCase.sourceFn(src), nil
+				},
+			}
+			change, err := promoMech.planSingleUpdate(
+				context.Background(),
+				metav1.ObjectMeta{
+					Name:      "fake-name",
+					Namespace: "fake-namespace",
+				},
+				kargoapi.ArgoCDAppUpdate{
+					AppName:       "fake-name",
+					SourceUpdates: []kargoapi.ArgoCDSourceUpdate{{RepoURL: "fake-url"}},
+				},
+				kargoapi.FreightReference{},
+			)
+			testCase.assertions(t, change, err)
+			// The Application itself must never be modified
+			require.Equal(t, "old-revision", app.Spec.Source.TargetRevision)
+		})
+	}
+}
+
 func TestLogAppEvent(t *testing.T) {
 	testCases := []struct {
 		name         string
@@ -1105,6 +1936,165 @@ func TestAuthorizeArgoCDAppUpdate(t *testing.T) {
 	}
 }
 
+func TestArgoCDApplySourceUpdatesWithRef(t *testing.T) {
+	newApp := func() *argocd.Application {
+		return &argocd.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "fake-app",
+				Namespace: "fake-namespace",
+			},
+			Spec: argocd.ApplicationSpec{
+				Sources: argocd.ApplicationSources{
+					{RepoURL: "https://github.com/fake-org/fake-repo.git", Ref: "values"},
+					{RepoURL: "https://github.com/fake-org/fake-repo.git", Ref: "manifests"},
+				},
+			},
+		}
+	}
+	freight := kargoapi.FreightReference{
+		Commits: []kargoapi.GitCommit{{
+			RepoURL: "https://github.com/fake-org/fake-repo.git",
+			ID:      "fake-commit",
+		}},
+	}
+	a := &argoCDMechanism{applyArgoCDSourceUpdateFn: applyArgoCDSourceUpdate}
+
+	t.Run("only the source with the ref is updated", func(t *testing.T) {
+		app := newApp()
+		err := a.applySourceUpdates(
+			app,
+			kargoapi.ArgoCDAppUpdate{
+				SourceUpdates: []kargoapi.ArgoCDSourceUpdate{{
+					RepoURL:              "https://github.com/fake-org/fake-repo.git",
+					Ref:                  "manifests",
+					UpdateTargetRevision: true,
+				}},
+			},
+			freight,
+		)
+		require.NoError(t, err)
+		require.Empty(t, app.Spec.Sources[0].TargetRevision)
+		require.Equal(t, "fake-commit", app.Spec.Sources[1].TargetRevision)
+	})
+
+	t.Run("without a ref, all matching sources are updated", func(t *testing.T) {
+		app := newApp()
+		err := a.applySourceUpdates(
+			app,
+			kargoapi.ArgoCDAppUpdate{
+				SourceUpdates: []kargoapi.ArgoCDSourceUpdate{{
+					RepoURL:              "https://github.com/fake-org/fake-repo.git",
+					UpdateTargetRevision: true,
+				}},
+			},
+			freight,
+		)
+		require.NoError(t, err)
+		require.Equal(t, "fake-commit", app.Spec.Sources[0].TargetRevision)
+		require.Equal(t, "fake-commit", app.Spec.Sources[1].TargetRevision)
+	})
+
+	t.Run("ref matches no source", func(t *testing.T) {
+		app := newApp()
+		err := a.applySourceUpdates(
+			app,
+			kargoapi.ArgoCDAppUpdate{
+				SourceUpdates: []kargoapi.ArgoCDSourceUpdate{{
+					RepoURL: "https://github.com/fake-org/fake-repo.git",
+					Ref:     "bogus",
+				}},
+			},
+			freight,
+		)
+		require.ErrorContains(t, err, "error selecting source of Argo CD Application")
+		require.ErrorContains(t, err, `no source has ref "bogus"`)
+		require.Equal(t, newApp(), app)
+	})
+}
+
+func TestSelectArgoCDSourceByRef(t *testing.T) {
+	testCases := []struct {
+		name       string
+		sources    argocd.ApplicationSources
+		update     kargoapi.ArgoCDSourceUpdate
+		assertions func(*testing.T, int, error)
+	}{
+		{
+			name: "no source has the ref",
+			sources: argocd.ApplicationSources{
+				{RepoURL: "fake-url", Ref: "other-ref"},
+			},
+			update: kargoapi.ArgoCDSourceUpdate{RepoURL: "fake-url", Ref: "fake-ref"},
+			assertions: func(t *testing.T, _ int, err error) {
+				require.ErrorContains(t, err, `no source has ref "fake-ref"`)
+			},
+		},
+		{
+			name: "more than one source has the ref",
+			sources: argocd.ApplicationSources{
+				{RepoURL: "fake-url", Ref: "fake-ref"},
+				{RepoURL: "fake-url", Ref: "other-ref"},
+				{RepoURL: "fake-url", Ref: "fake-ref"},
+			},
+			update: kargoapi.ArgoCDSourceUpdate{RepoURL: "fake-url", Ref: "fake-ref"},
+			assertions: func(t *testing.T, _ int, err error) {
+				require.ErrorContains(t, err, `sources 0 and 2 both have ref "fake-ref"`)
+			},
+		},
+		{
+			name: "source with the ref is for another repo",
+			sources: argocd.ApplicationSources{
+				{RepoURL: "other-url", Ref: "fake-ref"},
+			},
+			update: kargoapi.ArgoCDSourceUpdate{RepoURL: "fake-url", Ref: "fake-ref"},
+			assertions: func(t *testing.T, _ int, err error) {
+				require.ErrorContains(t, err, "does not match")
+			},
+		},
+		{
+			name: "source with the ref is for another chart",
+			sources: argocd.ApplicationSources{
+				{RepoURL: "fake-url", Chart: "other-chart", Ref: "fake-ref"},
+			},
+			update: kargoapi.ArgoCDSourceUpdate{
+				RepoURL: "oci://fake-url",
+				Chart:   "fake-chart",
+				Ref:     "fake-ref",
+			},
+			assertions: func(t *testing.T, _ int, err error) {
+				require.ErrorContains(t, err, "does not match")
+			},
+		},
+		{
+			name: "success",
+			sources: argocd.ApplicationSources{
+				{RepoURL: "fake-url", Chart: "fake-chart", Ref: "other-ref"},
+				{RepoURL: "fake-url", Chart: "fake-chart", Ref: "fake-ref"},
+			},
+			update: kargoapi.ArgoCDSourceUpdate{
+				RepoURL: "oci://fake-url",
+				Chart:   "fake-chart",
+				Ref:     "fake-ref",
+			},
+			assertions: func(t *testing.T, i int, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, i)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			i, err := selectArgoCDSourceByRef(
+				&argocd.Application{
+					Spec: argocd.ApplicationSpec{Sources: testCase.sources},
+				},
+				testCase.update,
+			)
+			testCase.assertions(t, i, err)
+		})
+	}
+}
+
 func TestApplyArgoCDSourceUpdate(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -1379,7 +2369,8 @@ func TestBuildKustomizeImagesForArgoCDAppSource(t *testing.T) {
 		},
 		{Image: "image-that-is-not-in-list"},
 	}
-	result := buildKustomizeImagesForArgoCDAppSource(images, imageUpdates)
+	result, err := buildKustomizeImagesForArgoCDAppSource(images, imageUpdates)
+	require.NoError(t, err)
 	require.Equal(
 		t,
 		argocd.KustomizeImages{
@@ -1388,6 +2379,12 @@ func TestBuildKustomizeImagesForArgoCDAppSource(t *testing.T) {
 		},
 		result,
 	)
+
+	_, err = buildKustomizeImagesForArgoCDAppSource(
+		[]kargoapi.Image{{RepoURL: "fake-url", Tag: "fake-tag"}},
+		[]kargoapi.ArgoCDKustomizeImageUpdate{{Image: "fake-url", UseDigest: true}},
+	)
+	require.ErrorContains(t, err, "does not specify a digest")
 }
 
 func TestBuildHelmParamChangesForArgoCDAppSource(t *testing.T) {
@@ -1440,7 +2437,8 @@ func TestBuildHelmParamChangesForArgoCDAppSource(t *testing.T) {
 			Value: "Tag",
 		},
 	}
-	result := buildHelmParamChangesForArgoCDAppSource(images, imageUpdates)
+	result, err := buildHelmParamChangesForArgoCDAppSource(images, imageUpdates)
+	require.NoError(t, err)
 	require.Equal(
 		t,
 		map[string]string{
@@ -1452,3 +2450,19 @@ func TestBuildHelmParamChangesForArgoCDAppSource(t *testing.T) {
 		result,
 	)
 }
+
+func TestBuildHelmParamChangesForArgoCDAppSourceWithoutDigest(t *testing.T) {
+	_, err := buildHelmParamChangesForArgoCDAppSource(
+		[]kargoapi.Image{{
+			RepoURL: "fake-url",
+			Tag:     "fake-tag",
+		}},
+		[]kargoapi.ArgoCDHelmImageUpdate{{
+			Image: "fake-url",
+			Key:   "fake-key",
+			Value: kargoapi.ImageUpdateValueTypeImageAndDigest,
+		}},
+	)
+	require.ErrorContains(t, err, `cannot update image "fake-url"`)
+	require.ErrorContains(t, err, "does not specify a digest")
+}
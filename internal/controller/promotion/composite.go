@@ -61,7 +61,12 @@ func (c *compositeMechanism) Promote(
 		var otherStatus *kargoapi.PromotionStatus
 		otherStatus, newFreight, err = childMechanism.Promote(ctx, stage, promo, newFreight)
 		if err != nil {
-			return nil, newFreight, fmt.Errorf(
+			// Hang on to whatever results the child mechanism recorded before it
+			// errored so that the caller can report which update was at fault.
+			if otherStatus != nil {
+				newStatus = aggregateGitPromoStatus(newStatus, *otherStatus)
+			}
+			return newStatus, newFreight, fmt.Errorf(
 				"error executing %s: %w",
 				childMechanism.GetName(),
 				err,
@@ -105,6 +110,8 @@ func aggregateGitPromoStatus(curr *kargoapi.PromotionStatus, other kargoapi.Prom
 		newStatus.Phase = kargoapi.PromotionPhaseSucceeded
 		newStatus.Message = firstNonEmpty(curr.Message, other.Message)
 	}
+	newStatus.PlannedChanges = append(newStatus.PlannedChanges, other.PlannedChanges...)
+	newStatus.Mechanisms = append(newStatus.Mechanisms, other.Mechanisms...)
 	// Merge the two metadata maps
 	if len(other.Metadata) > 0 {
 		if newStatus.Metadata == nil {
@@ -79,6 +79,76 @@ func TestCompositePromote(t *testing.T) {
 				require.ErrorContains(t, err, "something went wrong")
 			},
 		},
+		{
+			name: "error executing child promotion mechanism after another succeeded",
+			promoMech: &compositeMechanism{
+				childMechanisms: []Mechanism{
+					&FakeMechanism{
+						Name: "fake promotion mechanism",
+						PromoteFn: func(
+							_ context.Context,
+							_ *kargoapi.Stage,
+							newFreight kargoapi.FreightReference,
+						) (*kargoapi.PromotionStatus, kargoapi.FreightReference, error) {
+							return &kargoapi.PromotionStatus{
+								Phase: kargoapi.PromotionPhaseSucceeded,
+								Mechanisms: []kargoapi.MechanismResult{{
+									Type:   kargoapi.MechanismTypeJob,
+									Target: "fake-job",
+									Phase:  kargoapi.PromotionPhaseSucceeded,
+								}},
+							}, newFreight, nil
+						},
+					},
+					&FakeMechanism{
+						Name: "another fake promotion mechanism",
+						PromoteFn: func(
+							_ context.Context,
+							_ *kargoapi.Stage,
+							newFreight kargoapi.FreightReference,
+						) (*kargoapi.PromotionStatus, kargoapi.FreightReference, error) {
+							return &kargoapi.PromotionStatus{
+								Phase: kargoapi.PromotionPhaseErrored,
+								Mechanisms: []kargoapi.MechanismResult{{
+									Type:    kargoapi.MechanismTypeArgoCDAppUpdate,
+									Target:  "argocd/fake-app",
+									Phase:   kargoapi.PromotionPhaseErrored,
+									Message: "something went wrong",
+								}},
+							}, newFreight, errors.New("something went wrong")
+						},
+					},
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				promoStatus *kargoapi.PromotionStatus,
+				_ kargoapi.FreightReference,
+				_ kargoapi.FreightReference,
+				err error,
+			) {
+				require.ErrorContains(t, err, "error executing another fake promotion mechanism")
+				require.NotNil(t, promoStatus)
+				require.Equal(t, kargoapi.PromotionPhaseErrored, promoStatus.Phase)
+				require.Equal(
+					t,
+					[]kargoapi.MechanismResult{
+						{
+							Type:   kargoapi.MechanismTypeJob,
+							Target: "fake-job",
+							Phase:  kargoapi.PromotionPhaseSucceeded,
+						},
+						{
+							Type:    kargoapi.MechanismTypeArgoCDAppUpdate,
+							Target:  "argocd/fake-app",
+							Phase:   kargoapi.PromotionPhaseErrored,
+							Message: "something went wrong",
+						},
+					},
+					promoStatus.Mechanisms,
+				)
+			},
+		},
 		{
 			name: "success",
 			promoMech: &compositeMechanism{
@@ -25,7 +25,8 @@ func selectGenericGitUpdates(updates []kargoapi.GitRepoUpdate) []kargoapi.GitRep
 	for _, update := range updates {
 		if update.Kustomize == nil &&
 			update.Helm == nil &&
-			update.Render == nil {
+			update.Render == nil &&
+			update.YAML == nil {
 			selectedUpdates = append(selectedUpdates, update)
 		}
 	}
@@ -52,6 +52,10 @@ func TestSelectGenericGitUpdates(t *testing.T) {
 					RepoURL: "fake-url",
 					Helm:    &kargoapi.HelmPromotionMechanism{},
 				},
+				{
+					RepoURL: "fake-url",
+					YAML:    &kargoapi.YAMLPromotionMechanism{},
+				},
 				{
 					RepoURL: "fake-url",
 				},
@@ -1,11 +1,13 @@
 package promotion
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
+	"text/template"
 
 	"github.com/kelseyhightower/envconfig"
 
@@ -56,6 +58,8 @@ type gitMechanism struct {
 		repoURL string,
 	) (*git.RepoCredentials, error)
 	gitCommitFn func(
+		ctx context.Context,
+		promo *kargoapi.Promotion,
 		update kargoapi.GitRepoUpdate,
 		newFreight kargoapi.FreightReference,
 		readRef string,
@@ -63,6 +67,13 @@ type gitMechanism struct {
 		repo git.Repo,
 		repoCreds git.RepoCredentials,
 	) (string, error)
+	gitPlanFn func(
+		update kargoapi.GitRepoUpdate,
+		newFreight kargoapi.FreightReference,
+		readRef string,
+		repo git.Repo,
+		repoCreds git.RepoCredentials,
+	) ([]string, error)
 	applyConfigManagementFn func(
 		update kargoapi.GitRepoUpdate,
 		newFreight kargoapi.FreightReference,
@@ -100,6 +111,7 @@ func newGitMechanism(
 	g.getCredentialsFn = getRepoCredentialsFn(credentialsDB)
 	g.getAuthorFn = g.getAuthor
 	g.gitCommitFn = g.gitCommit
+	g.gitPlanFn = g.applyUpdate
 	g.applyConfigManagementFn = applyConfigManagementFn
 	return g
 }
@@ -116,7 +128,10 @@ func (g *gitMechanism) Promote(
 	promo *kargoapi.Promotion,
 	newFreight kargoapi.FreightReference,
 ) (*kargoapi.PromotionStatus, kargoapi.FreightReference, error) {
-	updates := g.selectUpdatesFn(stage.Spec.PromotionMechanisms.GitRepoUpdates)
+	var updates []kargoapi.GitRepoUpdate
+	if stage.Spec.PromotionMechanisms != nil {
+		updates = g.selectUpdatesFn(stage.Spec.PromotionMechanisms.GitRepoUpdates)
+	}
 
 	if len(updates) == 0 {
 		return &kargoapi.PromotionStatus{Phase: kargoapi.PromotionPhaseSucceeded}, newFreight, nil
@@ -131,13 +146,28 @@ func (g *gitMechanism) Promote(
 	for _, update := range updates {
 		var err error
 		var otherStatus *kargoapi.PromotionStatus
-		if otherStatus, newFreight, err = g.doSingleUpdateFn(
+		if otherStatus, newFreight, err = g.doSingleUpdateWithTimeout(
 			ctx,
 			promo,
 			update,
 			newFreight,
 		); err != nil {
-			return nil, newFreight, err
+			newStatus = aggregateGitPromoStatus(
+				newStatus,
+				kargoapi.PromotionStatus{
+					Phase: kargoapi.PromotionPhaseErrored,
+					Mechanisms: []kargoapi.MechanismResult{
+						gitUpdateResult(update, kargoapi.PromotionPhaseErrored, err.Error()),
+					},
+				},
+			)
+			return newStatus, newFreight, err
+		}
+		if !promo.Spec.DryRun {
+			otherStatus.Mechanisms = append(
+				otherStatus.Mechanisms,
+				gitUpdateResult(update, otherStatus.Phase, otherStatus.Message),
+			)
 		}
 		newStatus = aggregateGitPromoStatus(newStatus, *otherStatus)
 	}
@@ -147,6 +177,74 @@ func (g *gitMechanism) Promote(
 	return newStatus, newFreight, nil
 }
 
+// gitUpdateResult returns a MechanismResult describing the outcome of the
+// provided update. The message is only recorded if the update failed or
+// errored.
+func gitUpdateResult(
+	update kargoapi.GitRepoUpdate,
+	phase kargoapi.PromotionPhase,
+	message string,
+) kargoapi.MechanismResult {
+	target := update.RepoURL
+	if update.WriteBranch != "" {
+		target = fmt.Sprintf("%s@%s", update.RepoURL, update.WriteBranch)
+	}
+	res := kargoapi.MechanismResult{
+		Type:   kargoapi.MechanismTypeGitRepoUpdate,
+		Target: target,
+		Phase:  phase,
+	}
+	if phase == kargoapi.PromotionPhaseFailed || phase == kargoapi.PromotionPhaseErrored {
+		res.Message = message
+	}
+	return res
+}
+
+// doSingleUpdateWithTimeout carries out a single update, enforcing the
+// update's timeout, if one is specified. Because the underlying Git operations
+// are not context-aware, an update that exceeds its timeout is abandoned rather
+// than interrupted. The abandoned update checks for the timeout before each
+// step with a remote side effect -- committing and pushing, and opening a pull
+// request -- and stops short of it. Any results it produces after the fact are
+// discarded.
+func (g *gitMechanism) doSingleUpdateWithTimeout(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+	update kargoapi.GitRepoUpdate,
+	newFreight kargoapi.FreightReference,
+) (*kargoapi.PromotionStatus, kargoapi.FreightReference, error) {
+	if update.Timeout == nil || update.Timeout.Duration <= 0 {
+		return g.doSingleUpdateFn(ctx, promo, update, newFreight)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, update.Timeout.Duration)
+	defer cancel()
+
+	type result struct {
+		status  *kargoapi.PromotionStatus
+		freight kargoapi.FreightReference
+		err     error
+	}
+	resCh := make(chan result, 1)
+	go func(freight kargoapi.FreightReference) {
+		var res result
+		res.status, res.freight, res.err = g.doSingleUpdateFn(ctx, promo, update, freight)
+		resCh <- res
+	}(*newFreight.DeepCopy())
+
+	select {
+	case res := <-resCh:
+		return res.status, res.freight, res.err
+	case <-ctx.Done():
+		return nil, newFreight, fmt.Errorf(
+			"timed out after %s updating git repo %q: %w",
+			update.Timeout.Duration,
+			update.RepoURL,
+			ctx.Err(),
+		)
+	}
+}
+
 // doSingleUpdate updates configuration in a single Git repository by
 // making a git commit with the changes. If performing a pull request
 // promotion, will create a with PR for the git commit instead of
@@ -180,6 +278,9 @@ func (g *gitMechanism) doSingleUpdate(
 	if creds == nil {
 		creds = &git.RepoCredentials{}
 	}
+	if err = configureCommitSigning(update, author, *creds); err != nil {
+		return nil, newFreight, err
+	}
 	repo, err := git.Clone(
 		update.RepoURL,
 		&git.ClientOptions{
@@ -195,6 +296,19 @@ func (g *gitMechanism) doSingleUpdate(
 	}
 	defer repo.Close()
 
+	if promo.Spec.DryRun {
+		changes, err := g.gitPlanFn(update, newFreight, readRef, repo, *creds)
+		if err != nil {
+			return nil, newFreight, err
+		}
+		newStatus := promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded)
+		newStatus.PlannedChanges = append(
+			newStatus.PlannedChanges,
+			describeGitUpdate(update, changes),
+		)
+		return newStatus, newFreight, nil
+	}
+
 	commitBranch := update.WriteBranch
 	if update.PullRequest != nil {
 		// When doing a PR promotion, instead of committing to writeBranch directly,
@@ -210,6 +324,8 @@ func (g *gitMechanism) doSingleUpdate(
 	}
 
 	commitID, err := g.gitCommitFn(
+		ctx,
+		promo,
 		update,
 		newFreight,
 		readRef,
@@ -223,11 +339,31 @@ func (g *gitMechanism) doSingleUpdate(
 
 	newStatus := promo.Status.DeepCopy()
 	if update.PullRequest != nil {
+		if err = ctx.Err(); err != nil {
+			return nil, newFreight, fmt.Errorf(
+				"abandoned update of git repo %q before reconciling pull request: %w",
+				update.RepoURL,
+				err,
+			)
+		}
 		gpClient, err := newGitProvider(update.RepoURL, update.PullRequest, creds)
 		if err != nil {
 			return nil, newFreight, err
 		}
-		commitID, newStatus, err = reconcilePullRequest(ctx, promo.Status, repo, gpClient, commitBranch, update.WriteBranch)
+		commitID, newStatus, err = reconcilePullRequest(
+			ctx,
+			promo.Status,
+			repo,
+			gpClient,
+			update.PullRequest,
+			pullRequestTemplateData{
+				Project: promo.Namespace,
+				Stage:   promo.Spec.Stage,
+				Freight: newFreight,
+			},
+			commitBranch,
+			update.WriteBranch,
+		)
 		if err != nil {
 			return nil, newFreight, err
 		}
@@ -302,11 +438,13 @@ func getRepoCredentialsFn(
 			logger.Debug("found no credentials for git repo")
 			return nil, nil
 		}
-		logger.Debug("obtained credentials for git repo")
+		logger.WithField("credentialsSource", creds.Source).
+			Debug("obtained credentials for git repo")
 		return &git.RepoCredentials{
 			Username:      creds.Username,
 			Password:      creds.Password,
 			SSHPrivateKey: creds.SSHPrivateKey,
+			SigningKey:    creds.SigningKey,
 		}, nil
 	}
 }
@@ -341,12 +479,38 @@ func (g *gitMechanism) getAuthor() (*git.User, error) {
 	return &author, nil
 }
 
+// configureCommitSigning configures the provided author to GPG-sign commits
+// using the signing key from the provided credentials if the provided update
+// calls for signed commits. If it does not, the author is left unaltered, so
+// any signing key configured for the controller as a whole still applies.
+func configureCommitSigning(
+	update kargoapi.GitRepoUpdate,
+	author *git.User,
+	creds git.RepoCredentials,
+) error {
+	if !update.SignCommits {
+		return nil
+	}
+	if creds.SigningKey == "" {
+		return fmt.Errorf(
+			"commit signing was requested for git repo %q, but no signing key "+
+				"was found in the credentials for that repository",
+			update.RepoURL,
+		)
+	}
+	author.SigningKeyType = git.SigningKeyTypeGPG
+	author.SigningKey = creds.SigningKey
+	return nil
+}
+
 // gitCommit checks out the specified readRef (if non-empty), applies
 // the provided update function to the cloned repository, and then commits and
 // pushes any changes to the specified writeBranch. The function returns the
 // commit ID of the last commit made to the repository, or an error if any of
 // the above fails.
 func (g *gitMechanism) gitCommit(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
 	update kargoapi.GitRepoUpdate,
 	newFreight kargoapi.FreightReference,
 	readRef string,
@@ -354,34 +518,22 @@ func (g *gitMechanism) gitCommit(
 	repo git.Repo,
 	repoCreds git.RepoCredentials,
 ) (string, error) {
-	var err error
-	// If readRef is non-empty, check out the specified commit or branch,
-	// otherwise just move using the repository's default branch as the source.
-	if readRef != "" {
-		if err = repo.Checkout(readRef); err != nil {
-			return "", fmt.Errorf("error checking out %q from git repo: %w", readRef, err)
-		}
-	}
-
-	sourceCommitID, err := repo.LastCommitID()
+	changes, err := g.applyUpdate(update, newFreight, readRef, repo, repoCreds)
 	if err != nil {
-		return "", err // TODO: Wrap this
-	}
-
-	var changes []string
-	if g.applyConfigManagementFn != nil {
-		if changes, err = g.applyConfigManagementFn(
-			update,
-			newFreight,
-			sourceCommitID,
-			repo.HomeDir(),
-			repo.WorkingDir(),
-			repoCreds,
-		); err != nil {
-			return "", err
-		}
+		return "", err
+	}
+	commitMsg, err := renderCommitMessage(
+		update.CommitMessageTemplate,
+		commitMessageTemplateData{
+			Project: promo.Namespace,
+			Stage:   promo.Spec.Stage,
+			Freight: newFreight,
+			Changes: changes,
+		},
+	)
+	if err != nil {
+		return "", err
 	}
-	commitMsg := buildCommitMessage(changes)
 
 	// Sometimes we don't write to the same branch we read from...
 	if readRef != writeBranch {
@@ -443,6 +595,15 @@ func (g *gitMechanism) gitCommit(
 	}
 
 	if hasDiffs {
+		// Applying the update may have taken long enough for the update to
+		// have been abandoned, in which case nothing must be pushed.
+		if err = ctx.Err(); err != nil {
+			return "", fmt.Errorf(
+				"abandoned update of git repo %q before committing: %w",
+				update.RepoURL,
+				err,
+			)
+		}
 		if err = repo.AddAllAndCommit(commitMsg); err != nil {
 			return "", fmt.Errorf("error committing updates to git repo %q: %w", update.RepoURL, err)
 		}
@@ -459,6 +620,55 @@ func (g *gitMechanism) gitCommit(
 	return commitID, nil
 }
 
+// applyUpdate checks out the specified readRef (if non-empty) and applies the
+// provided update to the working tree of the cloned repository without
+// committing anything. It returns a summary of the changes that were made.
+func (g *gitMechanism) applyUpdate(
+	update kargoapi.GitRepoUpdate,
+	newFreight kargoapi.FreightReference,
+	readRef string,
+	repo git.Repo,
+	repoCreds git.RepoCredentials,
+) ([]string, error) {
+	// If readRef is non-empty, check out the specified commit or branch,
+	// otherwise just move using the repository's default branch as the source.
+	if readRef != "" {
+		if err := repo.Checkout(readRef); err != nil {
+			return nil, fmt.Errorf("error checking out %q from git repo: %w", readRef, err)
+		}
+	}
+
+	sourceCommitID, err := repo.LastCommitID()
+	if err != nil {
+		return nil, err // TODO: Wrap this
+	}
+
+	if g.applyConfigManagementFn == nil {
+		return nil, nil
+	}
+	return g.applyConfigManagementFn(
+		update,
+		newFreight,
+		sourceCommitID,
+		repo.HomeDir(),
+		repo.WorkingDir(),
+		repoCreds,
+	)
+}
+
+// describeGitUpdate returns a human-readable description of the provided
+// update and the summary of the changes it would make.
+func describeGitUpdate(update kargoapi.GitRepoUpdate, changes []string) string {
+	desc := fmt.Sprintf("update branch %q of git repo %q", update.WriteBranch, update.RepoURL)
+	if update.PullRequest != nil {
+		desc += " via pull request"
+	}
+	if len(changes) > 0 {
+		desc += ": " + strings.Join(changes, "; ")
+	}
+	return desc
+}
+
 // moveRepoContents transplants the entire contents of the source directory
 // EXCEPT for the .git subdirectory into the destination directory.
 func moveRepoContents(srcDir, destDir string) error {
@@ -515,3 +725,41 @@ func buildCommitMessage(changeSummary []string) string {
 	}
 	return msg
 }
+
+// commitMessageTemplateData is the data available to the template used to
+// render the message of a commit made by a GitRepoUpdate.
+type commitMessageTemplateData struct {
+	Project string
+	Stage   string
+	Freight kargoapi.FreightReference
+	// Changes summarizes the changes that were applied to the repository.
+	Changes []string
+}
+
+// renderCommitMessage renders the provided commit message template using the
+// provided data. If the template is empty, the message built by
+// buildCommitMessage is returned instead.
+func renderCommitMessage(
+	tmpl string,
+	data commitMessageTemplateData,
+) (string, error) {
+	if tmpl == "" {
+		return buildCommitMessage(data.Changes), nil
+	}
+	return renderTemplate("commit message", tmpl, data)
+}
+
+// renderTemplate parses the provided Go text/template and executes it using
+// the provided data. The name is used only to identify the template in error
+// messages.
+func renderTemplate(name, text string, data any) (string, error) {
+	tmpl, err := template.New(name).Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("error parsing %s template: %w", name, err)
+	}
+	buf := &bytes.Buffer{}
+	if err = tmpl.Execute(buf, data); err != nil {
+		return "", fmt.Errorf("error rendering %s template: %w", name, err)
+	}
+	return buf.String(), nil
+}
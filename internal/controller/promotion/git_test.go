@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -108,6 +109,44 @@ func TestGitPromote(t *testing.T) {
 				require.Equal(t, newFreightIn, newFreightOut)
 			},
 		},
+		{
+			name: "single update timed out",
+			promoMech: &gitMechanism{
+				selectUpdatesFn: func([]kargoapi.GitRepoUpdate) []kargoapi.GitRepoUpdate {
+					return []kargoapi.GitRepoUpdate{{
+						RepoURL: "fake-url",
+						Timeout: &metav1.Duration{Duration: time.Millisecond},
+					}}
+				},
+				doSingleUpdateFn: func(
+					ctx context.Context,
+					_ *kargoapi.Promotion,
+					_ kargoapi.GitRepoUpdate,
+					newFreight kargoapi.FreightReference,
+				) (*kargoapi.PromotionStatus, kargoapi.FreightReference, error) {
+					<-ctx.Done()
+					time.Sleep(10 * time.Millisecond)
+					return &kargoapi.PromotionStatus{Phase: kargoapi.PromotionPhaseSucceeded}, newFreight, nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				status *kargoapi.PromotionStatus,
+				newFreightIn kargoapi.FreightReference,
+				newFreightOut kargoapi.FreightReference,
+				err error,
+			) {
+				require.ErrorContains(t, err, "timed out after 1ms updating git repo \"fake-url\"")
+				require.ErrorIs(t, err, context.DeadlineExceeded)
+				require.Equal(t, kargoapi.PromotionPhaseErrored, status.Phase)
+				require.Len(t, status.Mechanisms, 1)
+				require.Equal(t, kargoapi.MechanismTypeGitRepoUpdate, status.Mechanisms[0].Type)
+				require.Equal(t, "fake-url", status.Mechanisms[0].Target)
+				require.Equal(t, kargoapi.PromotionPhaseErrored, status.Mechanisms[0].Phase)
+				require.Contains(t, status.Mechanisms[0].Message, "timed out after 1ms")
+				require.Equal(t, newFreightIn, newFreightOut)
+			},
+		},
 		{
 			name: "success",
 			promoMech: &gitMechanism{
@@ -134,6 +173,71 @@ func TestGitPromote(t *testing.T) {
 				require.Equal(t, newFreightIn, newFreightOut)
 			},
 		},
+		{
+			name: "multiple updates across repos",
+			promoMech: &gitMechanism{
+				selectUpdatesFn: func([]kargoapi.GitRepoUpdate) []kargoapi.GitRepoUpdate {
+					return []kargoapi.GitRepoUpdate{
+						{RepoURL: "fake-url-1", WriteBranch: "main"},
+						{RepoURL: "fake-url-2"},
+					}
+				},
+				doSingleUpdateFn: func(
+					_ context.Context,
+					_ *kargoapi.Promotion,
+					update kargoapi.GitRepoUpdate,
+					newFreight kargoapi.FreightReference,
+				) (*kargoapi.PromotionStatus, kargoapi.FreightReference, error) {
+					// Each update should see the Freight as modified by the
+					// previous update.
+					newFreight.Commits = append(newFreight.Commits, kargoapi.GitCommit{
+						RepoURL:           update.RepoURL,
+						HealthCheckCommit: fmt.Sprintf("%s-commit", update.RepoURL),
+					})
+					return &kargoapi.PromotionStatus{Phase: kargoapi.PromotionPhaseSucceeded}, newFreight, nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				status *kargoapi.PromotionStatus,
+				_ kargoapi.FreightReference,
+				newFreightOut kargoapi.FreightReference,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+				require.Equal(
+					t,
+					[]kargoapi.MechanismResult{
+						{
+							Type:   kargoapi.MechanismTypeGitRepoUpdate,
+							Target: "fake-url-1@main",
+							Phase:  kargoapi.PromotionPhaseSucceeded,
+						},
+						{
+							Type:   kargoapi.MechanismTypeGitRepoUpdate,
+							Target: "fake-url-2",
+							Phase:  kargoapi.PromotionPhaseSucceeded,
+						},
+					},
+					status.Mechanisms,
+				)
+				require.Equal(
+					t,
+					[]kargoapi.GitCommit{
+						{
+							RepoURL:           "fake-url-1",
+							HealthCheckCommit: "fake-url-1-commit",
+						},
+						{
+							RepoURL:           "fake-url-2",
+							HealthCheckCommit: "fake-url-2-commit",
+						},
+					},
+					newFreightOut.Commits,
+				)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -153,6 +257,25 @@ func TestGitPromote(t *testing.T) {
 	}
 }
 
+func TestGitPromoteWithoutPromotionMechanisms(t *testing.T) {
+	promoMech := &gitMechanism{
+		selectUpdatesFn: func([]kargoapi.GitRepoUpdate) []kargoapi.GitRepoUpdate {
+			require.FailNow(t, "no updates should have been selected")
+			return nil
+		},
+	}
+	newFreightIn := kargoapi.FreightReference{Name: "fake-freight"}
+	status, newFreightOut, err := promoMech.Promote(
+		context.Background(),
+		&kargoapi.Stage{},
+		&kargoapi.Promotion{},
+		newFreightIn,
+	)
+	require.NoError(t, err)
+	require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+	require.Equal(t, newFreightIn, newFreightOut)
+}
+
 func TestGitDoSingleUpdate(t *testing.T) {
 	const testRef = "fake-ref"
 	testCases := []struct {
@@ -272,6 +395,8 @@ func TestGitDoSingleUpdate(t *testing.T) {
 					return nil, nil
 				},
 				gitCommitFn: func(
+					context.Context,
+					*kargoapi.Promotion,
 					kargoapi.GitRepoUpdate,
 					kargoapi.FreightReference,
 					string,
@@ -314,6 +439,8 @@ func TestGitDoSingleUpdate(t *testing.T) {
 					return nil, nil
 				},
 				gitCommitFn: func(
+					context.Context,
+					*kargoapi.Promotion,
 					kargoapi.GitRepoUpdate,
 					kargoapi.FreightReference,
 					string,
@@ -361,6 +488,78 @@ func TestGitDoSingleUpdate(t *testing.T) {
 	}
 }
 
+// fakeGitRepo is a git.Repo whose working tree always has changes. It records
+// whether those were committed or pushed. Methods not used by gitCommit are
+// not implemented.
+type fakeGitRepo struct {
+	git.Repo
+	committed bool
+	pushed    bool
+}
+
+func (*fakeGitRepo) LastCommitID() (string, error) { return "fake-commit-id", nil }
+func (*fakeGitRepo) HomeDir() string               { return "" }
+func (*fakeGitRepo) WorkingDir() string            { return "" }
+func (*fakeGitRepo) HasDiffs() (bool, error)       { return true, nil }
+
+func (r *fakeGitRepo) AddAllAndCommit(string) error {
+	r.committed = true
+	return nil
+}
+
+func (r *fakeGitRepo) Push(bool) error {
+	r.pushed = true
+	return nil
+}
+
+func TestGitDoSingleUpdateWithTimeoutDoesNotPushAfterTimeout(t *testing.T) {
+	repo := &fakeGitRepo{}
+	timedOut := make(chan struct{})
+	done := make(chan error, 1)
+	g := &gitMechanism{
+		applyConfigManagementFn: func(
+			kargoapi.GitRepoUpdate,
+			kargoapi.FreightReference,
+			string,
+			string,
+			string,
+			git.RepoCredentials,
+		) ([]string, error) {
+			// Keep working until the update has been abandoned
+			<-timedOut
+			return nil, nil
+		},
+	}
+	g.gitCommitFn = g.gitCommit
+	g.doSingleUpdateFn = func(
+		ctx context.Context,
+		promo *kargoapi.Promotion,
+		update kargoapi.GitRepoUpdate,
+		newFreight kargoapi.FreightReference,
+	) (*kargoapi.PromotionStatus, kargoapi.FreightReference, error) {
+		_, err := g.gitCommitFn(ctx, promo, update, newFreight, "", "", repo, git.RepoCredentials{})
+		done <- err
+		return nil, newFreight, err
+	}
+
+	_, _, err := g.doSingleUpdateWithTimeout(
+		context.Background(),
+		&kargoapi.Promotion{},
+		kargoapi.GitRepoUpdate{
+			RepoURL: "fake-url",
+			Timeout: &metav1.Duration{Duration: time.Millisecond},
+		},
+		kargoapi.FreightReference{},
+	)
+	require.ErrorContains(t, err, "timed out after 1ms")
+	close(timedOut)
+
+	// The abandoned update must stop short of committing and pushing
+	require.ErrorContains(t, <-done, "abandoned update of git repo \"fake-url\" before committing")
+	require.False(t, repo.committed)
+	require.False(t, repo.pushed)
+}
+
 func TestGetReadRef(t *testing.T) {
 	const testBranch = "fake-branch"
 	testCases := []struct {
@@ -505,6 +704,66 @@ func TestGetRepoCredentials(t *testing.T) {
 	}
 }
 
+func TestConfigureCommitSigning(t *testing.T) {
+	testCases := []struct {
+		name       string
+		update     kargoapi.GitRepoUpdate
+		creds      git.RepoCredentials
+		assertions func(*testing.T, *git.User, error)
+	}{
+		{
+			name:   "signing not requested",
+			update: kargoapi.GitRepoUpdate{},
+			creds: git.RepoCredentials{
+				SigningKey: "fake-signing-key",
+			},
+			assertions: func(t *testing.T, author *git.User, err error) {
+				require.NoError(t, err)
+				require.Equal(t, &git.User{Name: "fake-name"}, author)
+			},
+		},
+		{
+			name: "signing requested, but no key found",
+			update: kargoapi.GitRepoUpdate{
+				RepoURL:     "fake-url",
+				SignCommits: true,
+			},
+			assertions: func(t *testing.T, _ *git.User, err error) {
+				require.ErrorContains(t, err, "no signing key was found")
+				require.ErrorContains(t, err, "fake-url")
+			},
+		},
+		{
+			name: "signing requested",
+			update: kargoapi.GitRepoUpdate{
+				SignCommits: true,
+			},
+			creds: git.RepoCredentials{
+				SigningKey: "fake-signing-key",
+			},
+			assertions: func(t *testing.T, author *git.User, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					&git.User{
+						Name:           "fake-name",
+						SigningKeyType: git.SigningKeyTypeGPG,
+						SigningKey:     "fake-signing-key",
+					},
+					author,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			author := &git.User{Name: "fake-name"}
+			err := configureCommitSigning(testCase.update, author, testCase.creds)
+			testCase.assertions(t, author, err)
+		})
+	}
+}
+
 func TestMoveRepoContents(t *testing.T) {
 	const subdirCount = 50
 	const fileCount = 50
@@ -557,6 +816,44 @@ func TestDeleteRepoContents(t *testing.T) {
 	require.Len(t, dirEntries, 1)
 }
 
+func TestDescribeGitUpdate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		update   kargoapi.GitRepoUpdate
+		changes  []string
+		expected string
+	}{
+		{
+			name: "no change summary",
+			update: kargoapi.GitRepoUpdate{
+				RepoURL:     "https://github.com/example/repo",
+				WriteBranch: "env/prod",
+			},
+			expected: `update branch "env/prod" of git repo "https://github.com/example/repo"`,
+		},
+		{
+			name: "change summary via pull request",
+			update: kargoapi.GitRepoUpdate{
+				RepoURL:     "https://github.com/example/repo",
+				WriteBranch: "main",
+				PullRequest: &kargoapi.PullRequestPromotionMechanism{},
+			},
+			changes: []string{"updated a.yaml", "updated b.yaml"},
+			expected: `update branch "main" of git repo "https://github.com/example/repo" ` +
+				"via pull request: updated a.yaml; updated b.yaml",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				describeGitUpdate(testCase.update, testCase.changes),
+			)
+		})
+	}
+}
+
 func TestBuildCommitMessage(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -619,6 +916,86 @@ func TestBuildCommitMessage(t *testing.T) {
 	}
 }
 
+func TestRenderCommitMessage(t *testing.T) {
+	testData := commitMessageTemplateData{
+		Project: "fake-project",
+		Stage:   "fake-stage",
+		Freight: kargoapi.FreightReference{
+			Name: "fake-freight",
+			Images: []kargoapi.Image{
+				{
+					RepoURL: "fake-url",
+					Tag:     "fake-tag",
+				},
+				{
+					RepoURL: "another-fake-url",
+					Tag:     "another-fake-tag",
+				},
+			},
+			Charts: []kargoapi.Chart{
+				{
+					Name:    "fake-chart",
+					Version: "1.2.3",
+				},
+			},
+		},
+		Changes: []string{"fake-change"},
+	}
+	testCases := []struct {
+		name       string
+		tmpl       string
+		assertions func(*testing.T, string, error)
+	}{
+		{
+			name: "no template",
+			assertions: func(t *testing.T, msg string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "fake-change", msg)
+			},
+		},
+		{
+			name: "invalid template",
+			tmpl: "{{ .Stage",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "error parsing commit message template")
+			},
+		},
+		{
+			name: "template refers to unknown field",
+			tmpl: "{{ .Bogus }}",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "error rendering commit message template")
+			},
+		},
+		{
+			name: "template with multiple images",
+			tmpl: "Promote {{ .Freight.Name }} to {{ .Project }}/{{ .Stage }}\n" +
+				"{{ range .Freight.Images }}\n* {{ .RepoURL }}:{{ .Tag }}{{ end }}" +
+				"{{ range .Freight.Charts }}\n* {{ .Name }} {{ .Version }}{{ end }}",
+			assertions: func(t *testing.T, msg string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{
+						"Promote fake-freight to fake-project/fake-stage",
+						"",
+						"* fake-url:fake-tag",
+						"* another-fake-url:another-fake-tag",
+						"* fake-chart 1.2.3",
+					},
+					strings.Split(msg, "\n"),
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			msg, err := renderCommitMessage(testCase.tmpl, testData)
+			testCase.assertions(t, msg, err)
+		})
+	}
+}
+
 func createDummyRepoDir(t *testing.T, dirCount, fileCount int) (string, error) {
 	t.Helper()
 	// Create a temporary directory
@@ -2,6 +2,7 @@ package promotion
 
 import (
 	"fmt"
+	"maps"
 	"os"
 	"path"
 	"path/filepath"
@@ -25,10 +26,11 @@ func newHelmMechanism(
 		credentialsDB,
 		selectHelmUpdates,
 		(&helmer{
-			buildValuesFilesChangesFn:     buildValuesFilesChanges,
-			buildChartDependencyChangesFn: buildChartDependencyChanges,
-			setStringsInYAMLFileFn:        libYAML.SetStringsInFile,
-			updateChartDependenciesFn:     helm.UpdateChartDependencies,
+			buildValuesFilesChangesFn:         buildValuesFilesChanges,
+			buildArtifactValuesFilesChangesFn: buildArtifactValuesFilesChanges,
+			buildChartDependencyChangesFn:     buildChartDependencyChanges,
+			setStringsInYAMLFileFn:            libYAML.SetStringsInFile,
+			updateChartDependenciesFn:         helm.UpdateChartDependencies,
 		}).apply,
 	)
 }
@@ -50,6 +52,10 @@ type helmer struct {
 	buildValuesFilesChangesFn func(
 		[]kargoapi.Image,
 		[]kargoapi.HelmImageUpdate,
+	) (map[string]map[string]string, []string, error)
+	buildArtifactValuesFilesChangesFn func(
+		[]kargoapi.OCIArtifact,
+		[]kargoapi.HelmOCIArtifactUpdate,
 	) (map[string]map[string]string, []string)
 	buildChartDependencyChangesFn func(
 		string,
@@ -71,8 +77,29 @@ func (h *helmer) apply(
 	_ git.RepoCredentials,
 ) ([]string, error) {
 	// Image updates
-	changesByFile, imageChangeSummary :=
+	changesByFile, imageChangeSummary, err :=
 		h.buildValuesFilesChangesFn(newFreight.Images, update.Helm.Images)
+	if err != nil {
+		return nil, fmt.Errorf("error preparing changes to affected values files: %w", err)
+	}
+
+	// OCI artifact updates may target the same values files as image updates,
+	// so their changes are merged in before any file is written.
+	artifactChangesByFile, artifactChangeSummary :=
+		h.buildArtifactValuesFilesChangesFn(
+			newFreight.OCIArtifacts,
+			update.Helm.OCIArtifacts,
+		)
+	if changesByFile == nil {
+		changesByFile = make(map[string]map[string]string, len(artifactChangesByFile))
+	}
+	for file, changes := range artifactChangesByFile {
+		if _, found := changesByFile[file]; !found {
+			changesByFile[file] = make(map[string]string, len(changes))
+		}
+		maps.Copy(changesByFile[file], changes)
+	}
+
 	for file, changes := range changesByFile {
 		if err := h.setStringsInYAMLFileFn(
 			filepath.Join(workingDir, file),
@@ -103,7 +130,8 @@ func (h *helmer) apply(
 		}
 	}
 
-	return append(imageChangeSummary, subchartChangeSummary...), nil
+	changeSummary := append(imageChangeSummary, artifactChangeSummary...)
+	return append(changeSummary, subchartChangeSummary...), nil
 }
 
 // buildValuesFilesChanges takes a list of images and a list of instructions
@@ -113,7 +141,7 @@ func (h *helmer) apply(
 func buildValuesFilesChanges(
 	images []kargoapi.Image,
 	imageUpdates []kargoapi.HelmImageUpdate,
-) (map[string]map[string]string, []string) {
+) (map[string]map[string]string, []string, error) {
 	tagsByImage := map[string]string{}
 	digestsByImage := make(map[string]string, len(images))
 	for _, image := range images {
@@ -123,12 +151,7 @@ func buildValuesFilesChanges(
 	changesByFile := make(map[string]map[string]string, len(imageUpdates))
 	changeSummary := make([]string, 0, len(imageUpdates))
 	for _, imageUpdate := range imageUpdates {
-		switch imageUpdate.Value {
-		case kargoapi.ImageUpdateValueTypeImageAndTag,
-			kargoapi.ImageUpdateValueTypeTag,
-			kargoapi.ImageUpdateValueTypeImageAndDigest,
-			kargoapi.ImageUpdateValueTypeDigest:
-		default:
+		if !imageUpdate.Value.IsValid() {
 			// This really shouldn't happen, so we'll ignore it.
 			continue
 		}
@@ -138,6 +161,9 @@ func buildValuesFilesChanges(
 			// There's no change to make in this case.
 			continue
 		}
+		if err := validateImageDigest(imageUpdate.Image, digest, imageUpdate.Value); err != nil {
+			return nil, nil, err
+		}
 		if _, found := changesByFile[imageUpdate.ValuesFilePath]; !found {
 			changesByFile[imageUpdate.ValuesFilePath] = map[string]string{}
 		}
@@ -168,6 +194,91 @@ func buildValuesFilesChanges(
 			),
 		)
 	}
+	return changesByFile, changeSummary, nil
+}
+
+// validateImageDigest returns an error if the provided value type calls for an
+// image's digest, but the provided digest, as obtained from the Freight being
+// promoted, is empty. Writing a reference with an empty digest would produce
+// an invalid image reference rather than a pinned one.
+func validateImageDigest(
+	image string,
+	digest string,
+	valueType kargoapi.ImageUpdateValueType,
+) error {
+	if digest != "" {
+		return nil
+	}
+	switch valueType {
+	case kargoapi.ImageUpdateValueTypeImageAndDigest, kargoapi.ImageUpdateValueTypeDigest:
+		return fmt.Errorf(
+			"cannot update image %q using value type %q: Freight does not "+
+				"specify a digest for this image",
+			image,
+			valueType,
+		)
+	}
+	return nil
+}
+
+// buildArtifactValuesFilesChanges takes a list of OCI artifacts and a list of
+// instructions about changes that should be made to various YAML files and
+// distills them into a map of maps that indexes new values for each YAML file
+// by file name and key.
+func buildArtifactValuesFilesChanges(
+	artifacts []kargoapi.OCIArtifact,
+	artifactUpdates []kargoapi.HelmOCIArtifactUpdate,
+) (map[string]map[string]string, []string) {
+	artifactsByRepo := make(map[string]kargoapi.OCIArtifact, len(artifacts))
+	for _, artifact := range artifacts {
+		artifactsByRepo[artifact.RepoURL] = artifact
+	}
+	changesByFile := make(map[string]map[string]string, len(artifactUpdates))
+	changeSummary := make([]string, 0, len(artifactUpdates))
+	for _, artifactUpdate := range artifactUpdates {
+		artifact, found := artifactsByRepo[artifactUpdate.RepoURL]
+		if !found {
+			// There's no change to make in this case.
+			continue
+		}
+		var value string
+		var fqArtifactRef string // Fully qualified artifact reference
+		switch artifactUpdate.Value {
+		case kargoapi.ImageUpdateValueTypeImageAndTag:
+			value = fmt.Sprintf("%s:%s", artifactUpdate.RepoURL, artifact.Tag)
+			fqArtifactRef = value
+		case kargoapi.ImageUpdateValueTypeTag:
+			value = "'" + artifact.Tag + "'"
+			fqArtifactRef = fmt.Sprintf("%s:%s", artifactUpdate.RepoURL, artifact.Tag)
+		case kargoapi.ImageUpdateValueTypeImageAndDigest:
+			value = fmt.Sprintf("%s@%s", artifactUpdate.RepoURL, artifact.Digest)
+			fqArtifactRef = value
+		case kargoapi.ImageUpdateValueTypeDigest:
+			value = artifact.Digest
+			fqArtifactRef = fmt.Sprintf("%s@%s", artifactUpdate.RepoURL, artifact.Digest)
+		default:
+			// This really shouldn't happen, so we'll ignore it.
+			continue
+		}
+		if artifact.Tag == "" &&
+			(artifactUpdate.Value == kargoapi.ImageUpdateValueTypeImageAndTag ||
+				artifactUpdate.Value == kargoapi.ImageUpdateValueTypeTag) {
+			// The artifact was pinned by digest, so there is no tag to write.
+			continue
+		}
+		if _, found := changesByFile[artifactUpdate.ValuesFilePath]; !found {
+			changesByFile[artifactUpdate.ValuesFilePath] = map[string]string{}
+		}
+		changesByFile[artifactUpdate.ValuesFilePath][artifactUpdate.Key] = value
+		changeSummary = append(
+			changeSummary,
+			fmt.Sprintf(
+				"updated %s to use OCI artifact %s",
+				artifactUpdate.ValuesFilePath,
+				fqArtifactRef,
+			),
+		)
+	}
 	return changesByFile, changeSummary
 }
 
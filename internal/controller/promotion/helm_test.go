@@ -82,18 +82,39 @@ func TestHelmerApply(t *testing.T) {
 		helmer     *helmer
 		assertions func(t *testing.T, changes []string, err error)
 	}{
+		{
+			name: "error building values file changes",
+			helmer: &helmer{
+				buildValuesFilesChangesFn: func(
+					[]kargoapi.Image,
+					[]kargoapi.HelmImageUpdate,
+				) (map[string]map[string]string, []string, error) {
+					return nil, nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ []string, err error) {
+				require.ErrorContains(t, err, "error preparing changes to affected values files")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
 		{
 			name: "error updating values file",
 			helmer: &helmer{
 				buildValuesFilesChangesFn: func(
 					[]kargoapi.Image,
 					[]kargoapi.HelmImageUpdate,
-				) (map[string]map[string]string, []string) {
+				) (map[string]map[string]string, []string, error) {
 					return map[string]map[string]string{
 						testValuesFile: {
 							testKey: testValue,
 						},
-					}, nil
+					}, nil, nil
+				},
+				buildArtifactValuesFilesChangesFn: func(
+					[]kargoapi.OCIArtifact,
+					[]kargoapi.HelmOCIArtifactUpdate,
+				) (map[string]map[string]string, []string) {
+					return nil, nil
 				},
 				setStringsInYAMLFileFn: func(string, map[string]string) error {
 					return errors.New("something went wrong")
@@ -110,10 +131,16 @@ func TestHelmerApply(t *testing.T) {
 				buildValuesFilesChangesFn: func(
 					[]kargoapi.Image,
 					[]kargoapi.HelmImageUpdate,
-				) (map[string]map[string]string, []string) {
+				) (map[string]map[string]string, []string, error) {
 					// This returns nothing so that the only calls to
 					// setStringsInYAMLFileFn will be for updating subcharts in
 					// Charts.yaml.
+					return nil, nil, nil
+				},
+				buildArtifactValuesFilesChangesFn: func(
+					[]kargoapi.OCIArtifact,
+					[]kargoapi.HelmOCIArtifactUpdate,
+				) (map[string]map[string]string, []string) {
 					return nil, nil
 				},
 				buildChartDependencyChangesFn: func(
@@ -135,10 +162,16 @@ func TestHelmerApply(t *testing.T) {
 				buildValuesFilesChangesFn: func(
 					[]kargoapi.Image,
 					[]kargoapi.HelmImageUpdate,
-				) (map[string]map[string]string, []string) {
+				) (map[string]map[string]string, []string, error) {
 					// This returns nothing so that the only calls to
 					// setStringsInYAMLFileFn will be for updating subcharts in
 					// Charts.yaml.
+					return nil, nil, nil
+				},
+				buildArtifactValuesFilesChangesFn: func(
+					[]kargoapi.OCIArtifact,
+					[]kargoapi.HelmOCIArtifactUpdate,
+				) (map[string]map[string]string, []string) {
 					return nil, nil
 				},
 				buildChartDependencyChangesFn: func(
@@ -167,6 +200,12 @@ func TestHelmerApply(t *testing.T) {
 				buildValuesFilesChangesFn: func(
 					[]kargoapi.Image,
 					[]kargoapi.HelmImageUpdate,
+				) (map[string]map[string]string, []string, error) {
+					return nil, nil, nil
+				},
+				buildArtifactValuesFilesChangesFn: func(
+					[]kargoapi.OCIArtifact,
+					[]kargoapi.HelmOCIArtifactUpdate,
 				) (map[string]map[string]string, []string) {
 					return nil, nil
 				},
@@ -199,12 +238,22 @@ func TestHelmerApply(t *testing.T) {
 				buildValuesFilesChangesFn: func(
 					[]kargoapi.Image,
 					[]kargoapi.HelmImageUpdate,
-				) (map[string]map[string]string, []string) {
+				) (map[string]map[string]string, []string, error) {
 					return map[string]map[string]string{
 						testValuesFile: {
 							testKey: testValue,
 						},
-					}, []string{"fake-image-update"}
+					}, []string{"fake-image-update"}, nil
+				},
+				buildArtifactValuesFilesChangesFn: func(
+					[]kargoapi.OCIArtifact,
+					[]kargoapi.HelmOCIArtifactUpdate,
+				) (map[string]map[string]string, []string) {
+					return map[string]map[string]string{
+						testValuesFile: {
+							"another-fake-key": testValue,
+						},
+					}, []string{"fake-artifact-update"}
 				},
 				buildChartDependencyChangesFn: func(
 					string,
@@ -226,7 +275,15 @@ func TestHelmerApply(t *testing.T) {
 			},
 			assertions: func(t *testing.T, changes []string, err error) {
 				require.NoError(t, err)
-				require.Len(t, changes, 2)
+				require.Equal(
+					t,
+					[]string{
+						"fake-image-update",
+						"fake-artifact-update",
+						"fake-chart-update",
+					},
+					changes,
+				)
 			},
 		},
 	}
@@ -302,7 +359,8 @@ func TestBuildValuesFilesChanges(t *testing.T) {
 			Value:          "Tag",
 		},
 	}
-	result, changeSummary := buildValuesFilesChanges(images, imageUpdates)
+	result, changeSummary, err := buildValuesFilesChanges(images, imageUpdates)
+	require.NoError(t, err)
 	require.Equal(
 		t,
 		map[string]map[string]string{
@@ -329,6 +387,131 @@ func TestBuildValuesFilesChanges(t *testing.T) {
 	)
 }
 
+func TestBuildValuesFilesChangesWithoutDigest(t *testing.T) {
+	images := []kargoapi.Image{
+		{
+			RepoURL: "fake-url",
+			Tag:     "fake-tag",
+		},
+	}
+
+	// Tags can still be written...
+	result, _, err := buildValuesFilesChanges(
+		images,
+		[]kargoapi.HelmImageUpdate{{
+			ValuesFilePath: "fake-values.yaml",
+			Image:          "fake-url",
+			Key:            "fake-key",
+			Value:          kargoapi.ImageUpdateValueTypeImageAndTag,
+		}},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		map[string]map[string]string{
+			"fake-values.yaml": {"fake-key": "fake-url:fake-tag"},
+		},
+		result,
+	)
+
+	// ...but there is no digest to pin
+	for _, valueType := range []kargoapi.ImageUpdateValueType{
+		kargoapi.ImageUpdateValueTypeImageAndDigest,
+		kargoapi.ImageUpdateValueTypeDigest,
+	} {
+		t.Run(string(valueType), func(t *testing.T) {
+			_, _, err := buildValuesFilesChanges(
+				images,
+				[]kargoapi.HelmImageUpdate{{
+					ValuesFilePath: "fake-values.yaml",
+					Image:          "fake-url",
+					Key:            "fake-key",
+					Value:          valueType,
+				}},
+			)
+			require.ErrorContains(t, err, "does not specify a digest")
+		})
+	}
+}
+
+func TestBuildArtifactValuesFilesChanges(t *testing.T) {
+	artifacts := []kargoapi.OCIArtifact{
+		{
+			RepoURL: "fake-url",
+			Tag:     "fake-tag",
+			Digest:  "fake-digest",
+		},
+		{
+			RepoURL: "second-fake-url",
+			Digest:  "second-fake-digest",
+		},
+	}
+	artifactUpdates := []kargoapi.HelmOCIArtifactUpdate{
+		{
+			ValuesFilePath: "fake-values.yaml",
+			RepoURL:        "fake-url",
+			Key:            "fake-key",
+			Value:          kargoapi.ImageUpdateValueTypeImageAndTag,
+		},
+		{
+			ValuesFilePath: "fake-values.yaml",
+			RepoURL:        "fake-url",
+			Key:            "second-fake-key",
+			Value:          kargoapi.ImageUpdateValueTypeTag,
+		},
+		{
+			ValuesFilePath: "another-fake-values.yaml",
+			RepoURL:        "second-fake-url",
+			Key:            "third-fake-key",
+			Value:          kargoapi.ImageUpdateValueTypeImageAndDigest,
+		},
+		{
+			ValuesFilePath: "another-fake-values.yaml",
+			RepoURL:        "second-fake-url",
+			Key:            "fourth-fake-key",
+			Value:          kargoapi.ImageUpdateValueTypeDigest,
+		},
+		{
+			// The artifact was pinned by digest, so this should be skipped.
+			ValuesFilePath: "another-fake-values.yaml",
+			RepoURL:        "second-fake-url",
+			Key:            "fifth-fake-key",
+			Value:          kargoapi.ImageUpdateValueTypeTag,
+		},
+		{
+			ValuesFilePath: "yet-another-fake-values.yaml",
+			RepoURL:        "artifact-that-is-not-in-list",
+			Key:            "fake-key",
+			Value:          kargoapi.ImageUpdateValueTypeDigest,
+		},
+	}
+	result, changeSummary := buildArtifactValuesFilesChanges(artifacts, artifactUpdates)
+	require.Equal(
+		t,
+		map[string]map[string]string{
+			"fake-values.yaml": {
+				"fake-key":        "fake-url:fake-tag",
+				"second-fake-key": "'fake-tag'",
+			},
+			"another-fake-values.yaml": {
+				"third-fake-key":  "second-fake-url@second-fake-digest",
+				"fourth-fake-key": "second-fake-digest",
+			},
+		},
+		result,
+	)
+	require.Equal(
+		t,
+		[]string{
+			"updated fake-values.yaml to use OCI artifact fake-url:fake-tag",
+			"updated fake-values.yaml to use OCI artifact fake-url:fake-tag",
+			"updated another-fake-values.yaml to use OCI artifact second-fake-url@second-fake-digest",
+			"updated another-fake-values.yaml to use OCI artifact second-fake-url@second-fake-digest",
+		},
+		changeSummary,
+	)
+}
+
 func TestBuildChartDependencyChanges(t *testing.T) {
 	// Set up a couple of fake Chart.yaml files
 	testDir := t.TempDir()
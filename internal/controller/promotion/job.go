@@ -0,0 +1,455 @@
+package promotion
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+const (
+	// jobContainerName is the name of the sole container in the Pod of a Job
+	// created by the Job promotion mechanism.
+	jobContainerName = "main"
+
+	// jobLogTailLines and jobLogLimitBytes bound the amount of a Job's output
+	// that is recorded in a Promotion's status.
+	jobLogTailLines  = 20
+	jobLogLimitBytes = 2048
+)
+
+// jobMechanism is an implementation of the Mechanism interface that runs
+// user-specified containers as Kubernetes Jobs.
+type jobMechanism struct {
+	kargoClient client.Client
+	// These behaviors are overridable for testing purposes:
+	getJobFn    func(context.Context, types.NamespacedName) (*batchv1.Job, error)
+	createJobFn func(
+		context.Context,
+		client.Object,
+		...client.CreateOption,
+	) error
+	getJobResultFn func(
+		ctx context.Context,
+		namespace string,
+		jobName string,
+	) (*int32, string, error)
+}
+
+// newJobMechanism returns an implementation of the Mechanism interface that
+// runs user-specified containers as Kubernetes Jobs.
+func newJobMechanism(
+	kargoClient client.Client,
+	podsClient typedcorev1.PodsGetter,
+) Mechanism {
+	j := &jobMechanism{
+		kargoClient: kargoClient,
+	}
+	j.getJobFn = j.getJob
+	if kargoClient != nil {
+		j.createJobFn = kargoClient.Create
+	}
+	j.getJobResultFn = getJobResultFn(podsClient)
+	return j
+}
+
+// GetName implements the Mechanism interface.
+func (*jobMechanism) GetName() string {
+	return "Job promotion mechanism"
+}
+
+// Promote implements the Mechanism interface.
+func (j *jobMechanism) Promote(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	promo *kargoapi.Promotion,
+	newFreight kargoapi.FreightReference,
+) (*kargoapi.PromotionStatus, kargoapi.FreightReference, error) {
+	var jobs []kargoapi.PromotionJob
+	if stage.Spec.PromotionMechanisms != nil {
+		jobs = stage.Spec.PromotionMechanisms.Jobs
+	}
+
+	if len(jobs) == 0 {
+		return promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded), newFreight, nil
+	}
+
+	if promo.Spec.DryRun {
+		newStatus := promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded)
+		for _, job := range jobs {
+			newStatus.PlannedChanges = append(
+				newStatus.PlannedChanges,
+				fmt.Sprintf("run Job %q using image %q", job.Name, job.Image),
+			)
+		}
+		return newStatus, newFreight, nil
+	}
+
+	if j.kargoClient == nil {
+		return promo.Status.WithPhase(kargoapi.PromotionPhaseFailed), newFreight,
+			errors.New("Job promotion mechanism is not configured on this controller")
+	}
+
+	logger := logging.LoggerFromContext(ctx)
+	logger.Debug("executing Job-based promotion mechanisms")
+
+	newStatus := promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded)
+	if newStatus.Metadata == nil {
+		newStatus.Metadata = make(map[string]string, len(jobs))
+	}
+	for _, job := range jobs {
+		jobLogger := logger.WithField("job", job.Name)
+		key := jobMetadataKey(job.Name)
+		phase, err := j.runJob(
+			logging.ContextWithLogger(ctx, jobLogger),
+			stage,
+			promo,
+			job,
+			newFreight,
+			newStatus.Metadata,
+		)
+		result := kargoapi.MechanismResult{
+			Type:   kargoapi.MechanismTypeJob,
+			Target: job.Name,
+			Phase:  phase,
+		}
+		if err != nil {
+			result.Phase = kargoapi.PromotionPhaseErrored
+			result.Message = err.Error()
+			newStatus.Phase = kargoapi.PromotionPhaseErrored
+			newStatus.Mechanisms = append(newStatus.Mechanisms, result)
+			return newStatus, newFreight, err
+		}
+		newStatus.Metadata[key] = string(phase)
+		if phase != kargoapi.PromotionPhaseSucceeded {
+			// Jobs are run one at a time, so there is nothing more to do until
+			// this one has succeeded.
+			newStatus.Phase = phase
+			if phase == kargoapi.PromotionPhaseFailed {
+				newStatus.Message = jobFailureMessage(job.Name, newStatus.Metadata)
+				result.Message = newStatus.Message
+			}
+			newStatus.Mechanisms = append(newStatus.Mechanisms, result)
+			break
+		}
+		newStatus.Mechanisms = append(newStatus.Mechanisms, result)
+		jobLogger.Debug("Job succeeded")
+	}
+
+	logger.Debug("done executing Job-based promotion mechanisms")
+	return newStatus, newFreight, nil
+}
+
+// runJob creates the Kubernetes Job for the provided PromotionJob if it does
+// not already exist and returns a Promotion phase reflecting the Job's
+// progress. Once the Job has finished, the exit code and tail end of the
+// output of its container are recorded in the provided metadata.
+func (j *jobMechanism) runJob(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	promo *kargoapi.Promotion,
+	job kargoapi.PromotionJob,
+	newFreight kargoapi.FreightReference,
+	metadata map[string]string,
+) (kargoapi.PromotionPhase, error) {
+	logger := logging.LoggerFromContext(ctx)
+	key := types.NamespacedName{
+		Namespace: stage.Namespace,
+		Name:      jobResourceName(promo.Name, job.Name, promo.Status.Attempts),
+	}
+	k8sJob, err := j.getJobFn(ctx, key)
+	if err != nil {
+		return "", fmt.Errorf(
+			"error getting Job %q in namespace %q: %w",
+			key.Name,
+			key.Namespace,
+			err,
+		)
+	}
+	if k8sJob == nil {
+		if k8sJob, err = buildJob(key, stage, promo, job, newFreight); err != nil {
+			return "", err
+		}
+		if err = j.createJobFn(ctx, k8sJob); err != nil && !apierrors.IsAlreadyExists(err) {
+			return "", fmt.Errorf(
+				"error creating Job %q in namespace %q: %w",
+				key.Name,
+				key.Namespace,
+				err,
+			)
+		}
+		logger.WithField("k8sJob", key.Name).Debug("created Job")
+		return kargoapi.PromotionPhaseRunning, nil
+	}
+
+	var phase kargoapi.PromotionPhase
+	var timedOut bool
+	for _, cond := range k8sJob.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			phase = kargoapi.PromotionPhaseSucceeded
+		case batchv1.JobFailed:
+			phase = kargoapi.PromotionPhaseFailed
+			timedOut = cond.Reason == "DeadlineExceeded"
+		}
+	}
+	if phase == "" {
+		return kargoapi.PromotionPhaseRunning, nil
+	}
+
+	exitCode, logs, err := j.getJobResultFn(ctx, key.Namespace, key.Name)
+	if err != nil {
+		// The outcome of the Job is already known, so failing to retrieve its
+		// details should not hold up the Promotion.
+		logger.Warnf("error retrieving result of Job %q: %s", key.Name, err)
+	}
+	prefix := jobMetadataKey(job.Name)
+	if exitCode != nil {
+		metadata[prefix+".exitCode"] = strconv.Itoa(int(*exitCode))
+	}
+	if logs != "" {
+		metadata[prefix+".logs"] = logs
+	}
+	if timedOut {
+		metadata[prefix+".timedOut"] = "true"
+	}
+	logger.WithFields(log.Fields{
+		"k8sJob": key.Name,
+		"phase":  phase,
+	}).Debug("Job finished")
+	return phase, nil
+}
+
+// jobFailureMessage returns a message describing the failure of the named
+// Job, using the details recorded for it in the provided metadata.
+func jobFailureMessage(name string, metadata map[string]string) string {
+	prefix := jobMetadataKey(name)
+	if metadata[prefix+".timedOut"] == "true" {
+		return fmt.Sprintf("Job %q timed out", name)
+	}
+	if exitCode, ok := metadata[prefix+".exitCode"]; ok {
+		return fmt.Sprintf("Job %q failed with exit code %s", name, exitCode)
+	}
+	return fmt.Sprintf("Job %q failed", name)
+}
+
+// jobMetadataKey returns the key used to record the phase of the named Job in
+// a Promotion's status metadata. Further details of the Job are recorded
+// using keys with this one as a prefix.
+func jobMetadataKey(name string) string {
+	return fmt.Sprintf("job:%s", name)
+}
+
+// jobResourceName returns the name of the Kubernetes Job that runs the named
+// PromotionJob on behalf of the named Promotion. Promotion names are too long
+// to be incorporated verbatim, so a hash of both names is used to keep the
+// result unique. Every retry of a Promotion runs its Jobs afresh, so attempts
+// after the first are also incorporated into the hash.
+func jobResourceName(promoName, jobName string, attempt int32) string {
+	id := promoName + "/" + jobName
+	if attempt > 1 {
+		id = fmt.Sprintf("%s/%d", id, attempt)
+	}
+	hash := fmt.Sprintf("%x", sha1.Sum([]byte(id)))
+	return fmt.Sprintf("kargo-%s-%s", jobName, hash[:10])
+}
+
+// buildJob returns a Kubernetes Job that runs the provided PromotionJob on
+// behalf of the provided Promotion.
+func buildJob(
+	key types.NamespacedName,
+	stage *kargoapi.Stage,
+	promo *kargoapi.Promotion,
+	job kargoapi.PromotionJob,
+	newFreight kargoapi.FreightReference,
+) (*batchv1.Job, error) {
+	freightJSON, err := json.Marshal(newFreight)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling Freight %q: %w", newFreight.Name, err)
+	}
+	env := make([]corev1.EnvVar, 0, len(job.Env)+5)
+	for _, e := range job.Env {
+		if !isKargoJobEnvVar(e.Name) {
+			env = append(env, e)
+		}
+	}
+	env = append(
+		env,
+		corev1.EnvVar{Name: "KARGO_PROJECT", Value: stage.Namespace},
+		corev1.EnvVar{Name: "KARGO_STAGE", Value: stage.Name},
+		corev1.EnvVar{Name: "KARGO_PROMOTION", Value: promo.Name},
+		corev1.EnvVar{Name: "KARGO_FREIGHT", Value: newFreight.Name},
+		corev1.EnvVar{Name: "KARGO_FREIGHT_JSON", Value: string(freightJSON)},
+	)
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: key.Namespace,
+			Name:      key.Name,
+			Labels: map[string]string{
+				kargoapi.PromotionJobLabelKey: job.Name,
+				kargoapi.StageLabelKey:        stage.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: kargoapi.GroupVersion.String(),
+					Kind:       "Promotion",
+					Name:       promo.Name,
+					UID:        promo.UID,
+					Controller: ptr.To(true),
+				},
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:          ptr.To(int32(0)),
+			ActiveDeadlineSeconds: jobActiveDeadlineSeconds(job.Timeout),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						kargoapi.PromotionJobLabelKey: job.Name,
+						kargoapi.StageLabelKey:        stage.Name,
+					},
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy:      corev1.RestartPolicyNever,
+					ServiceAccountName: job.ServiceAccountName,
+					// The container is user-supplied, so it runs with as little
+					// privilege as possible by default.
+					AutomountServiceAccountToken: ptr.To(false),
+					SecurityContext: &corev1.PodSecurityContext{
+						RunAsNonRoot: ptr.To(true),
+						SeccompProfile: &corev1.SeccompProfile{
+							Type: corev1.SeccompProfileTypeRuntimeDefault,
+						},
+					},
+					Containers: []corev1.Container{
+						{
+							Name:      jobContainerName,
+							Image:     job.Image,
+							Command:   job.Command,
+							Args:      job.Args,
+							Env:       env,
+							Resources: job.Resources,
+							SecurityContext: &corev1.SecurityContext{
+								AllowPrivilegeEscalation: ptr.To(false),
+								Capabilities: &corev1.Capabilities{
+									Drop: []corev1.Capability{"ALL"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// isKargoJobEnvVar returns true if the named environment variable is one that
+// Kargo sets in the container of every Job it runs.
+func isKargoJobEnvVar(name string) bool {
+	switch name {
+	case "KARGO_PROJECT", "KARGO_STAGE", "KARGO_PROMOTION", "KARGO_FREIGHT",
+		"KARGO_FREIGHT_JSON":
+		return true
+	}
+	return false
+}
+
+func (j *jobMechanism) getJob(
+	ctx context.Context,
+	key types.NamespacedName,
+) (*batchv1.Job, error) {
+	job := &batchv1.Job{}
+	if err := j.kargoClient.Get(ctx, key, job); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return job, nil
+}
+
+// getJobResultFn returns a function that retrieves the exit code and the tail
+// end of the output of the container of the most recently created Pod of the
+// specified Job. If the provided PodsGetter is nil, the returned function
+// always returns nothing.
+func getJobResultFn(
+	podsClient typedcorev1.PodsGetter,
+) func(context.Context, string, string) (*int32, string, error) {
+	return func(
+		ctx context.Context,
+		namespace string,
+		jobName string,
+	) (*int32, string, error) {
+		if podsClient == nil {
+			return nil, "", nil
+		}
+		pods, err := podsClient.Pods(namespace).List(ctx, metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("%s=%s", batchv1.JobNameLabel, jobName),
+		})
+		if err != nil {
+			return nil, "", fmt.Errorf("error listing Pods: %w", err)
+		}
+		var pod *corev1.Pod
+		for i := range pods.Items {
+			if pod == nil ||
+				pod.CreationTimestamp.Before(&pods.Items[i].CreationTimestamp) {
+				pod = &pods.Items[i]
+			}
+		}
+		if pod == nil {
+			return nil, "", nil
+		}
+		var exitCode *int32
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.Name == jobContainerName && status.State.Terminated != nil {
+				exitCode = ptr.To(status.State.Terminated.ExitCode)
+			}
+		}
+		logs, err := podsClient.Pods(namespace).GetLogs(
+			pod.Name,
+			&corev1.PodLogOptions{
+				Container:  jobContainerName,
+				TailLines:  ptr.To(int64(jobLogTailLines)),
+				LimitBytes: ptr.To(int64(jobLogLimitBytes)),
+			},
+		).DoRaw(ctx)
+		if err != nil {
+			return exitCode, "", fmt.Errorf(
+				"error getting logs of Pod %q: %w",
+				pod.Name,
+				err,
+			)
+		}
+		return exitCode, string(logs), nil
+	}
+}
+
+// jobActiveDeadlineSeconds converts a PromotionJob's timeout into a Job's
+// ActiveDeadlineSeconds. Fractional seconds are rounded up and the result is
+// never less than one, because Kubernetes rejects a deadline of zero and
+// truncating would otherwise either do that or cut a timeout short.
+func jobActiveDeadlineSeconds(timeout *metav1.Duration) *int64 {
+	if timeout == nil {
+		return nil
+	}
+	return ptr.To(max(int64(math.Ceil(timeout.Duration.Seconds())), 1))
+}
@@ -0,0 +1,533 @@
+package promotion
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestNewJobMechanism(t *testing.T) {
+	j, ok := newJobMechanism(fake.NewClientBuilder().Build(), nil).(*jobMechanism)
+	require.True(t, ok)
+	require.NotNil(t, j.kargoClient)
+	require.NotNil(t, j.getJobFn)
+	require.NotNil(t, j.createJobFn)
+	require.NotNil(t, j.getJobResultFn)
+}
+
+func TestJobGetName(t *testing.T) {
+	require.NotEmpty(t, (&jobMechanism{}).GetName())
+}
+
+func TestJobPromote(t *testing.T) {
+	finishedJob := func(condType batchv1.JobConditionType, reason string) *batchv1.Job {
+		return &batchv1.Job{
+			Status: batchv1.JobStatus{
+				Conditions: []batchv1.JobCondition{
+					{
+						Type:   condType,
+						Status: corev1.ConditionTrue,
+						Reason: reason,
+					},
+				},
+			},
+		}
+	}
+	testCases := []struct {
+		name       string
+		jobs       []kargoapi.PromotionJob
+		dryRun     bool
+		mechanism  *jobMechanism
+		assertions func(*testing.T, *kargoapi.PromotionStatus, error)
+	}{
+		{
+			name:      "no jobs",
+			mechanism: &jobMechanism{},
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+			},
+		},
+		{
+			name:      "mechanism not configured",
+			jobs:      []kargoapi.PromotionJob{{Name: "deploy"}},
+			mechanism: &jobMechanism{},
+			assertions: func(t *testing.T, _ *kargoapi.PromotionStatus, err error) {
+				require.ErrorContains(t, err, "not configured")
+			},
+		},
+		{
+			name: "dry run",
+			jobs: []kargoapi.PromotionJob{
+				{Name: "deploy", Image: "example/deploy:v1"},
+				{Name: "notify", Image: "example/notify:v1"},
+			},
+			dryRun: true,
+			// No client is needed since no Jobs are created
+			mechanism: &jobMechanism{},
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+				require.Equal(
+					t,
+					[]string{
+						`run Job "deploy" using image "example/deploy:v1"`,
+						`run Job "notify" using image "example/notify:v1"`,
+					},
+					status.PlannedChanges,
+				)
+			},
+		},
+		{
+			name: "error getting job",
+			jobs: []kargoapi.PromotionJob{{Name: "deploy"}},
+			mechanism: &jobMechanism{
+				kargoClient: fake.NewClientBuilder().Build(),
+				getJobFn: func(context.Context, types.NamespacedName) (*batchv1.Job, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.PromotionStatus, err error) {
+				require.ErrorContains(t, err, "error getting Job")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "error creating job",
+			jobs: []kargoapi.PromotionJob{{Name: "deploy"}},
+			mechanism: &jobMechanism{
+				kargoClient: fake.NewClientBuilder().Build(),
+				getJobFn: func(context.Context, types.NamespacedName) (*batchv1.Job, error) {
+					return nil, nil
+				},
+				createJobFn: func(context.Context, client.Object, ...client.CreateOption) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.ErrorContains(t, err, "error creating Job")
+				require.ErrorContains(t, err, "something went wrong")
+				require.Len(t, status.Mechanisms, 1)
+				require.Equal(t, kargoapi.MechanismTypeJob, status.Mechanisms[0].Type)
+				require.Equal(t, "deploy", status.Mechanisms[0].Target)
+				require.Equal(t, kargoapi.PromotionPhaseErrored, status.Mechanisms[0].Phase)
+				require.Equal(t, err.Error(), status.Mechanisms[0].Message)
+			},
+		},
+		{
+			name: "job created",
+			jobs: []kargoapi.PromotionJob{{Name: "deploy"}, {Name: "notify"}},
+			mechanism: &jobMechanism{
+				kargoClient: fake.NewClientBuilder().Build(),
+				getJobFn: func(context.Context, types.NamespacedName) (*batchv1.Job, error) {
+					return nil, nil
+				},
+				createJobFn: func(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+					if obj.GetLabels()[kargoapi.PromotionJobLabelKey] != "deploy" {
+						return errors.New("unexpected job")
+					}
+					return nil
+				},
+			},
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+				require.Equal(
+					t,
+					map[string]string{"job:deploy": string(kargoapi.PromotionPhaseRunning)},
+					status.Metadata,
+				)
+			},
+		},
+		{
+			name: "job running",
+			jobs: []kargoapi.PromotionJob{{Name: "deploy"}},
+			mechanism: &jobMechanism{
+				kargoClient: fake.NewClientBuilder().Build(),
+				getJobFn: func(context.Context, types.NamespacedName) (*batchv1.Job, error) {
+					return &batchv1.Job{}, nil
+				},
+			},
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+			},
+		},
+		{
+			name: "jobs succeeded",
+			jobs: []kargoapi.PromotionJob{{Name: "deploy"}, {Name: "notify"}},
+			mechanism: &jobMechanism{
+				kargoClient: fake.NewClientBuilder().Build(),
+				getJobFn: func(context.Context, types.NamespacedName) (*batchv1.Job, error) {
+					return finishedJob(batchv1.JobComplete, ""), nil
+				},
+				getJobResultFn: func(context.Context, string, string) (*int32, string, error) {
+					return ptr.To(int32(0)), "done", nil
+				},
+			},
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+				require.Equal(
+					t,
+					map[string]string{
+						"job:deploy":          string(kargoapi.PromotionPhaseSucceeded),
+						"job:deploy.exitCode": "0",
+						"job:deploy.logs":     "done",
+						"job:notify":          string(kargoapi.PromotionPhaseSucceeded),
+						"job:notify.exitCode": "0",
+						"job:notify.logs":     "done",
+					},
+					status.Metadata,
+				)
+				require.Equal(
+					t,
+					[]kargoapi.MechanismResult{
+						{
+							Type:   kargoapi.MechanismTypeJob,
+							Target: "deploy",
+							Phase:  kargoapi.PromotionPhaseSucceeded,
+						},
+						{
+							Type:   kargoapi.MechanismTypeJob,
+							Target: "notify",
+							Phase:  kargoapi.PromotionPhaseSucceeded,
+						},
+					},
+					status.Mechanisms,
+				)
+			},
+		},
+		{
+			name: "job failed",
+			jobs: []kargoapi.PromotionJob{{Name: "deploy"}, {Name: "notify"}},
+			mechanism: &jobMechanism{
+				kargoClient: fake.NewClientBuilder().Build(),
+				getJobFn: func(context.Context, types.NamespacedName) (*batchv1.Job, error) {
+					return finishedJob(batchv1.JobFailed, "BackoffLimitExceeded"), nil
+				},
+				getJobResultFn: func(context.Context, string, string) (*int32, string, error) {
+					return ptr.To(int32(3)), "", errors.New("logs unavailable")
+				},
+			},
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseFailed, status.Phase)
+				require.Equal(t, `Job "deploy" failed with exit code 3`, status.Message)
+				require.Equal(t, "3", status.Metadata["job:deploy.exitCode"])
+				require.NotContains(t, status.Metadata, "job:notify")
+				require.Equal(
+					t,
+					[]kargoapi.MechanismResult{{
+						Type:    kargoapi.MechanismTypeJob,
+						Target:  "deploy",
+						Phase:   kargoapi.PromotionPhaseFailed,
+						Message: `Job "deploy" failed with exit code 3`,
+					}},
+					status.Mechanisms,
+				)
+			},
+		},
+		{
+			name: "job timed out",
+			jobs: []kargoapi.PromotionJob{{Name: "deploy"}},
+			mechanism: &jobMechanism{
+				kargoClient: fake.NewClientBuilder().Build(),
+				getJobFn: func(context.Context, types.NamespacedName) (*batchv1.Job, error) {
+					return finishedJob(batchv1.JobFailed, "DeadlineExceeded"), nil
+				},
+				getJobResultFn: func(context.Context, string, string) (*int32, string, error) {
+					return nil, "", nil
+				},
+			},
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseFailed, status.Phase)
+				require.Equal(t, `Job "deploy" timed out`, status.Message)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			status, _, err := testCase.mechanism.Promote(
+				context.Background(),
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "fake-stage",
+					},
+					Spec: kargoapi.StageSpec{
+						PromotionMechanisms: &kargoapi.PromotionMechanisms{
+							Jobs: testCase.jobs,
+						},
+					},
+				},
+				&kargoapi.Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "fake-promotion",
+					},
+					Spec: kargoapi.PromotionSpec{
+						DryRun: testCase.dryRun,
+					},
+				},
+				kargoapi.FreightReference{Name: "fake-freight"},
+			)
+			testCase.assertions(t, status, err)
+		})
+	}
+}
+
+func TestJobPromoteRetry(t *testing.T) {
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		},
+		Spec: kargoapi.StageSpec{
+			PromotionMechanisms: &kargoapi.PromotionMechanisms{
+				Jobs: []kargoapi.PromotionJob{{Name: "deploy", Image: "example/deploy:v1"}},
+			},
+		},
+	}
+	promo := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-promotion",
+		},
+		Status: kargoapi.PromotionStatus{Attempts: 1},
+	}
+	// The Job run by the first attempt failed
+	failedJob := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      jobResourceName("fake-promotion", "deploy", 1),
+		},
+		Status: batchv1.JobStatus{
+			Conditions: []batchv1.JobCondition{{
+				Type:   batchv1.JobFailed,
+				Status: corev1.ConditionTrue,
+			}},
+		},
+	}
+	c := fake.NewClientBuilder().WithObjects(failedJob).Build()
+	j := newJobMechanism(c, k8sfake.NewSimpleClientset().CoreV1())
+
+	status, _, err := j.Promote(context.Background(), stage, promo, kargoapi.FreightReference{})
+	require.NoError(t, err)
+	require.Equal(t, kargoapi.PromotionPhaseFailed, status.Phase)
+
+	// The second attempt runs the Job again instead of reusing the failed one
+	promo.Status.Attempts = 2
+	status, _, err = j.Promote(context.Background(), stage, promo, kargoapi.FreightReference{})
+	require.NoError(t, err)
+	require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+	jobs := &batchv1.JobList{}
+	require.NoError(t, c.List(context.Background(), jobs))
+	require.Len(t, jobs.Items, 2)
+	retryJob := &batchv1.Job{}
+	require.NoError(t, c.Get(
+		context.Background(),
+		types.NamespacedName{
+			Namespace: "fake-namespace",
+			Name:      jobResourceName("fake-promotion", "deploy", 2),
+		},
+		retryJob,
+	))
+}
+
+func TestJobPromoteWithoutPromotionMechanisms(t *testing.T) {
+	status, _, err := (&jobMechanism{}).Promote(
+		context.Background(),
+		&kargoapi.Stage{},
+		&kargoapi.Promotion{},
+		kargoapi.FreightReference{Name: "fake-freight"},
+	)
+	require.NoError(t, err)
+	require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+}
+
+func TestJobResourceName(t *testing.T) {
+	name := jobResourceName(
+		"a-stage-with-a-fairly-long-name.01hq8xkzb8mcp3fd4rfc6n3g0x.f9b3c1a",
+		"a-job-with-a-name-of-the-maximum-length",
+		1,
+	)
+	require.LessOrEqual(t, len(name), 63)
+	require.Equal(
+		t,
+		name,
+		jobResourceName(
+			"a-stage-with-a-fairly-long-name.01hq8xkzb8mcp3fd4rfc6n3g0x.f9b3c1a",
+			"a-job-with-a-name-of-the-maximum-length",
+			1,
+		),
+	)
+	require.NotEqual(t, name, jobResourceName("another-promotion", "a-job", 1))
+	// The first attempt keeps the name Jobs had before retries were possible
+	require.Equal(t, jobResourceName("a-promotion", "a-job", 0), jobResourceName("a-promotion", "a-job", 1))
+	// Each retry gets a Job of its own
+	require.NotEqual(t, jobResourceName("a-promotion", "a-job", 1), jobResourceName("a-promotion", "a-job", 2))
+}
+
+func TestBuildJob(t *testing.T) {
+	freight := kargoapi.FreightReference{
+		Name: "fake-freight",
+		Images: []kargoapi.Image{
+			{
+				RepoURL: "fake-repo",
+				Tag:     "v1.0.0",
+			},
+		},
+	}
+	job, err := buildJob(
+		types.NamespacedName{
+			Namespace: "fake-namespace",
+			Name:      "fake-job",
+		},
+		&kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      "fake-stage",
+			},
+		},
+		&kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "fake-promotion",
+				UID:  "fake-uid",
+			},
+		},
+		kargoapi.PromotionJob{
+			Name:    "deploy",
+			Image:   "fake-image",
+			Args:    []string{"--verbose"},
+			Timeout: &metav1.Duration{Duration: 5 * time.Minute},
+			Env: []corev1.EnvVar{
+				{Name: "FOO", Value: "bar"},
+				{Name: "KARGO_STAGE", Value: "an-attempted-override"},
+			},
+		},
+		freight,
+	)
+	require.NoError(t, err)
+	require.Equal(t, "fake-uid", string(job.OwnerReferences[0].UID))
+	require.Equal(t, "deploy", job.Labels[kargoapi.PromotionJobLabelKey])
+	require.Equal(t, int64(300), *job.Spec.ActiveDeadlineSeconds)
+	require.Equal(t, int32(0), *job.Spec.BackoffLimit)
+	require.Equal(t, corev1.RestartPolicyNever, job.Spec.Template.Spec.RestartPolicy)
+	require.False(t, *job.Spec.Template.Spec.AutomountServiceAccountToken)
+	require.True(t, *job.Spec.Template.Spec.SecurityContext.RunAsNonRoot)
+	container := job.Spec.Template.Spec.Containers[0]
+	require.Equal(t, "fake-image", container.Image)
+	require.Equal(t, []string{"--verbose"}, container.Args)
+	require.False(t, *container.SecurityContext.AllowPrivilegeEscalation)
+	require.Equal(t, []corev1.Capability{"ALL"}, container.SecurityContext.Capabilities.Drop)
+	freightJSON, err := json.Marshal(freight)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]corev1.EnvVar{
+			{Name: "FOO", Value: "bar"},
+			{Name: "KARGO_PROJECT", Value: "fake-namespace"},
+			{Name: "KARGO_STAGE", Value: "fake-stage"},
+			{Name: "KARGO_PROMOTION", Value: "fake-promotion"},
+			{Name: "KARGO_FREIGHT", Value: "fake-freight"},
+			{Name: "KARGO_FREIGHT_JSON", Value: string(freightJSON)},
+		},
+		container.Env,
+	)
+}
+
+func TestGetJobResultFn(t *testing.T) {
+	clientset := k8sfake.NewSimpleClientset(
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      "fake-pod",
+				Labels: map[string]string{
+					batchv1.JobNameLabel: "fake-job",
+				},
+			},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						Name: jobContainerName,
+						State: corev1.ContainerState{
+							Terminated: &corev1.ContainerStateTerminated{
+								ExitCode: 2,
+							},
+						},
+					},
+				},
+			},
+		},
+	)
+	getJobResult := getJobResultFn(clientset.CoreV1())
+
+	exitCode, logs, err := getJobResult(context.Background(), "fake-namespace", "fake-job")
+	require.NoError(t, err)
+	require.Equal(t, int32(2), *exitCode)
+	require.NotEmpty(t, logs)
+
+	exitCode, logs, err = getJobResult(context.Background(), "fake-namespace", "other-job")
+	require.NoError(t, err)
+	require.Nil(t, exitCode)
+	require.Empty(t, logs)
+
+	exitCode, logs, err = getJobResultFn(nil)(context.Background(), "fake-namespace", "fake-job")
+	require.NoError(t, err)
+	require.Nil(t, exitCode)
+	require.Empty(t, logs)
+}
+
+func TestJobActiveDeadlineSeconds(t *testing.T) {
+	testCases := []struct {
+		name     string
+		timeout  *metav1.Duration
+		expected *int64
+	}{
+		{
+			name:    "no timeout",
+			timeout: nil,
+		},
+		{
+			name:     "whole seconds",
+			timeout:  &metav1.Duration{Duration: 5 * time.Minute},
+			expected: ptr.To(int64(300)),
+		},
+		{
+			name:     "fractional seconds are rounded up",
+			timeout:  &metav1.Duration{Duration: 1500 * time.Millisecond},
+			expected: ptr.To(int64(2)),
+		},
+		{
+			name:     "sub-second timeout is at least one second",
+			timeout:  &metav1.Duration{Duration: 500 * time.Millisecond},
+			expected: ptr.To(int64(1)),
+		},
+		{
+			name:     "zero timeout is at least one second",
+			timeout:  &metav1.Duration{},
+			expected: ptr.To(int64(1)),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, jobActiveDeadlineSeconds(testCase.timeout))
+		})
+	}
+}
@@ -55,13 +55,21 @@ func (k *kustomizer) apply(
 ) ([]string, error) {
 	changeSummary := make([]string, 0, len(update.Kustomize.Images))
 	for _, imgUpdate := range update.Kustomize.Images {
+		image, name, newName := kustomizeImageNames(imgUpdate)
 		var fqImageRef string // Fully-qualified image reference
 		for _, img := range newFreight.Images {
-			if img.RepoURL == imgUpdate.Image {
+			if img.RepoURL == image {
 				if imgUpdate.UseDigest {
-					fqImageRef = fmt.Sprintf("%s@%s", img.RepoURL, img.Digest)
+					if img.Digest == "" {
+						return nil, fmt.Errorf(
+							"cannot update image %q using its digest: Freight does not "+
+								"specify a digest for this image",
+							image,
+						)
+					}
+					fqImageRef = fmt.Sprintf("%s@%s", newName, img.Digest)
 				} else {
-					fqImageRef = fmt.Sprintf("%s:%s", img.RepoURL, img.Tag)
+					fqImageRef = fmt.Sprintf("%s:%s", newName, img.Tag)
 				}
 				break
 			}
@@ -70,11 +78,15 @@ func (k *kustomizer) apply(
 			// TODO: Warn?
 			continue
 		}
+		if name != newName {
+			// Tells Kustomize to replace references to name with the new image
+			fqImageRef = fmt.Sprintf("%s=%s", name, fqImageRef)
+		}
 		dir := filepath.Join(workingDir, imgUpdate.Path)
 		if err := k.setImageFn(dir, fqImageRef); err != nil {
 			return nil, fmt.Errorf(
 				"error updating image %q to %q using Kustomize: %w",
-				imgUpdate.Image,
+				name,
 				fqImageRef,
 				err,
 			)
@@ -90,3 +102,28 @@ func (k *kustomizer) apply(
 	}
 	return changeSummary, nil
 }
+
+// kustomizeImageNames returns the name of the image in the Freight whose
+// version should be used, the name of the image as referenced by the
+// manifests, and the name it should be replaced with, applying the defaults
+// documented on KustomizeImageUpdate.
+func kustomizeImageNames(
+	imgUpdate kargoapi.KustomizeImageUpdate,
+) (image, name, newName string) {
+	image = imgUpdate.Image
+	if image == "" {
+		image = imgUpdate.NewName
+	}
+	if image == "" {
+		image = imgUpdate.Name
+	}
+	name = imgUpdate.Name
+	if name == "" {
+		name = image
+	}
+	newName = imgUpdate.NewName
+	if newName == "" {
+		newName = image
+	}
+	return image, name, newName
+}
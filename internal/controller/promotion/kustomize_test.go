@@ -152,6 +152,69 @@ func TestKustomizerApply(t *testing.T) {
 				)
 			},
 		},
+		{
+			name: "success remapping image name",
+			update: kargoapi.GitRepoUpdate{
+				Kustomize: &kargoapi.KustomizePromotionMechanism{
+					Images: []kargoapi.KustomizeImageUpdate{
+						{
+							Image: "fake-image",
+							Name:  "fake-name",
+							Path:  "fake-path",
+						},
+						{
+							Name:    "another-fake-name",
+							NewName: "fake-image",
+							Path:    "fake-path",
+						},
+					},
+				},
+			},
+			kustomizer: &kustomizer{
+				setImageFn: func(_ string, fqImageRef string) error {
+					if fqImageRef != "fake-name=fake-image:fake-tag" &&
+						fqImageRef != "another-fake-name=fake-image:fake-tag" {
+						return errors.New("unexpected image reference")
+					}
+					return nil
+				},
+			},
+			assertions: func(t *testing.T, changes []string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{
+						"updated fake-path/kustomization.yaml to use image " +
+							"fake-name=fake-image:fake-tag",
+						"updated fake-path/kustomization.yaml to use image " +
+							"another-fake-name=fake-image:fake-tag",
+					},
+					changes,
+				)
+			},
+		},
+		{
+			name: "digest requested, but not known",
+			update: kargoapi.GitRepoUpdate{
+				Kustomize: &kargoapi.KustomizePromotionMechanism{
+					Images: []kargoapi.KustomizeImageUpdate{
+						{
+							Image:     "image-without-digest",
+							Path:      "fake-path",
+							UseDigest: true,
+						},
+					},
+				},
+			},
+			kustomizer: &kustomizer{
+				setImageFn: func(string, string) error {
+					return errors.New("should not be called")
+				},
+			},
+			assertions: func(t *testing.T, _ []string, err error) {
+				require.ErrorContains(t, err, `cannot update image "image-without-digest" using its digest`)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -164,6 +227,10 @@ func TestKustomizerApply(t *testing.T) {
 							Tag:     "fake-tag",
 							Digest:  "fake-digest",
 						},
+						{
+							RepoURL: "image-without-digest",
+							Tag:     "fake-tag",
+						},
 					},
 				},
 				"",
@@ -175,3 +242,56 @@ func TestKustomizerApply(t *testing.T) {
 		})
 	}
 }
+
+func TestKustomizeImageNames(t *testing.T) {
+	testCases := []struct {
+		name            string
+		update          kargoapi.KustomizeImageUpdate
+		expectedImage   string
+		expectedName    string
+		expectedNewName string
+	}{
+		{
+			name:            "only image",
+			update:          kargoapi.KustomizeImageUpdate{Image: "fake-image"},
+			expectedImage:   "fake-image",
+			expectedName:    "fake-image",
+			expectedNewName: "fake-image",
+		},
+		{
+			name: "image and name",
+			update: kargoapi.KustomizeImageUpdate{
+				Image: "fake-image",
+				Name:  "fake-name",
+			},
+			expectedImage:   "fake-image",
+			expectedName:    "fake-name",
+			expectedNewName: "fake-image",
+		},
+		{
+			name: "name and new name",
+			update: kargoapi.KustomizeImageUpdate{
+				Name:    "fake-name",
+				NewName: "fake-new-name",
+			},
+			expectedImage:   "fake-new-name",
+			expectedName:    "fake-name",
+			expectedNewName: "fake-new-name",
+		},
+		{
+			name:            "only name",
+			update:          kargoapi.KustomizeImageUpdate{Name: "fake-name"},
+			expectedImage:   "fake-name",
+			expectedName:    "fake-name",
+			expectedNewName: "fake-name",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			image, name, newName := kustomizeImageNames(testCase.update)
+			require.Equal(t, testCase.expectedImage, image)
+			require.Equal(t, testCase.expectedName, name)
+			require.Equal(t, testCase.expectedNewName, newName)
+		})
+	}
+}
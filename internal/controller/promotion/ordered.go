@@ -0,0 +1,255 @@
+package promotion
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// orderedMechanism is an implementation of the Mechanism interface that
+// carries out the steps of a Stage's promotion mechanisms one at a time, in an
+// order that honors any dependencies declared between them. When no step
+// declares any dependencies, it defers entirely to a default Mechanism, which
+// applies the implicit order of Git-based mechanisms, then Jobs, then Argo
+// CD-based mechanisms.
+type orderedMechanism struct {
+	defaultMechanism Mechanism
+	gitMechanism     Mechanism
+	jobMechanism     Mechanism
+	argoCDMechanism  Mechanism
+}
+
+// newOrderedMechanism returns an implementation of the Mechanism interface
+// that carries out the steps of a Stage's promotion mechanisms in an order that
+// honors any dependencies declared between them.
+func newOrderedMechanism(
+	gitMechanism Mechanism,
+	jobMechanism Mechanism,
+	argoCDMechanism Mechanism,
+) Mechanism {
+	return &orderedMechanism{
+		defaultMechanism: newCompositeMechanism(
+			"promotion mechanisms",
+			gitMechanism,
+			jobMechanism,
+			argoCDMechanism,
+		),
+		gitMechanism:    gitMechanism,
+		jobMechanism:    jobMechanism,
+		argoCDMechanism: argoCDMechanism,
+	}
+}
+
+// GetName implements the Mechanism interface.
+func (o *orderedMechanism) GetName() string {
+	return o.defaultMechanism.GetName()
+}
+
+// Promote implements the Mechanism interface.
+func (o *orderedMechanism) Promote(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	promo *kargoapi.Promotion,
+	newFreight kargoapi.FreightReference,
+) (*kargoapi.PromotionStatus, kargoapi.FreightReference, error) {
+	promoMechs := stage.Spec.PromotionMechanisms
+	if promoMechs == nil || !hasStepDependencies(promoMechs) {
+		return o.defaultMechanism.Promote(ctx, stage, promo, newFreight)
+	}
+
+	steps, err := orderPromotionSteps(promoMechs)
+	if err != nil {
+		return nil, newFreight, err
+	}
+
+	var newStatus *kargoapi.PromotionStatus
+	newFreight = *newFreight.DeepCopy()
+
+	logger := logging.LoggerFromContext(ctx)
+	logger.Debug("executing promotion steps in dependency order")
+
+	for _, step := range steps {
+		mechanism := o.argoCDMechanism
+		switch {
+		case len(step.mechanisms.GitRepoUpdates) > 0:
+			mechanism = o.gitMechanism
+		case len(step.mechanisms.Jobs) > 0:
+			mechanism = o.jobMechanism
+		}
+		stepStage := stage.DeepCopy()
+		stepStage.Spec.PromotionMechanisms = step.mechanisms.DeepCopy()
+
+		var otherStatus *kargoapi.PromotionStatus
+		otherStatus, newFreight, err = mechanism.Promote(ctx, stepStage, promo, newFreight)
+		if err != nil {
+			if otherStatus != nil {
+				newStatus = aggregateGitPromoStatus(newStatus, *otherStatus)
+			}
+			return newStatus, newFreight, fmt.Errorf(
+				"error executing promotion step %s: %w",
+				step.label,
+				err,
+			)
+		}
+		newStatus = aggregateGitPromoStatus(newStatus, *otherStatus)
+		if newStatus.Phase != kargoapi.PromotionPhaseSucceeded {
+			// Every step after this one may depend on it, directly or otherwise,
+			// so nothing more is started until it has succeeded. If it failed,
+			// this fails the Promotion without starting any other step.
+			logger.Debugf("promotion step %s is %s", step.label, newStatus.Phase)
+			break
+		}
+	}
+
+	logger.Debug("done executing promotion steps. aggregated status: ", newStatus.Phase)
+
+	return newStatus, newFreight, nil
+}
+
+// promotionStep is a single step of a Stage's promotion mechanisms.
+type promotionStep struct {
+	// label identifies the step in log and error messages.
+	label     string
+	name      string
+	dependsOn []string
+	// mechanisms holds the step as the sole entry of a PromotionMechanisms.
+	mechanisms kargoapi.PromotionMechanisms
+}
+
+// hasStepDependencies returns true if any step of the provided promotion
+// mechanisms declares a dependency on another.
+func hasStepDependencies(promoMechs *kargoapi.PromotionMechanisms) bool {
+	for _, update := range promoMechs.GitRepoUpdates {
+		if len(update.DependsOn) > 0 {
+			return true
+		}
+	}
+	for _, job := range promoMechs.Jobs {
+		if len(job.DependsOn) > 0 {
+			return true
+		}
+	}
+	for _, update := range promoMechs.ArgoCDAppUpdates {
+		if len(update.DependsOn) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// orderPromotionSteps breaks the provided promotion mechanisms into individual
+// steps and returns them in an order that honors the dependencies between
+// them. Among steps whose dependencies have all been satisfied, Git repository
+// updates come first, then Jobs, then Argo CD App updates, each in the order
+// listed. An error is returned if a dependency refers to an unknown step or if
+// the dependencies form a cycle. Both are normally rejected at admission.
+func orderPromotionSteps(
+	promoMechs *kargoapi.PromotionMechanisms,
+) ([]promotionStep, error) {
+	steps := make(
+		[]promotionStep,
+		0,
+		len(promoMechs.GitRepoUpdates)+len(promoMechs.Jobs)+len(promoMechs.ArgoCDAppUpdates),
+	)
+	for i, update := range promoMechs.GitRepoUpdates {
+		steps = append(steps, promotionStep{
+			label:     stepLabel(update.Name, "gitRepoUpdates", i),
+			name:      update.Name,
+			dependsOn: update.DependsOn,
+			mechanisms: kargoapi.PromotionMechanisms{
+				GitRepoUpdates: []kargoapi.GitRepoUpdate{update},
+			},
+		})
+	}
+	for i, job := range promoMechs.Jobs {
+		steps = append(steps, promotionStep{
+			label:     stepLabel(job.Name, "jobs", i),
+			name:      job.Name,
+			dependsOn: job.DependsOn,
+			mechanisms: kargoapi.PromotionMechanisms{
+				Jobs: []kargoapi.PromotionJob{job},
+			},
+		})
+	}
+	for i, update := range promoMechs.ArgoCDAppUpdates {
+		steps = append(steps, promotionStep{
+			label:     stepLabel(update.Name, "argoCDAppUpdates", i),
+			name:      update.Name,
+			dependsOn: update.DependsOn,
+			mechanisms: kargoapi.PromotionMechanisms{
+				ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{update},
+			},
+		})
+	}
+
+	known := make(map[string]struct{}, len(steps))
+	for _, step := range steps {
+		if step.name != "" {
+			known[step.name] = struct{}{}
+		}
+	}
+	for _, step := range steps {
+		for _, dep := range step.dependsOn {
+			if _, ok := known[dep]; !ok {
+				return nil, fmt.Errorf(
+					"promotion step %s depends on unknown step %q",
+					step.label,
+					dep,
+				)
+			}
+		}
+	}
+
+	ordered := make([]promotionStep, 0, len(steps))
+	done := make(map[string]struct{}, len(steps))
+	scheduled := make([]bool, len(steps))
+	for len(ordered) < len(steps) {
+		next := -1
+		for i, step := range steps {
+			if scheduled[i] {
+				continue
+			}
+			ready := true
+			for _, dep := range step.dependsOn {
+				if _, ok := done[dep]; !ok {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				next = i
+				break
+			}
+		}
+		if next == -1 {
+			var blocked []string
+			for i, step := range steps {
+				if !scheduled[i] {
+					blocked = append(blocked, step.label)
+				}
+			}
+			return nil, fmt.Errorf(
+				"dependencies between promotion steps %s form a cycle",
+				strings.Join(blocked, ", "),
+			)
+		}
+		scheduled[next] = true
+		if steps[next].name != "" {
+			done[steps[next].name] = struct{}{}
+		}
+		ordered = append(ordered, steps[next])
+	}
+	return ordered, nil
+}
+
+// stepLabel returns the name of a step if it has one, or else its position
+// within the promotion mechanisms, e.g. gitRepoUpdates[0].
+func stepLabel(name, field string, index int) string {
+	if name != "" {
+		return fmt.Sprintf("%q", name)
+	}
+	return fmt.Sprintf("%s[%d]", field, index)
+}
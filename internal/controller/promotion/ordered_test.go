@@ -0,0 +1,291 @@
+package promotion
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestOrderedPromote(t *testing.T) {
+	// recordingMechanism returns a fake mechanism that, like the real ones,
+	// carries out only the steps of the kind it handles. It records each of
+	// them and reports the phase returned by phaseFn for each.
+	recordingMechanism := func(
+		kind string,
+		executed *[]string,
+		phaseFn func(step string) kargoapi.PromotionPhase,
+	) *FakeMechanism {
+		return &FakeMechanism{
+			Name: "fake promotion mechanism",
+			PromoteFn: func(
+				_ context.Context,
+				stage *kargoapi.Stage,
+				newFreight kargoapi.FreightReference,
+			) (*kargoapi.PromotionStatus, kargoapi.FreightReference, error) {
+				promoMechs := stage.Spec.PromotionMechanisms
+				var steps []string
+				switch kind {
+				case "git":
+					for _, update := range promoMechs.GitRepoUpdates {
+						steps = append(steps, "git:"+update.RepoURL)
+					}
+				case "job":
+					for _, job := range promoMechs.Jobs {
+						steps = append(steps, "job:"+job.Name)
+					}
+				case "argocd":
+					for _, update := range promoMechs.ArgoCDAppUpdates {
+						steps = append(steps, "argocd:"+update.AppName)
+					}
+				}
+				phase := kargoapi.PromotionPhaseSucceeded
+				for _, step := range steps {
+					*executed = append(*executed, step)
+					if p := phaseFn(step); p != kargoapi.PromotionPhaseSucceeded {
+						phase = p
+					}
+				}
+				return &kargoapi.PromotionStatus{Phase: phase}, newFreight, nil
+			},
+		}
+	}
+	succeeded := func(string) kargoapi.PromotionPhase {
+		return kargoapi.PromotionPhaseSucceeded
+	}
+
+	testCases := []struct {
+		name       string
+		promoMechs *kargoapi.PromotionMechanisms
+		phaseFn    func(step string) kargoapi.PromotionPhase
+		promoteErr error
+		assertions func(
+			t *testing.T,
+			executed []string,
+			status *kargoapi.PromotionStatus,
+			err error,
+		)
+	}{
+		{
+			name: "no dependencies",
+			promoMechs: &kargoapi.PromotionMechanisms{
+				GitRepoUpdates:   []kargoapi.GitRepoUpdate{{RepoURL: "repo"}},
+				ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{{AppName: "app"}},
+				Jobs:             []kargoapi.PromotionJob{{Name: "job"}},
+			},
+			phaseFn: succeeded,
+			assertions: func(
+				t *testing.T,
+				executed []string,
+				status *kargoapi.PromotionStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+				require.Equal(t, []string{"git:repo", "job:job", "argocd:app"}, executed)
+			},
+		},
+		{
+			name: "steps run in dependency order",
+			promoMechs: &kargoapi.PromotionMechanisms{
+				GitRepoUpdates: []kargoapi.GitRepoUpdate{
+					{Name: "manifests", RepoURL: "repo", DependsOn: []string{"migrate"}},
+				},
+				Jobs: []kargoapi.PromotionJob{
+					{Name: "migrate"},
+					{Name: "smoke-test", DependsOn: []string{"sync"}},
+				},
+				ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+					{Name: "sync", AppName: "app", DependsOn: []string{"manifests"}},
+				},
+			},
+			phaseFn: succeeded,
+			assertions: func(
+				t *testing.T,
+				executed []string,
+				status *kargoapi.PromotionStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+				require.Equal(
+					t,
+					[]string{"job:migrate", "git:repo", "argocd:app", "job:smoke-test"},
+					executed,
+				)
+			},
+		},
+		{
+			name: "failed dependency stops later steps",
+			promoMechs: &kargoapi.PromotionMechanisms{
+				GitRepoUpdates: []kargoapi.GitRepoUpdate{
+					{Name: "manifests", RepoURL: "repo", DependsOn: []string{"migrate"}},
+				},
+				Jobs: []kargoapi.PromotionJob{{Name: "migrate"}},
+			},
+			phaseFn: func(step string) kargoapi.PromotionPhase {
+				if step == "job:migrate" {
+					return kargoapi.PromotionPhaseFailed
+				}
+				return kargoapi.PromotionPhaseSucceeded
+			},
+			assertions: func(
+				t *testing.T,
+				executed []string,
+				status *kargoapi.PromotionStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseFailed, status.Phase)
+				require.Equal(t, []string{"job:migrate"}, executed)
+			},
+		},
+		{
+			name: "errored dependency stops later steps",
+			promoMechs: &kargoapi.PromotionMechanisms{
+				GitRepoUpdates: []kargoapi.GitRepoUpdate{
+					{Name: "manifests", RepoURL: "repo"},
+				},
+				ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+					{AppName: "app", DependsOn: []string{"manifests"}},
+				},
+			},
+			phaseFn:    succeeded,
+			promoteErr: errors.New("something went wrong"),
+			assertions: func(
+				t *testing.T,
+				executed []string,
+				_ *kargoapi.PromotionStatus,
+				err error,
+			) {
+				require.ErrorContains(t, err, `error executing promotion step "manifests"`)
+				require.ErrorContains(t, err, "something went wrong")
+				require.Equal(t, []string{"git:repo"}, executed)
+			},
+		},
+		{
+			name: "dependency cycle",
+			promoMechs: &kargoapi.PromotionMechanisms{
+				GitRepoUpdates: []kargoapi.GitRepoUpdate{
+					{Name: "manifests", DependsOn: []string{"sync"}},
+				},
+				ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+					{Name: "sync", DependsOn: []string{"manifests"}},
+				},
+			},
+			phaseFn: succeeded,
+			assertions: func(
+				t *testing.T,
+				executed []string,
+				_ *kargoapi.PromotionStatus,
+				err error,
+			) {
+				require.ErrorContains(t, err, "form a cycle")
+				require.Empty(t, executed)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var executed []string
+			gitMech := recordingMechanism("git", &executed, testCase.phaseFn)
+			if testCase.promoteErr != nil {
+				recordingPromoteFn := gitMech.PromoteFn
+				gitMech.PromoteFn = func(
+					ctx context.Context,
+					stage *kargoapi.Stage,
+					newFreight kargoapi.FreightReference,
+				) (*kargoapi.PromotionStatus, kargoapi.FreightReference, error) {
+					_, _, _ = recordingPromoteFn(ctx, stage, newFreight)
+					return nil, newFreight, testCase.promoteErr
+				}
+			}
+			mech := newOrderedMechanism(
+				gitMech,
+				recordingMechanism("job", &executed, testCase.phaseFn),
+				recordingMechanism("argocd", &executed, testCase.phaseFn),
+			)
+			status, _, err := mech.Promote(
+				context.Background(),
+				&kargoapi.Stage{
+					Spec: kargoapi.StageSpec{
+						PromotionMechanisms: testCase.promoMechs,
+					},
+				},
+				&kargoapi.Promotion{},
+				kargoapi.FreightReference{},
+			)
+			testCase.assertions(t, executed, status, err)
+		})
+	}
+}
+
+func TestOrderPromotionSteps(t *testing.T) {
+	testCases := []struct {
+		name       string
+		promoMechs *kargoapi.PromotionMechanisms
+		assertions func(*testing.T, []promotionStep, error)
+	}{
+		{
+			name: "implicit order is preserved",
+			promoMechs: &kargoapi.PromotionMechanisms{
+				GitRepoUpdates:   []kargoapi.GitRepoUpdate{{}, {Name: "manifests"}},
+				Jobs:             []kargoapi.PromotionJob{{Name: "migrate"}},
+				ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{{}},
+			},
+			assertions: func(t *testing.T, steps []promotionStep, err error) {
+				require.NoError(t, err)
+				labels := make([]string, len(steps))
+				for i, step := range steps {
+					labels[i] = step.label
+				}
+				require.Equal(
+					t,
+					[]string{`gitRepoUpdates[0]`, `"manifests"`, `"migrate"`, `argoCDAppUpdates[0]`},
+					labels,
+				)
+			},
+		},
+		{
+			name: "unknown dependency",
+			promoMechs: &kargoapi.PromotionMechanisms{
+				ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+					{DependsOn: []string{"manifests"}},
+				},
+			},
+			assertions: func(t *testing.T, _ []promotionStep, err error) {
+				require.ErrorContains(
+					t,
+					err,
+					`promotion step argoCDAppUpdates[0] depends on unknown step "manifests"`,
+				)
+			},
+		},
+		{
+			name: "cycle",
+			promoMechs: &kargoapi.PromotionMechanisms{
+				Jobs: []kargoapi.PromotionJob{
+					{Name: "a", DependsOn: []string{"b"}},
+					{Name: "b", DependsOn: []string{"a"}},
+					{Name: "c"},
+				},
+			},
+			assertions: func(t *testing.T, _ []promotionStep, err error) {
+				require.ErrorContains(
+					t,
+					err,
+					`dependencies between promotion steps "a", "b" form a cycle`,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			steps, err := orderPromotionSteps(testCase.promoMechs)
+			testCase.assertions(t, steps, err)
+		})
+	}
+}
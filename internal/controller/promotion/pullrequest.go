@@ -112,6 +112,52 @@ func newGitProvider(
 	return gpClient, nil
 }
 
+// pullRequestTemplateData is the data available to the templates used to
+// render the title and description of a pull request.
+type pullRequestTemplateData struct {
+	Project       string
+	Stage         string
+	Freight       kargoapi.FreightReference
+	CommitMessage string
+}
+
+// buildCreatePullRequestOpts returns options for opening a pull request from
+// prBranch into writeBranch, rendering the title and description templates
+// from the provided PullRequestPromotionMechanism, if any.
+func buildCreatePullRequestOpts(
+	pullRequest *kargoapi.PullRequestPromotionMechanism,
+	data pullRequestTemplateData,
+	prBranch string,
+	writeBranch string,
+) (gitprovider.CreatePullRequestOpts, error) {
+	opts := gitprovider.CreatePullRequestOpts{
+		Head:   prBranch,
+		Base:   writeBranch,
+		Title:  data.CommitMessage,
+		Labels: pullRequest.Labels,
+	}
+	var err error
+	if pullRequest.TitleTemplate != "" {
+		if opts.Title, err = renderTemplate(
+			"pull request title",
+			pullRequest.TitleTemplate,
+			data,
+		); err != nil {
+			return opts, err
+		}
+	}
+	if pullRequest.DescriptionTemplate != "" {
+		if opts.Description, err = renderTemplate(
+			"pull request description",
+			pullRequest.DescriptionTemplate,
+			data,
+		); err != nil {
+			return opts, err
+		}
+	}
+	return opts, nil
+}
+
 // reconcilePullRequest creates and monitors a pull request for the promotion,
 // then returns a PromotionStatus reflecting current status adding metadata
 // it tracks (i.e. PR url).
@@ -120,6 +166,8 @@ func reconcilePullRequest(
 	status kargoapi.PromotionStatus,
 	repo git.Repo,
 	gpClient gitprovider.GitProviderService,
+	pullRequest *kargoapi.PullRequestPromotionMechanism,
+	tmplData pullRequestTemplateData,
 	prBranch string,
 	writeBranch string,
 ) (string, *kargoapi.PromotionStatus, error) {
@@ -133,14 +181,17 @@ func reconcilePullRequest(
 			return "", nil, err
 		}
 		if needsPR {
-			title, err := repo.CommitMessage(prBranch)
-			if err != nil {
+			if tmplData.CommitMessage, err = repo.CommitMessage(prBranch); err != nil {
 				return "", nil, err
 			}
-			createOpts := gitprovider.CreatePullRequestOpts{
-				Head:  prBranch,
-				Base:  writeBranch,
-				Title: title,
+			createOpts, err := buildCreatePullRequestOpts(
+				pullRequest,
+				tmplData,
+				prBranch,
+				writeBranch,
+			)
+			if err != nil {
+				return "", nil, err
 			}
 			pr, err := gpClient.CreatePullRequest(ctx, repo.URL(), createOpts)
 			if err != nil {
@@ -0,0 +1,101 @@
+package promotion
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/gitprovider"
+)
+
+func TestBuildCreatePullRequestOpts(t *testing.T) {
+	testData := pullRequestTemplateData{
+		Project: "fake-project",
+		Stage:   "fake-stage",
+		Freight: kargoapi.FreightReference{
+			Name: "fake-freight",
+			Images: []kargoapi.Image{
+				{
+					RepoURL: "fake-url",
+					Tag:     "fake-tag",
+				},
+			},
+		},
+		CommitMessage: "fake-commit-message",
+	}
+	testCases := []struct {
+		name        string
+		pullRequest *kargoapi.PullRequestPromotionMechanism
+		assertions  func(*testing.T, gitprovider.CreatePullRequestOpts, error)
+	}{
+		{
+			name:        "no templates",
+			pullRequest: &kargoapi.PullRequestPromotionMechanism{},
+			assertions: func(t *testing.T, opts gitprovider.CreatePullRequestOpts, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					gitprovider.CreatePullRequestOpts{
+						Head:  "fake-pr-branch",
+						Base:  "fake-write-branch",
+						Title: "fake-commit-message",
+					},
+					opts,
+				)
+			},
+		},
+		{
+			name: "invalid title template",
+			pullRequest: &kargoapi.PullRequestPromotionMechanism{
+				TitleTemplate: "{{ .Stage ",
+			},
+			assertions: func(t *testing.T, _ gitprovider.CreatePullRequestOpts, err error) {
+				require.ErrorContains(t, err, "error parsing pull request title template")
+			},
+		},
+		{
+			name: "description template refers to unknown field",
+			pullRequest: &kargoapi.PullRequestPromotionMechanism{
+				DescriptionTemplate: "{{ .Bogus }}",
+			},
+			assertions: func(t *testing.T, _ gitprovider.CreatePullRequestOpts, err error) {
+				require.ErrorContains(t, err, "error rendering pull request description template")
+			},
+		},
+		{
+			name: "templates and labels",
+			pullRequest: &kargoapi.PullRequestPromotionMechanism{
+				TitleTemplate: "Promote {{ .Freight.Name }} to {{ .Project }}/{{ .Stage }}",
+				DescriptionTemplate: "{{ .CommitMessage }}\n" +
+					"{{ range .Freight.Images }}* {{ .RepoURL }}:{{ .Tag }}\n{{ end }}",
+				Labels: []string{"kargo", "promotion"},
+			},
+			assertions: func(t *testing.T, opts gitprovider.CreatePullRequestOpts, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					gitprovider.CreatePullRequestOpts{
+						Head:        "fake-pr-branch",
+						Base:        "fake-write-branch",
+						Title:       "Promote fake-freight to fake-project/fake-stage",
+						Description: "fake-commit-message\n* fake-url:fake-tag\n",
+						Labels:      []string{"kargo", "promotion"},
+					},
+					opts,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			opts, err := buildCreatePullRequestOpts(
+				testCase.pullRequest,
+				testData,
+				"fake-pr-branch",
+				"fake-write-branch",
+			)
+			testCase.assertions(t, opts, err)
+		})
+	}
+}
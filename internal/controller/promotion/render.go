@@ -58,9 +58,21 @@ func (r *renderer) apply(
 	images := make([]string, 0, len(newFreight.Images))
 	if len(update.Render.Images) == 0 {
 		// When no explicit image updates are specified, we will pass all images
-		// from the Freight in <ulr>:<tag> format.
+		// from the Freight in <url>:<tag> format, or in <url>@<digest> format if
+		// so requested.
 		for _, image := range newFreight.Images {
-			images = append(images, fmt.Sprintf("%s:%s", image.RepoURL, image.Tag))
+			if !update.Render.UseDigests {
+				images = append(images, fmt.Sprintf("%s:%s", image.RepoURL, image.Tag))
+				continue
+			}
+			if image.Digest == "" {
+				return nil, fmt.Errorf(
+					"cannot pass image %q to Kargo Render using its digest: Freight "+
+						"does not specify a digest for this image",
+					image.RepoURL,
+				)
+			}
+			images = append(images, fmt.Sprintf("%s@%s", image.RepoURL, image.Digest))
 		}
 	} else {
 		// When explicit image updates are specified, we will only pass images with
@@ -94,11 +106,17 @@ func (r *renderer) apply(
 	defer os.RemoveAll(tempDir)
 	writeDir := filepath.Join(tempDir, "rendered-manifests")
 
+	targetBranch := update.Render.TargetBranch
+	if targetBranch == "" {
+		targetBranch = update.WriteBranch
+	}
+
 	req := render.Request{
-		TargetBranch: update.WriteBranch,
+		TargetBranch: targetBranch,
 		Images:       images,
 		LocalInPath:  workingDir,
 		LocalOutPath: writeDir,
+		AllowEmpty:   update.Render.AllowEmpty,
 		RepoCreds:    repoCreds,
 	}
 
@@ -209,6 +209,80 @@ func TestKargoRenderApply(t *testing.T) {
 				)
 			},
 		},
+		{
+			name: "update requests digests, but Freight has none",
+			update: kargoapi.GitRepoUpdate{
+				Render: &kargoapi.KargoRenderPromotionMechanism{
+					UseDigests: true,
+				},
+			},
+			newFreight: kargoapi.FreightReference{
+				Images: []kargoapi.Image{
+					{
+						RepoURL: "fake-url",
+						Tag:     "fake-tag",
+					},
+				},
+			},
+			renderer: &renderer{
+				renderManifestsFn: func(render.Request) error {
+					return errors.New("should not be called")
+				},
+			},
+			assertions: func(t *testing.T, _ []string, _ string, err error) {
+				require.ErrorContains(
+					t,
+					err,
+					`cannot pass image "fake-url" to Kargo Render using its digest`,
+				)
+			},
+		},
+		{
+			name: "update specifies options",
+			update: kargoapi.GitRepoUpdate{
+				WriteBranch: "fake-write-branch",
+				Render: &kargoapi.KargoRenderPromotionMechanism{
+					TargetBranch: "fake-target-branch",
+					UseDigests:   true,
+					AllowEmpty:   true,
+				},
+			},
+			newFreight: kargoapi.FreightReference{
+				Images: []kargoapi.Image{
+					{
+						RepoURL: "fake-url",
+						Tag:     "fake-tag",
+						Digest:  "fake-digest",
+					},
+				},
+			},
+			renderer: &renderer{
+				renderManifestsFn: func(req render.Request) error {
+					if req.TargetBranch != "fake-target-branch" {
+						return fmt.Errorf("unexpected target branch %q", req.TargetBranch)
+					}
+					if !req.AllowEmpty {
+						return errors.New("expected empty output to be allowed")
+					}
+					// Render nothing
+					return os.MkdirAll(req.LocalOutPath, 0755)
+				},
+			},
+			assertions: func(t *testing.T, changeSummary []string, workDir string, err error) {
+				require.NoError(t, err)
+				files, err := os.ReadDir(workDir)
+				require.NoError(t, err)
+				require.Empty(t, files)
+				require.Equal(
+					t,
+					[]string{
+						fmt.Sprintf("rendered manifests from commit %s", testSourceCommitID[:7]),
+						"updated manifests to use image fake-url@fake-digest",
+					},
+					changeSummary,
+				)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		testWorkDir := t.TempDir()
@@ -3,6 +3,7 @@ package promotion
 import (
 	"context"
 
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
@@ -27,18 +28,22 @@ type Mechanism interface {
 // NewMechanisms returns the entrypoint to a hierarchical tree of promotion
 // mechanisms.
 func NewMechanisms(
+	kargoClient client.Client,
+	podsClient typedcorev1.PodsGetter,
 	argocdClient client.Client,
+	argocdInstanceClients map[string]client.Client,
 	credentialsDB credentials.Database,
 ) Mechanism {
-	return newCompositeMechanism(
-		"promotion mechanisms",
+	return newOrderedMechanism(
 		newCompositeMechanism(
 			"Git-based promotion mechanisms",
 			newGenericGitMechanism(credentialsDB),
 			newKargoRenderMechanism(credentialsDB),
 			newKustomizeMechanism(credentialsDB),
 			newHelmMechanism(credentialsDB),
+			newYAMLMechanism(credentialsDB),
 		),
-		newArgoCDMechanism(argocdClient),
+		newJobMechanism(kargoClient, podsClient),
+		newArgoCDMechanism(argocdClient, argocdInstanceClients),
 	)
 }
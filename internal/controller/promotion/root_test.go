@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
@@ -12,11 +14,19 @@ import (
 )
 
 func TestNewMechanisms(t *testing.T) {
+	credentialsDB, err :=
+		credentials.NewKubernetesDatabase(nil, credentials.KubernetesDatabaseConfig{})
+	require.NoError(t, err)
 	promoMechs := NewMechanisms(
 		fake.NewClientBuilder().Build(),
-		credentials.NewKubernetesDatabase(nil, credentials.KubernetesDatabaseConfig{}),
+		k8sfake.NewSimpleClientset().CoreV1(),
+		fake.NewClientBuilder().Build(),
+		map[string]client.Client{
+			"fake-instance": fake.NewClientBuilder().Build(),
+		},
+		credentialsDB,
 	)
-	require.IsType(t, &compositeMechanism{}, promoMechs)
+	require.IsType(t, &orderedMechanism{}, promoMechs)
 }
 
 // FakeMechanism is a fake implementation of the Mechanism interface used for
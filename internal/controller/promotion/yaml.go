@@ -0,0 +1,97 @@
+package promotion
+
+import (
+	"fmt"
+	"path/filepath"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/git"
+	"github.com/akuity/kargo/internal/credentials"
+	libYAML "github.com/akuity/kargo/internal/yaml"
+)
+
+// newYAMLMechanism returns a gitMechanism that only selects and performs
+// updates that directly modify plain YAML files.
+func newYAMLMechanism(
+	credentialsDB credentials.Database,
+) Mechanism {
+	return newGitMechanism(
+		"YAML promotion mechanism",
+		credentialsDB,
+		selectYAMLUpdates,
+		(&yamlUpdater{
+			buildYAMLFilesChangesFn: buildYAMLFilesChanges,
+			setStringsInYAMLFileFn:  libYAML.SetStringsInFile,
+		}).apply,
+	)
+}
+
+// selectYAMLUpdates returns a subset of the given updates that directly
+// modify plain YAML files.
+func selectYAMLUpdates(updates []kargoapi.GitRepoUpdate) []kargoapi.GitRepoUpdate {
+	selectedUpdates := make([]kargoapi.GitRepoUpdate, 0, len(updates))
+	for _, update := range updates {
+		if update.YAML != nil {
+			selectedUpdates = append(selectedUpdates, update)
+		}
+	}
+	return selectedUpdates
+}
+
+// yamlUpdater is a helper struct whose sole purpose is to close over several
+// other functions that are used in the implementation of the apply() function.
+type yamlUpdater struct {
+	buildYAMLFilesChangesFn func(
+		[]kargoapi.Image,
+		[]kargoapi.YAMLImageUpdate,
+	) (map[string]map[string]string, []string, error)
+	setStringsInYAMLFileFn func(file string, changes map[string]string) error
+}
+
+// apply updates values in plain YAML files in the specified working directory
+// to carry out the provided update. Comments and formatting in those files
+// are preserved.
+func (y *yamlUpdater) apply(
+	update kargoapi.GitRepoUpdate,
+	newFreight kargoapi.FreightReference,
+	_ string,
+	_ string,
+	workingDir string,
+	_ git.RepoCredentials,
+) ([]string, error) {
+	changesByFile, changeSummary, err :=
+		y.buildYAMLFilesChangesFn(newFreight.Images, update.YAML.Images)
+	if err != nil {
+		return nil, fmt.Errorf("error preparing changes to affected YAML files: %w", err)
+	}
+	for file, changes := range changesByFile {
+		if err = y.setStringsInYAMLFileFn(
+			filepath.Join(workingDir, file),
+			changes,
+		); err != nil {
+			return nil, fmt.Errorf("error updating values in file %q: %w", file, err)
+		}
+	}
+	return changeSummary, nil
+}
+
+// buildYAMLFilesChanges takes a list of images and a list of instructions
+// about changes that should be made to various YAML files and distills them
+// into a map of maps that indexes new values for each YAML file by file name
+// and key. The values written are the same ones the Helm promotion mechanism
+// writes to values files.
+func buildYAMLFilesChanges(
+	images []kargoapi.Image,
+	imageUpdates []kargoapi.YAMLImageUpdate,
+) (map[string]map[string]string, []string, error) {
+	helmImageUpdates := make([]kargoapi.HelmImageUpdate, len(imageUpdates))
+	for i, imageUpdate := range imageUpdates {
+		helmImageUpdates[i] = kargoapi.HelmImageUpdate{
+			Image:          imageUpdate.Image,
+			ValuesFilePath: imageUpdate.FilePath,
+			Key:            imageUpdate.Key,
+			Value:          imageUpdate.Value,
+		}
+	}
+	return buildValuesFilesChanges(images, helmImageUpdates)
+}
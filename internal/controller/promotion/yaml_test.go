@@ -0,0 +1,267 @@
+package promotion
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/git"
+	"github.com/akuity/kargo/internal/credentials"
+	libYAML "github.com/akuity/kargo/internal/yaml"
+)
+
+func TestNewYAMLMechanism(t *testing.T) {
+	pm := newYAMLMechanism(&credentials.FakeDB{})
+	ypm, ok := pm.(*gitMechanism)
+	require.True(t, ok)
+	require.NotNil(t, ypm.selectUpdatesFn)
+	require.NotNil(t, ypm.applyConfigManagementFn)
+}
+
+func TestSelectYAMLUpdates(t *testing.T) {
+	testCases := []struct {
+		name       string
+		updates    []kargoapi.GitRepoUpdate
+		assertions func(*testing.T, []kargoapi.GitRepoUpdate)
+	}{
+		{
+			name: "no updates",
+			assertions: func(t *testing.T, selectedUpdates []kargoapi.GitRepoUpdate) {
+				require.Empty(t, selectedUpdates)
+			},
+		},
+		{
+			name: "no yaml updates",
+			updates: []kargoapi.GitRepoUpdate{
+				{
+					RepoURL: "fake-url",
+				},
+			},
+			assertions: func(t *testing.T, selectedUpdates []kargoapi.GitRepoUpdate) {
+				require.Empty(t, selectedUpdates)
+			},
+		},
+		{
+			name: "some yaml updates",
+			updates: []kargoapi.GitRepoUpdate{
+				{
+					RepoURL: "fake-url",
+					YAML:    &kargoapi.YAMLPromotionMechanism{},
+				},
+				{
+					RepoURL: "fake-url",
+					Helm:    &kargoapi.HelmPromotionMechanism{},
+				},
+				{
+					RepoURL: "fake-url",
+				},
+			},
+			assertions: func(t *testing.T, selectedUpdates []kargoapi.GitRepoUpdate) {
+				require.Len(t, selectedUpdates, 1)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(t, selectYAMLUpdates(testCase.updates))
+		})
+	}
+}
+
+func TestYAMLUpdaterApply(t *testing.T) {
+	const testManifest = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: fake-app
+spec:
+  template:
+    spec:
+      containers:
+      - name: fake-app
+        # Updated by Kargo
+        image: fake-url:old-tag # Don't touch this by hand
+`
+	testCases := []struct {
+		name       string
+		updater    *yamlUpdater
+		assertions func(t *testing.T, changes []string, workDir string, err error)
+	}{
+		{
+			name: "error building changes",
+			updater: &yamlUpdater{
+				buildYAMLFilesChangesFn: func(
+					[]kargoapi.Image,
+					[]kargoapi.YAMLImageUpdate,
+				) (map[string]map[string]string, []string, error) {
+					return nil, nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ []string, _ string, err error) {
+				require.ErrorContains(t, err, "error preparing changes to affected YAML files")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "error updating file",
+			updater: &yamlUpdater{
+				buildYAMLFilesChangesFn: buildYAMLFilesChanges,
+				setStringsInYAMLFileFn: func(string, map[string]string) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ []string, _ string, err error) {
+				require.ErrorContains(t, err, `error updating values in file "deployment.yaml"`)
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "success",
+			updater: &yamlUpdater{
+				buildYAMLFilesChangesFn: buildYAMLFilesChanges,
+				setStringsInYAMLFileFn:  libYAML.SetStringsInFile,
+			},
+			assertions: func(t *testing.T, changes []string, workDir string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{"updated deployment.yaml to use image fake-url:fake-tag"},
+					changes,
+				)
+				contents, err := os.ReadFile(filepath.Join(workDir, "deployment.yaml"))
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: fake-app
+spec:
+  template:
+    spec:
+      containers:
+      - name: fake-app
+        # Updated by Kargo
+        image: fake-url:fake-tag # Don't touch this by hand
+`,
+					string(contents),
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			workDir := t.TempDir()
+			require.NoError(
+				t,
+				os.WriteFile(
+					filepath.Join(workDir, "deployment.yaml"),
+					[]byte(testManifest),
+					0600,
+				),
+			)
+			changes, err := testCase.updater.apply(
+				kargoapi.GitRepoUpdate{
+					YAML: &kargoapi.YAMLPromotionMechanism{
+						Images: []kargoapi.YAMLImageUpdate{
+							{
+								Image:    "fake-url",
+								FilePath: "deployment.yaml",
+								Key:      "spec.template.spec.containers[0].image",
+								Value:    kargoapi.ImageUpdateValueTypeImageAndTag,
+							},
+						},
+					},
+				},
+				kargoapi.FreightReference{
+					Images: []kargoapi.Image{
+						{
+							RepoURL: "fake-url",
+							Tag:     "fake-tag",
+						},
+					},
+				},
+				"",
+				"",
+				workDir,
+				git.RepoCredentials{},
+			)
+			testCase.assertions(t, changes, workDir, err)
+		})
+	}
+}
+
+func TestBuildYAMLFilesChanges(t *testing.T) {
+	images := []kargoapi.Image{
+		{
+			RepoURL: "fake-url",
+			Tag:     "fake-tag",
+			Digest:  "fake-digest",
+		},
+		{
+			RepoURL: "another-fake-url",
+			Tag:     "another-fake-tag",
+		},
+	}
+	changes, changeSummary, err := buildYAMLFilesChanges(
+		images,
+		[]kargoapi.YAMLImageUpdate{
+			{
+				Image:    "fake-url",
+				FilePath: "deployment.yaml",
+				Key:      "spec.template.spec.containers[0].image",
+				Value:    kargoapi.ImageUpdateValueTypeImageAndDigest,
+			},
+			{
+				Image:    "another-fake-url",
+				FilePath: "cronjob.yaml",
+				Key:      "spec.jobTemplate.spec.template.spec.containers[0].image",
+				Value:    kargoapi.ImageUpdateValueTypeImageAndTag,
+			},
+			{
+				// There's no such image in the Freight
+				Image:    "yet-another-fake-url",
+				FilePath: "deployment.yaml",
+				Key:      "spec.template.spec.containers[1].image",
+				Value:    kargoapi.ImageUpdateValueTypeImageAndTag,
+			},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		map[string]map[string]string{
+			"deployment.yaml": {
+				"spec.template.spec.containers[0].image": "fake-url@fake-digest",
+			},
+			"cronjob.yaml": {
+				"spec.jobTemplate.spec.template.spec.containers[0].image": "another-fake-url:another-fake-tag",
+			},
+		},
+		changes,
+	)
+	require.Equal(
+		t,
+		[]string{
+			"updated deployment.yaml to use image fake-url@fake-digest",
+			"updated cronjob.yaml to use image another-fake-url:another-fake-tag",
+		},
+		changeSummary,
+	)
+
+	_, _, err = buildYAMLFilesChanges(
+		images,
+		[]kargoapi.YAMLImageUpdate{
+			{
+				Image:    "another-fake-url",
+				FilePath: "cronjob.yaml",
+				Key:      "spec.jobTemplate.spec.template.spec.containers[0].image",
+				Value:    kargoapi.ImageUpdateValueTypeDigest,
+			},
+		},
+	)
+	require.ErrorContains(t, err, "does not specify a digest")
+}
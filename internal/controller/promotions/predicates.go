@@ -2,6 +2,8 @@ package promotions
 
 import (
 	log "github.com/sirupsen/logrus"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 
 	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
@@ -58,3 +60,80 @@ func (p ArgoCDAppOperationCompleted) Delete(event.DeleteEvent) bool {
 func (p ArgoCDAppOperationCompleted) Generic(event.GenericEvent) bool {
 	return false
 }
+
+// ArgoCDAppConverging is a predicate that filters out ArgoCD Application Update
+// events other than those where the sync or health status of an Application
+// whose most recent operation has completed has changed. This is useful for
+// triggering a reconciliation of a Promotion that waits for an Application to
+// become Synced and Healthy after its operation has finished.
+type ArgoCDAppConverging struct{}
+
+func (p ArgoCDAppConverging) Create(event.CreateEvent) bool {
+	return false
+}
+
+func (p ArgoCDAppConverging) Update(e event.UpdateEvent) bool {
+	newApp, ok := e.ObjectNew.(*argocd.Application)
+	if !ok {
+		return false
+	}
+	oldApp, ok := e.ObjectOld.(*argocd.Application)
+	if !ok {
+		return false
+	}
+	if newApp.Status.OperationState == nil ||
+		!newApp.Status.OperationState.Phase.Completed() {
+		return false
+	}
+	return oldApp.Status.Sync.Status != newApp.Status.Sync.Status ||
+		oldApp.Status.Health.Status != newApp.Status.Health.Status
+}
+
+func (p ArgoCDAppConverging) Delete(event.DeleteEvent) bool {
+	return false
+}
+
+func (p ArgoCDAppConverging) Generic(event.GenericEvent) bool {
+	return false
+}
+
+// JobFinished is a predicate that filters out Job Update events other than
+// those where the Job has just completed or failed. This is useful for
+// triggering a reconciliation of a Promotion only when a Job created by the Job
+// promotion mechanism has finished.
+type JobFinished struct{}
+
+func (p JobFinished) Create(event.CreateEvent) bool {
+	return false
+}
+
+func (p JobFinished) Update(e event.UpdateEvent) bool {
+	newJob, ok := e.ObjectNew.(*batchv1.Job)
+	if !ok {
+		return false
+	}
+	oldJob, ok := e.ObjectOld.(*batchv1.Job)
+	if !ok {
+		return false
+	}
+	return jobFinished(newJob) && !jobFinished(oldJob)
+}
+
+func (p JobFinished) Delete(event.DeleteEvent) bool {
+	return false
+}
+
+func (p JobFinished) Generic(event.GenericEvent) bool {
+	return false
+}
+
+// jobFinished returns true if the provided Job has either completed or failed.
+func jobFinished(job *batchv1.Job) bool {
+	for _, cond := range job.Status.Conditions {
+		if (cond.Type == batchv1.JobComplete || cond.Type == batchv1.JobFailed) &&
+			cond.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
@@ -118,3 +118,90 @@ func TestArgoCDAppOperationCompleted_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestArgoCDAppConverging_Update(t *testing.T) {
+	completedApp := func(
+		sync argocd.SyncStatusCode,
+		health argocd.HealthStatusCode,
+	) *argocd.Application {
+		return &argocd.Application{
+			Status: argocd.ApplicationStatus{
+				Sync:   argocd.SyncStatus{Status: sync},
+				Health: argocd.HealthStatus{Status: health},
+				OperationState: &argocd.OperationState{
+					Phase: argocd.OperationSucceeded,
+				},
+			},
+		}
+	}
+	testCases := []struct {
+		name string
+		e    event.UpdateEvent
+		want bool
+	}{
+		{
+			name: "Failed to convert ObjectNew",
+			e: event.UpdateEvent{
+				ObjectOld: &argocd.Application{},
+				ObjectNew: &unstructured.Unstructured{},
+			},
+			want: false,
+		},
+		{
+			name: "No operation state",
+			e: event.UpdateEvent{
+				ObjectOld: &argocd.Application{},
+				ObjectNew: &argocd.Application{
+					Status: argocd.ApplicationStatus{
+						Health: argocd.HealthStatus{Status: argocd.HealthStatusHealthy},
+					},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "Operation running",
+			e: event.UpdateEvent{
+				ObjectOld: &argocd.Application{},
+				ObjectNew: &argocd.Application{
+					Status: argocd.ApplicationStatus{
+						Health: argocd.HealthStatus{Status: argocd.HealthStatusHealthy},
+						OperationState: &argocd.OperationState{
+							Phase: argocd.OperationRunning,
+						},
+					},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "Health changed after operation completed",
+			e: event.UpdateEvent{
+				ObjectOld: completedApp(argocd.SyncStatusCodeSynced, argocd.HealthStatusProgressing),
+				ObjectNew: completedApp(argocd.SyncStatusCodeSynced, argocd.HealthStatusHealthy),
+			},
+			want: true,
+		},
+		{
+			name: "Sync status changed after operation completed",
+			e: event.UpdateEvent{
+				ObjectOld: completedApp("OutOfSync", argocd.HealthStatusHealthy),
+				ObjectNew: completedApp(argocd.SyncStatusCodeSynced, argocd.HealthStatusHealthy),
+			},
+			want: true,
+		},
+		{
+			name: "Nothing relevant changed",
+			e: event.UpdateEvent{
+				ObjectOld: completedApp(argocd.SyncStatusCodeSynced, argocd.HealthStatusHealthy),
+				ObjectNew: completedApp(argocd.SyncStatusCodeSynced, argocd.HealthStatusHealthy),
+			},
+			want: false,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.want, ArgoCDAppConverging{}.Update(testCase.e))
+		})
+	}
+}
@@ -14,22 +14,40 @@ import (
 )
 
 // promoQueues is a data structure to hold priority queues of all Stages
-// as well as the "active" promotion for each stage
+// as well as the "active" promotions for each stage
 type promoQueues struct {
-	// activePromoByStage holds the active promotion for a given stage (if any)
-	activePromoByStage map[types.NamespacedName]string
-	// pendingPromoQueuesByStage holds a priority queue of promotions, per Stage. We allow one
-	// promotion to run at a time, ordered by creationTimestamp.
+	// activePromosByStage holds the names of the active promotions for a given
+	// stage (if any)
+	activePromosByStage map[types.NamespacedName]map[string]struct{}
+	// maxActivePromosByStage holds the most recently observed limit on the
+	// number of active promotions for a given stage. Stages without an entry
+	// are limited to one.
+	maxActivePromosByStage map[types.NamespacedName]int
+	// pendingPromoQueuesByStage holds a priority queue of promotions, per Stage. We allow up
+	// to the Stage's limit of promotions to run at a time, ordered by priority and then by
+	// creationTimestamp.
 	pendingPromoQueuesByStage map[types.NamespacedName]runtime.PriorityQueue
 	// promoQueuesByStageMu protects access to the above maps
 	promoQueuesByStageMu sync.RWMutex
 }
 
+func newPromoQueues() *promoQueues {
+	return &promoQueues{
+		activePromosByStage:       map[types.NamespacedName]map[string]struct{}{},
+		maxActivePromosByStage:    map[types.NamespacedName]int{},
+		pendingPromoQueuesByStage: map[types.NamespacedName]runtime.PriorityQueue{},
+	}
+}
+
 func newPriorityQueue() runtime.PriorityQueue {
 	// We can safely ignore errors here because the only error that can happen
 	// involves initializing the queue with a nil priority function, which we
 	// know we aren't doing.
 	pq, _ := runtime.NewPriorityQueue(func(left, right client.Object) bool {
+		if leftPriority, rightPriority :=
+			promotionPriority(left), promotionPriority(right); leftPriority != rightPriority {
+			return leftPriority > rightPriority
+		}
 		if left.GetCreationTimestamp().Time.Equal(
 			right.GetCreationTimestamp().Time,
 		) {
@@ -41,6 +59,15 @@ func newPriorityQueue() runtime.PriorityQueue {
 	return pq
 }
 
+// promotionPriority returns the priority of the provided object if it is a
+// Promotion. Otherwise, it returns the default priority of zero.
+func promotionPriority(obj client.Object) int32 {
+	if promo, ok := obj.(*kargoapi.Promotion); ok {
+		return promo.Spec.Priority
+	}
+	return 0
+}
+
 // initializeQueues adds the promotion list to relevant priority queues.
 // This is intended to be invoked ONCE and the caller MUST ensure that.
 func (pqs *promoQueues) initializeQueues(ctx context.Context, promos kargoapi.PromotionList) {
@@ -62,9 +89,7 @@ func (pqs *promoQueues) initializeQueues(ctx context.Context, promos kargoapi.Pr
 			pqs.pendingPromoQueuesByStage[stage] = pq
 		}
 		if promo.Status.Phase == kargoapi.PromotionPhaseRunning {
-			if pqs.activePromoByStage[stage] == "" {
-				pqs.activePromoByStage[stage] = promo.Name
-			}
+			pqs.activate(stage, promo.Name)
 			continue
 		}
 		pq.Push(&promo)
@@ -86,10 +111,15 @@ func (pqs *promoQueues) initializeQueues(ctx context.Context, promos kargoapi.Pr
 	}
 }
 
-// tryBegin tries to mark the given Pending promotion as the active one, so it can reconcile.
+// tryBegin tries to mark the given Pending promotion as an active one, so it can reconcile.
 // Returns true if the promo is already active or became active as a result of this call.
-// Returns false if it should not reconcile (another promo is active, or next in line).
-func (pqs *promoQueues) tryBegin(ctx context.Context, promo *kargoapi.Promotion) bool {
+// Returns false if it should not reconcile (the stage already has maxActive promos active,
+// or others are ahead of it in line). A maxActive of less than one is treated as one.
+func (pqs *promoQueues) tryBegin(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+	maxActive int,
+) bool {
 	if promo == nil || len(promo.Spec.Stage) == 0 {
 		return false
 	}
@@ -102,6 +132,8 @@ func (pqs *promoQueues) tryBegin(ctx context.Context, promo *kargoapi.Promotion)
 	pqs.promoQueuesByStageMu.Lock()
 	defer pqs.promoQueuesByStageMu.Unlock()
 
+	pqs.maxActivePromosByStage[stageKey] = max(maxActive, 1)
+
 	pq, ok := pqs.pendingPromoQueuesByStage[stageKey]
 	if !ok {
 		// PriorityQueue for the stage has not been initialized
@@ -109,8 +141,7 @@ func (pqs *promoQueues) tryBegin(ctx context.Context, promo *kargoapi.Promotion)
 		pqs.pendingPromoQueuesByStage[stageKey] = pq
 	}
 
-	activePromoName := pqs.activePromoByStage[stageKey]
-	if activePromoName == promo.Name {
+	if _, active := pqs.activePromosByStage[stageKey][promo.Name]; active {
 		// This promo is already active
 		return true
 	}
@@ -120,18 +151,13 @@ func (pqs *promoQueues) tryBegin(ctx context.Context, promo *kargoapi.Promotion)
 	if pq.Push(promo) {
 		logger.Debug("promo added to priority queue")
 	}
-	if activePromoName == "" {
-		// If we get here, the Stage does not have any active Promotions Running against it.
-		// Now check if it is this promo is the one that should run next.
-		// NOTE: first will never be empty because of the push call above
-		first := pq.Peek()
-		if first.GetNamespace() == promo.Namespace && first.GetName() == promo.Name {
-			// This promo is the first in the queue. Mark it as active and pop it off the pending queue.
-			popped := pq.Pop()
-			pqs.activePromoByStage[stageKey] = popped.GetName()
-			logger.Debug("begin promo")
-			return true
-		}
+	// If we get here, the promo is not active. Check whether the Stage has room for
+	// another active promo and whether this promo is among those that should run next.
+	// If so, mark it as active. It is removed from the pending queue either way.
+	if free := pqs.freeSlots(stageKey); free > 0 && popIfAmongFirst(pq, promo, free) {
+		pqs.activate(stageKey, promo.Name)
+		logger.Debug("begin promo")
+		return true
 	}
 	return false
 }
@@ -139,14 +165,62 @@ func (pqs *promoQueues) tryBegin(ctx context.Context, promo *kargoapi.Promotion)
 // conclude removes the given active promotion entry for the given stage key.
 // This should only be called after the active promotion has become terminal.
 func (pqs *promoQueues) conclude(ctx context.Context, stageKey types.NamespacedName, promoName string) {
-	pqs.promoQueuesByStageMu.RLock()
-	defer pqs.promoQueuesByStageMu.RUnlock()
-	if pqs.activePromoByStage[stageKey] == promoName {
+	pqs.promoQueuesByStageMu.Lock()
+	defer pqs.promoQueuesByStageMu.Unlock()
+	active := pqs.activePromosByStage[stageKey]
+	if _, ok := active[promoName]; ok {
 		logger := logging.LoggerFromContext(ctx).WithFields(log.Fields{
 			"namespace": stageKey.Namespace,
 			"promotion": promoName,
 		})
-		delete(pqs.activePromoByStage, stageKey)
+		delete(active, promoName)
+		if len(active) == 0 {
+			delete(pqs.activePromosByStage, stageKey)
+		}
 		logger.Debug("conclude promo")
 	}
 }
+
+// activate records the given promotion as active for the given stage key. The
+// caller MUST hold the write lock.
+func (pqs *promoQueues) activate(stageKey types.NamespacedName, promoName string) {
+	active, ok := pqs.activePromosByStage[stageKey]
+	if !ok {
+		active = map[string]struct{}{}
+		pqs.activePromosByStage[stageKey] = active
+	}
+	active[promoName] = struct{}{}
+}
+
+// freeSlots returns how many more promotions may become active for the given
+// stage key. The caller MUST hold a lock.
+func (pqs *promoQueues) freeSlots(stageKey types.NamespacedName) int {
+	maxActive := pqs.maxActivePromosByStage[stageKey]
+	if maxActive < 1 {
+		maxActive = 1
+	}
+	return maxActive - len(pqs.activePromosByStage[stageKey])
+}
+
+// popIfAmongFirst returns true if the given promotion is among the n highest
+// priority objects in the given queue, in which case it is removed from the
+// queue. The queue is otherwise left unchanged.
+func popIfAmongFirst(pq runtime.PriorityQueue, promo *kargoapi.Promotion, n int) bool {
+	popped := make([]client.Object, 0, n)
+	for len(popped) < n {
+		obj := pq.Pop()
+		if obj == nil {
+			break
+		}
+		popped = append(popped, obj)
+	}
+	var found bool
+	for _, obj := range popped {
+		if obj.GetNamespace() == promo.Namespace && obj.GetName() == promo.Name {
+			found = true
+			continue
+		}
+		pq.Push(obj)
+	}
+	return found
+}
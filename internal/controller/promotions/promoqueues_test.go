@@ -11,7 +11,6 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
-	"github.com/akuity/kargo/internal/controller/runtime"
 )
 
 var (
@@ -67,14 +66,11 @@ func newPromo(namespace, name, stage string,
 }
 
 func TestInitializeQueues(t *testing.T) {
-	pqs := promoQueues{
-		activePromoByStage:        map[types.NamespacedName]string{},
-		pendingPromoQueuesByStage: map[types.NamespacedName]runtime.PriorityQueue{},
-	}
+	pqs := newPromoQueues()
 	pqs.initializeQueues(context.Background(), testPromos)
 
 	// foo stage checks
-	require.Equal(t, "", pqs.activePromoByStage[fooStageKey])
+	require.Empty(t, pqs.activePromosByStage[fooStageKey])
 	require.Equal(t, 4, pqs.pendingPromoQueuesByStage[fooStageKey].Depth())
 	require.Equal(t, "a", pqs.pendingPromoQueuesByStage[fooStageKey].Pop().GetName())
 	require.Equal(t, "b", pqs.pendingPromoQueuesByStage[fooStageKey].Pop().GetName())
@@ -83,8 +79,8 @@ func TestInitializeQueues(t *testing.T) {
 	require.Nil(t, pqs.pendingPromoQueuesByStage[fooStageKey].Pop())
 
 	// bar stage checks
-	require.Equal(t, "y", pqs.activePromoByStage[barStageKey])
-	// We expect 2 instead of 4 (one was deduped, one went to activePromoByStage)
+	require.Equal(t, map[string]struct{}{"y": {}}, pqs.activePromosByStage[barStageKey])
+	// We expect 2 instead of 4 (one was deduped, one went to activePromosByStage)
 	require.Equal(t, 2, pqs.pendingPromoQueuesByStage[barStageKey].Depth())
 	require.Equal(t, "x", pqs.pendingPromoQueuesByStage[barStageKey].Pop().GetName())
 	require.Equal(t, "z", pqs.pendingPromoQueuesByStage[barStageKey].Pop().GetName())
@@ -126,65 +122,144 @@ func TestNewPromotionsQueue(t *testing.T) {
 	}
 }
 
-func TestTryBegin(t *testing.T) {
-	pqs := promoQueues{
-		activePromoByStage:        map[types.NamespacedName]string{},
-		pendingPromoQueuesByStage: map[types.NamespacedName]runtime.PriorityQueue{},
+func TestNewPromotionsQueueWithPriorities(t *testing.T) {
+	pq := newPriorityQueue()
+	newPriorityPromo := func(name string, priority int32, created metav1.Time) *kargoapi.Promotion {
+		promo := newPromo(testNamespace, name, "foo", "", created)
+		promo.Spec.Priority = priority
+		return promo
 	}
+	require.True(t, pq.Push(newPriorityPromo("oldest", 0, before)))
+	require.True(t, pq.Push(newPriorityPromo("deprioritized", -1, before)))
+	require.True(t, pq.Push(newPriorityPromo("urgent", 10, after)))
+	require.True(t, pq.Push(newPriorityPromo("newer", 0, now)))
+	require.True(t, pq.Push(newPriorityPromo("important", 5, now)))
+
+	// Higher priorities come first, regardless of creation time. Equal
+	// priorities are ordered by creation time.
+	var names []string
+	for object := pq.Pop(); object != nil; object = pq.Pop() {
+		names = append(names, object.GetName())
+	}
+	require.Equal(
+		t,
+		[]string{"urgent", "important", "oldest", "newer", "deprioritized"},
+		names,
+	)
+}
+
+func TestTryBeginWithPriority(t *testing.T) {
+	pqs := newPromoQueues()
+	pqs.initializeQueues(context.Background(), testPromos)
+	ctx := context.TODO()
+
+	// A Promotion created after all others, but with a higher priority, jumps
+	// ahead of them.
+	urgent := newPromo(testNamespace, "urgent", "foo", "", after)
+	urgent.Spec.Priority = 1
+	require.True(t, pqs.tryBegin(ctx, urgent, 1))
+	require.Equal(t, map[string]struct{}{"urgent": {}}, pqs.activePromosByStage[fooStageKey])
+	require.Equal(t, 4, pqs.pendingPromoQueuesByStage[fooStageKey].Depth())
+	require.Equal(t, "a", pqs.pendingPromoQueuesByStage[fooStageKey].Peek().GetName())
+}
+
+func TestTryBegin(t *testing.T) {
+	pqs := newPromoQueues()
 	pqs.initializeQueues(context.Background(), testPromos)
 
 	ctx := context.TODO()
 
 	// 1. nil promotion
-	require.False(t, pqs.tryBegin(ctx, nil))
+	require.False(t, pqs.tryBegin(ctx, nil, 1))
 
 	// 2. invalid promotion
-	require.False(t, pqs.tryBegin(ctx, &kargoapi.Promotion{}))
+	require.False(t, pqs.tryBegin(ctx, &kargoapi.Promotion{}, 1))
 
 	// 3. Try to begin promos not first in queue
 	for _, promoName := range []string{"b", "c", "d"} {
-		require.False(t, pqs.tryBegin(ctx, newPromo(testNamespace, promoName, "foo", "", now)))
-		require.Equal(t, "", pqs.activePromoByStage[fooStageKey])
+		require.False(t, pqs.tryBegin(ctx, newPromo(testNamespace, promoName, "foo", "", now), 1))
+		require.Empty(t, pqs.activePromosByStage[fooStageKey])
 		require.Equal(t, 4, pqs.pendingPromoQueuesByStage[fooStageKey].Depth())
 	}
 
 	// 4. Now try to begin highest priority. this should succeed
-	require.True(t, pqs.tryBegin(ctx, newPromo(testNamespace, "a", "foo", "", now)))
-	require.Equal(t, "a", pqs.activePromoByStage[fooStageKey])
+	require.True(t, pqs.tryBegin(ctx, newPromo(testNamespace, "a", "foo", "", now), 1))
+	require.Equal(t, map[string]struct{}{"a": {}}, pqs.activePromosByStage[fooStageKey])
 	require.Equal(t, 3, pqs.pendingPromoQueuesByStage[fooStageKey].Depth())
 
 	// 5. Begin an already active promo, this should be a no-op
-	require.True(t, pqs.tryBegin(ctx, newPromo(testNamespace, "a", "foo", "", now)))
-	require.Equal(t, "a", pqs.activePromoByStage[fooStageKey])
+	require.True(t, pqs.tryBegin(ctx, newPromo(testNamespace, "a", "foo", "", now), 1))
+	require.Equal(t, map[string]struct{}{"a": {}}, pqs.activePromosByStage[fooStageKey])
 	require.Equal(t, 3, pqs.pendingPromoQueuesByStage[fooStageKey].Depth())
 
 	// 5. Begin a promo with something else active, this should be a no-op
-	require.False(t, pqs.tryBegin(ctx, newPromo(testNamespace, "b", "foo", "", now)))
-	require.Equal(t, "a", pqs.activePromoByStage[fooStageKey])
+	require.False(t, pqs.tryBegin(ctx, newPromo(testNamespace, "b", "foo", "", now), 1))
+	require.Equal(t, map[string]struct{}{"a": {}}, pqs.activePromosByStage[fooStageKey])
 	require.Equal(t, 3, pqs.pendingPromoQueuesByStage[fooStageKey].Depth())
 }
 
 func TestConclude(t *testing.T) {
-	pqs := promoQueues{
-		activePromoByStage:        map[types.NamespacedName]string{},
-		pendingPromoQueuesByStage: map[types.NamespacedName]runtime.PriorityQueue{},
-	}
+	pqs := newPromoQueues()
 	pqs.initializeQueues(context.Background(), testPromos)
 
 	ctx := context.TODO()
 
 	// Test setup
-	require.True(t, pqs.tryBegin(ctx, newPromo(testNamespace, "a", "foo", "", now)))
+	require.True(t, pqs.tryBegin(ctx, newPromo(testNamespace, "a", "foo", "", now), 1))
 
 	// 1. conclude something not even active. it should be a no-op
 	pqs.conclude(ctx, fooStageKey, "not-active")
-	require.Equal(t, "a", pqs.activePromoByStage[fooStageKey])
+	require.Equal(t, map[string]struct{}{"a": {}}, pqs.activePromosByStage[fooStageKey])
 
 	// 2. Conclude the active one
 	pqs.conclude(ctx, fooStageKey, "a")
-	require.Equal(t, "", pqs.activePromoByStage[fooStageKey])
+	require.Empty(t, pqs.activePromosByStage[fooStageKey])
 
 	// 3. Conclude the same key, should be a noop
 	pqs.conclude(ctx, fooStageKey, "a")
-	require.Equal(t, "", pqs.activePromoByStage[fooStageKey])
+	require.Empty(t, pqs.activePromosByStage[fooStageKey])
+}
+
+func TestTryBeginWithMaxActive(t *testing.T) {
+	pqs := newPromoQueues()
+	pqs.initializeQueues(context.Background(), testPromos)
+
+	ctx := context.TODO()
+	const maxActive = 2
+
+	// 1. A promo that is not among the first maxActive in line cannot begin
+	require.False(t, pqs.tryBegin(ctx, newPromo(testNamespace, "c", "foo", "", now), maxActive))
+	require.Empty(t, pqs.activePromosByStage[fooStageKey])
+	require.Equal(t, 4, pqs.pendingPromoQueuesByStage[fooStageKey].Depth())
+
+	// 2. The second in line can begin without waiting for the first
+	require.True(t, pqs.tryBegin(ctx, newPromo(testNamespace, "b", "foo", "", now), maxActive))
+	require.Equal(t, map[string]struct{}{"b": {}}, pqs.activePromosByStage[fooStageKey])
+	require.Equal(t, 3, pqs.pendingPromoQueuesByStage[fooStageKey].Depth())
+	require.Equal(t, "a", pqs.pendingPromoQueuesByStage[fooStageKey].Peek().GetName())
+
+	// 3. With one slot left, only the first in line can begin
+	require.False(t, pqs.tryBegin(ctx, newPromo(testNamespace, "d", "foo", "", after), maxActive))
+	require.True(t, pqs.tryBegin(ctx, newPromo(testNamespace, "a", "foo", "", before), maxActive))
+	require.Equal(
+		t,
+		map[string]struct{}{"a": {}, "b": {}},
+		pqs.activePromosByStage[fooStageKey],
+	)
+	require.Equal(t, 2, pqs.pendingPromoQueuesByStage[fooStageKey].Depth())
+
+	// 4. With no slots left, nothing else can begin
+	require.False(t, pqs.tryBegin(ctx, newPromo(testNamespace, "c", "foo", "", now), maxActive))
+	require.Equal(t, 2, pqs.pendingPromoQueuesByStage[fooStageKey].Depth())
+
+	// 5. Concluding an active promo frees a slot
+	pqs.conclude(ctx, fooStageKey, "b")
+	require.Equal(t, map[string]struct{}{"a": {}}, pqs.activePromosByStage[fooStageKey])
+	require.True(t, pqs.tryBegin(ctx, newPromo(testNamespace, "c", "foo", "", now), maxActive))
+	require.Equal(
+		t,
+		map[string]struct{}{"a": {}, "c": {}},
+		pqs.activePromosByStage[fooStageKey],
+	)
+	require.Equal(t, 1, pqs.pendingPromoQueuesByStage[fooStageKey].Depth())
 }
@@ -4,17 +4,23 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/kelseyhightower/envconfig"
 	log "github.com/sirupsen/logrus"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/source"
@@ -23,7 +29,6 @@ import (
 	"github.com/akuity/kargo/internal/controller"
 	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
 	"github.com/akuity/kargo/internal/controller/promotion"
-	"github.com/akuity/kargo/internal/controller/runtime"
 	"github.com/akuity/kargo/internal/credentials"
 	"github.com/akuity/kargo/internal/kargo"
 	"github.com/akuity/kargo/internal/kubeclient"
@@ -34,6 +39,15 @@ import (
 // ReconcilerConfig represents configuration for the promotion reconciler.
 type ReconcilerConfig struct {
 	ShardName string `envconfig:"SHARD_NAME"`
+	// MaxConcurrentReconciles is the maximum number of Promotions that may be
+	// reconciled concurrently. Regardless of this setting, no Stage ever has
+	// more Promotions in progress than its spec permits.
+	MaxConcurrentReconciles int `envconfig:"MAX_CONCURRENT_PROMOTION_RECONCILES" default:"4"`
+	// ArgoCDAppUpdateDedupWindow is how long to wait after an update to an
+	// Argo CD Application before enqueuing the Promotions that are waiting on
+	// it. Any further updates to the Application during that window do not
+	// enqueue those Promotions again. Zero disables deduplication.
+	ArgoCDAppUpdateDedupWindow time.Duration `envconfig:"ARGOCD_APP_UPDATE_DEDUP_WINDOW" default:"1s"`
 }
 
 func (c ReconcilerConfig) Name() string {
@@ -59,17 +73,27 @@ type reconciler struct {
 
 	recorder record.EventRecorder
 
-	pqs            *promoQueues
-	initializeOnce sync.Once
+	pqs *promoQueues
+	// initializeMu serializes attempts to initialize pqs from existing
+	// Promotions and initialized records whether one has succeeded.
+	initializeMu sync.Mutex
+	initialized  bool
 
 	// The following behaviors are overridable for testing purposes:
 
+	nowFn func() time.Time
+
 	getStageFn func(
 		context.Context,
 		client.Client,
 		types.NamespacedName,
 	) (*kargoapi.Stage, error)
 
+	getLatestAvailableFreightFn func(
+		context.Context,
+		*kargoapi.Stage,
+	) (*kargoapi.Freight, error)
+
 	promoteFn func(context.Context, kargoapi.Promotion, *kargoapi.Freight) (*kargoapi.PromotionStatus, error)
 }
 
@@ -79,6 +103,7 @@ func SetupReconcilerWithManager(
 	ctx context.Context,
 	kargoMgr manager.Manager,
 	argocdMgr manager.Manager,
+	argocdInstanceMgrs map[string]manager.Manager,
 	credentialsDB credentials.Database,
 	cfg ReconcilerConfig,
 ) error {
@@ -101,23 +126,38 @@ func SetupReconcilerWithManager(
 	if argocdMgr != nil {
 		argocdClient = argocdMgr.GetClient()
 	}
+	argocdInstanceClients := make(map[string]client.Client, len(argocdInstanceMgrs))
+	for instance, mgr := range argocdInstanceMgrs {
+		argocdInstanceClients[instance] = mgr.GetClient()
+	}
+
+	clientset, err := kubernetes.NewForConfig(kargoMgr.GetConfig())
+	if err != nil {
+		return fmt.Errorf("error creating Kubernetes clientset: %w", err)
+	}
 
 	reconciler := newReconciler(
 		kargoMgr.GetClient(),
+		clientset.CoreV1(),
 		argocdClient,
+		argocdInstanceClients,
 		libEvent.NewRecorder(ctx, kargoMgr.GetScheme(), kargoMgr.GetClient(), cfg.Name()),
 		credentialsDB,
 		cfg,
 	)
 
+	opts := controller.CommonOptions()
+	opts.MaxConcurrentReconciles = cfg.MaxConcurrentReconciles
+
 	c, err := ctrl.NewControllerManagedBy(kargoMgr).
 		For(&kargoapi.Promotion{}).
 		WithEventFilter(predicate.Or(
 			predicate.GenerationChangedPredicate{},
 			kargo.RefreshRequested{},
+			kargo.AbortRequested{},
 		)).
 		WithEventFilter(shardPredicate).
-		WithOptions(controller.CommonOptions()).
+		WithOptions(opts).
 		Build(reconciler)
 	if err != nil {
 		return fmt.Errorf("error building Promotion controller: %w", err)
@@ -125,32 +165,70 @@ func SetupReconcilerWithManager(
 
 	logger := logging.LoggerFromContext(ctx)
 
-	// If Argo CD integration is disabled, this manager will be nil and we won't
-	// care about this watch anyway.
-	if argocdMgr != nil {
-		if err := c.Watch(
+	watchArgoCDApps := func(instance string, mgr manager.Manager) error {
+		return c.Watch(
 			source.Kind(
-				argocdMgr.GetCache(),
+				mgr.GetCache(),
 				&argocd.Application{},
 			),
 			&UpdatedArgoCDAppHandler{
 				kargoClient:   kargoMgr.GetClient(),
 				shardSelector: shardSelector,
+				instance:      instance,
+				dedupWindow:   cfg.ArgoCDAppUpdateDedupWindow,
 			},
-			ArgoCDAppOperationCompleted{
-				logger: logger,
-			},
-		); err != nil {
+			predicate.Or(
+				ArgoCDAppOperationCompleted{
+					logger: logger,
+				},
+				// Promotions that wait for Applications to become Synced and
+				// Healthy need to hear about them converging after their
+				// operations have completed.
+				ArgoCDAppConverging{},
+			),
+		)
+	}
+
+	// If Argo CD integration is disabled, this manager will be nil and we won't
+	// care about this watch anyway.
+	if argocdMgr != nil {
+		if err := watchArgoCDApps("", argocdMgr); err != nil {
 			return fmt.Errorf("unable to watch Applications: %w", err)
 		}
 	}
+	for instance, mgr := range argocdInstanceMgrs {
+		if err := watchArgoCDApps(instance, mgr); err != nil {
+			return fmt.Errorf(
+				"unable to watch Applications of Argo CD instance %q: %w",
+				instance,
+				err,
+			)
+		}
+	}
+
+	// Watch Jobs created by the Job promotion mechanism so that their Promotions
+	// are reconciled as soon as they finish.
+	if err := c.Watch(
+		source.Kind(
+			kargoMgr.GetCache(),
+			&batchv1.Job{},
+		),
+		handler.EnqueueRequestForOwner(
+			kargoMgr.GetScheme(),
+			kargoMgr.GetRESTMapper(),
+			&kargoapi.Promotion{},
+			handler.OnlyControllerOwner(),
+		),
+		JobFinished{},
+	); err != nil {
+		return fmt.Errorf("unable to watch Jobs: %w", err)
+	}
 
 	// Watch Promotions that complete and enqueue the next highest promotion key
 	priorityQueueHandler := &EnqueueHighestPriorityPromotionHandler{
-		ctx:         ctx,
-		logger:      logger,
-		kargoClient: reconciler.kargoClient,
-		pqs:         reconciler.pqs,
+		kargoClient:   reconciler.kargoClient,
+		pqs:           reconciler.pqs,
+		shardSelector: shardSelector,
 	}
 	promoWentTerminal := kargo.NewPromoWentTerminalPredicate(logger)
 	if err := c.Watch(
@@ -168,30 +246,68 @@ func SetupReconcilerWithManager(
 
 func newReconciler(
 	kargoClient client.Client,
+	podsClient typedcorev1.PodsGetter,
 	argocdClient client.Client,
+	argocdInstanceClients map[string]client.Client,
 	recorder record.EventRecorder,
 	credentialsDB credentials.Database,
 	cfg ReconcilerConfig,
 ) *reconciler {
-	pqs := promoQueues{
-		activePromoByStage:        map[types.NamespacedName]string{},
-		pendingPromoQueuesByStage: map[types.NamespacedName]runtime.PriorityQueue{},
-	}
 	r := &reconciler{
 		kargoClient: kargoClient,
 		recorder:    recorder,
 		cfg:         cfg,
-		pqs:         &pqs,
+		pqs:         newPromoQueues(),
 		promoMechanisms: promotion.NewMechanisms(
+			kargoClient,
+			podsClient,
 			argocdClient,
+			argocdInstanceClients,
 			credentialsDB,
 		),
 	}
+	r.nowFn = time.Now
 	r.getStageFn = kargoapi.GetStage
+	r.getLatestAvailableFreightFn = r.getLatestAvailableFreight
 	r.promoteFn = r.promote
 	return r
 }
 
+// initializeQueues rebuilds the Stage-specific Promotion queues, including
+// which Promotions are active, from the existing non-terminal Promotions that
+// belong to this controller's shard. This restores the state the queues were
+// in before the controller was restarted. Once initialization has succeeded,
+// this is a no-op. If it fails, it is attempted again by the next call, so a
+// transient error cannot leave the queues empty.
+func (r *reconciler) initializeQueues(ctx context.Context) error {
+	r.initializeMu.Lock()
+	defer r.initializeMu.Unlock()
+	if r.initialized {
+		return nil
+	}
+
+	shardRequirement, err := controller.GetShardRequirement(r.cfg.ShardName)
+	if err != nil {
+		return fmt.Errorf("error creating shard requirement: %w", err)
+	}
+	promos := kargoapi.PromotionList{}
+	if err = r.kargoClient.List(
+		ctx,
+		&promos,
+		&client.ListOptions{
+			LabelSelector: labels.NewSelector().Add(*shardRequirement),
+		},
+	); err != nil {
+		return fmt.Errorf("error listing promotions: %w", err)
+	}
+	r.pqs.initializeQueues(ctx, promos)
+	r.initialized = true
+	logging.LoggerFromContext(ctx).Debug(
+		"initialized Stage-specific Promotion queues from list of existing Promotions",
+	)
+	return nil
+}
+
 // Reconcile is part of the main Kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
 func (r *reconciler) Reconcile(
@@ -209,19 +325,7 @@ func (r *reconciler) Reconcile(
 	// Note that initialization occurs here because we basically know that the
 	// controller runtime client's cache is ready at this point. We cannot attempt
 	// to list Promotions prior to that point.
-	var err error
-	r.initializeOnce.Do(func() {
-		promos := kargoapi.PromotionList{}
-		if err = r.kargoClient.List(ctx, &promos); err != nil {
-			err = fmt.Errorf("error listing promotions: %w", err)
-		} else {
-			r.pqs.initializeQueues(ctx, promos)
-			logger.Debug(
-				"initialized Stage-specific Promotion queues from list of existing Promotions",
-			)
-		}
-	})
-	if err != nil {
+	if err := r.initializeQueues(ctx); err != nil {
 		return ctrl.Result{}, fmt.Errorf("error initializing Promotion queues: %w", err)
 	}
 
@@ -236,32 +340,55 @@ func (r *reconciler) Reconcile(
 		return ctrl.Result{}, nil
 	}
 	// Find the Freight
-	freight, err := kargoapi.GetFreight(ctx, r.kargoClient, types.NamespacedName{
-		Namespace: promo.Namespace,
-		Name:      promo.Spec.Freight,
-	})
+	freight, err := r.getTargetFreight(ctx, promo)
 	if err != nil {
-		return ctrl.Result{}, fmt.Errorf(
-			"error finding Freight %q in namespace %q: %w",
-			promo.Spec.Freight,
-			promo.Namespace,
-			err,
-		)
+		return ctrl.Result{}, err
+	}
+	freightName := promo.Spec.Freight
+	if freight != nil {
+		freightName = freight.Name
 	}
 
 	logger = logger.WithFields(log.Fields{
 		"namespace": req.NamespacedName.Namespace,
 		"promotion": req.NamespacedName.Name,
 		"stage":     promo.Spec.Stage,
-		"freight":   promo.Spec.Freight,
+		"freight":   freightName,
 	})
 
+	// A Promotion that has been asked to abort is neither started nor
+	// continued, whether it is still queued or already running.
+	if abortReq, ok := kargoapi.AbortAnnotationValue(promo.GetAnnotations()); ok {
+		return ctrl.Result{}, r.abort(
+			logging.ContextWithLogger(ctx, logger),
+			promo,
+			freight,
+			abortReq,
+		)
+	}
+
 	if promo.Status.Phase == kargoapi.PromotionPhaseRunning {
 		// anything we've already marked Running, we allow it to continue to reconcile
 		logger.Debug("continuing Promotion")
 	} else {
 		// promo is Pending. Try to begin it.
-		if !r.pqs.tryBegin(ctx, promo) {
+		stage, err := r.getStageFn(
+			ctx,
+			r.kargoClient,
+			types.NamespacedName{
+				Namespace: promo.Namespace,
+				Name:      promo.Spec.Stage,
+			},
+		)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf(
+				"error finding Stage %q in namespace %q: %w",
+				promo.Spec.Stage,
+				promo.Namespace,
+				err,
+			)
+		}
+		if !r.pqs.tryBegin(ctx, promo, maxConcurrentPromotions(stage)) {
 			// It wasn't our turn. Mark this promo as Pending (if it wasn't already)
 			if promo.Status.Phase != kargoapi.PromotionPhasePending {
 				err = kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
@@ -279,19 +406,68 @@ func (r *reconciler) Reconcile(
 	if promo.Status.Phase != kargoapi.PromotionPhaseRunning {
 		if err = kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
 			status.Phase = kargoapi.PromotionPhaseRunning
+			status.StartedAt = &metav1.Time{Time: r.nowFn()}
+			status.Attempts = 1
+			if freight != nil && promo.Spec.Freight == kargoapi.PromotionFreightLatest {
+				// Pin the Freight the sentinel resolved to, so that it is not
+				// resolved differently if the Promotion is reconciled again.
+				status.Freight = &kargoapi.FreightReference{Name: freight.Name}
+			}
 		}); err != nil {
 			return ctrl.Result{}, err
 		}
+		r.recorder.AnnotatedEventf(
+			promo,
+			kargoapi.NewPromotionEventAnnotations(
+				ctx,
+				kargoapi.FormatEventControllerActor(r.cfg.Name()),
+				promo,
+				freight,
+			),
+			corev1.EventTypeNormal,
+			kargoapi.EventReasonPromotionStarted,
+			"Promotion started for Stage %q",
+			promo.Spec.Stage,
+		)
 	}
 
 	promoCtx := logging.ContextWithLogger(ctx, logger)
 
+	// If the Promotion specifies a timeout, work out when it expires. The
+	// context passed to promoteFn() carries the same deadline so that any
+	// context-aware operations are interrupted when it passes.
+	var deadline time.Time
+	if promo.Spec.Timeout != nil && promo.Status.StartedAt != nil {
+		deadline = promo.Status.StartedAt.Add(promo.Spec.Timeout.Duration)
+		var cancel context.CancelFunc
+		promoCtx, cancel = context.WithDeadline(promoCtx, deadline)
+		defer cancel()
+	}
+
+	// A Promotion that is due to be retried is not attempted again until its
+	// backoff has elapsed, unless its deadline passes first.
+	if next := promo.Status.NextAttemptAfter; next != nil && r.nowFn().Before(next.Time) &&
+		(deadline.IsZero() || r.nowFn().Before(deadline)) {
+		requeueAfter := next.Sub(r.nowFn())
+		if !deadline.IsZero() {
+			if untilDeadline := deadline.Sub(r.nowFn()); untilDeadline < requeueAfter {
+				requeueAfter = untilDeadline
+			}
+		}
+		logger.Debugf("waiting %s before attempting Promotion again", requeueAfter)
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+
 	newStatus := promo.Status.DeepCopy()
 
 	// Wrap the promoteFn() call in an anonymous function to recover() any panics, so
 	// we can update the promo's phase with Error if it does. This breaks an infinite
 	// cycle of a bad promo continuously failing to reconcile, and surfaces the error.
 	func() {
+		if !deadline.IsZero() && !r.nowFn().Before(deadline) {
+			// Don't bother attempting the promotion if it has already timed out.
+			return
+		}
 		defer func() {
 			if err := recover(); err != nil {
 				logger.Errorf("Promotion panic: %v", err)
@@ -307,16 +483,78 @@ func (r *reconciler) Reconcile(
 		if promoteErr != nil {
 			newStatus.Phase = kargoapi.PromotionPhaseErrored
 			newStatus.Message = promoteErr.Error()
+			if otherStatus != nil {
+				// Keep the results of the individual updates so it is clear
+				// which one errored.
+				newStatus.Mechanisms = otherStatus.Mechanisms
+			}
 			logger.Errorf("error executing Promotion: %s", promoteErr)
 		} else {
 			newStatus = otherStatus
 		}
 	}()
 
+	// A Promotion that is still running or that errored after its deadline
+	// passed is considered to have timed out. A Promotion that succeeded or
+	// failed outright is left alone, since it reached a conclusion regardless.
+	timedOut := !deadline.IsZero() && !r.nowFn().Before(deadline)
+	if timedOut &&
+		(newStatus.Phase == kargoapi.PromotionPhaseRunning ||
+			newStatus.Phase == kargoapi.PromotionPhaseErrored) {
+		msg := fmt.Sprintf("Promotion timed out after %s", promo.Spec.Timeout.Duration)
+		if newStatus.Phase == kargoapi.PromotionPhaseErrored && newStatus.Message != "" {
+			msg = fmt.Sprintf("%s: %s", msg, newStatus.Message)
+		}
+		newStatus.Phase = kargoapi.PromotionPhaseErrored
+		newStatus.Message = msg
+		logger.Error(msg)
+	}
+
+	// The status returned by promoteFn() is built from scratch, so carry over
+	// the number of attempts made so far. A Promotion with a retry policy that
+	// failed or errored is attempted again, after a backoff, until it runs out
+	// of attempts. A Promotion that timed out is never retried.
+	newStatus.Attempts = max(promo.Status.Attempts, 1)
+	newStatus.NextAttemptAfter = nil
+	var retryAfter time.Duration
+	if policy := promo.Spec.RetryPolicy; policy != nil && !timedOut &&
+		(newStatus.Phase == kargoapi.PromotionPhaseFailed ||
+			newStatus.Phase == kargoapi.PromotionPhaseErrored) {
+		lastFailure := strings.ToLower(string(newStatus.Phase))
+		if newStatus.Message != "" {
+			lastFailure = fmt.Sprintf("%s: %s", lastFailure, newStatus.Message)
+		}
+		if newStatus.Attempts < policy.MaxAttempts {
+			retryAfter = retryBackoff(policy, newStatus.Attempts)
+			newStatus.Message = fmt.Sprintf(
+				"attempt %d of %d %s; retrying in %s",
+				newStatus.Attempts,
+				policy.MaxAttempts,
+				lastFailure,
+				retryAfter,
+			)
+			newStatus.Phase = kargoapi.PromotionPhaseRunning
+			newStatus.Attempts++
+			newStatus.NextAttemptAfter = &metav1.Time{Time: r.nowFn().Add(retryAfter)}
+			logger.Warn(newStatus.Message)
+		} else if policy.MaxAttempts > 1 {
+			newStatus.Phase = kargoapi.PromotionPhaseFailed
+			newStatus.Message = fmt.Sprintf(
+				"Promotion failed after %d attempts; last attempt %s",
+				newStatus.Attempts,
+				lastFailure,
+			)
+		}
+	}
+
 	if newStatus.Phase.IsTerminal() {
 		logger.Infof("promotion %s", newStatus.Phase)
 	}
 
+	// The status returned by promoteFn() is built from scratch, so carry over
+	// the time at which the Promotion started.
+	newStatus.StartedAt = promo.Status.StartedAt
+
 	// Record the current refresh token as having been handled.
 	if token, ok := kargoapi.RefreshAnnotationValue(promo.GetAnnotations()); ok {
 		newStatus.LastHandledRefresh = token
@@ -331,7 +569,65 @@ func (r *reconciler) Reconcile(
 
 	// Record event after patching status if new phase is terminal
 	if newStatus.Phase.IsTerminal() {
-		stage, getStageErr := r.getStageFn(
+		if recordErr := r.recordOutcome(ctx, promo, freight, newStatus); recordErr != nil {
+			return ctrl.Result{}, recordErr
+		}
+	}
+
+	if err != nil {
+		// Controller runtime automatically gives us a progressive backoff if err is
+		// not nil
+		return ctrl.Result{}, err
+	}
+
+	// If the promotion is still running, we'll need to periodically check on
+	// it. If it is due to be retried, the next check is the retry. If it has a
+	// deadline that will pass before the next check, we check again at the
+	// deadline instead.
+	//
+	// TODO: Make this configurable
+	if newStatus.Phase == kargoapi.PromotionPhaseRunning {
+		requeueAfter := 5 * time.Minute
+		if retryAfter > 0 {
+			requeueAfter = retryAfter
+		}
+		if !deadline.IsZero() {
+			if untilDeadline := deadline.Sub(r.nowFn()); untilDeadline < requeueAfter {
+				requeueAfter = untilDeadline
+			}
+		}
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+// abort transitions a Promotion to the Aborted phase without executing any
+// more of its promotion mechanisms. Changes those mechanisms have already
+// applied are not reverted. The results recorded for them in the Promotion's
+// status are kept, and if the Promotion was running, they are also recorded as
+// the Stage's last promotion.
+func (r *reconciler) abort(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+	freight *kargoapi.Freight,
+	req *kargoapi.VerificationRequest,
+) error {
+	logger := logging.LoggerFromContext(ctx)
+
+	newStatus := promo.Status.DeepCopy()
+	wasRunning := newStatus.Phase == kargoapi.PromotionPhaseRunning
+	newStatus.Phase = kargoapi.PromotionPhaseAborted
+	newStatus.Message = "Promotion aborted"
+	if req.Actor != "" {
+		newStatus.Message += fmt.Sprintf(" by %s", req.Actor)
+	}
+	if len(newStatus.Mechanisms) > 0 {
+		newStatus.Message += "; changes already applied were not reverted"
+	}
+	logger.Info(newStatus.Message)
+
+	if wasRunning && !promo.Spec.DryRun {
+		stage, err := r.getStageFn(
 			ctx,
 			r.kargoClient,
 			types.NamespacedName{
@@ -339,57 +635,271 @@ func (r *reconciler) Reconcile(
 				Name:      promo.Spec.Stage,
 			},
 		)
-		if getStageErr != nil {
-			return ctrl.Result{}, fmt.Errorf("get stage: %w", err)
-		}
-		if stage == nil {
-			return ctrl.Result{}, fmt.Errorf(
-				"stage %q not found in namespace %q",
+		if err != nil {
+			return fmt.Errorf(
+				"error finding Stage %q in namespace %q: %w",
 				promo.Spec.Stage,
 				promo.Namespace,
+				err,
 			)
 		}
-
-		var reason string
-		switch newStatus.Phase {
-		case kargoapi.PromotionPhaseSucceeded:
-			reason = kargoapi.EventReasonPromotionSucceeded
-		case kargoapi.PromotionPhaseFailed:
-			reason = kargoapi.EventReasonPromotionFailed
-		case kargoapi.PromotionPhaseErrored:
-			reason = kargoapi.EventReasonPromotionErrored
+		if stage != nil {
+			if err = kubeclient.PatchStatus(ctx, r.kargoClient, stage, func(status *kargoapi.StageStatus) {
+				if status.CurrentPromotion != nil && status.CurrentPromotion.Name == promo.Name {
+					status.LastPromotion = status.CurrentPromotion
+					status.LastPromotion.Status = newStatus
+				}
+			}); err != nil {
+				return fmt.Errorf(
+					"error updating status of Stage %q in namespace %q: %w",
+					promo.Spec.Stage,
+					promo.Namespace,
+					err,
+				)
+			}
 		}
+	}
 
-		msg := fmt.Sprintf("Promotion %s", newStatus.Phase)
-		if newStatus.Message != "" {
-			msg += fmt.Sprintf(": %s", newStatus.Message)
+	if err := kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
+		*status = *newStatus
+	}); err != nil {
+		return fmt.Errorf("error updating Promotion status: %w", err)
+	}
+
+	return r.recordOutcome(ctx, promo, freight, newStatus)
+}
+
+// recordOutcome records the terminal phase of a Promotion in its Stage's
+// promotion history and emits an event describing it.
+func (r *reconciler) recordOutcome(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+	freight *kargoapi.Freight,
+	newStatus *kargoapi.PromotionStatus,
+) error {
+	logger := logging.LoggerFromContext(ctx)
+	freightName := promo.Spec.Freight
+	if freight != nil {
+		freightName = freight.Name
+	}
+	stage, getStageErr := r.getStageFn(
+		ctx,
+		r.kargoClient,
+		types.NamespacedName{
+			Namespace: promo.Namespace,
+			Name:      promo.Spec.Stage,
+		},
+	)
+	if getStageErr != nil {
+		return fmt.Errorf("get stage: %w", getStageErr)
+	}
+	if stage == nil {
+		return fmt.Errorf(
+			"stage %q not found in namespace %q",
+			promo.Spec.Stage,
+			promo.Namespace,
+		)
+	}
+
+	// Record the outcome in the Stage's own history so that it outlives the
+	// Promotion resource itself. Dry runs never affected the Stage, so they
+	// are left out of its history.
+	if !promo.Spec.DryRun {
+		if patchErr := kubeclient.PatchStatus(ctx, r.kargoClient, stage, func(status *kargoapi.StageStatus) {
+			status.PromotionHistory.UpdateOrPush(kargoapi.PromotionRecord{
+				Name:       promo.Name,
+				Freight:    freightName,
+				Actor:      promo.Annotations[kargoapi.AnnotationKeyCreateActor],
+				Phase:      newStatus.Phase,
+				Message:    newStatus.Message,
+				StartedAt:  newStatus.StartedAt,
+				FinishedAt: &metav1.Time{Time: r.nowFn()},
+			})
+		}); patchErr != nil {
+			logger.Errorf("error recording Promotion in Stage history: %s", patchErr)
 		}
+	}
+
+	var reason string
+	switch newStatus.Phase {
+	case kargoapi.PromotionPhaseSucceeded:
+		reason = kargoapi.EventReasonPromotionSucceeded
+	case kargoapi.PromotionPhaseFailed:
+		reason = kargoapi.EventReasonPromotionFailed
+	case kargoapi.PromotionPhaseErrored:
+		reason = kargoapi.EventReasonPromotionErrored
+	case kargoapi.PromotionPhaseAborted:
+		reason = kargoapi.EventReasonPromotionAborted
+	}
 
-		eventAnnotations := kargoapi.NewPromotionEventAnnotations(ctx,
-			kargoapi.FormatEventControllerActor(r.cfg.Name()),
-			promo, freight)
+	msg := fmt.Sprintf("Promotion %s for Stage %q", newStatus.Phase, promo.Spec.Stage)
+	if newStatus.Message != "" {
+		msg += fmt.Sprintf(": %s", newStatus.Message)
+	}
+
+	eventType := corev1.EventTypeNormal
+	switch newStatus.Phase {
+	case kargoapi.PromotionPhaseFailed, kargoapi.PromotionPhaseErrored:
+		eventType = corev1.EventTypeWarning
+	}
+
+	eventAnnotations := kargoapi.NewPromotionEventAnnotations(ctx,
+		kargoapi.FormatEventControllerActor(r.cfg.Name()),
+		promo, freight)
+
+	if newStatus.Phase == kargoapi.PromotionPhaseSucceeded {
+		eventAnnotations[kargoapi.AnnotationKeyEventVerificationPending] =
+			strconv.FormatBool(stage.Spec.Verification != nil && !promo.Spec.DryRun)
+	}
+	r.recorder.AnnotatedEventf(promo, eventAnnotations, eventType, reason, msg)
+	return nil
+}
 
-		if newStatus.Phase == kargoapi.PromotionPhaseSucceeded {
-			eventAnnotations[kargoapi.AnnotationKeyEventVerificationPending] =
-				strconv.FormatBool(stage.Spec.Verification != nil)
+// getTargetFreight returns the Freight the provided Promotion promotes. If the
+// Promotion specifies the PromotionFreightLatest sentinel, this is the Freight
+// the sentinel was resolved to when the Promotion began running or, if it has
+// not begun running yet, the newest Freight currently available to its Stage.
+// It returns nil if there is no such Freight.
+func (r *reconciler) getTargetFreight(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+) (*kargoapi.Freight, error) {
+	freightName := promo.Spec.Freight
+	if freightName == kargoapi.PromotionFreightLatest {
+		if promo.Status.Freight == nil || promo.Status.Freight.Name == "" {
+			return r.resolveLatestFreight(ctx, promo)
 		}
-		r.recorder.AnnotatedEventf(promo, eventAnnotations, corev1.EventTypeNormal, reason, msg)
+		freightName = promo.Status.Freight.Name
+	}
+	freight, err := kargoapi.GetFreight(ctx, r.kargoClient, types.NamespacedName{
+		Namespace: promo.Namespace,
+		Name:      freightName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error finding Freight %q in namespace %q: %w",
+			freightName,
+			promo.Namespace,
+			err,
+		)
 	}
+	return freight, nil
+}
 
+// resolveLatestFreight returns the newest Freight available to the Stage the
+// provided Promotion targets. It returns nil if the Stage does not exist or no
+// Freight is available to it.
+func (r *reconciler) resolveLatestFreight(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+) (*kargoapi.Freight, error) {
+	stage, err := r.getStageFn(
+		ctx,
+		r.kargoClient,
+		types.NamespacedName{
+			Namespace: promo.Namespace,
+			Name:      promo.Spec.Stage,
+		},
+	)
 	if err != nil {
-		// Controller runtime automatically gives us a progressive backoff if err is
-		// not nil
-		return ctrl.Result{}, err
+		return nil, fmt.Errorf(
+			"error finding Stage %q in namespace %q: %w",
+			promo.Spec.Stage,
+			promo.Namespace,
+			err,
+		)
+	}
+	if stage == nil {
+		return nil, nil
+	}
+	freight, err := r.getLatestAvailableFreightFn(ctx, stage)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error finding latest Freight available to Stage %q in namespace %q: %w",
+			stage.Name,
+			stage.Namespace,
+			err,
+		)
 	}
+	return freight, nil
+}
 
-	// If the promotion is still running, we'll need to periodically check on
-	// it.
-	//
-	// TODO: Make this configurable
-	if newStatus.Phase == kargoapi.PromotionPhaseRunning {
-		return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+// getLatestAvailableFreight returns the newest Freight available to the
+// provided Stage. Freight is available to a Stage that subscribes to a
+// Warehouse if it originated from that Warehouse. Otherwise, it is available if
+// it has been verified in any of the Stage's upstream Stages or approved for
+// the Stage. It returns nil if no Freight is available to the Stage.
+func (r *reconciler) getLatestAvailableFreight(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+) (*kargoapi.Freight, error) {
+	freightList := kargoapi.FreightList{}
+	if err := r.kargoClient.List(
+		ctx,
+		&freightList,
+		client.InNamespace(stage.Namespace),
+	); err != nil {
+		return nil, fmt.Errorf(
+			"error listing Freight in namespace %q: %w",
+			stage.Namespace,
+			err,
+		)
 	}
-	return ctrl.Result{}, nil
+	upstreamStages := make([]string, len(stage.Spec.Subscriptions.UpstreamStages))
+	for i, upstreamStage := range stage.Spec.Subscriptions.UpstreamStages {
+		upstreamStages[i] = upstreamStage.Name
+	}
+	var latest *kargoapi.Freight
+	for i := range freightList.Items {
+		freight := &freightList.Items[i]
+		if warehouse := stage.Spec.Subscriptions.Warehouse; warehouse != "" {
+			if freight.Warehouse != warehouse {
+				continue
+			}
+		} else if !kargoapi.IsFreightAvailable(freight, stage.Name, upstreamStages) {
+			continue
+		}
+		if latest == nil || freight.CreationTimestamp.After(latest.CreationTimestamp.Time) {
+			latest = freight
+		}
+	}
+	return latest, nil
+}
+
+// retryBackoff returns how long to wait before retrying a Promotion with the
+// provided retry policy after the specified number of failed attempts.
+func retryBackoff(policy *kargoapi.PromotionRetryPolicy, failedAttempts int32) time.Duration {
+	const maxBackoff = 5 * time.Minute
+	backoff := 10 * time.Second
+	if policy.Backoff != nil && policy.Backoff.Duration > 0 {
+		backoff = policy.Backoff.Duration
+	}
+	for i := int32(1); i < failedAttempts && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, maxBackoff)
+}
+
+// maxConcurrentPromotions returns the maximum number of Promotions that may
+// run against the provided Stage at once. A Stage that could not be found is
+// limited to one, since the Promotion will fail regardless.
+func maxConcurrentPromotions(stage *kargoapi.Stage) int {
+	if stage == nil || stage.Spec.MaxConcurrentPromotions < 1 {
+		return 1
+	}
+	return int(stage.Spec.MaxConcurrentPromotions)
+}
+
+// verifiedUpstreamStage returns the first of the provided upstream Stages in
+// which the provided Freight has been verified. It returns an empty string if
+// there is no such Stage.
+func verifiedUpstreamStage(freight *kargoapi.Freight, upstreamStages []string) string {
+	for _, stage := range upstreamStages {
+		if _, ok := freight.Status.VerifiedIn[stage]; ok {
+			return stage
+		}
+	}
+	return ""
 }
 
 func (r *reconciler) promote(
@@ -418,30 +928,96 @@ func (r *reconciler) promote(
 	logger.Debug("found associated Stage")
 
 	if targetFreight == nil {
+		if promo.Spec.Freight == kargoapi.PromotionFreightLatest {
+			return &kargoapi.PromotionStatus{
+				Phase: kargoapi.PromotionPhaseFailed,
+				Message: fmt.Sprintf(
+					"no Freight is available to Stage %q in namespace %q",
+					stageName,
+					stageNamespace,
+				),
+			}, nil
+		}
 		return nil, fmt.Errorf("Freight %q not found in namespace %q", promo.Spec.Freight, promo.Namespace)
 	}
 	upstreamStages := make([]string, len(stage.Spec.Subscriptions.UpstreamStages))
 	for i, upstreamStage := range stage.Spec.Subscriptions.UpstreamStages {
 		upstreamStages[i] = upstreamStage.Name
 	}
+	var forced bool
 	if !kargoapi.IsFreightAvailable(targetFreight, stageName, upstreamStages) {
-		return nil, fmt.Errorf(
-			"Freight %q is not available to Stage %q in namespace %q",
-			promo.Spec.Freight,
-			stageName,
-			stageNamespace,
-		)
+		if !promo.Spec.Force {
+			// Nothing about the Promotion itself can change this, so it fails
+			// outright rather than erroring.
+			return &kargoapi.PromotionStatus{
+				Phase: kargoapi.PromotionPhaseFailed,
+				Message: fmt.Sprintf(
+					"Freight %q is not available to Stage %q in namespace %q",
+					targetFreight.Name,
+					stageName,
+					stageNamespace,
+				),
+			}, nil
+		}
+		forced = true
+		logger.Warn("Freight is not available to Stage; proceeding because Promotion is forced")
+		// Only record the event the first time the Promotion is found to have
+		// been forced, not on every subsequent reconciliation. Dry runs don't
+		// actually promote anything, so they're not worth warning about.
+		if !promo.Status.Forced && !promo.Spec.DryRun {
+			r.recorder.AnnotatedEventf(
+				&promo,
+				kargoapi.NewPromotionEventAnnotations(
+					ctx,
+					kargoapi.FormatEventControllerActor(r.cfg.Name()),
+					&promo,
+					targetFreight,
+				),
+				corev1.EventTypeWarning,
+				kargoapi.EventReasonPromotionForced,
+				"Promotion forced: Freight %q has not been verified upstream of or approved for Stage %q",
+				targetFreight.Name,
+				stageName,
+			)
+		}
 	}
 
 	logger = logger.WithField("targetFreight", targetFreight.Name)
 
 	targetFreightRef := kargoapi.FreightReference{
-		Name:      targetFreight.Name,
-		Commits:   targetFreight.Commits,
-		Images:    targetFreight.Images,
-		Charts:    targetFreight.Charts,
-		Warehouse: targetFreight.Warehouse,
+		Name:          targetFreight.Name,
+		Commits:       targetFreight.Commits,
+		Images:        targetFreight.Images,
+		Charts:        targetFreight.Charts,
+		OCIArtifacts:  targetFreight.OCIArtifacts,
+		HTTPArtifacts: targetFreight.HTTPArtifacts,
+		Warehouse:     targetFreight.Warehouse,
+		Provenance: &kargoapi.FreightProvenance{
+			Warehouse:     targetFreight.Warehouse,
+			UpstreamStage: verifiedUpstreamStage(targetFreight, upstreamStages),
+			PromotedAt:    &metav1.Time{Time: r.nowFn()},
+			Promotion:     promo.Name,
+		},
+	}
+	// Every attempt records the results of the individual updates afresh.
+	promo.Status.Mechanisms = nil
+
+	// A dry run leaves the Stage untouched. The promotion mechanisms only
+	// report what they would have done.
+	if promo.Spec.DryRun {
+		logger.Debug("promotion is a dry run")
+		newStatus, _, err := r.promoMechanisms.Promote(ctx, stage, &promo, targetFreightRef)
+		if err != nil {
+			return newStatus, err
+		}
+		newStatus.Freight = &targetFreightRef
+		newStatus.Forced = forced
+		if newStatus.Phase == kargoapi.PromotionPhaseSucceeded && newStatus.Message == "" {
+			newStatus.Message = "dry run; no changes were made"
+		}
+		return newStatus, nil
 	}
+
 	err = kubeclient.PatchStatus(ctx, r.kargoClient, stage, func(status *kargoapi.StageStatus) {
 		status.Phase = kargoapi.StagePhasePromoting
 		status.CurrentPromotion = &kargoapi.PromotionInfo{
@@ -455,15 +1031,28 @@ func (r *reconciler) promote(
 
 	newStatus, nextFreight, err := r.promoMechanisms.Promote(ctx, stage, &promo, targetFreightRef)
 	if err != nil {
-		return nil, err
+		return newStatus, err
 	}
 	newStatus.Freight = &nextFreight
+	newStatus.Forced = forced
 
 	logger.Debugf("promotion %s", newStatus.Phase)
 
 	if newStatus.Phase.IsTerminal() {
-		// The assumption is that controller does not process multiple promotions in one stage
-		// so we are safe from race conditions and can just update the status
+		// When a Stage permits concurrent Promotions, they may conclude out of
+		// order. One that concludes after a newer Promotion has already
+		// updated the Stage's current Freight must not revert it. Its outcome
+		// is only recorded in the Stage's promotion history.
+		var superseded bool
+		if superseded, err = r.isSupersededPromotion(ctx, stage, &promo); err != nil {
+			return nil, err
+		}
+		if superseded {
+			logger.Info(
+				"a newer Promotion has already updated the Stage; not updating the Stage's current Freight",
+			)
+			return newStatus, nil
+		}
 		// TODO: remove all patching of Stage status out of promo reconciler
 		if err = kubeclient.PatchStatus(ctx, r.kargoClient, stage, func(status *kargoapi.StageStatus) {
 			status.LastPromotion = status.CurrentPromotion
@@ -490,6 +1079,11 @@ func (r *reconciler) promote(
 				} else if stage.Spec.PromotionMechanisms != nil {
 					status.CurrentFreight = &nextFreight
 					status.History.UpdateOrPush(nextFreight)
+					status.History.Prune(
+						stage.Spec.HistoryRetention,
+						nextFreight.Name,
+						r.nowFn(),
+					)
 				}
 				status.Phase = kargoapi.StagePhaseVerifying
 				status.CurrentPromotion = nil
@@ -506,3 +1100,44 @@ func (r *reconciler) promote(
 
 	return newStatus, nil
 }
+
+// isSupersededPromotion returns true if the Stage's current Freight was
+// promoted by a Promotion that is newer than the provided one. Promotions are
+// ordered by creation time and, for those created within the same second, by
+// name, which for Promotions created by Kargo embeds a ULID. If the Promotion
+// that promoted the current Freight no longer exists, the provided one is not
+// considered superseded.
+func (r *reconciler) isSupersededPromotion(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	promo *kargoapi.Promotion,
+) (bool, error) {
+	current := stage.Status.CurrentFreight
+	if current == nil || current.Provenance == nil ||
+		current.Provenance.Promotion == "" || current.Provenance.Promotion == promo.Name {
+		return false, nil
+	}
+	currentPromo, err := kargoapi.GetPromotion(
+		ctx,
+		r.kargoClient,
+		types.NamespacedName{
+			Namespace: promo.Namespace,
+			Name:      current.Provenance.Promotion,
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf(
+			"error finding Promotion %q in namespace %q: %w",
+			current.Provenance.Promotion,
+			promo.Namespace,
+			err,
+		)
+	}
+	if currentPromo == nil {
+		return false, nil
+	}
+	if !currentPromo.CreationTimestamp.Equal(&promo.CreationTimestamp) {
+		return promo.CreationTimestamp.Before(&currentPromo.CreationTimestamp), nil
+	}
+	return promo.Name < currentPromo.Name, nil
+}
@@ -3,14 +3,19 @@ package promotions
 import (
 	"context"
 	"errors"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	"github.com/akuity/kargo/api/v1alpha1"
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
@@ -22,14 +27,18 @@ func TestNewPromotionReconciler(t *testing.T) {
 	kubeClient := fake.NewClientBuilder().Build()
 	r := newReconciler(
 		kubeClient,
+		nil,
 		kubeClient,
+		nil,
 		&fakeevent.EventRecorder{},
 		&credentials.FakeDB{},
 		ReconcilerConfig{},
 	)
 	require.NotNil(t, r.kargoClient)
 	require.NotNil(t, r.pqs.pendingPromoQueuesByStage)
+	require.NotNil(t, r.nowFn)
 	require.NotNil(t, r.getStageFn)
+	require.NotNil(t, r.getLatestAvailableFreightFn)
 	require.NotNil(t, r.promoteFn)
 }
 
@@ -45,7 +54,9 @@ func newFakeReconciler(
 	kubeClient := fake.NewClientBuilder().Build()
 	return newReconciler(
 		kargoClient,
+		nil,
 		kubeClient,
+		nil,
 		recorder,
 		&credentials.FakeDB{},
 		ReconcilerConfig{},
@@ -61,15 +72,17 @@ func TestReconcile(t *testing.T) {
 		promoToReconcile      *types.NamespacedName // if nil, uses the first of the promos
 		expectPromoteFnCalled bool
 		expectedPhase         kargoapi.PromotionPhase
-		expectedEventRecorded bool
-		expectedEventReason   string
+		expectedEventReasons  []string
+		expectedMechanisms    []kargoapi.MechanismResult
 	}{
 		{
 			name:                  "normal reconcile",
 			expectPromoteFnCalled: true,
 			expectedPhase:         kargoapi.PromotionPhaseSucceeded,
-			expectedEventRecorded: true,
-			expectedEventReason:   kargoapi.EventReasonPromotionSucceeded,
+			expectedEventReasons: []string{
+				kargoapi.EventReasonPromotionStarted,
+				kargoapi.EventReasonPromotionSucceeded,
+			},
 			promos: []client.Object{
 				newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, now),
 			},
@@ -83,8 +96,6 @@ func TestReconcile(t *testing.T) {
 			name:                  "promo already completed",
 			expectPromoteFnCalled: false,
 			expectedPhase:         kargoapi.PromotionPhaseErrored,
-			expectedEventRecorded: false,
-			expectedEventReason:   kargoapi.EventReasonPromotionErrored,
 			promos: []client.Object{
 				newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhaseErrored, now),
 			},
@@ -93,8 +104,9 @@ func TestReconcile(t *testing.T) {
 			name:                  "promo already running",
 			expectPromoteFnCalled: true,
 			expectedPhase:         kargoapi.PromotionPhaseSucceeded,
-			expectedEventRecorded: true,
-			expectedEventReason:   kargoapi.EventReasonPromotionSucceeded,
+			expectedEventReasons: []string{
+				kargoapi.EventReasonPromotionSucceeded,
+			},
 			promos: []client.Object{
 				newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhaseRunning, now),
 			},
@@ -114,8 +126,10 @@ func TestReconcile(t *testing.T) {
 			expectPromoteFnCalled: true,
 			promoToReconcile:      &types.NamespacedName{Namespace: "fake-namespace", Name: "fake-promo1"},
 			expectedPhase:         kargoapi.PromotionPhaseSucceeded,
-			expectedEventRecorded: true,
-			expectedEventReason:   kargoapi.EventReasonPromotionSucceeded,
+			expectedEventReasons: []string{
+				kargoapi.EventReasonPromotionStarted,
+				kargoapi.EventReasonPromotionSucceeded,
+			},
 			promos: []client.Object{
 				newPromo("fake-namespace", "fake-promo1", "fake-stage", kargoapi.PromotionPhasePending, before),
 				newPromo("fake-namespace", "fake-promo2", "fake-stage", kargoapi.PromotionPhasePending, now),
@@ -125,8 +139,10 @@ func TestReconcile(t *testing.T) {
 			name:                  "promoteFn panics",
 			expectPromoteFnCalled: true,
 			expectedPhase:         kargoapi.PromotionPhaseErrored,
-			expectedEventRecorded: true,
-			expectedEventReason:   kargoapi.EventReasonPromotionErrored,
+			expectedEventReasons: []string{
+				kargoapi.EventReasonPromotionStarted,
+				kargoapi.EventReasonPromotionErrored,
+			},
 			promos: []client.Object{
 				newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, before),
 			},
@@ -138,8 +154,10 @@ func TestReconcile(t *testing.T) {
 			name:                  "promoteFn errors",
 			expectPromoteFnCalled: true,
 			expectedPhase:         kargoapi.PromotionPhaseErrored,
-			expectedEventRecorded: true,
-			expectedEventReason:   kargoapi.EventReasonPromotionErrored,
+			expectedEventReasons: []string{
+				kargoapi.EventReasonPromotionStarted,
+				kargoapi.EventReasonPromotionErrored,
+			},
 			promos: []client.Object{
 				newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, before),
 			},
@@ -147,11 +165,54 @@ func TestReconcile(t *testing.T) {
 				return nil, errors.New("expected error")
 			},
 		},
+		{
+			name:                  "promoteFn errors after recording mechanism results",
+			expectPromoteFnCalled: true,
+			expectedPhase:         kargoapi.PromotionPhaseErrored,
+			expectedEventReasons: []string{
+				kargoapi.EventReasonPromotionStarted,
+				kargoapi.EventReasonPromotionErrored,
+			},
+			expectedMechanisms: []kargoapi.MechanismResult{
+				{
+					Type:   kargoapi.MechanismTypeGitRepoUpdate,
+					Target: "https://github.com/example/repo.git@main",
+					Phase:  kargoapi.PromotionPhaseSucceeded,
+				},
+				{
+					Type:    kargoapi.MechanismTypeArgoCDAppUpdate,
+					Target:  "argocd/fake-app",
+					Phase:   kargoapi.PromotionPhaseErrored,
+					Message: "expected error",
+				},
+			},
+			promos: []client.Object{
+				newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, before),
+			},
+			promoteFn: func(_ context.Context, _ v1alpha1.Promotion, _ *v1alpha1.Freight) (*kargoapi.PromotionStatus, error) {
+				return &kargoapi.PromotionStatus{
+					Phase: kargoapi.PromotionPhaseErrored,
+					Mechanisms: []kargoapi.MechanismResult{
+						{
+							Type:   kargoapi.MechanismTypeGitRepoUpdate,
+							Target: "https://github.com/example/repo.git@main",
+							Phase:  kargoapi.PromotionPhaseSucceeded,
+						},
+						{
+							Type:    kargoapi.MechanismTypeArgoCDAppUpdate,
+							Target:  "argocd/fake-app",
+							Phase:   kargoapi.PromotionPhaseErrored,
+							Message: "expected error",
+						},
+					},
+				}, errors.New("expected error")
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			ctx := context.TODO()
-			recorder := fakeevent.NewEventRecorder(1)
+			recorder := fakeevent.NewEventRecorder(2)
 			r := newFakeReconciler(t, recorder, tc.promos...)
 			promoteWasCalled := false
 			r.getStageFn = func(context.Context, client.Client, types.NamespacedName) (*kargoapi.Stage, error) {
@@ -185,16 +246,1044 @@ func TestReconcile(t *testing.T) {
 				err = r.kargoClient.Get(ctx, req.NamespacedName, &updatedPromo)
 				require.NoError(t, err)
 				require.Equal(t, tc.expectedPhase, updatedPromo.Status.Phase)
-				if tc.expectedEventRecorded {
-					require.Len(t, recorder.Events, 1)
+				if tc.expectedMechanisms != nil {
+					require.Equal(t, tc.expectedMechanisms, updatedPromo.Status.Mechanisms)
+				}
+				require.Len(t, recorder.Events, len(tc.expectedEventReasons))
+				for _, reason := range tc.expectedEventReasons {
 					event := <-recorder.Events
-					require.Equal(t, tc.expectedEventReason, event.Reason)
+					require.Equal(t, reason, event.Reason)
+					if reason == kargoapi.EventReasonPromotionErrored {
+						require.Equal(t, corev1.EventTypeWarning, event.EventType)
+					} else {
+						require.Equal(t, corev1.EventTypeNormal, event.EventType)
+					}
+					require.Contains(t, event.Message, `Stage "fake-stage"`)
+				}
+			}
+		})
+	}
+}
+
+func TestReconcileTimeout(t *testing.T) {
+	startedAt := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	newTimeoutPromo := func(
+		phase kargoapi.PromotionPhase,
+		started bool,
+	) *kargoapi.Promotion {
+		promo := newPromo("fake-namespace", "fake-promo", "fake-stage", phase, before)
+		promo.Spec.Timeout = &metav1.Duration{Duration: time.Hour}
+		if started {
+			promo.Status.StartedAt = &metav1.Time{Time: startedAt}
+		}
+		return promo
+	}
+
+	testCases := []struct {
+		name       string
+		promo      *kargoapi.Promotion
+		now        time.Time
+		promoteFn  func(*reconciler) func(context.Context, kargoapi.Promotion, *kargoapi.Freight) (*kargoapi.PromotionStatus, error)
+		assertions func(*testing.T, ctrl.Result, kargoapi.PromotionStatus, bool)
+	}{
+		{
+			name:  "running promotion has already timed out",
+			promo: newTimeoutPromo(kargoapi.PromotionPhaseRunning, true),
+			now:   startedAt.Add(2 * time.Hour),
+			assertions: func(
+				t *testing.T,
+				_ ctrl.Result,
+				status kargoapi.PromotionStatus,
+				promoteCalled bool,
+			) {
+				require.False(t, promoteCalled)
+				require.Equal(t, kargoapi.PromotionPhaseErrored, status.Phase)
+				require.Equal(t, "Promotion timed out after 1h0m0s", status.Message)
+			},
+		},
+		{
+			name:  "promotion starts with a deadline",
+			promo: newTimeoutPromo(kargoapi.PromotionPhasePending, false),
+			now:   startedAt,
+			promoteFn: func(*reconciler) func(context.Context, kargoapi.Promotion, *kargoapi.Freight) (*kargoapi.PromotionStatus, error) {
+				return func(ctx context.Context, _ kargoapi.Promotion, _ *kargoapi.Freight) (*kargoapi.PromotionStatus, error) {
+					deadline, ok := ctx.Deadline()
+					if !ok || !deadline.Equal(startedAt.Add(time.Hour)) {
+						return nil, errors.New("unexpected deadline")
+					}
+					return &kargoapi.PromotionStatus{Phase: kargoapi.PromotionPhaseRunning}, nil
+				}
+			},
+			assertions: func(
+				t *testing.T,
+				result ctrl.Result,
+				status kargoapi.PromotionStatus,
+				promoteCalled bool,
+			) {
+				require.True(t, promoteCalled)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+				require.NotNil(t, status.StartedAt)
+				require.True(t, status.StartedAt.Equal(&metav1.Time{Time: startedAt}))
+				require.Equal(t, 5*time.Minute, result.RequeueAfter)
+			},
+		},
+		{
+			name:  "running promotion is requeued at its deadline",
+			promo: newTimeoutPromo(kargoapi.PromotionPhaseRunning, true),
+			now:   startedAt.Add(58 * time.Minute),
+			promoteFn: func(*reconciler) func(context.Context, kargoapi.Promotion, *kargoapi.Freight) (*kargoapi.PromotionStatus, error) {
+				return func(context.Context, kargoapi.Promotion, *kargoapi.Freight) (*kargoapi.PromotionStatus, error) {
+					return &kargoapi.PromotionStatus{Phase: kargoapi.PromotionPhaseRunning}, nil
+				}
+			},
+			assertions: func(
+				t *testing.T,
+				result ctrl.Result,
+				status kargoapi.PromotionStatus,
+				promoteCalled bool,
+			) {
+				require.True(t, promoteCalled)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+				require.Equal(t, 2*time.Minute, result.RequeueAfter)
+			},
+		},
+		{
+			name:  "promotion errors after its deadline",
+			promo: newTimeoutPromo(kargoapi.PromotionPhaseRunning, true),
+			now:   startedAt.Add(59 * time.Minute),
+			promoteFn: func(r *reconciler) func(context.Context, kargoapi.Promotion, *kargoapi.Freight) (*kargoapi.PromotionStatus, error) {
+				return func(context.Context, kargoapi.Promotion, *kargoapi.Freight) (*kargoapi.PromotionStatus, error) {
+					r.nowFn = func() time.Time { return startedAt.Add(61 * time.Minute) }
+					return nil, errors.New("something went wrong")
+				}
+			},
+			assertions: func(
+				t *testing.T,
+				_ ctrl.Result,
+				status kargoapi.PromotionStatus,
+				promoteCalled bool,
+			) {
+				require.True(t, promoteCalled)
+				require.Equal(t, kargoapi.PromotionPhaseErrored, status.Phase)
+				require.Equal(
+					t,
+					"Promotion timed out after 1h0m0s: something went wrong",
+					status.Message,
+				)
+			},
+		},
+		{
+			name:  "promotion succeeds after its deadline",
+			promo: newTimeoutPromo(kargoapi.PromotionPhaseRunning, true),
+			now:   startedAt.Add(59 * time.Minute),
+			promoteFn: func(r *reconciler) func(context.Context, kargoapi.Promotion, *kargoapi.Freight) (*kargoapi.PromotionStatus, error) {
+				return func(context.Context, kargoapi.Promotion, *kargoapi.Freight) (*kargoapi.PromotionStatus, error) {
+					r.nowFn = func() time.Time { return startedAt.Add(61 * time.Minute) }
+					return &kargoapi.PromotionStatus{Phase: kargoapi.PromotionPhaseSucceeded}, nil
 				}
+			},
+			assertions: func(
+				t *testing.T,
+				_ ctrl.Result,
+				status kargoapi.PromotionStatus,
+				promoteCalled bool,
+			) {
+				require.True(t, promoteCalled)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := newFakeReconciler(t, fakeevent.NewEventRecorder(1), testCase.promo)
+			r.nowFn = func() time.Time { return testCase.now }
+			r.getStageFn = func(context.Context, client.Client, types.NamespacedName) (*kargoapi.Stage, error) {
+				return &kargoapi.Stage{}, nil
+			}
+			var promoteCalled bool
+			r.promoteFn = func(context.Context, kargoapi.Promotion, *kargoapi.Freight) (*kargoapi.PromotionStatus, error) {
+				promoteCalled = true
+				return nil, errors.New("promoteFn should not have been called")
 			}
+			if testCase.promoteFn != nil {
+				promoteFn := testCase.promoteFn(r)
+				r.promoteFn = func(
+					ctx context.Context,
+					promo kargoapi.Promotion,
+					freight *kargoapi.Freight,
+				) (*kargoapi.PromotionStatus, error) {
+					promoteCalled = true
+					return promoteFn(ctx, promo, freight)
+				}
+			}
+			req := ctrl.Request{NamespacedName: types.NamespacedName{
+				Namespace: testCase.promo.Namespace,
+				Name:      testCase.promo.Name,
+			}}
+			result, err := r.Reconcile(ctx, req)
+			require.NoError(t, err)
+			var updatedPromo kargoapi.Promotion
+			require.NoError(t, r.kargoClient.Get(ctx, req.NamespacedName, &updatedPromo))
+			testCase.assertions(t, result, updatedPromo.Status, promoteCalled)
 		})
 	}
 }
 
+func TestReconcileRetry(t *testing.T) {
+	// Times in status are persisted with a precision of seconds
+	now := now.Rfc3339Copy()
+	newRetryPromo := func(attempts int32) *kargoapi.Promotion {
+		promo := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhaseRunning, before)
+		promo.Spec.RetryPolicy = &kargoapi.PromotionRetryPolicy{
+			MaxAttempts: 3,
+			Backoff:     &metav1.Duration{Duration: 30 * time.Second},
+		}
+		promo.Status.StartedAt = &metav1.Time{Time: now.Time}
+		promo.Status.Attempts = attempts
+		return promo
+	}
+	testCases := []struct {
+		name       string
+		promo      *kargoapi.Promotion
+		promoteFn  func(context.Context, kargoapi.Promotion, *kargoapi.Freight) (*kargoapi.PromotionStatus, error)
+		assertions func(*testing.T, ctrl.Result, kargoapi.PromotionStatus)
+	}{
+		{
+			name: "failed promotion without retry policy",
+			promo: func() *kargoapi.Promotion {
+				promo := newRetryPromo(1)
+				promo.Spec.RetryPolicy = nil
+				return promo
+			}(),
+			promoteFn: func(context.Context, kargoapi.Promotion, *kargoapi.Freight) (*kargoapi.PromotionStatus, error) {
+				return &kargoapi.PromotionStatus{
+					Phase:   kargoapi.PromotionPhaseFailed,
+					Message: "sync failed",
+				}, nil
+			},
+			assertions: func(t *testing.T, _ ctrl.Result, status kargoapi.PromotionStatus) {
+				require.Equal(t, kargoapi.PromotionPhaseFailed, status.Phase)
+				require.Equal(t, "sync failed", status.Message)
+				require.Equal(t, int32(1), status.Attempts)
+			},
+		},
+		{
+			name:  "failed attempt is retried",
+			promo: newRetryPromo(1),
+			promoteFn: func(context.Context, kargoapi.Promotion, *kargoapi.Freight) (*kargoapi.PromotionStatus, error) {
+				return &kargoapi.PromotionStatus{
+					Phase:   kargoapi.PromotionPhaseFailed,
+					Message: "sync failed",
+				}, nil
+			},
+			assertions: func(t *testing.T, result ctrl.Result, status kargoapi.PromotionStatus) {
+				require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+				require.Equal(
+					t,
+					"attempt 1 of 3 failed: sync failed; retrying in 30s",
+					status.Message,
+				)
+				require.Equal(t, int32(2), status.Attempts)
+				require.Equal(t, 30*time.Second, result.RequeueAfter)
+				require.NotNil(t, status.NextAttemptAfter)
+				require.True(t, now.Add(30*time.Second).Equal(status.NextAttemptAfter.Time))
+			},
+		},
+		{
+			name: "attempt is not retried before its backoff elapses",
+			promo: func() *kargoapi.Promotion {
+				promo := newRetryPromo(2)
+				promo.Status.NextAttemptAfter = &metav1.Time{Time: now.Add(20 * time.Second)}
+				return promo
+			}(),
+			promoteFn: func(context.Context, kargoapi.Promotion, *kargoapi.Freight) (*kargoapi.PromotionStatus, error) {
+				return nil, errors.New("promotion attempted during backoff")
+			},
+			assertions: func(t *testing.T, result ctrl.Result, status kargoapi.PromotionStatus) {
+				require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+				require.Equal(t, int32(2), status.Attempts)
+				require.NotNil(t, status.NextAttemptAfter)
+				require.Equal(t, 20*time.Second, result.RequeueAfter)
+			},
+		},
+		{
+			name: "attempt is retried once its backoff elapses",
+			promo: func() *kargoapi.Promotion {
+				promo := newRetryPromo(2)
+				promo.Status.NextAttemptAfter = &metav1.Time{Time: now.Add(-time.Second)}
+				return promo
+			}(),
+			promoteFn: func(context.Context, kargoapi.Promotion, *kargoapi.Freight) (*kargoapi.PromotionStatus, error) {
+				return &kargoapi.PromotionStatus{Phase: kargoapi.PromotionPhaseSucceeded}, nil
+			},
+			assertions: func(t *testing.T, _ ctrl.Result, status kargoapi.PromotionStatus) {
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+				require.Equal(t, int32(2), status.Attempts)
+				require.Nil(t, status.NextAttemptAfter)
+			},
+		},
+		{
+			name:  "errored attempt is retried with a longer backoff",
+			promo: newRetryPromo(2),
+			promoteFn: func(context.Context, kargoapi.Promotion, *kargoapi.Freight) (*kargoapi.PromotionStatus, error) {
+				return nil, errors.New("connection refused")
+			},
+			assertions: func(t *testing.T, result ctrl.Result, status kargoapi.PromotionStatus) {
+				require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+				require.Equal(
+					t,
+					"attempt 2 of 3 errored: connection refused; retrying in 1m0s",
+					status.Message,
+				)
+				require.Equal(t, int32(3), status.Attempts)
+				require.Equal(t, time.Minute, result.RequeueAfter)
+			},
+		},
+		{
+			name:  "attempts exhausted",
+			promo: newRetryPromo(3),
+			promoteFn: func(context.Context, kargoapi.Promotion, *kargoapi.Freight) (*kargoapi.PromotionStatus, error) {
+				return nil, errors.New("connection refused")
+			},
+			assertions: func(t *testing.T, result ctrl.Result, status kargoapi.PromotionStatus) {
+				require.Equal(t, kargoapi.PromotionPhaseFailed, status.Phase)
+				require.Equal(
+					t,
+					"Promotion failed after 3 attempts; last attempt errored: connection refused",
+					status.Message,
+				)
+				require.Equal(t, int32(3), status.Attempts)
+				require.Zero(t, result.RequeueAfter)
+			},
+		},
+		{
+			name:  "retried attempt succeeds",
+			promo: newRetryPromo(2),
+			promoteFn: func(context.Context, kargoapi.Promotion, *kargoapi.Freight) (*kargoapi.PromotionStatus, error) {
+				return &kargoapi.PromotionStatus{Phase: kargoapi.PromotionPhaseSucceeded}, nil
+			},
+			assertions: func(t *testing.T, _ ctrl.Result, status kargoapi.PromotionStatus) {
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+				require.Equal(t, int32(2), status.Attempts)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := newFakeReconciler(t, fakeevent.NewEventRecorder(1), testCase.promo)
+			r.getStageFn = func(context.Context, client.Client, types.NamespacedName) (*kargoapi.Stage, error) {
+				return &kargoapi.Stage{}, nil
+			}
+			r.promoteFn = testCase.promoteFn
+			r.nowFn = func() time.Time { return now.Time }
+			req := ctrl.Request{NamespacedName: types.NamespacedName{
+				Namespace: testCase.promo.Namespace,
+				Name:      testCase.promo.Name,
+			}}
+			result, err := r.Reconcile(ctx, req)
+			require.NoError(t, err)
+			var updatedPromo kargoapi.Promotion
+			require.NoError(t, r.kargoClient.Get(ctx, req.NamespacedName, &updatedPromo))
+			testCase.assertions(t, result, updatedPromo.Status)
+		})
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	testCases := []struct {
+		name           string
+		policy         *kargoapi.PromotionRetryPolicy
+		failedAttempts int32
+		expected       time.Duration
+	}{
+		{
+			name:           "default backoff",
+			policy:         &kargoapi.PromotionRetryPolicy{},
+			failedAttempts: 1,
+			expected:       10 * time.Second,
+		},
+		{
+			name: "backoff doubles",
+			policy: &kargoapi.PromotionRetryPolicy{
+				Backoff: &metav1.Duration{Duration: 15 * time.Second},
+			},
+			failedAttempts: 3,
+			expected:       time.Minute,
+		},
+		{
+			name: "backoff is capped",
+			policy: &kargoapi.PromotionRetryPolicy{
+				Backoff: &metav1.Duration{Duration: time.Minute},
+			},
+			failedAttempts: 10,
+			expected:       5 * time.Minute,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				retryBackoff(testCase.policy, testCase.failedAttempts),
+			)
+		})
+	}
+}
+
+func TestReconcileRecordsPromotionHistory(t *testing.T) {
+	ctx := context.Background()
+	startedAt := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	finishedAt := startedAt.Add(time.Minute)
+
+	promo := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhaseRunning, before)
+	promo.Spec.Freight = "fake-freight"
+	promo.Annotations = map[string]string{
+		kargoapi.AnnotationKeyCreateActor: "email:tony@starkindustries.com",
+	}
+	promo.Status.StartedAt = &metav1.Time{Time: startedAt}
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		},
+	}
+
+	r := newFakeReconciler(t, fakeevent.NewEventRecorder(1), promo, stage)
+	r.nowFn = func() time.Time { return finishedAt }
+	r.promoteFn = func(context.Context, kargoapi.Promotion, *kargoapi.Freight) (*kargoapi.PromotionStatus, error) {
+		return &kargoapi.PromotionStatus{
+			Phase:   kargoapi.PromotionPhaseFailed,
+			Message: "something went wrong",
+		}, nil
+	}
+
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{
+		Namespace: promo.Namespace,
+		Name:      promo.Name,
+	}})
+	require.NoError(t, err)
+
+	updatedStage := &kargoapi.Stage{}
+	require.NoError(t, r.kargoClient.Get(ctx, client.ObjectKeyFromObject(stage), updatedStage))
+	require.Len(t, updatedStage.Status.PromotionHistory, 1)
+	record := updatedStage.Status.PromotionHistory[0]
+	require.Equal(t, "fake-promo", record.Name)
+	require.Equal(t, "fake-freight", record.Freight)
+	require.Equal(t, "email:tony@starkindustries.com", record.Actor)
+	require.Equal(t, kargoapi.PromotionPhaseFailed, record.Phase)
+	require.Equal(t, "something went wrong", record.Message)
+	require.NotNil(t, record.StartedAt)
+	require.True(t, record.StartedAt.Time.Equal(startedAt))
+	require.NotNil(t, record.FinishedAt)
+	require.True(t, record.FinishedAt.Time.Equal(finishedAt))
+}
+
+func TestReconcileAbort(t *testing.T) {
+	appliedUpdate := kargoapi.MechanismResult{
+		Type:   kargoapi.MechanismTypeGitRepoUpdate,
+		Target: "https://github.com/example/repo.git@main",
+		Phase:  kargoapi.PromotionPhaseSucceeded,
+	}
+	testCases := []struct {
+		name       string
+		promo      func() *kargoapi.Promotion
+		assertions func(*testing.T, *kargoapi.Promotion, *kargoapi.Stage, *fakeevent.EventRecorder)
+	}{
+		{
+			name: "running promotion",
+			promo: func() *kargoapi.Promotion {
+				promo := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhaseRunning, before)
+				promo.Annotations = map[string]string{
+					kargoapi.AnnotationKeyAbort: (&kargoapi.VerificationRequest{
+						ID:    "fake-promo",
+						Actor: "email:tony@starkindustries.com",
+					}).String(),
+				}
+				promo.Status.Mechanisms = []kargoapi.MechanismResult{appliedUpdate}
+				return promo
+			},
+			assertions: func(
+				t *testing.T,
+				promo *kargoapi.Promotion,
+				stage *kargoapi.Stage,
+				recorder *fakeevent.EventRecorder,
+			) {
+				require.Equal(t, kargoapi.PromotionPhaseAborted, promo.Status.Phase)
+				require.Equal(
+					t,
+					"Promotion aborted by email:tony@starkindustries.com; "+
+						"changes already applied were not reverted",
+					promo.Status.Message,
+				)
+				require.Equal(t, []kargoapi.MechanismResult{appliedUpdate}, promo.Status.Mechanisms)
+
+				require.NotNil(t, stage.Status.LastPromotion)
+				require.Equal(t, "fake-promo", stage.Status.LastPromotion.Name)
+				require.NotNil(t, stage.Status.LastPromotion.Status)
+				require.Equal(t, kargoapi.PromotionPhaseAborted, stage.Status.LastPromotion.Status.Phase)
+				require.Equal(
+					t,
+					[]kargoapi.MechanismResult{appliedUpdate},
+					stage.Status.LastPromotion.Status.Mechanisms,
+				)
+				require.Len(t, stage.Status.PromotionHistory, 1)
+				require.Equal(t, kargoapi.PromotionPhaseAborted, stage.Status.PromotionHistory[0].Phase)
+
+				require.Len(t, recorder.Events, 1)
+				event := <-recorder.Events
+				require.Equal(t, kargoapi.EventReasonPromotionAborted, event.Reason)
+			},
+		},
+		{
+			name: "pending promotion",
+			promo: func() *kargoapi.Promotion {
+				promo := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, before)
+				promo.Annotations = map[string]string{
+					kargoapi.AnnotationKeyAbort: "fake-promo",
+				}
+				return promo
+			},
+			assertions: func(
+				t *testing.T,
+				promo *kargoapi.Promotion,
+				stage *kargoapi.Stage,
+				recorder *fakeevent.EventRecorder,
+			) {
+				require.Equal(t, kargoapi.PromotionPhaseAborted, promo.Status.Phase)
+				require.Equal(t, "Promotion aborted", promo.Status.Message)
+				require.Empty(t, promo.Status.Mechanisms)
+
+				// The Promotion never started, so the Stage's current Promotion is
+				// not its to record.
+				require.Equal(t, "other-promo", stage.Status.LastPromotion.Name)
+				require.Len(t, stage.Status.PromotionHistory, 1)
+				require.Equal(t, kargoapi.PromotionPhaseAborted, stage.Status.PromotionHistory[0].Phase)
+
+				require.Len(t, recorder.Events, 1)
+				event := <-recorder.Events
+				require.Equal(t, kargoapi.EventReasonPromotionAborted, event.Reason)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.Background()
+			promo := testCase.promo()
+			currentPromo := "other-promo"
+			if promo.Status.Phase == kargoapi.PromotionPhaseRunning {
+				currentPromo = promo.Name
+			}
+			stage := &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+					Name:      "fake-stage",
+				},
+				Status: kargoapi.StageStatus{
+					Phase: kargoapi.StagePhasePromoting,
+					CurrentPromotion: &kargoapi.PromotionInfo{
+						Name: currentPromo,
+					},
+					LastPromotion: &kargoapi.PromotionInfo{
+						Name: "other-promo",
+					},
+				},
+			}
+
+			recorder := fakeevent.NewEventRecorder(1)
+			r := newFakeReconciler(t, recorder, promo, stage)
+			r.promoteFn = func(context.Context, kargoapi.Promotion, *kargoapi.Freight) (*kargoapi.PromotionStatus, error) {
+				require.Fail(t, "an aborted Promotion should not be executed")
+				return nil, nil
+			}
+
+			result, err := r.Reconcile(ctx, ctrl.Request{
+				NamespacedName: client.ObjectKeyFromObject(promo),
+			})
+			require.NoError(t, err)
+			require.Zero(t, result.RequeueAfter)
+
+			updatedPromo := &kargoapi.Promotion{}
+			require.NoError(t, r.kargoClient.Get(ctx, client.ObjectKeyFromObject(promo), updatedPromo))
+			updatedStage := &kargoapi.Stage{}
+			require.NoError(t, r.kargoClient.Get(ctx, client.ObjectKeyFromObject(stage), updatedStage))
+			testCase.assertions(t, updatedPromo, updatedStage, recorder)
+		})
+	}
+}
+
+// succeedingMechanism is a promotion.Mechanism that always succeeds.
+type succeedingMechanism struct{}
+
+func (s *succeedingMechanism) GetName() string {
+	return "succeeding mechanism"
+}
+
+func (s *succeedingMechanism) Promote(
+	_ context.Context,
+	_ *kargoapi.Stage,
+	_ *kargoapi.Promotion,
+	freight kargoapi.FreightReference,
+) (*kargoapi.PromotionStatus, kargoapi.FreightReference, error) {
+	return &kargoapi.PromotionStatus{Phase: kargoapi.PromotionPhaseSucceeded}, freight, nil
+}
+
+func TestPromoteForced(t *testing.T) {
+	newStage := func() *kargoapi.Stage {
+		return &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      "fake-stage",
+			},
+			Spec: kargoapi.StageSpec{
+				Subscriptions: kargoapi.Subscriptions{
+					UpstreamStages: []kargoapi.StageSubscription{{Name: "fake-upstream-stage"}},
+				},
+			},
+		}
+	}
+	freight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-freight",
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		force      bool
+		assertions func(*testing.T, *fakeevent.EventRecorder, *kargoapi.PromotionStatus, error)
+	}{
+		{
+			name: "unavailable Freight without force",
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				status *kargoapi.PromotionStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseFailed, status.Phase)
+				require.Equal(
+					t,
+					`Freight "fake-freight" is not available to Stage "fake-stage" in namespace "fake-namespace"`,
+					status.Message,
+				)
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name:  "unavailable Freight with force",
+			force: true,
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				status *kargoapi.PromotionStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.True(t, status.Forced)
+				require.Len(t, recorder.Events, 1)
+				event := <-recorder.Events
+				require.Equal(t, corev1.EventTypeWarning, event.EventType)
+				require.Equal(t, kargoapi.EventReasonPromotionForced, event.Reason)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			stage := newStage()
+			recorder := fakeevent.NewEventRecorder(1)
+			r := newFakeReconciler(t, recorder, stage)
+			r.promoMechanisms = &succeedingMechanism{}
+			promo := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhaseRunning, now)
+			promo.Spec.Freight = freight.Name
+			promo.Spec.Force = testCase.force
+			status, err := r.promote(context.Background(), *promo, freight)
+			testCase.assertions(t, recorder, status, err)
+		})
+	}
+}
+
+func TestPromoteDryRun(t *testing.T) {
+	ctx := context.Background()
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		},
+		Spec: kargoapi.StageSpec{
+			Subscriptions: kargoapi.Subscriptions{
+				UpstreamStages: []kargoapi.StageSubscription{{Name: "fake-upstream-stage"}},
+			},
+			PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+		},
+	}
+	freight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-freight",
+		},
+		Warehouse: "fake-warehouse",
+		Status: kargoapi.FreightStatus{
+			VerifiedIn: map[string]kargoapi.VerifiedStage{
+				"fake-upstream-stage": {},
+			},
+		},
+	}
+	r := newFakeReconciler(t, fakeevent.NewEventRecorder(1), stage)
+	r.promoMechanisms = &succeedingMechanism{}
+	r.nowFn = func() time.Time { return now.Time }
+	promo := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhaseRunning, now)
+	promo.Spec.Freight = freight.Name
+	promo.Spec.DryRun = true
+
+	status, err := r.promote(ctx, *promo, freight)
+	require.NoError(t, err)
+	require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+	require.Equal(t, "dry run; no changes were made", status.Message)
+	require.NotNil(t, status.Freight)
+	require.Equal(t, freight.Name, status.Freight.Name)
+	require.Equal(t, "fake-warehouse", status.Freight.Warehouse)
+	require.Equal(
+		t,
+		&kargoapi.FreightProvenance{
+			Warehouse:     "fake-warehouse",
+			UpstreamStage: "fake-upstream-stage",
+			PromotedAt:    &metav1.Time{Time: now.Time},
+			Promotion:     "fake-promo",
+		},
+		status.Freight.Provenance,
+	)
+
+	// The Stage must not have been touched
+	updatedStage := &kargoapi.Stage{}
+	require.NoError(t, r.kargoClient.Get(ctx, client.ObjectKeyFromObject(stage), updatedStage))
+	require.Empty(t, updatedStage.Status.Phase)
+	require.Nil(t, updatedStage.Status.CurrentPromotion)
+	require.Nil(t, updatedStage.Status.LastPromotion)
+	require.Nil(t, updatedStage.Status.CurrentFreight)
+}
+
+func TestPromotePrunesHistory(t *testing.T) {
+	ctx := context.Background()
+	promotedDaysAgo := func(name string, days int) kargoapi.FreightReference {
+		return kargoapi.FreightReference{
+			Name: name,
+			Provenance: &kargoapi.FreightProvenance{
+				PromotedAt: &metav1.Time{
+					Time: now.Add(-time.Duration(days) * 24 * time.Hour),
+				},
+			},
+		}
+	}
+	current := promotedDaysAgo("current-freight", 1)
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		},
+		Spec: kargoapi.StageSpec{
+			Subscriptions: kargoapi.Subscriptions{
+				UpstreamStages: []kargoapi.StageSubscription{{Name: "fake-upstream-stage"}},
+			},
+			PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+			HistoryRetention: &kargoapi.HistoryRetention{
+				MaxLength: 3,
+				MaxAge:    &metav1.Duration{Duration: 7 * 24 * time.Hour},
+			},
+		},
+		Status: kargoapi.StageStatus{
+			CurrentFreight: &current,
+			History: kargoapi.FreightReferenceStack{
+				current,
+				promotedDaysAgo("too-old-freight", 10),
+				promotedDaysAgo("recent-freight", 2),
+				promotedDaysAgo("one-too-many-freight", 3),
+			},
+		},
+	}
+	freight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-freight",
+		},
+		Status: kargoapi.FreightStatus{
+			VerifiedIn: map[string]kargoapi.VerifiedStage{
+				"fake-upstream-stage": {},
+			},
+		},
+	}
+	r := newFakeReconciler(t, fakeevent.NewEventRecorder(1), stage)
+	r.promoMechanisms = &succeedingMechanism{}
+	r.nowFn = func() time.Time { return now.Time }
+	promo := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhaseRunning, now)
+	promo.Spec.Freight = freight.Name
+
+	status, err := r.promote(ctx, *promo, freight)
+	require.NoError(t, err)
+	require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+
+	updatedStage := &kargoapi.Stage{}
+	require.NoError(t, r.kargoClient.Get(ctx, client.ObjectKeyFromObject(stage), updatedStage))
+	history := make([]string, len(updatedStage.Status.History))
+	for i, item := range updatedStage.Status.History {
+		history[i] = item.Name
+	}
+	require.Equal(
+		t,
+		[]string{"fake-freight", "current-freight", "recent-freight"},
+		history,
+	)
+}
+
+func TestPromoteOutOfOrder(t *testing.T) {
+	ctx := context.Background()
+	freight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-freight",
+		},
+		Status: kargoapi.FreightStatus{
+			VerifiedIn: map[string]kargoapi.VerifiedStage{
+				"fake-upstream-stage": {},
+			},
+		},
+	}
+	newStage := func() *kargoapi.Stage {
+		return &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      "fake-stage",
+			},
+			Spec: kargoapi.StageSpec{
+				Subscriptions: kargoapi.Subscriptions{
+					UpstreamStages: []kargoapi.StageSubscription{{Name: "fake-upstream-stage"}},
+				},
+				PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+			},
+			Status: kargoapi.StageStatus{
+				CurrentFreight: &kargoapi.FreightReference{
+					Name: "current-freight",
+					Provenance: &kargoapi.FreightProvenance{
+						Promotion: "current-promo",
+					},
+				},
+			},
+		}
+	}
+	// The Promotion that promoted the current Freight
+	currentPromo := newPromo(
+		"fake-namespace",
+		"current-promo",
+		"fake-stage",
+		kargoapi.PromotionPhaseSucceeded,
+		now,
+	)
+	testCases := []struct {
+		name            string
+		creationTime    metav1.Time
+		expectedFreight string
+	}{
+		{
+			name:            "older Promotion concludes after newer one",
+			creationTime:    before,
+			expectedFreight: "current-freight",
+		},
+		{
+			name:            "newer Promotion concludes",
+			creationTime:    metav1.NewTime(now.Add(time.Minute)),
+			expectedFreight: "fake-freight",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			stage := newStage()
+			r := newFakeReconciler(t, fakeevent.NewEventRecorder(1), stage, currentPromo.DeepCopy())
+			r.promoMechanisms = &succeedingMechanism{}
+			promo := newPromo(
+				"fake-namespace",
+				"fake-promo",
+				"fake-stage",
+				kargoapi.PromotionPhaseRunning,
+				testCase.creationTime,
+			)
+			promo.Spec.Freight = freight.Name
+
+			status, err := r.promote(ctx, *promo, freight)
+			require.NoError(t, err)
+			require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+
+			updatedStage := &kargoapi.Stage{}
+			require.NoError(t, r.kargoClient.Get(ctx, client.ObjectKeyFromObject(stage), updatedStage))
+			require.Equal(t, testCase.expectedFreight, updatedStage.Status.CurrentFreight.Name)
+		})
+	}
+}
+
+func TestReconcileLatestFreight(t *testing.T) {
+	ctx := context.Background()
+	newFreight := func(name, warehouse string, created time.Time) *kargoapi.Freight {
+		return &kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "fake-namespace",
+				Name:              name,
+				CreationTimestamp: metav1.Time{Time: created},
+			},
+			Warehouse: warehouse,
+		}
+	}
+	promo := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, now)
+	promo.Spec.Freight = kargoapi.PromotionFreightLatest
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		},
+		Spec: kargoapi.StageSpec{
+			Subscriptions: kargoapi.Subscriptions{
+				Warehouse: "fake-warehouse",
+			},
+		},
+	}
+	r := newFakeReconciler(
+		t,
+		fakeevent.NewEventRecorder(10),
+		promo,
+		stage,
+		newFreight("old-freight", "fake-warehouse", before.Time),
+		newFreight("new-freight", "fake-warehouse", now.Time),
+		// Newer, but not available to the Stage
+		newFreight("other-freight", "other-warehouse", now.Add(time.Minute)),
+	)
+	var promotedFreight string
+	r.promoteFn = func(
+		_ context.Context,
+		_ kargoapi.Promotion,
+		freight *kargoapi.Freight,
+	) (*kargoapi.PromotionStatus, error) {
+		promotedFreight = freight.Name
+		return &kargoapi.PromotionStatus{
+			Phase:   kargoapi.PromotionPhaseRunning,
+			Freight: &kargoapi.FreightReference{Name: freight.Name},
+		}, nil
+	}
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(promo)}
+
+	// The sentinel is resolved to the newest Freight available to the Stage and
+	// the Freight it resolved to is recorded in the Promotion's status
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, "new-freight", promotedFreight)
+	updatedPromo, err := kargoapi.GetPromotion(ctx, r.kargoClient, req.NamespacedName)
+	require.NoError(t, err)
+	require.Equal(t, kargoapi.PromotionFreightLatest, updatedPromo.Spec.Freight)
+	require.NotNil(t, updatedPromo.Status.Freight)
+	require.Equal(t, "new-freight", updatedPromo.Status.Freight.Name)
+
+	// Once resolved, the Promotion sticks with the same Freight even if newer
+	// Freight becomes available
+	require.NoError(
+		t,
+		r.kargoClient.Create(ctx, newFreight("newer-freight", "fake-warehouse", now.Add(time.Hour))),
+	)
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, "new-freight", promotedFreight)
+}
+
+func TestGetLatestAvailableFreight(t *testing.T) {
+	freight := []client.Object{
+		&kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "fake-namespace",
+				Name:              "verified-freight",
+				CreationTimestamp: before,
+			},
+			Warehouse: "fake-warehouse",
+			Status: kargoapi.FreightStatus{
+				VerifiedIn: map[string]kargoapi.VerifiedStage{
+					"fake-upstream-stage": {},
+				},
+			},
+		},
+		&kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "fake-namespace",
+				Name:              "unverified-freight",
+				CreationTimestamp: now,
+			},
+			Warehouse: "fake-warehouse",
+		},
+	}
+	testCases := []struct {
+		name       string
+		subs       kargoapi.Subscriptions
+		assertions func(*testing.T, *kargoapi.Freight, error)
+	}{
+		{
+			name: "subscribed to Warehouse",
+			subs: kargoapi.Subscriptions{Warehouse: "fake-warehouse"},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, freight)
+				require.Equal(t, "unverified-freight", freight.Name)
+			},
+		},
+		{
+			name: "subscribed to Warehouse with no Freight",
+			subs: kargoapi.Subscriptions{Warehouse: "other-warehouse"},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.Nil(t, freight)
+			},
+		},
+		{
+			name: "subscribed to upstream Stages",
+			subs: kargoapi.Subscriptions{
+				UpstreamStages: []kargoapi.StageSubscription{{Name: "fake-upstream-stage"}},
+			},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, freight)
+				require.Equal(t, "verified-freight", freight.Name)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := newFakeReconciler(t, fakeevent.NewEventRecorder(1), freight...)
+			latest, err := r.getLatestAvailableFreight(
+				context.Background(),
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "fake-stage",
+					},
+					Spec: kargoapi.StageSpec{Subscriptions: testCase.subs},
+				},
+			)
+			testCase.assertions(t, latest, err)
+		})
+	}
+}
+
+func TestVerifiedUpstreamStage(t *testing.T) {
+	freight := &kargoapi.Freight{
+		Status: kargoapi.FreightStatus{
+			VerifiedIn: map[string]kargoapi.VerifiedStage{
+				"uat":  {},
+				"test": {},
+			},
+		},
+	}
+	require.Empty(t, verifiedUpstreamStage(freight, nil))
+	require.Empty(t, verifiedUpstreamStage(freight, []string{"dev"}))
+	require.Equal(t, "test", verifiedUpstreamStage(freight, []string{"dev", "test", "uat"}))
+}
+
 // Tests that initalizeQueues is called properly
 func TestReconcileInitializeQueues(t *testing.T) {
 	ctx := context.TODO()
@@ -215,3 +1304,155 @@ func TestReconcileInitializeQueues(t *testing.T) {
 	stageKey := types.NamespacedName{Namespace: "fake-namespace", Name: "fake-stage"}
 	require.Equal(t, 2, r.pqs.pendingPromoQueuesByStage[stageKey].Depth())
 }
+
+// Tests that queues are rebuilt from the Promotions that exist when the
+// controller starts
+func TestReconcileRecoversQueues(t *testing.T) {
+	ctx := context.TODO()
+	stageKey := types.NamespacedName{Namespace: "fake-namespace", Name: "fake-stage"}
+	otherShardPromo := newPromo("fake-namespace", "other-shard", "fake-stage", kargoapi.PromotionPhasePending, before)
+	otherShardPromo.Labels = map[string]string{kargoapi.ShardLabelKey: "other-shard"}
+	highPriorityPromo := newPromo("fake-namespace", "high-priority", "fake-stage", kargoapi.PromotionPhasePending, after)
+	highPriorityPromo.Spec.Priority = 10
+	promos := []client.Object{
+		newPromo("fake-namespace", "succeeded", "fake-stage", kargoapi.PromotionPhaseSucceeded, before),
+		newPromo("fake-namespace", "running", "fake-stage", kargoapi.PromotionPhaseRunning, before),
+		newPromo("fake-namespace", "pending-2", "fake-stage", kargoapi.PromotionPhasePending, after),
+		newPromo("fake-namespace", "pending-1", "fake-stage", kargoapi.PromotionPhasePending, now),
+		highPriorityPromo,
+		otherShardPromo,
+	}
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, kargoapi.SchemeBuilder.AddToScheme(scheme))
+	listErr := errors.New("something went wrong")
+	kargoClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(promos...).WithStatusSubresource(promos...).
+		WithInterceptorFuncs(interceptor.Funcs{
+			List: func(
+				ctx context.Context,
+				c client.WithWatch,
+				list client.ObjectList,
+				opts ...client.ListOption,
+			) error {
+				if listErr != nil {
+					return listErr
+				}
+				return c.List(ctx, list, opts...)
+			},
+		}).
+		Build()
+	r := newReconciler(
+		kargoClient,
+		nil,
+		fake.NewClientBuilder().Build(),
+		nil,
+		&fakeevent.EventRecorder{},
+		&credentials.FakeDB{},
+		ReconcilerConfig{},
+	)
+
+	// reconcile a non-existent promo to trigger initializeQueues
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "does-not-exist", Name: "does-not-exist"}}
+
+	// A failure to list Promotions must not leave the queues empty for good
+	_, err := r.Reconcile(ctx, req)
+	require.ErrorContains(t, err, "error initializing Promotion queues")
+	require.Empty(t, r.pqs.pendingPromoQueuesByStage)
+
+	listErr = nil
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	// The Running Promotion is active again and the others are pending in
+	// priority order. Terminal Promotions and those of other shards are
+	// left out.
+	require.Equal(t, map[string]struct{}{"running": {}}, r.pqs.activePromosByStage[stageKey])
+	pq := r.pqs.pendingPromoQueuesByStage[stageKey]
+	require.Equal(t, 3, pq.Depth())
+	require.Equal(t, "high-priority", pq.Pop().GetName())
+	require.Equal(t, "pending-1", pq.Pop().GetName())
+	require.Equal(t, "pending-2", pq.Pop().GetName())
+}
+
+// Tests that Promotions for different Stages are carried out concurrently
+// while Promotions for the same Stage are still serialized
+func TestReconcileConcurrentStages(t *testing.T) {
+	ctx := context.TODO()
+	promos := []client.Object{
+		newPromo("fake-namespace", "fake-promo-a1", "fake-stage-a", kargoapi.PromotionPhasePending, before),
+		newPromo("fake-namespace", "fake-promo-a2", "fake-stage-a", kargoapi.PromotionPhasePending, now),
+		newPromo("fake-namespace", "fake-promo-b", "fake-stage-b", kargoapi.PromotionPhasePending, now),
+	}
+	// Each Promotion that runs records an Event when it starts and another
+	// when it finishes.
+	recorder := fakeevent.NewEventRecorder(2 * len(promos))
+	r := newFakeReconciler(t, recorder, promos...)
+	r.getStageFn = func(context.Context, client.Client, types.NamespacedName) (*kargoapi.Stage, error) {
+		return &kargoapi.Stage{}, nil
+	}
+
+	started := make(chan string, len(promos))
+	release := make(chan struct{})
+	r.promoteFn = func(
+		_ context.Context,
+		p v1alpha1.Promotion,
+		_ *v1alpha1.Freight,
+	) (*kargoapi.PromotionStatus, error) {
+		started <- p.Name
+		<-release
+		return &kargoapi.PromotionStatus{Phase: kargoapi.PromotionPhaseSucceeded}, nil
+	}
+
+	var wg sync.WaitGroup
+	errCh := make(chan error, 2)
+	for _, name := range []string{"fake-promo-a1", "fake-promo-b"} {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			_, err := r.Reconcile(ctx, ctrl.Request{
+				NamespacedName: types.NamespacedName{Namespace: "fake-namespace", Name: name},
+			})
+			errCh <- err
+		}(name)
+	}
+
+	// Both Promotions should be in progress at the same time. Neither can
+	// complete until both have started.
+	startedPromos := map[string]struct{}{}
+	for len(startedPromos) < 2 {
+		select {
+		case name := <-started:
+			startedPromos[name] = struct{}{}
+		case <-time.After(10 * time.Second):
+			close(release)
+			t.Fatalf("Promotions for different Stages did not run concurrently; started: %v", startedPromos)
+		}
+	}
+
+	// A second Promotion for a Stage with a Promotion in progress should not
+	// begin.
+	a2Key := types.NamespacedName{Namespace: "fake-namespace", Name: "fake-promo-a2"}
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: a2Key})
+	require.NoError(t, err)
+	var a2 kargoapi.Promotion
+	require.NoError(t, r.kargoClient.Get(ctx, a2Key, &a2))
+	require.Equal(t, kargoapi.PromotionPhasePending, a2.Status.Phase)
+	require.Empty(t, started)
+
+	close(release)
+	wg.Wait()
+	close(errCh)
+	for err := range errCh {
+		require.NoError(t, err)
+	}
+
+	for _, name := range []string{"fake-promo-a1", "fake-promo-b"} {
+		var promo kargoapi.Promotion
+		require.NoError(t, r.kargoClient.Get(
+			ctx,
+			types.NamespacedName{Namespace: "fake-namespace", Name: name},
+			&promo,
+		))
+		require.Equal(t, kargoapi.PromotionPhaseSucceeded, promo.Status.Phase)
+	}
+}
@@ -2,7 +2,8 @@ package promotions
 
 import (
 	"context"
-	"fmt"
+	"slices"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/fields"
@@ -14,6 +15,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
 	"github.com/akuity/kargo/internal/kubeclient"
 	"github.com/akuity/kargo/internal/logging"
 )
@@ -21,10 +23,11 @@ import (
 // EnqueueHighestPriorityPromotionHandler is an event handler that enqueues the next
 // highest priority Promotion for reconciliation when an active Promotion becomes terminal
 type EnqueueHighestPriorityPromotionHandler struct {
-	logger      *log.Entry
-	ctx         context.Context
 	pqs         *promoQueues
 	kargoClient client.Client
+	// shardSelector, if non-nil, restricts the Promotions this handler enqueues
+	// to those belonging to the controller's shard.
+	shardSelector labels.Selector
 }
 
 // Create implements EventHandler.
@@ -39,7 +42,7 @@ func (e *EnqueueHighestPriorityPromotionHandler) Create(
 // Delete implements EventHandler. In case a Running promotion
 // becomes deleted, we should enqueue the next one
 func (e *EnqueueHighestPriorityPromotionHandler) Delete(
-	_ context.Context,
+	ctx context.Context,
 	evt event.DeleteEvent,
 	wq workqueue.RateLimitingInterface,
 ) {
@@ -48,8 +51,8 @@ func (e *EnqueueHighestPriorityPromotionHandler) Delete(
 			Namespace: promo.Namespace,
 			Name:      promo.Spec.Stage,
 		}
-		e.pqs.conclude(e.ctx, stageKey, promo.Name)
-		e.enqueueNext(stageKey, wq)
+		e.pqs.conclude(ctx, stageKey, promo.Name)
+		e.enqueueNext(ctx, stageKey, wq)
 	}
 }
 
@@ -65,17 +68,19 @@ func (e *EnqueueHighestPriorityPromotionHandler) Generic(
 // Update implements EventHandler. This should only be called with
 // a promo that transitioned from non-terminal to terminal.
 func (e *EnqueueHighestPriorityPromotionHandler) Update(
-	_ context.Context,
+	ctx context.Context,
 	evt event.UpdateEvent,
 	wq workqueue.RateLimitingInterface,
 ) {
+	logger := logging.LoggerFromContext(ctx)
+
 	if evt.ObjectNew == nil {
-		e.logger.Errorf("Update event has no new object to update: %v", evt)
+		logger.Errorf("Update event has no new object to update: %v", evt)
 		return
 	}
 	promo, ok := evt.ObjectNew.(*kargoapi.Promotion)
 	if !ok {
-		e.logger.Errorf("Failed to convert new Promotion: %v", evt.ObjectNew)
+		logger.Errorf("Failed to convert new Promotion: %v", evt.ObjectNew)
 		return
 	}
 	if promo.Status.Phase.IsTerminal() {
@@ -85,21 +90,27 @@ func (e *EnqueueHighestPriorityPromotionHandler) Update(
 		}
 		// This promo just went terminal. Deactivate it and enqueue
 		// the next highest priority promo for reconciliation
-		e.pqs.conclude(e.ctx, stageKey, promo.Name)
-		e.enqueueNext(stageKey, wq)
+		e.pqs.conclude(ctx, stageKey, promo.Name)
+		e.enqueueNext(ctx, stageKey, wq)
 	}
 }
 
-// enqueueNext enqueues the next highest priority promotion for reconciliation to the workqueue.
-// Also discards pending promotions in the queue that no longer exist
+// enqueueNext enqueues the next highest priority promotions for reconciliation to the
+// workqueue, as many as the stage has room to make active. Also discards pending
+// promotions in the queue that no longer exist
 func (e *EnqueueHighestPriorityPromotionHandler) enqueueNext(
+	ctx context.Context,
 	stageKey types.NamespacedName,
 	wq workqueue.RateLimitingInterface,
 ) {
-	e.pqs.promoQueuesByStageMu.RLock()
-	defer e.pqs.promoQueuesByStageMu.RUnlock()
-	if e.pqs.activePromoByStage[stageKey] != "" {
-		// there's already an active promotion. don't need to enqueue the next one
+	logger := logging.LoggerFromContext(ctx)
+
+	e.pqs.promoQueuesByStageMu.Lock()
+	defer e.pqs.promoQueuesByStageMu.Unlock()
+	free := e.pqs.freeSlots(stageKey)
+	if free <= 0 {
+		// the stage already has as many active promotions as it allows. don't need to
+		// enqueue the next one
 		return
 	}
 	pq, ok := e.pqs.pendingPromoQueuesByStage[stageKey]
@@ -107,27 +118,48 @@ func (e *EnqueueHighestPriorityPromotionHandler) enqueueNext(
 		return
 	}
 
+	// Promotions are popped off the queue only so we can look past the first of them.
+	// Those that were enqueued are pushed back on before returning, since they only
+	// become active once they are reconciled.
+	enqueued := make([]client.Object, 0, free)
+	defer func() {
+		for _, obj := range enqueued {
+			pq.Push(obj)
+		}
+	}()
+
 	// NOTE: at first glance, this for loop appears to be expensive to do while holding
 	// the pqs mutex. But it isn't as bad as it looks, since we count on the fact that
 	// GetPromotion calls pull from the informer cache and do not involve an HTTP call.
 	// and in the common case, we only do a single iteration
-	for {
-		first := pq.Peek()
+	for len(enqueued) < free {
+		first := pq.Pop()
 		if first == nil {
 			// pending queue is empty
 			return
 		}
 		// Check if promo exists, and enqueue it if it does
 		firstKey := types.NamespacedName{Namespace: first.GetNamespace(), Name: first.GetName()}
-		promo, err := kargoapi.GetPromotion(e.ctx, e.kargoClient, firstKey)
+		promo, err := kargoapi.GetPromotion(ctx, e.kargoClient, firstKey)
 		if err != nil {
-			e.logger.Errorf("Failed to get next highest priority Promotion (%s) for enqueue: %v", firstKey, err)
+			pq.Push(first)
+			logger.Errorf("Failed to get next highest priority Promotion (%s) for enqueue: %v", firstKey, err)
 			return
 		}
 		if promo == nil || promo.Status.Phase.IsTerminal() {
 			// Found a promotion in the pending queue that no longer exists
-			// or terminal. Pop it and loop to the next item in the queue
-			_ = pq.Pop()
+			// or terminal. Discard it and loop to the next item in the queue
+			continue
+		}
+		if e.shardSelector != nil && !e.shardSelector.Matches(labels.Set(promo.Labels)) {
+			// Found a promotion in the pending queue that belongs to another
+			// shard. It is that shard's controller that must reconcile it, so
+			// discard it and loop to the next item in the queue
+			logger.WithFields(log.Fields{
+				"promotion": promo.Name,
+				"namespace": promo.Namespace,
+				"stage":     promo.Spec.Stage,
+			}).Debug("discarded promo belonging to another shard")
 			continue
 		}
 		wq.AddRateLimited(
@@ -138,12 +170,12 @@ func (e *EnqueueHighestPriorityPromotionHandler) enqueueNext(
 				},
 			},
 		)
-		e.logger.WithFields(log.Fields{
+		enqueued = append(enqueued, first)
+		logger.WithFields(log.Fields{
 			"promotion": promo.Name,
 			"namespace": promo.Namespace,
 			"stage":     promo.Spec.Stage,
 		}).Debug("enqueued promo")
-		return
 	}
 }
 
@@ -152,6 +184,15 @@ func (e *EnqueueHighestPriorityPromotionHandler) enqueueNext(
 type UpdatedArgoCDAppHandler struct {
 	kargoClient   client.Client
 	shardSelector labels.Selector
+	// instance is the name of the Argo CD instance whose Applications this
+	// handler is notified about. It is empty for the default instance.
+	instance string
+	// dedupWindow, if non-zero, delays the enqueuing of Promotions by the
+	// specified duration. The workqueue holds only one pending entry per
+	// Promotion, so a rapid succession of updates to an Application (e.g. while
+	// it is syncing) results in a single reconciliation instead of one per
+	// update.
+	dedupWindow time.Duration
 }
 
 // Create implements EventHandler.
@@ -194,6 +235,15 @@ func (u *UpdatedArgoCDAppHandler) Update(
 		return
 	}
 
+	if oldApp, ok := e.ObjectOld.(*argocd.Application); ok {
+		if newApp, ok := e.ObjectNew.(*argocd.Application); ok &&
+			!argoCDAppChanged(oldApp, newApp) {
+			logger.WithField("app", newApp.GetName()).
+				Trace("ignoring Application update without relevant changes")
+			return
+		}
+	}
+
 	promotions := &kargoapi.PromotionList{}
 	if err := u.kargoClient.List(
 		ctx,
@@ -201,8 +251,8 @@ func (u *UpdatedArgoCDAppHandler) Update(
 		&client.ListOptions{
 			FieldSelector: fields.OneTermEqualSelector(
 				kubeclient.RunningPromotionsByArgoCDApplicationsIndexField,
-				fmt.Sprintf(
-					"%s:%s",
+				kubeclient.ArgoCDInstanceApplicationKey(
+					u.instance,
 					e.ObjectNew.GetNamespace(),
 					e.ObjectNew.GetName(),
 				),
@@ -220,14 +270,17 @@ func (u *UpdatedArgoCDAppHandler) Update(
 	}
 
 	for _, promotion := range promotions.Items {
-		wq.Add(
-			reconcile.Request{
-				NamespacedName: types.NamespacedName{
-					Namespace: promotion.Namespace,
-					Name:      promotion.Name,
-				},
+		req := reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Namespace: promotion.Namespace,
+				Name:      promotion.Name,
 			},
-		)
+		}
+		if u.dedupWindow > 0 {
+			wq.AddAfter(req, u.dedupWindow)
+		} else {
+			wq.Add(req)
+		}
 		logger.WithFields(log.Fields{
 			"namespace": promotion.Namespace,
 			"promotion": promotion.Name,
@@ -235,3 +288,42 @@ func (u *UpdatedArgoCDAppHandler) Update(
 		}).Debug("enqueued Promotion for reconciliation")
 	}
 }
+
+// argoCDAppChanged returns true if any of the fields of an Argo CD Application
+// that a Promotion may be waiting on differ between the two provided versions
+// of the Application. These are the sync and health status, the revisions the
+// Application targets, and the phase of its current operation. Updates that
+// change none of these (e.g. a refresh that only bumps the reconciliation
+// timestamp) are of no interest to a Promotion.
+func argoCDAppChanged(oldApp, newApp *argocd.Application) bool {
+	if oldApp.Status.Sync.Status != newApp.Status.Sync.Status ||
+		oldApp.Status.Sync.Revision != newApp.Status.Sync.Revision ||
+		!slices.Equal(oldApp.Status.Sync.Revisions, newApp.Status.Sync.Revisions) ||
+		oldApp.Status.Health.Status != newApp.Status.Health.Status {
+		return true
+	}
+	if !slices.Equal(argoCDAppTargetRevisions(oldApp), argoCDAppTargetRevisions(newApp)) {
+		return true
+	}
+	var oldPhase, newPhase argocd.OperationPhase
+	if oldApp.Status.OperationState != nil {
+		oldPhase = oldApp.Status.OperationState.Phase
+	}
+	if newApp.Status.OperationState != nil {
+		newPhase = newApp.Status.OperationState.Phase
+	}
+	return oldPhase != newPhase
+}
+
+// argoCDAppTargetRevisions returns the target revisions of all of the provided
+// Application's sources.
+func argoCDAppTargetRevisions(app *argocd.Application) []string {
+	revisions := make([]string, 0, len(app.Spec.Sources)+1)
+	if app.Spec.Source != nil {
+		revisions = append(revisions, app.Spec.Source.TargetRevision)
+	}
+	for _, source := range app.Spec.Sources {
+		revisions = append(revisions, source.TargetRevision)
+	}
+	return revisions
+}
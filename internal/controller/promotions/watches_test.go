@@ -3,8 +3,10 @@ package promotions
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -31,6 +33,7 @@ func TestUpdatedArgoCDAppHandler_Update(t *testing.T) {
 		indexer       client.IndexerFunc
 		interceptor   interceptor.Funcs
 		shardSelector labels.Selector
+		instance      string
 		e             event.UpdateEvent
 		assertions    func(*testing.T, workqueue.RateLimitingInterface)
 	}{
@@ -52,6 +55,39 @@ func TestUpdatedArgoCDAppHandler_Update(t *testing.T) {
 				require.Equal(t, 0, wq.Len())
 			},
 		},
+		{
+			name: "Event without relevant changes",
+			applications: []client.Object{
+				&kargoapi.Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "matching-promotion",
+						Namespace: "fake-namespace",
+					},
+				},
+			},
+			indexer: func(client.Object) []string {
+				return []string{"fake-application-namespace:fake-application-name"}
+			},
+			e: event.UpdateEvent{
+				ObjectOld: &argocd.Application{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "fake-application-name",
+						Namespace:       "fake-application-namespace",
+						ResourceVersion: "1",
+					},
+				},
+				ObjectNew: &argocd.Application{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "fake-application-name",
+						Namespace:       "fake-application-namespace",
+						ResourceVersion: "2",
+					},
+				},
+			},
+			assertions: func(t *testing.T, wq workqueue.RateLimitingInterface) {
+				require.Equal(t, 0, wq.Len())
+			},
+		},
 		{
 			name: "Event object has indexed Promotion",
 			applications: []client.Object{
@@ -81,6 +117,11 @@ func TestUpdatedArgoCDAppHandler_Update(t *testing.T) {
 						Name:      "fake-application-name",
 						Namespace: "fake-application-namespace",
 					},
+					Status: argocd.ApplicationStatus{
+						OperationState: &argocd.OperationState{
+							Phase: argocd.OperationSucceeded,
+						},
+					},
 				},
 			},
 			assertions: func(t *testing.T, wq workqueue.RateLimitingInterface) {
@@ -95,6 +136,118 @@ func TestUpdatedArgoCDAppHandler_Update(t *testing.T) {
 				}, item)
 			},
 		},
+		{
+			name: "Application in a namespace other than Argo CD's",
+			applications: []client.Object{
+				&kargoapi.Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "default-namespace-promotion",
+						Namespace: "fake-namespace",
+					},
+				},
+				&kargoapi.Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "team-namespace-promotion",
+						Namespace: "fake-namespace",
+					},
+				},
+			},
+			indexer: func(obj client.Object) []string {
+				// Both Promotions update an Application with the same name, but
+				// only one of them targets the Application outside of Argo CD's
+				// own namespace.
+				switch obj.GetName() {
+				case "default-namespace-promotion":
+					return []string{kubeclient.ArgoCDApplicationKey("argocd", "fake-application-name")}
+				case "team-namespace-promotion":
+					return []string{kubeclient.ArgoCDApplicationKey("team-apps", "fake-application-name")}
+				}
+				return nil
+			},
+			e: event.UpdateEvent{
+				ObjectOld: &argocd.Application{},
+				ObjectNew: &argocd.Application{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-application-name",
+						Namespace: "team-apps",
+					},
+					Status: argocd.ApplicationStatus{
+						OperationState: &argocd.OperationState{
+							Phase: argocd.OperationSucceeded,
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, wq workqueue.RateLimitingInterface) {
+				require.Equal(t, 1, wq.Len())
+
+				item, _ := wq.Get()
+				require.Equal(t, reconcile.Request{
+					NamespacedName: types.NamespacedName{
+						Namespace: "fake-namespace",
+						Name:      "team-namespace-promotion",
+					},
+				}, item)
+			},
+		},
+		{
+			name: "Application of another Argo CD instance",
+			applications: []client.Object{
+				&kargoapi.Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "default-instance-promotion",
+						Namespace: "fake-namespace",
+					},
+				},
+				&kargoapi.Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "other-instance-promotion",
+						Namespace: "fake-namespace",
+					},
+				},
+			},
+			indexer: func(obj client.Object) []string {
+				// Both Promotions update an Application with the same namespace
+				// and name, but managed by different Argo CD instances.
+				switch obj.GetName() {
+				case "default-instance-promotion":
+					return []string{
+						kubeclient.ArgoCDInstanceApplicationKey("", "argocd", "fake-application-name"),
+					}
+				case "other-instance-promotion":
+					return []string{
+						kubeclient.ArgoCDInstanceApplicationKey("other-instance", "argocd", "fake-application-name"),
+					}
+				}
+				return nil
+			},
+			instance: "other-instance",
+			e: event.UpdateEvent{
+				ObjectOld: &argocd.Application{},
+				ObjectNew: &argocd.Application{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-application-name",
+						Namespace: "argocd",
+					},
+					Status: argocd.ApplicationStatus{
+						OperationState: &argocd.OperationState{
+							Phase: argocd.OperationSucceeded,
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, wq workqueue.RateLimitingInterface) {
+				require.Equal(t, 1, wq.Len())
+
+				item, _ := wq.Get()
+				require.Equal(t, reconcile.Request{
+					NamespacedName: types.NamespacedName{
+						Namespace: "fake-namespace",
+						Name:      "other-instance-promotion",
+					},
+				}, item)
+			},
+		},
 		{
 			name: "Event object has multiple indexed Promotions",
 			applications: []client.Object{
@@ -130,6 +283,11 @@ func TestUpdatedArgoCDAppHandler_Update(t *testing.T) {
 						Name:      "fake-application-name",
 						Namespace: "fake-application-namespace",
 					},
+					Status: argocd.ApplicationStatus{
+						OperationState: &argocd.OperationState{
+							Phase: argocd.OperationSucceeded,
+						},
+					},
 				},
 			},
 			assertions: func(t *testing.T, wq workqueue.RateLimitingInterface) {
@@ -205,6 +363,11 @@ func TestUpdatedArgoCDAppHandler_Update(t *testing.T) {
 						Name:      "fake-application-name",
 						Namespace: "fake-application-namespace",
 					},
+					Status: argocd.ApplicationStatus{
+						OperationState: &argocd.OperationState{
+							Phase: argocd.OperationSucceeded,
+						},
+					},
 				},
 			},
 			assertions: func(t *testing.T, wq workqueue.RateLimitingInterface) {
@@ -239,6 +402,11 @@ func TestUpdatedArgoCDAppHandler_Update(t *testing.T) {
 						Name:      "fake-application-name",
 						Namespace: "fake-application-namespace",
 					},
+					Status: argocd.ApplicationStatus{
+						OperationState: &argocd.OperationState{
+							Phase: argocd.OperationSucceeded,
+						},
+					},
 				},
 			},
 			assertions: func(t *testing.T, wq workqueue.RateLimitingInterface) {
@@ -270,6 +438,11 @@ func TestUpdatedArgoCDAppHandler_Update(t *testing.T) {
 						Name:      "fake-application-name",
 						Namespace: "fake-application-namespace",
 					},
+					Status: argocd.ApplicationStatus{
+						OperationState: &argocd.OperationState{
+							Phase: argocd.OperationSucceeded,
+						},
+					},
 				},
 			},
 			assertions: func(t *testing.T, wq workqueue.RateLimitingInterface) {
@@ -295,6 +468,7 @@ func TestUpdatedArgoCDAppHandler_Update(t *testing.T) {
 			u := &UpdatedArgoCDAppHandler{
 				kargoClient:   c.Build(),
 				shardSelector: tt.shardSelector,
+				instance:      tt.instance,
 			}
 
 			wq := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
@@ -304,3 +478,308 @@ func TestUpdatedArgoCDAppHandler_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdatedArgoCDAppHandler_UpdateDedup(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	promo := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-promotion",
+			Namespace: "fake-namespace",
+		},
+	}
+	u := &UpdatedArgoCDAppHandler{
+		kargoClient: fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(promo).
+			WithIndex(
+				&kargoapi.Promotion{},
+				kubeclient.RunningPromotionsByArgoCDApplicationsIndexField,
+				func(client.Object) []string {
+					return []string{"fake-application-namespace:fake-application-name"}
+				},
+			).
+			Build(),
+		dedupWindow: 100 * time.Millisecond,
+	}
+
+	wq := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	defer wq.ShutDown()
+
+	// Simulate an Application that is updated many times in quick succession
+	app := &argocd.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-application-name",
+			Namespace: "fake-application-namespace",
+		},
+	}
+	for i := 0; i < 10; i++ {
+		updatedApp := app.DeepCopy()
+		updatedApp.Status.Sync.Revision = fmt.Sprintf("fake-revision-%d", i)
+		u.Update(
+			context.Background(),
+			event.UpdateEvent{ObjectOld: app, ObjectNew: updatedApp},
+			wq,
+		)
+		app = updatedApp
+	}
+
+	// Nothing is enqueued until the window has passed...
+	require.Equal(t, 0, wq.Len())
+	// ...and then the Promotion is enqueued only once
+	require.Eventually(t, func() bool {
+		return wq.Len() > 0
+	}, 5*time.Second, 10*time.Millisecond)
+	time.Sleep(200 * time.Millisecond)
+	require.Equal(t, 1, wq.Len())
+	item, _ := wq.Get()
+	require.Equal(t, reconcile.Request{
+		NamespacedName: client.ObjectKeyFromObject(promo),
+	}, item)
+}
+
+func TestEnqueueHighestPriorityPromotionHandler_Update(t *testing.T) {
+	stageKey := types.NamespacedName{Namespace: "fake-namespace", Name: "fake-stage"}
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	for _, phase := range []kargoapi.PromotionPhase{
+		kargoapi.PromotionPhaseSucceeded,
+		kargoapi.PromotionPhaseAborted,
+	} {
+		t.Run(string(phase), func(t *testing.T) {
+			activePromo := newPromo("fake-namespace", "fake-promo-1", "fake-stage", kargoapi.PromotionPhaseRunning, before)
+			pendingPromo := newPromo("fake-namespace", "fake-promo-2", "fake-stage", kargoapi.PromotionPhasePending, now)
+
+			pqs := newPromoQueues()
+			pqs.initializeQueues(
+				context.Background(),
+				kargoapi.PromotionList{Items: []kargoapi.Promotion{*activePromo, *pendingPromo}},
+			)
+			require.Equal(t, map[string]struct{}{"fake-promo-1": {}}, pqs.activePromosByStage[stageKey])
+
+			h := &EnqueueHighestPriorityPromotionHandler{
+				pqs: pqs,
+				kargoClient: fake.NewClientBuilder().WithScheme(scheme).
+					WithObjects(activePromo, pendingPromo).Build(),
+			}
+
+			finishedPromo := activePromo.DeepCopy()
+			finishedPromo.Status.Phase = phase
+			wq := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+			defer wq.ShutDown()
+			h.Update(
+				context.Background(),
+				event.UpdateEvent{ObjectOld: activePromo, ObjectNew: finishedPromo},
+				wq,
+			)
+
+			require.Empty(t, pqs.activePromosByStage[stageKey])
+			item, _ := wq.Get()
+			require.Equal(t, reconcile.Request{
+				NamespacedName: client.ObjectKeyFromObject(pendingPromo),
+			}, item)
+		})
+	}
+}
+
+func TestEnqueueHighestPriorityPromotionHandler_UpdateWithMaxActive(t *testing.T) {
+	stageKey := types.NamespacedName{Namespace: "fake-namespace", Name: "fake-stage"}
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	activePromo := newPromo("fake-namespace", "fake-promo-1", "fake-stage", kargoapi.PromotionPhaseRunning, before)
+	pendingPromos := []*kargoapi.Promotion{
+		newPromo("fake-namespace", "fake-promo-2", "fake-stage", kargoapi.PromotionPhasePending, now),
+		newPromo("fake-namespace", "fake-promo-3", "fake-stage", kargoapi.PromotionPhasePending, now),
+		newPromo("fake-namespace", "fake-promo-4", "fake-stage", kargoapi.PromotionPhasePending, after),
+	}
+
+	pqs := newPromoQueues()
+	pqs.initializeQueues(
+		context.Background(),
+		kargoapi.PromotionList{
+			Items: []kargoapi.Promotion{
+				*activePromo,
+				*pendingPromos[0],
+				*pendingPromos[1],
+				*pendingPromos[2],
+			},
+		},
+	)
+	pqs.maxActivePromosByStage[stageKey] = 2
+
+	h := &EnqueueHighestPriorityPromotionHandler{
+		pqs: pqs,
+		kargoClient: fake.NewClientBuilder().WithScheme(scheme).
+			WithObjects(activePromo, pendingPromos[0], pendingPromos[1], pendingPromos[2]).
+			Build(),
+	}
+
+	finishedPromo := activePromo.DeepCopy()
+	finishedPromo.Status.Phase = kargoapi.PromotionPhaseSucceeded
+	wq := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	defer wq.ShutDown()
+	h.Update(
+		context.Background(),
+		event.UpdateEvent{ObjectOld: activePromo, ObjectNew: finishedPromo},
+		wq,
+	)
+
+	// Both free slots are filled, in priority order
+	for _, promo := range pendingPromos[:2] {
+		item, _ := wq.Get()
+		require.Equal(t, reconcile.Request{
+			NamespacedName: client.ObjectKeyFromObject(promo),
+		}, item)
+		wq.Done(item)
+	}
+	// Enqueued promos remain pending until they are reconciled
+	require.Equal(t, 3, pqs.pendingPromoQueuesByStage[stageKey].Depth())
+	require.Equal(t, "fake-promo-2", pqs.pendingPromoQueuesByStage[stageKey].Peek().GetName())
+}
+
+func TestArgoCDAppChanged(t *testing.T) {
+	app := &argocd.Application{
+		Spec: argocd.ApplicationSpec{
+			Source: &argocd.ApplicationSource{TargetRevision: "main"},
+		},
+		Status: argocd.ApplicationStatus{
+			Sync: argocd.SyncStatus{
+				Status:   argocd.SyncStatusCodeSynced,
+				Revision: "fake-revision",
+			},
+			Health: argocd.HealthStatus{Status: argocd.HealthStatusHealthy},
+			OperationState: &argocd.OperationState{
+				Phase: argocd.OperationSucceeded,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		mutate   func(*argocd.Application)
+		expected bool
+	}{
+		{
+			name:     "no changes",
+			mutate:   func(*argocd.Application) {},
+			expected: false,
+		},
+		{
+			name: "irrelevant changes",
+			mutate: func(app *argocd.Application) {
+				app.ResourceVersion = "2"
+				app.Status.Health.Message = "fake-message"
+				app.Status.OperationState.Message = "fake-message"
+			},
+			expected: false,
+		},
+		{
+			name: "sync status changed",
+			mutate: func(app *argocd.Application) {
+				app.Status.Sync.Status = "OutOfSync"
+			},
+			expected: true,
+		},
+		{
+			name: "synced revision changed",
+			mutate: func(app *argocd.Application) {
+				app.Status.Sync.Revision = "other-revision"
+			},
+			expected: true,
+		},
+		{
+			name: "health changed",
+			mutate: func(app *argocd.Application) {
+				app.Status.Health.Status = argocd.HealthStatusProgressing
+			},
+			expected: true,
+		},
+		{
+			name: "target revision changed",
+			mutate: func(app *argocd.Application) {
+				app.Spec.Source.TargetRevision = "v1.0.0"
+			},
+			expected: true,
+		},
+		{
+			name: "source added",
+			mutate: func(app *argocd.Application) {
+				app.Spec.Sources = argocd.ApplicationSources{{TargetRevision: "main"}}
+			},
+			expected: true,
+		},
+		{
+			name: "operation phase changed",
+			mutate: func(app *argocd.Application) {
+				app.Status.OperationState.Phase = argocd.OperationRunning
+			},
+			expected: true,
+		},
+		{
+			name: "operation state removed",
+			mutate: func(app *argocd.Application) {
+				app.Status.OperationState = nil
+			},
+			expected: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			newApp := app.DeepCopy()
+			testCase.mutate(newApp)
+			require.Equal(t, testCase.expected, argoCDAppChanged(app, newApp))
+		})
+	}
+}
+
+func TestEnqueueHighestPriorityPromotionHandler_UpdateSkipsOtherShards(t *testing.T) {
+	stageKey := types.NamespacedName{Namespace: "fake-namespace", Name: "fake-stage"}
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	activePromo := newPromo("fake-namespace", "fake-promo-1", "fake-stage", kargoapi.PromotionPhaseRunning, before)
+	activePromo.Labels = map[string]string{kargoapi.ShardLabelKey: "fake-shard"}
+	// Although first in line, this Promotion belongs to another shard
+	otherShardPromo := newPromo("fake-namespace", "fake-promo-2", "fake-stage", kargoapi.PromotionPhasePending, now)
+	otherShardPromo.Labels = map[string]string{kargoapi.ShardLabelKey: "other-shard"}
+	pendingPromo := newPromo("fake-namespace", "fake-promo-3", "fake-stage", kargoapi.PromotionPhasePending, after)
+	pendingPromo.Labels = map[string]string{kargoapi.ShardLabelKey: "fake-shard"}
+
+	pqs := newPromoQueues()
+	pqs.initializeQueues(
+		context.Background(),
+		kargoapi.PromotionList{
+			Items: []kargoapi.Promotion{*activePromo, *otherShardPromo, *pendingPromo},
+		},
+	)
+
+	h := &EnqueueHighestPriorityPromotionHandler{
+		pqs: pqs,
+		kargoClient: fake.NewClientBuilder().WithScheme(scheme).
+			WithObjects(activePromo, otherShardPromo, pendingPromo).Build(),
+		shardSelector: labels.SelectorFromSet(labels.Set{
+			kargoapi.ShardLabelKey: "fake-shard",
+		}),
+	}
+
+	finishedPromo := activePromo.DeepCopy()
+	finishedPromo.Status.Phase = kargoapi.PromotionPhaseSucceeded
+	wq := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	defer wq.ShutDown()
+	h.Update(
+		context.Background(),
+		event.UpdateEvent{ObjectOld: activePromo, ObjectNew: finishedPromo},
+		wq,
+	)
+
+	item, _ := wq.Get()
+	require.Equal(t, reconcile.Request{
+		NamespacedName: client.ObjectKeyFromObject(pendingPromo),
+	}, item)
+	// The other shard's Promotion was discarded from the queue
+	require.Equal(t, 1, pqs.pendingPromoQueuesByStage[stageKey].Depth())
+	require.Equal(t, "fake-promo-3", pqs.pendingPromoQueuesByStage[stageKey].Peek().GetName())
+}
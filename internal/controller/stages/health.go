@@ -0,0 +1,223 @@
+package stages
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	libHTTP "github.com/akuity/kargo/internal/http"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+const (
+	// defaultHealthCheckInterval is how often the health of a Stage is
+	// reassessed when the Stage does not specify an interval of its own.
+	defaultHealthCheckInterval = 5 * time.Minute
+	// defaultHTTPHealthCheckTimeout is how long to wait for the endpoint of an
+	// HTTP health check to respond when the check does not specify a timeout.
+	defaultHTTPHealthCheckTimeout = 10 * time.Second
+	// maxHTTPHealthCheckRedirects is how many redirects the endpoint of an
+	// HTTP health check may respond with before the check gives up.
+	maxHTTPHealthCheckRedirects = 10
+	// maxHTTPHealthCheckTimeout is the longest to wait for the endpoint of an
+	// HTTP health check to respond, since checks are performed while a Stage is
+	// being reconciled. It matches the limit enforced when a Stage is admitted
+	// and only comes into play for Stages admitted before that limit existed.
+	maxHTTPHealthCheckTimeout = time.Minute
+)
+
+// incorporateHTTPHealth polls the endpoint of the Stage's HTTP health check,
+// if it has one, and merges the result into the provided health. Stages
+// without an HTTP health check are left unaffected.
+func (r *reconciler) incorporateHTTPHealth(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	health *kargoapi.Health,
+) *kargoapi.Health {
+	hc := stage.Spec.HealthCheck
+	if hc == nil || hc.HTTP == nil {
+		return health
+	}
+	if health == nil {
+		health = &kargoapi.Health{Status: kargoapi.HealthStateHealthy}
+	}
+	state, err := r.checkHTTPHealthFn(ctx, stage.Namespace, hc.HTTP)
+	health.Status = health.Status.Merge(state)
+	if err != nil {
+		health.Issues = append(health.Issues, err.Error())
+	}
+	return health
+}
+
+// checkHTTPHealth sends a GET request to the endpoint of the provided HTTP
+// health check and returns Healthy if it responds with the expected status
+// code. Otherwise, it returns Unhealthy and an error explaining why. If
+// credentials for the endpoint are found, they are used for basic
+// authentication.
+//
+// Since anyone who can edit a Stage chooses the endpoint, and the outcome is
+// recorded in the Stage's status, the endpoint may only be reached at a
+// publicly routable address or in one of the networks the reconciler has
+// been configured to allow.
+func (r *reconciler) checkHTTPHealth(
+	ctx context.Context,
+	namespace string,
+	check *kargoapi.HTTPHealthCheck,
+) (kargoapi.HealthState, error) {
+	timeout := defaultHTTPHealthCheckTimeout
+	if check.Timeout != nil && check.Timeout.Duration > 0 {
+		timeout = min(check.Timeout.Duration, maxHTTPHealthCheckTimeout)
+	}
+	expectedStatus := http.StatusOK
+	if check.ExpectedStatus != 0 {
+		expectedStatus = int(check.ExpectedStatus)
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodGet, check.URL, nil)
+	if err != nil {
+		return kargoapi.HealthStateUnknown,
+			fmt.Errorf("error building request for health endpoint %q: %w", check.URL, err)
+	}
+
+	if r.credentialsDB != nil {
+		creds, ok, err := r.credentialsDB.Get(ctx, namespace, credentials.TypeHTTP, check.URL)
+		if err != nil {
+			return kargoapi.HealthStateUnknown, fmt.Errorf(
+				"error obtaining credentials for health endpoint %q: %w",
+				check.URL,
+				err,
+			)
+		}
+		if ok {
+			logging.LoggerFromContext(ctx).WithField("source", creds.Source).
+				Debug("using credentials for health endpoint")
+			req.SetBasicAuth(creds.Username, creds.Password)
+		}
+	}
+
+	resp, err := newHTTPHealthCheckClient(
+		timeout,
+		r.cfg.HTTPHealthCheckAllowedNetworks,
+	).Do(req)
+	if err != nil {
+		return kargoapi.HealthStateUnhealthy,
+			fmt.Errorf("error polling health endpoint %q: %w", check.URL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != expectedStatus {
+		return kargoapi.HealthStateUnhealthy, fmt.Errorf(
+			"health endpoint %q responded with status %d; expected %d",
+			check.URL,
+			resp.StatusCode,
+			expectedStatus,
+		)
+	}
+	return kargoapi.HealthStateHealthy, nil
+}
+
+// newHTTPHealthCheckClient returns an HTTP client for polling the endpoint of
+// an HTTP health check. Requests made using it, including any redirects, are
+// abandoned once the provided timeout has elapsed, and only connect to
+// publicly routable addresses or to addresses in the provided networks.
+// Credentials are not forwarded when the endpoint redirects to a different
+// host.
+func newHTTPHealthCheckClient(
+	timeout time.Duration,
+	allowedNetworks []*net.IPNet,
+) *http.Client {
+	return &http.Client{
+		Transport: libHTTP.NewPublicTransport(allowedNetworks),
+		Timeout:   timeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxHTTPHealthCheckRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxHTTPHealthCheckRedirects)
+			}
+			if req.URL.Host != via[0].URL.Host {
+				req.Header.Del("Authorization")
+			}
+			return nil
+		},
+	}
+}
+
+// enforceHealthCheckTimeout tracks how long the provided health of a Stage has
+// been unresolved, i.e. Progressing or Unknown, and marks it Unhealthy if that
+// has exceeded the timeout of the Stage's health check. The time at which the
+// health first became unresolved is carried over from the Stage's previously
+// recorded health, unless a Promotion has concluded since.
+func enforceHealthCheckTimeout(
+	stage *kargoapi.Stage,
+	health *kargoapi.Health,
+	now time.Time,
+) *kargoapi.Health {
+	if health == nil {
+		return nil
+	}
+	switch health.Status {
+	case kargoapi.HealthStateProgressing, kargoapi.HealthStateUnknown:
+	default:
+		health.UnresolvedSince = nil
+		return health
+	}
+
+	since := now
+	if prev := stage.Status.Health; prev != nil && prev.UnresolvedSince != nil {
+		since = prev.UnresolvedSince.Time
+	}
+	// A Promotion that concluded since the health became unresolved restarts
+	// the clock, since the health now relates to different Freight.
+	if history := stage.Status.PromotionHistory; len(history) > 0 &&
+		history[0].FinishedAt != nil && history[0].FinishedAt.After(since) &&
+		!history[0].FinishedAt.After(now) {
+		since = history[0].FinishedAt.Time
+	}
+	health.UnresolvedSince = &metav1.Time{Time: since}
+
+	hc := stage.Spec.HealthCheck
+	if hc == nil || hc.Timeout == nil {
+		return health
+	}
+	if now.Sub(health.UnresolvedSince.Time) >= hc.Timeout.Duration {
+		health.Status = kargoapi.HealthStateUnhealthy
+		health.Issues = append(
+			health.Issues,
+			fmt.Sprintf("health check timed out after %s", hc.Timeout.Duration),
+		)
+	}
+	return health
+}
+
+// healthCheckRequeueAfter returns how long to wait before reassessing the
+// health of a Stage. This is the interval of the Stage's health check, unless
+// its health is unresolved and will time out sooner than that.
+func healthCheckRequeueAfter(
+	stage *kargoapi.Stage,
+	health *kargoapi.Health,
+	now time.Time,
+) time.Duration {
+	requeueAfter := defaultHealthCheckInterval
+	hc := stage.Spec.HealthCheck
+	if hc == nil {
+		return requeueAfter
+	}
+	if hc.Interval != nil && hc.Interval.Duration > 0 {
+		requeueAfter = hc.Interval.Duration
+	}
+	if hc.Timeout != nil && health != nil && health.UnresolvedSince != nil &&
+		health.Status != kargoapi.HealthStateUnhealthy {
+		untilTimeout := health.UnresolvedSince.Add(hc.Timeout.Duration).Sub(now)
+		if untilTimeout > 0 && untilTimeout < requeueAfter {
+			requeueAfter = untilTimeout
+		}
+	}
+	return requeueAfter
+}
@@ -2,8 +2,18 @@ package stages
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	libHTTP "github.com/akuity/kargo/internal/http"
 )
 
 type mockAppHealthEvaluator struct {
@@ -17,3 +27,458 @@ func (m *mockAppHealthEvaluator) EvaluateHealth(
 ) *kargoapi.Health {
 	return m.Health
 }
+
+func TestEnforceHealthCheckTimeout(t *testing.T) {
+	now := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+	withTimeout := kargoapi.StageSpec{
+		HealthCheck: &kargoapi.HealthCheck{
+			Timeout: &metav1.Duration{Duration: 30 * time.Second},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		stage      *kargoapi.Stage
+		health     *kargoapi.Health
+		assertions func(*testing.T, *kargoapi.Health)
+	}{
+		{
+			name:   "health not applicable",
+			stage:  &kargoapi.Stage{Spec: withTimeout},
+			health: nil,
+			assertions: func(t *testing.T, health *kargoapi.Health) {
+				require.Nil(t, health)
+			},
+		},
+		{
+			name: "resolved health clears unresolved since",
+			stage: &kargoapi.Stage{
+				Spec: withTimeout,
+				Status: kargoapi.StageStatus{
+					Health: &kargoapi.Health{
+						Status:          kargoapi.HealthStateProgressing,
+						UnresolvedSince: &metav1.Time{Time: now.Add(-time.Hour)},
+					},
+				},
+			},
+			health: &kargoapi.Health{Status: kargoapi.HealthStateHealthy},
+			assertions: func(t *testing.T, health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateHealthy, health.Status)
+				require.Nil(t, health.UnresolvedSince)
+				require.Empty(t, health.Issues)
+			},
+		},
+		{
+			name:  "newly unresolved health starts the clock",
+			stage: &kargoapi.Stage{Spec: withTimeout},
+			health: &kargoapi.Health{
+				Status: kargoapi.HealthStateProgressing,
+			},
+			assertions: func(t *testing.T, health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateProgressing, health.Status)
+				require.NotNil(t, health.UnresolvedSince)
+				require.True(t, health.UnresolvedSince.Time.Equal(now))
+			},
+		},
+		{
+			name: "unresolved health within timeout",
+			stage: &kargoapi.Stage{
+				Spec: withTimeout,
+				Status: kargoapi.StageStatus{
+					Health: &kargoapi.Health{
+						Status:          kargoapi.HealthStateUnknown,
+						UnresolvedSince: &metav1.Time{Time: now.Add(-10 * time.Second)},
+					},
+				},
+			},
+			health: &kargoapi.Health{
+				Status: kargoapi.HealthStateUnknown,
+				Issues: []string{"Argo CD Application is being synced"},
+			},
+			assertions: func(t *testing.T, health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateUnknown, health.Status)
+				require.True(t, health.UnresolvedSince.Time.Equal(now.Add(-10*time.Second)))
+				require.Equal(t, []string{"Argo CD Application is being synced"}, health.Issues)
+			},
+		},
+		{
+			name: "stuck health check times out",
+			stage: &kargoapi.Stage{
+				Spec: withTimeout,
+				Status: kargoapi.StageStatus{
+					Health: &kargoapi.Health{
+						Status:          kargoapi.HealthStateProgressing,
+						UnresolvedSince: &metav1.Time{Time: now.Add(-time.Minute)},
+					},
+				},
+			},
+			health: &kargoapi.Health{
+				Status: kargoapi.HealthStateProgressing,
+				Issues: []string{"Argo CD Application is progressing"},
+			},
+			assertions: func(t *testing.T, health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateUnhealthy, health.Status)
+				require.Equal(
+					t,
+					[]string{
+						"Argo CD Application is progressing",
+						"health check timed out after 30s",
+					},
+					health.Issues,
+				)
+			},
+		},
+		{
+			name: "no timeout configured",
+			stage: &kargoapi.Stage{
+				Status: kargoapi.StageStatus{
+					Health: &kargoapi.Health{
+						Status:          kargoapi.HealthStateProgressing,
+						UnresolvedSince: &metav1.Time{Time: now.Add(-24 * time.Hour)},
+					},
+				},
+			},
+			health: &kargoapi.Health{Status: kargoapi.HealthStateProgressing},
+			assertions: func(t *testing.T, health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateProgressing, health.Status)
+				require.Empty(t, health.Issues)
+			},
+		},
+		{
+			name: "promotion since health became unresolved restarts the clock",
+			stage: &kargoapi.Stage{
+				Spec: withTimeout,
+				Status: kargoapi.StageStatus{
+					Health: &kargoapi.Health{
+						Status:          kargoapi.HealthStateProgressing,
+						UnresolvedSince: &metav1.Time{Time: now.Add(-time.Hour)},
+					},
+					PromotionHistory: kargoapi.PromotionRecordStack{
+						{
+							Name:       "fake-promo",
+							Phase:      kargoapi.PromotionPhaseSucceeded,
+							FinishedAt: &metav1.Time{Time: now.Add(-5 * time.Second)},
+						},
+					},
+				},
+			},
+			health: &kargoapi.Health{Status: kargoapi.HealthStateProgressing},
+			assertions: func(t *testing.T, health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateProgressing, health.Status)
+				require.True(t, health.UnresolvedSince.Time.Equal(now.Add(-5*time.Second)))
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				enforceHealthCheckTimeout(testCase.stage, testCase.health, now),
+			)
+		})
+	}
+}
+
+func TestHealthCheckRequeueAfter(t *testing.T) {
+	now := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name     string
+		spec     kargoapi.StageSpec
+		health   *kargoapi.Health
+		expected time.Duration
+	}{
+		{
+			name:     "no health check configured",
+			expected: defaultHealthCheckInterval,
+		},
+		{
+			name: "interval configured",
+			spec: kargoapi.StageSpec{
+				HealthCheck: &kargoapi.HealthCheck{
+					Interval: &metav1.Duration{Duration: time.Minute},
+				},
+			},
+			expected: time.Minute,
+		},
+		{
+			name: "unresolved health times out before next interval",
+			spec: kargoapi.StageSpec{
+				HealthCheck: &kargoapi.HealthCheck{
+					Interval: &metav1.Duration{Duration: time.Minute},
+					Timeout:  &metav1.Duration{Duration: 30 * time.Second},
+				},
+			},
+			health: &kargoapi.Health{
+				Status:          kargoapi.HealthStateProgressing,
+				UnresolvedSince: &metav1.Time{Time: now.Add(-20 * time.Second)},
+			},
+			expected: 10 * time.Second,
+		},
+		{
+			name: "unresolved health already timed out",
+			spec: kargoapi.StageSpec{
+				HealthCheck: &kargoapi.HealthCheck{
+					Interval: &metav1.Duration{Duration: time.Minute},
+					Timeout:  &metav1.Duration{Duration: 30 * time.Second},
+				},
+			},
+			health: &kargoapi.Health{
+				Status:          kargoapi.HealthStateUnhealthy,
+				UnresolvedSince: &metav1.Time{Time: now.Add(-time.Hour)},
+			},
+			expected: time.Minute,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				healthCheckRequeueAfter(
+					&kargoapi.Stage{Spec: testCase.spec},
+					testCase.health,
+					now,
+				),
+			)
+		})
+	}
+}
+
+func TestIncorporateHTTPHealth(t *testing.T) {
+	httpCheck := &kargoapi.HealthCheck{
+		HTTP: &kargoapi.HTTPHealthCheck{URL: "https://example.com/healthz"},
+	}
+
+	testCases := []struct {
+		name       string
+		spec       kargoapi.StageSpec
+		health     *kargoapi.Health
+		checkFn    func(context.Context, string, *kargoapi.HTTPHealthCheck) (kargoapi.HealthState, error)
+		assertions func(*testing.T, *kargoapi.Health)
+	}{
+		{
+			name: "no HTTP health check configured",
+			checkFn: func(context.Context, string, *kargoapi.HTTPHealthCheck) (kargoapi.HealthState, error) {
+				require.Fail(t, "no HTTP health check should have been performed")
+				return "", nil
+			},
+			assertions: func(t *testing.T, health *kargoapi.Health) {
+				require.Nil(t, health)
+			},
+		},
+		{
+			name: "healthy endpoint without other health",
+			spec: kargoapi.StageSpec{HealthCheck: httpCheck},
+			checkFn: func(context.Context, string, *kargoapi.HTTPHealthCheck) (kargoapi.HealthState, error) {
+				return kargoapi.HealthStateHealthy, nil
+			},
+			assertions: func(t *testing.T, health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateHealthy, health.Status)
+				require.Empty(t, health.Issues)
+			},
+		},
+		{
+			name: "unhealthy endpoint with healthy Argo CD Applications",
+			spec: kargoapi.StageSpec{HealthCheck: httpCheck},
+			health: &kargoapi.Health{
+				Status:     kargoapi.HealthStateHealthy,
+				ArgoCDApps: []kargoapi.ArgoCDAppStatus{{Name: "fake-app"}},
+			},
+			checkFn: func(context.Context, string, *kargoapi.HTTPHealthCheck) (kargoapi.HealthState, error) {
+				return kargoapi.HealthStateUnhealthy, errors.New("something went wrong")
+			},
+			assertions: func(t *testing.T, health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateUnhealthy, health.Status)
+				require.Equal(t, []string{"something went wrong"}, health.Issues)
+				require.Len(t, health.ArgoCDApps, 1)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := &reconciler{checkHTTPHealthFn: testCase.checkFn}
+			testCase.assertions(
+				t,
+				r.incorporateHTTPHealth(
+					context.Background(),
+					&kargoapi.Stage{Spec: testCase.spec},
+					testCase.health,
+				),
+			)
+		})
+	}
+}
+
+func TestCheckHTTPHealth(t *testing.T) {
+	// otherServer is on a different host than server, as far as redirects are
+	// concerned, since it listens on a different port.
+	otherServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, _, ok := r.BasicAuth(); ok {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(otherServer.Close)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/healthz":
+			w.WriteHeader(http.StatusOK)
+		case "/ready":
+			w.WriteHeader(http.StatusNoContent)
+		case "/private":
+			if user, pass, ok := r.BasicAuth(); !ok || user != "fake-user" || pass != "fake-pass" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		case "/slow":
+			time.Sleep(100 * time.Millisecond)
+			w.WriteHeader(http.StatusOK)
+		case "/redirect-same-host":
+			http.Redirect(w, r, "/private", http.StatusFound)
+		case "/redirect-other-host":
+			http.Redirect(w, r, otherServer.URL, http.StatusFound)
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	privateCreds := &credentials.FakeDB{
+		GetFn: func(
+			_ context.Context,
+			_ string,
+			credType credentials.Type,
+			_ string,
+		) (credentials.Credentials, bool, error) {
+			require.Equal(t, credentials.TypeHTTP, credType)
+			return credentials.Credentials{
+				Username: "fake-user",
+				Password: "fake-pass",
+			}, true, nil
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		check         *kargoapi.HTTPHealthCheck
+		credentialsDB credentials.Database
+		assertions    func(*testing.T, kargoapi.HealthState, error)
+	}{
+		{
+			name:  "expected status",
+			check: &kargoapi.HTTPHealthCheck{URL: server.URL + "/healthz"},
+			assertions: func(t *testing.T, state kargoapi.HealthState, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.HealthStateHealthy, state)
+			},
+		},
+		{
+			name: "custom expected status",
+			check: &kargoapi.HTTPHealthCheck{
+				URL:            server.URL + "/ready",
+				ExpectedStatus: http.StatusNoContent,
+			},
+			assertions: func(t *testing.T, state kargoapi.HealthState, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.HealthStateHealthy, state)
+			},
+		},
+		{
+			name:  "unexpected status",
+			check: &kargoapi.HTTPHealthCheck{URL: server.URL + "/broken"},
+			assertions: func(t *testing.T, state kargoapi.HealthState, err error) {
+				require.ErrorContains(t, err, "responded with status 503; expected 200")
+				require.Equal(t, kargoapi.HealthStateUnhealthy, state)
+			},
+		},
+		{
+			name:          "basic auth",
+			check:         &kargoapi.HTTPHealthCheck{URL: server.URL + "/private"},
+			credentialsDB: privateCreds,
+			assertions: func(t *testing.T, state kargoapi.HealthState, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.HealthStateHealthy, state)
+			},
+		},
+		{
+			name:          "basic auth after redirect to same host",
+			check:         &kargoapi.HTTPHealthCheck{URL: server.URL + "/redirect-same-host"},
+			credentialsDB: privateCreds,
+			assertions: func(t *testing.T, state kargoapi.HealthState, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.HealthStateHealthy, state)
+			},
+		},
+		{
+			name:          "no basic auth after redirect to other host",
+			check:         &kargoapi.HTTPHealthCheck{URL: server.URL + "/redirect-other-host"},
+			credentialsDB: privateCreds,
+			assertions: func(t *testing.T, state kargoapi.HealthState, err error) {
+				// The other host rejects requests that carry credentials
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.HealthStateHealthy, state)
+			},
+		},
+		{
+			name: "timeout",
+			check: &kargoapi.HTTPHealthCheck{
+				URL:     server.URL + "/slow",
+				Timeout: &metav1.Duration{Duration: 10 * time.Millisecond},
+			},
+			assertions: func(t *testing.T, state kargoapi.HealthState, err error) {
+				require.ErrorContains(t, err, "error polling health endpoint")
+				require.Equal(t, kargoapi.HealthStateUnhealthy, state)
+			},
+		},
+		{
+			name:  "credentials error",
+			check: &kargoapi.HTTPHealthCheck{URL: server.URL + "/healthz"},
+			credentialsDB: &credentials.FakeDB{
+				GetFn: func(
+					context.Context,
+					string,
+					credentials.Type,
+					string,
+				) (credentials.Credentials, bool, error) {
+					return credentials.Credentials{}, false, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, state kargoapi.HealthState, err error) {
+				require.ErrorContains(t, err, "error obtaining credentials")
+				require.Equal(t, kargoapi.HealthStateUnknown, state)
+			},
+		},
+	}
+	// The test servers listen on a loopback address, which must be allowed
+	// explicitly.
+	var allowedNetworks libHTTP.NetworkList
+	require.NoError(t, allowedNetworks.Decode("127.0.0.0/8, ::1/128"))
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := &reconciler{
+				cfg:           ReconcilerConfig{HTTPHealthCheckAllowedNetworks: allowedNetworks},
+				credentialsDB: testCase.credentialsDB,
+			}
+			if r.credentialsDB == nil {
+				r.credentialsDB = &credentials.FakeDB{}
+			}
+			state, err := r.checkHTTPHealth(context.Background(), "fake-namespace", testCase.check)
+			testCase.assertions(t, state, err)
+		})
+	}
+
+	t.Run("non-public address not allowed", func(t *testing.T) {
+		r := &reconciler{credentialsDB: &credentials.FakeDB{}}
+		state, err := r.checkHTTPHealth(
+			context.Background(),
+			"fake-namespace",
+			&kargoapi.HTTPHealthCheck{URL: server.URL + "/healthz"},
+		)
+		require.ErrorContains(t, err, "connections to non-public address 127.0.0.1 are not permitted")
+		require.Equal(t, kargoapi.HealthStateUnhealthy, state)
+	})
+}
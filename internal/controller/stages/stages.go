@@ -29,6 +29,8 @@ import (
 	"github.com/akuity/kargo/internal/controller"
 	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
 	rollouts "github.com/akuity/kargo/internal/controller/rollouts/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	libHTTP "github.com/akuity/kargo/internal/http"
 	"github.com/akuity/kargo/internal/kargo"
 	"github.com/akuity/kargo/internal/kubeclient"
 	libEvent "github.com/akuity/kargo/internal/kubernetes/event"
@@ -40,6 +42,11 @@ type ReconcilerConfig struct {
 	ShardName                    string `envconfig:"SHARD_NAME"`
 	RolloutsIntegrationEnabled   bool   `envconfig:"ROLLOUTS_INTEGRATION_ENABLED"`
 	RolloutsControllerInstanceID string `envconfig:"ROLLOUTS_CONTROLLER_INSTANCE_ID"`
+	// HTTPHealthCheckAllowedNetworks lists the networks, in CIDR notation, in
+	// which the endpoints of HTTP health checks may be reached even though they
+	// are not publicly routable. By default, loopback, link-local, and private
+	// addresses are off limits.
+	HTTPHealthCheckAllowedNetworks libHTTP.NetworkList `envconfig:"HTTP_HEALTH_CHECK_ALLOWED_NETWORKS"`
 }
 
 func (c ReconcilerConfig) Name() string {
@@ -87,6 +94,14 @@ type reconciler struct {
 
 	appHealth libargocd.ApplicationHealthEvaluator
 
+	credentialsDB credentials.Database
+
+	checkHTTPHealthFn func(
+		ctx context.Context,
+		namespace string,
+		check *kargoapi.HTTPHealthCheck,
+	) (kargoapi.HealthState, error)
+
 	// Freight verification:
 
 	startVerificationFn func(
@@ -235,6 +250,8 @@ func SetupReconcilerWithManager(
 	ctx context.Context,
 	kargoMgr manager.Manager,
 	argocdMgr manager.Manager,
+	argocdInstanceMgrs map[string]manager.Manager,
+	credentialsDB credentials.Database,
 	cfg ReconcilerConfig,
 ) error {
 	// Index Promotions in non-terminal states by Stage
@@ -299,6 +316,10 @@ func SetupReconcilerWithManager(
 	if argocdMgr != nil {
 		argocdClient = argocdMgr.GetClient()
 	}
+	argocdInstanceClients := make(map[string]client.Client, len(argocdInstanceMgrs))
+	for instance, mgr := range argocdInstanceMgrs {
+		argocdInstanceClients[instance] = mgr.GetClient()
+	}
 
 	c, err := ctrl.NewControllerManagedBy(kargoMgr).
 		For(&kargoapi.Stage{}).
@@ -326,7 +347,9 @@ func SetupReconcilerWithManager(
 			newReconciler(
 				kargoMgr.GetClient(),
 				argocdClient,
+				argocdInstanceClients,
 				libEvent.NewRecorder(ctx, kargoMgr.GetScheme(), kargoMgr.GetClient(), cfg.Name()),
+				credentialsDB,
 				cfg,
 				shardRequirement,
 			),
@@ -415,6 +438,26 @@ func SetupReconcilerWithManager(
 			return fmt.Errorf("unable to watch Applications: %w", err)
 		}
 	}
+	for instance, mgr := range argocdInstanceMgrs {
+		updatedArgoCDAppHandler := &updatedArgoCDAppHandler{
+			kargoClient:   kargoMgr.GetClient(),
+			shardSelector: shardSelector,
+			instance:      instance,
+		}
+		if err := c.Watch(
+			source.Kind(
+				mgr.GetCache(),
+				&argocd.Application{},
+			),
+			updatedArgoCDAppHandler,
+		); err != nil {
+			return fmt.Errorf(
+				"unable to watch Applications of Argo CD instance %q: %w",
+				instance,
+				err,
+			)
+		}
+	}
 
 	// We only care about this if Rollouts integration is enabled.
 	if cfg.RolloutsIntegrationEnabled {
@@ -439,7 +482,9 @@ func SetupReconcilerWithManager(
 func newReconciler(
 	kargoClient client.Client,
 	argocdClient client.Client,
+	argocdInstanceClients map[string]client.Client,
 	recorder record.EventRecorder,
+	credentialsDB credentials.Database,
 	cfg ReconcilerConfig,
 	shardRequirement *labels.Requirement,
 ) *reconciler {
@@ -448,7 +493,8 @@ func newReconciler(
 		argocdClient:     argocdClient,
 		recorder:         recorder,
 		cfg:              cfg,
-		appHealth:        libargocd.NewApplicationHealthEvaluator(argocdClient),
+		appHealth:        libargocd.NewApplicationHealthEvaluator(argocdClient, argocdInstanceClients),
+		credentialsDB:    credentialsDB,
 		shardRequirement: shardRequirement,
 	}
 	// The following default behaviors are overridable for testing purposes:
@@ -456,6 +502,8 @@ func newReconciler(
 	r.nowFn = time.Now
 	r.hasNonTerminalPromotionsFn = r.hasNonTerminalPromotions
 	r.listPromosFn = r.kargoClient.List
+	// Health checks:
+	r.checkHTTPHealthFn = r.checkHTTPHealth
 	// Freight verification:
 	r.startVerificationFn = r.startVerification
 	r.abortVerificationFn = r.abortVerification
@@ -572,10 +620,11 @@ func (r *reconciler) Reconcile(
 		return ctrl.Result{}, err
 	}
 
-	// Everything succeeded, look for new changes on the defined interval.
-	//
-	// TODO: Make this configurable
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	// Everything succeeded, look for new changes on the Stage's health check
+	// interval.
+	return ctrl.Result{
+		RequeueAfter: healthCheckRequeueAfter(stage, newStatus.Health, r.nowFn()),
+	}, nil
 }
 
 func (r *reconciler) syncControlFlowStage(
@@ -721,22 +770,40 @@ func (r *reconciler) syncNormalStage(
 		shouldRecordFreightVerificationEvent := false
 
 		// Push the latest state of the current Freight to the history at the
-		// end of each reconciliation loop.
+		// end of each reconciliation loop, then drop whatever the Stage's
+		// retention policy no longer permits keeping.
 		defer func() {
 			status.History.UpdateOrPush(*status.CurrentFreight)
+			status.History.Prune(
+				stage.Spec.HistoryRetention,
+				status.CurrentFreight.Name,
+				r.nowFn(),
+			)
 		}()
 
 		// Check health
-		if status.Health = r.appHealth.EvaluateHealth(
+		status.Health = r.appHealth.EvaluateHealth(
 			ctx,
 			*status.CurrentFreight,
 			stage.Spec.PromotionMechanisms.ArgoCDAppUpdates,
+		)
+		status.Health = r.incorporateHTTPHealth(ctx, stage, status.Health)
+		if status.Health = enforceHealthCheckTimeout(
+			stage,
+			status.Health,
+			r.nowFn(),
 		); status.Health != nil {
 			freightLogger.WithField("health", status.Health.Status).
 				Debug("Stage health assessed")
 		} else {
 			freightLogger.Debug("Stage health deemed not applicable")
 		}
+		// Remember that the Stage was healthy with this Freight so that the Stage
+		// can later be rolled back to it.
+		if (status.Health == nil || status.Health.Status == kargoapi.HealthStateHealthy) &&
+			status.CurrentFreight.HealthyAt == nil {
+			status.CurrentFreight.HealthyAt = ptr.To(metav1.NewTime(r.nowFn()))
+		}
 
 		// If the Stage is healthy and no verification process is defined, then the
 		// Stage should transition to the Steady phase.
@@ -958,32 +1025,42 @@ func (r *reconciler) syncNormalStage(
 	}
 
 	// If a promotion already exists for this Stage + Freight, then we're
-	// disqualified from auto-promotion.
-	promos := kargoapi.PromotionList{}
-	if err := r.listPromosFn(
-		ctx,
-		&promos,
-		&client.ListOptions{
-			Namespace: stage.Namespace,
-			FieldSelector: fields.Set(
-				map[string]string{
-					kubeclient.PromotionsByStageAndFreightIndexField: kubeclient.
-						StageAndFreightKey(stage.Name, latestFreight.Name),
-				},
-			).AsSelector(),
-		},
-	); err != nil {
-		return status, fmt.Errorf(
-			"error listing existing Promotions for Freight %q in namespace %q: %w",
-			latestFreight.Name,
-			stage.Namespace,
-			err,
-		)
-	}
+	// disqualified from auto-promotion. The same goes for a Promotion to the
+	// "latest" Freight that is still in progress, since the Freight it resolves
+	// to is likely to be the very same.
+	for _, freightName := range []string{
+		latestFreight.Name,
+		kargoapi.PromotionFreightLatest,
+	} {
+		promos := kargoapi.PromotionList{}
+		if err := r.listPromosFn(
+			ctx,
+			&promos,
+			&client.ListOptions{
+				Namespace: stage.Namespace,
+				FieldSelector: fields.Set(
+					map[string]string{
+						kubeclient.PromotionsByStageAndFreightIndexField: kubeclient.
+							StageAndFreightKey(stage.Name, freightName),
+					},
+				).AsSelector(),
+			},
+		); err != nil {
+			return status, fmt.Errorf(
+				"error listing existing Promotions for Freight %q in namespace %q: %w",
+				freightName,
+				stage.Namespace,
+				err,
+			)
+		}
 
-	if len(promos.Items) > 0 {
-		logger.Debug("Promotion already exists for Freight")
-		return status, nil
+		// Dry runs don't actually promote anything, so they don't count.
+		for _, promo := range promos.Items {
+			if !promo.Spec.DryRun {
+				logger.Debugf("Promotion already exists for Freight %q", freightName)
+				return status, nil
+			}
+		}
 	}
 
 	logger.Debug("auto-promotion will proceed")
@@ -16,6 +16,8 @@ import (
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/controller"
 	rollouts "github.com/akuity/kargo/internal/controller/rollouts/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/kubeclient"
 	fakeevent "github.com/akuity/kargo/internal/kubernetes/event/fake"
 )
 
@@ -34,7 +36,9 @@ func TestNewReconciler(t *testing.T) {
 	r := newReconciler(
 		kubeClient,
 		kubeClient,
+		nil,
 		recorder,
+		&credentials.FakeDB{},
 		testCfg,
 		requirement,
 	)
@@ -449,6 +453,7 @@ func TestSyncNormalStage(t *testing.T) {
 				Status: kargoapi.StageStatus{
 					Phase: kargoapi.StagePhaseSteady,
 					CurrentFreight: &kargoapi.FreightReference{
+						HealthyAt: ptr.To(metav1.NewTime(fakeTime)),
 						VerificationInfo: &kargoapi.VerificationInfo{
 							Phase: kargoapi.VerificationPhaseFailed,
 							AnalysisRun: &kargoapi.AnalysisRunReference{
@@ -500,8 +505,10 @@ func TestSyncNormalStage(t *testing.T) {
 					Verification:        &kargoapi.Verification{},
 				},
 				Status: kargoapi.StageStatus{
-					Phase:          kargoapi.StagePhaseVerifying,
-					CurrentFreight: &kargoapi.FreightReference{},
+					Phase: kargoapi.StagePhaseVerifying,
+					CurrentFreight: &kargoapi.FreightReference{
+						HealthyAt: ptr.To(metav1.NewTime(fakeTime)),
+					},
 				},
 			},
 			reconciler: &reconciler{
@@ -582,8 +589,10 @@ func TestSyncNormalStage(t *testing.T) {
 					Verification:        &kargoapi.Verification{},
 				},
 				Status: kargoapi.StageStatus{
-					Phase:          kargoapi.StagePhaseVerifying,
-					CurrentFreight: &kargoapi.FreightReference{},
+					Phase: kargoapi.StagePhaseVerifying,
+					CurrentFreight: &kargoapi.FreightReference{
+						HealthyAt: ptr.To(metav1.NewTime(fakeTime)),
+					},
 				},
 			},
 			reconciler: &reconciler{
@@ -976,8 +985,10 @@ func TestSyncNormalStage(t *testing.T) {
 					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
 				},
 				Status: kargoapi.StageStatus{
-					Phase:          kargoapi.StagePhaseVerifying,
-					CurrentFreight: &kargoapi.FreightReference{},
+					Phase: kargoapi.StagePhaseVerifying,
+					CurrentFreight: &kargoapi.FreightReference{
+						HealthyAt: ptr.To(metav1.NewTime(fakeTime)),
+					},
 				},
 			},
 			reconciler: &reconciler{
@@ -1008,6 +1019,73 @@ func TestSyncNormalStage(t *testing.T) {
 			},
 		},
 
+		{
+			name: "records when the Stage is found healthy with its Freight",
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+				Status: kargoapi.StageStatus{
+					Phase: kargoapi.StagePhaseVerifying,
+					CurrentFreight: &kargoapi.FreightReference{
+						Name: "fake-freight",
+					},
+				},
+			},
+			reconciler: &reconciler{
+				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				appHealth: &mockAppHealthEvaluator{
+					Health: &kargoapi.Health{Status: kargoapi.HealthStateHealthy},
+				},
+				verifyFreightInStageFn: func(context.Context, string, string, string) (bool, error) {
+					return false, nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.NotNil(t, newStatus.CurrentFreight)
+				require.Equal(t, ptr.To(metav1.NewTime(fakeTime)), newStatus.CurrentFreight.HealthyAt)
+			},
+		},
+
+		{
+			name: "does not record health of an unhealthy Stage",
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+				Status: kargoapi.StageStatus{
+					Phase: kargoapi.StagePhaseVerifying,
+					CurrentFreight: &kargoapi.FreightReference{
+						Name: "fake-freight",
+					},
+				},
+			},
+			reconciler: &reconciler{
+				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				appHealth: &mockAppHealthEvaluator{
+					Health: &kargoapi.Health{Status: kargoapi.HealthStateUnhealthy},
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.NotNil(t, newStatus.CurrentFreight)
+				require.Nil(t, newStatus.CurrentFreight.HealthyAt)
+			},
+		},
+
 		{
 			name: "error checking if auto-promotion is permitted",
 			stage: &kargoapi.Stage{
@@ -1018,7 +1096,9 @@ func TestSyncNormalStage(t *testing.T) {
 					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
 				},
 				Status: kargoapi.StageStatus{
-					CurrentFreight: &kargoapi.FreightReference{},
+					CurrentFreight: &kargoapi.FreightReference{
+						HealthyAt: ptr.To(metav1.NewTime(fakeTime)),
+					},
 				},
 			},
 			reconciler: &reconciler{
@@ -1079,7 +1159,9 @@ func TestSyncNormalStage(t *testing.T) {
 					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
 				},
 				Status: kargoapi.StageStatus{
-					CurrentFreight: &kargoapi.FreightReference{},
+					CurrentFreight: &kargoapi.FreightReference{
+						HealthyAt: ptr.To(metav1.NewTime(fakeTime)),
+					},
 				},
 			},
 			reconciler: &reconciler{
@@ -1139,7 +1221,9 @@ func TestSyncNormalStage(t *testing.T) {
 					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
 				},
 				Status: kargoapi.StageStatus{
-					CurrentFreight: &kargoapi.FreightReference{},
+					CurrentFreight: &kargoapi.FreightReference{
+						HealthyAt: ptr.To(metav1.NewTime(fakeTime)),
+					},
 				},
 			},
 			reconciler: &reconciler{
@@ -1207,7 +1291,9 @@ func TestSyncNormalStage(t *testing.T) {
 					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
 				},
 				Status: kargoapi.StageStatus{
-					CurrentFreight: &kargoapi.FreightReference{},
+					CurrentFreight: &kargoapi.FreightReference{
+						HealthyAt: ptr.To(metav1.NewTime(fakeTime)),
+					},
 				},
 			},
 			reconciler: &reconciler{
@@ -1265,7 +1351,8 @@ func TestSyncNormalStage(t *testing.T) {
 				},
 				Status: kargoapi.StageStatus{
 					CurrentFreight: &kargoapi.FreightReference{
-						Name: "fake-freight-id",
+						HealthyAt: ptr.To(metav1.NewTime(fakeTime)),
+						Name:      "fake-freight-id",
 					},
 				},
 			},
@@ -1324,7 +1411,9 @@ func TestSyncNormalStage(t *testing.T) {
 					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
 				},
 				Status: kargoapi.StageStatus{
-					CurrentFreight: &kargoapi.FreightReference{},
+					CurrentFreight: &kargoapi.FreightReference{
+						HealthyAt: ptr.To(metav1.NewTime(fakeTime)),
+					},
 				},
 			},
 			reconciler: &reconciler{
@@ -1386,6 +1475,97 @@ func TestSyncNormalStage(t *testing.T) {
 			},
 		},
 
+		{
+			name: "Promotion to latest Freight in progress",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "fake-stage",
+				},
+				Spec: kargoapi.StageSpec{
+					Subscriptions: kargoapi.Subscriptions{
+						Warehouse: "fake-warehouse",
+					},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+				Status: kargoapi.StageStatus{
+					CurrentFreight: &kargoapi.FreightReference{
+						HealthyAt: ptr.To(metav1.NewTime(fakeTime)),
+					},
+				},
+			},
+			reconciler: &reconciler{
+				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				appHealth:                  &mockAppHealthEvaluator{},
+				verifyFreightInStageFn: func(context.Context, string, string, string) (bool, error) {
+					return true, nil
+				},
+				isAutoPromotionPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return true, nil
+				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "fake-freight-id",
+						},
+					}, nil
+				},
+				getLatestAvailableFreightFn: func(
+					context.Context,
+					string,
+					*kargoapi.Stage,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "fake-freight-id",
+						},
+					}, nil
+				},
+				listPromosFn: func(
+					_ context.Context,
+					obj client.ObjectList,
+					opts ...client.ListOption,
+				) error {
+					promos, ok := obj.(*kargoapi.PromotionList)
+					require.True(t, ok)
+					listOpts, ok := opts[0].(*client.ListOptions)
+					require.True(t, ok)
+					// Only a Promotion to the "latest" Freight exists
+					if listOpts.FieldSelector.String() ==
+						kubeclient.PromotionsByStageAndFreightIndexField+"=fake-stage:latest" {
+						promos.Items = []kargoapi.Promotion{{}}
+					}
+					return nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					require.Fail(t, "no Promotion should have been created")
+					return nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				initialStatus kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+				// Status should be returned unchanged
+				require.Equal(t, initialStatus, newStatus)
+			},
+		},
+
 		{
 			name: "error creating Promotion",
 			stage: &kargoapi.Stage{
@@ -1396,7 +1576,9 @@ func TestSyncNormalStage(t *testing.T) {
 					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
 				},
 				Status: kargoapi.StageStatus{
-					CurrentFreight: &kargoapi.FreightReference{},
+					CurrentFreight: &kargoapi.FreightReference{
+						HealthyAt: ptr.To(metav1.NewTime(fakeTime)),
+					},
 				},
 			},
 			reconciler: &reconciler{
@@ -1476,6 +1658,104 @@ func TestSyncNormalStage(t *testing.T) {
 			},
 		},
 
+		{
+			name: "only a dry run Promotion exists",
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					Subscriptions: kargoapi.Subscriptions{
+						Warehouse: "fake-warehouse",
+					},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+				Status: kargoapi.StageStatus{
+					CurrentFreight: &kargoapi.FreightReference{
+						HealthyAt: ptr.To(metav1.NewTime(fakeTime)),
+					},
+				},
+			},
+			reconciler: &reconciler{
+				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				appHealth:                  &mockAppHealthEvaluator{},
+				verifyFreightInStageFn: func(context.Context, string, string, string) (bool, error) {
+					return true, nil
+				},
+				isAutoPromotionPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return true, nil
+				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "fake-freight-id",
+						},
+					}, nil
+				},
+				getLatestAvailableFreightFn: func(
+					context.Context,
+					string,
+					*kargoapi.Stage,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "fake-freight-id",
+						},
+					}, nil
+				},
+				listPromosFn: func(
+					_ context.Context,
+					obj client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					promos, ok := obj.(*kargoapi.PromotionList)
+					require.True(t, ok)
+					promos.Items = []kargoapi.Promotion{
+						{Spec: kargoapi.PromotionSpec{DryRun: true}},
+					}
+					return nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					// Reaching this point shows the dry run was disregarded
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				initialStatus kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				// Verification should be done before promotion
+				require.Len(t, recorder.Events, 1)
+				event := <-recorder.Events
+				require.Equal(t, kargoapi.EventReasonFreightVerificationSucceeded, event.Reason)
+				require.Equal(t,
+					fakeTime.Format(time.RFC3339),
+					event.Annotations[kargoapi.AnnotationKeyEventVerificationStartTime],
+				)
+				require.Equal(t,
+					fakeTime.Format(time.RFC3339),
+					event.Annotations[kargoapi.AnnotationKeyEventVerificationFinishTime],
+				)
+
+				require.ErrorContains(t, err, "something went wrong")
+				require.ErrorContains(t, err, "error creating Promotion of Stage")
+				// Status should be returned unchanged
+				require.Equal(t, initialStatus, newStatus)
+			},
+		},
+
 		{
 			name: "skip event recording if no verification performed",
 			stage: &kargoapi.Stage{
@@ -297,6 +297,9 @@ func (c *createdFreightEventHandler) Update(
 type updatedArgoCDAppHandler struct {
 	kargoClient   client.Client
 	shardSelector labels.Selector
+	// instance is the name of the Argo CD instance whose Applications this
+	// handler is notified about. It is empty for the default instance.
+	instance string
 }
 
 // Create implements EventHandler.
@@ -341,8 +344,8 @@ func (u *updatedArgoCDAppHandler) Update(
 			&client.ListOptions{
 				FieldSelector: fields.OneTermEqualSelector(
 					kubeclient.StagesByArgoCDApplicationsIndexField,
-					fmt.Sprintf(
-						"%s:%s",
+					kubeclient.ArgoCDInstanceApplicationKey(
+						u.instance,
 						e.ObjectNew.GetNamespace(),
 						e.ObjectNew.GetName(),
 					),
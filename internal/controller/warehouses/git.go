@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/Masterminds/semver/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/controller/git"
@@ -23,10 +24,11 @@ const (
 )
 
 type gitMeta struct {
-	Commit  string
-	Tag     string
-	Message string
-	Author  string
+	Commit     string
+	Tag        string
+	Message    string
+	Author     string
+	CommitDate *metav1.Time
 }
 
 type pathSelector func(path string) (bool, error)
@@ -37,8 +39,6 @@ func (r *reconciler) selectCommits(
 	subs []kargoapi.RepoSubscription,
 	lastFreight *kargoapi.FreightReference,
 ) ([]kargoapi.GitCommit, error) {
-	latestCommits := make([]kargoapi.GitCommit, 0, len(subs))
-
 	var repoCommitMappings map[string]string
 	if lastFreight != nil {
 		repoCommitMappings = make(map[string]string, len(lastFreight.Commits))
@@ -47,57 +47,80 @@ func (r *reconciler) selectCommits(
 		}
 	}
 
-	for _, s := range subs {
-		if s.Git == nil {
-			continue
-		}
-		sub := s.Git
-		logger := logging.LoggerFromContext(ctx).WithField("repo", sub.RepoURL)
-		creds, ok, err :=
-			r.credentialsDB.Get(ctx, namespace, credentials.TypeGit, sub.RepoURL)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"error obtaining credentials for git repo %q: %w",
-				sub.RepoURL,
-				err,
+	return pollSubscriptions(
+		ctx,
+		subs,
+		func(ctx context.Context, s kargoapi.RepoSubscription) ([]kargoapi.GitCommit, error) {
+			if s.Git == nil {
+				return nil, nil
+			}
+			commit, err := r.selectCommit(
+				ctx,
+				namespace,
+				s.Git,
+				repoCommitMappings[s.Git.RepoURL+"#"+s.Git.Branch],
 			)
-		}
-		var repoCreds *git.RepoCredentials
-		if ok {
-			repoCreds = &git.RepoCredentials{
-				Username:      creds.Username,
-				Password:      creds.Password,
-				SSHPrivateKey: creds.SSHPrivateKey,
+			if err != nil {
+				recordSubscriptionPoll(ctx, s, "", err)
+				return nil, err
 			}
-			logger.Debug("obtained credentials for git repo")
-		} else {
-			logger.Debug("found no credentials for git repo")
-		}
-
-		baseCommit := repoCommitMappings[sub.RepoURL+"#"+sub.Branch]
+			recordSubscriptionPoll(ctx, s, commit.ID, nil)
+			return []kargoapi.GitCommit{*commit}, nil
+		},
+	)
+}
 
-		gm, err := r.selectCommitMetaFn(ctx, *s.Git, repoCreds, baseCommit)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"error determining latest commit ID of git repo %q: %w",
-				sub.RepoURL,
-				err,
-			)
+// selectCommit returns the latest suitable commit from the repository the
+// provided GitSubscription refers to. The provided base commit, if any, is the
+// one previously selected from the same repository and branch.
+func (r *reconciler) selectCommit(
+	ctx context.Context,
+	namespace string,
+	sub *kargoapi.GitSubscription,
+	baseCommit string,
+) (*kargoapi.GitCommit, error) {
+	logger := logging.LoggerFromContext(ctx).WithField("repo", sub.RepoURL)
+	creds, ok, err :=
+		r.credentialsDB.Get(ctx, namespace, credentials.TypeGit, sub.RepoURL)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error obtaining credentials for git repo %q: %w",
+			sub.RepoURL,
+			err,
+		)
+	}
+	var repoCreds *git.RepoCredentials
+	if ok {
+		repoCreds = &git.RepoCredentials{
+			Username:      creds.Username,
+			Password:      creds.Password,
+			SSHPrivateKey: creds.SSHPrivateKey,
 		}
-		logger.WithField("commit", gm.Commit).
-			Debug("found latest commit from repo")
-		latestCommits = append(
-			latestCommits,
-			kargoapi.GitCommit{
-				RepoURL: sub.RepoURL,
-				ID:      gm.Commit,
-				Branch:  sub.Branch,
-				Tag:     gm.Tag,
-				Message: gm.Message,
-			},
+		logger.WithField("credentialsSource", creds.Source).
+			Debug("obtained credentials for git repo")
+	} else {
+		logger.Debug("found no credentials for git repo")
+	}
+
+	gm, err := r.selectCommitMetaFn(ctx, *sub, repoCreds, baseCommit)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error determining latest commit ID of git repo %q: %w",
+			sub.RepoURL,
+			err,
 		)
 	}
-	return latestCommits, nil
+	logger.WithField("commit", gm.Commit).
+		Debug("found latest commit from repo")
+	return &kargoapi.GitCommit{
+		RepoURL:    sub.RepoURL,
+		ID:         gm.Commit,
+		Branch:     sub.Branch,
+		Tag:        gm.Tag,
+		Message:    gm.Message,
+		Author:     gm.Author,
+		CommitDate: gm.CommitDate,
+	}, nil
 }
 
 // selectCommitMeta uses criteria from the provided GitSubscription to select
@@ -150,13 +173,25 @@ func (r *reconciler) selectCommitMeta(
 		// This is best effort, so just log the error
 		logger.Warnf("failed to get message from commit %q: %v", selectedCommit, err)
 	}
+	// Author and date are also best effort
+	author, err := repo.CommitAuthor(selectedCommit)
+	if err != nil {
+		logger.Warnf("failed to get author of commit %q: %v", selectedCommit, err)
+	}
+	var commitDate *metav1.Time
+	if date, err := repo.CommitDate(selectedCommit); err != nil {
+		logger.Warnf("failed to get date of commit %q: %v", selectedCommit, err)
+	} else {
+		commitDate = &metav1.Time{Time: date}
+	}
 	return &gitMeta{
 		Commit: selectedCommit,
 		Tag:    selectedTag,
 		// Since we currently store commit messages in Stage status, we only capture
 		// the first line of the commit message for brevity
-		Message: strings.Split(strings.TrimSpace(msg), "\n")[0],
-		// TODO: support git author
+		Message:    strings.Split(strings.TrimSpace(msg), "\n")[0],
+		Author:     author,
+		CommitDate: commitDate,
 	}, nil
 }
 
@@ -6,8 +6,10 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
@@ -91,7 +93,12 @@ func TestSelectCommits(t *testing.T) {
 					*git.RepoCredentials,
 					string,
 				) (*gitMeta, error) {
-					return &gitMeta{Commit: "fake-commit", Message: "message"}, nil
+					return &gitMeta{
+						Commit:     "fake-commit",
+						Message:    "message",
+						Author:     "Jane Doe",
+						CommitDate: &metav1.Time{Time: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+					}, nil
 				},
 			},
 			assertions: func(t *testing.T, commits []kargoapi.GitCommit, err error) {
@@ -100,9 +107,11 @@ func TestSelectCommits(t *testing.T) {
 				require.Equal(
 					t,
 					kargoapi.GitCommit{
-						RepoURL: "fake-url",
-						ID:      "fake-commit",
-						Message: "message",
+						RepoURL:    "fake-url",
+						ID:         "fake-commit",
+						Message:    "message",
+						Author:     "Jane Doe",
+						CommitDate: &metav1.Time{Time: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
 					},
 					commits[0],
 				)
@@ -150,7 +159,7 @@ func TestSelectCommitMeta(t *testing.T) {
 			sub: kargoapi.GitSubscription{
 				RepoURL: "https://github.com/akuity/kargo.git",
 			},
-			reconciler: newReconciler(fake.NewClientBuilder().Build(), nil),
+			reconciler: newReconciler(fake.NewClientBuilder().Build(), nil, ReconcilerConfig{}),
 			assertions: func(t *testing.T, gm *gitMeta, err error) {
 				require.NoError(t, err)
 				require.NotEmpty(t, gm.Commit)
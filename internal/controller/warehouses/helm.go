@@ -3,6 +3,10 @@ package warehouses
 import (
 	"context"
 	"fmt"
+	"regexp"
+
+	"github.com/Masterminds/semver/v3"
+	log "github.com/sirupsen/logrus"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/credentials"
@@ -10,95 +14,263 @@ import (
 	"github.com/akuity/kargo/internal/logging"
 )
 
+// chartDowngradeError is returned when the newest suitable version of a chart
+// is older than the version of that chart referenced by a Warehouse's most
+// recently produced Freight and the applicable subscription does not permit
+// downgrades.
+type chartDowngradeError struct {
+	repoURL        string
+	name           string
+	newestVersion  string
+	currentVersion string
+}
+
+func (e *chartDowngradeError) Error() string {
+	chart := e.repoURL
+	if e.name != "" {
+		chart = fmt.Sprintf("%s/%s", e.repoURL, e.name)
+	}
+	return fmt.Sprintf(
+		"newest suitable version %s of chart %q is not greater than current "+
+			"version %s",
+		e.newestVersion,
+		chart,
+		e.currentVersion,
+	)
+}
+
 func (r *reconciler) selectCharts(
 	ctx context.Context,
 	namespace string,
 	subs []kargoapi.RepoSubscription,
+	lastFreight *kargoapi.FreightReference,
 ) ([]kargoapi.Chart, error) {
-	charts := make([]kargoapi.Chart, 0, len(subs))
+	return pollSubscriptions(
+		ctx,
+		subs,
+		func(ctx context.Context, s kargoapi.RepoSubscription) ([]kargoapi.Chart, error) {
+			if s.Chart == nil {
+				return nil, nil
+			}
+			chart, err := r.selectChart(ctx, namespace, s.Chart, lastFreight)
+			if err != nil {
+				recordSubscriptionPoll(ctx, s, "", err)
+				return nil, err
+			}
+			recordSubscriptionPoll(ctx, s, chart.Version, nil)
+			return []kargoapi.Chart{*chart}, nil
+		},
+	)
+}
 
-	for _, s := range subs {
-		if s.Chart == nil {
-			continue
-		}
+// selectChart returns the latest suitable version of the chart the provided
+// ChartSubscription refers to.
+func (r *reconciler) selectChart(
+	ctx context.Context,
+	namespace string,
+	sub *kargoapi.ChartSubscription,
+	lastFreight *kargoapi.FreightReference,
+) (*kargoapi.Chart, error) {
+	logger := logging.LoggerFromContext(ctx).WithField("repoURL", sub.RepoURL)
+	if sub.Name != "" {
+		logger = logger.WithField("chart", sub.Name)
+	}
 
-		sub := s.Chart
+	creds, ok, err :=
+		r.credentialsDB.Get(ctx, namespace, credentials.TypeHelm, sub.RepoURL)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error obtaining credentials for chart repository %q: %w",
+			sub.RepoURL,
+			err,
+		)
+	}
 
-		logger := logging.LoggerFromContext(ctx).WithField("repoURL", sub.RepoURL)
-		if sub.Name != "" {
-			logger = logger.WithField("chart", sub.Name)
+	var helmCreds *helm.Credentials
+	if ok {
+		helmCreds = &helm.Credentials{
+			Username: creds.Username,
+			Password: creds.Password,
 		}
+		logger.WithField("credentialsSource", creds.Source).
+			Debug("obtained credentials for chart repo")
+	} else {
+		logger.Debug("found no credentials for chart repo")
+	}
 
-		creds, ok, err :=
-			r.credentialsDB.Get(ctx, namespace, credentials.TypeHelm, sub.RepoURL)
-		if err != nil {
+	var caBundle string
+	if sub.CABundle != nil {
+		if caBundle, err = r.getCABundleFn(ctx, namespace, *sub.CABundle); err != nil {
 			return nil, fmt.Errorf(
-				"error obtaining credentials for chart repository %q: %w",
+				"error obtaining CA bundle for chart repository %q: %w",
 				sub.RepoURL,
 				err,
 			)
 		}
+		logger.Debug("obtained CA bundle for chart repo")
+	}
+	filter, err := getChartVersionFilter(sub)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error applying version filters for chart repository %q: %w",
+			sub.RepoURL,
+			err,
+		)
+	}
 
-		var helmCreds *helm.Credentials
-		if ok {
-			helmCreds = &helm.Credentials{
-				Username: creds.Username,
-				Password: creds.Password,
-			}
-			logger.Debug("obtained credentials for chart repo")
-		} else {
-			logger.Debug("found no credentials for chart repo")
+	reqCtx, cancel := withRegistryTimeout(ctx)
+	defer cancel()
+	vers, err := r.selectChartVersionFn(
+		reqCtx,
+		sub.RepoURL,
+		sub.Name,
+		sub.SemverConstraint,
+		sub.AllowPrereleases,
+		helm.SelectionMode(sub.SelectionMode),
+		filter,
+		helmCreds,
+		sub.InsecureSkipTLSVerify,
+		caBundle,
+		r.listingCache,
+	)
+	if timedOut(reqCtx) {
+		if sub.Name == "" {
+			return nil, fmt.Errorf(
+				"timed out selecting version of chart in repository %q: %w",
+				sub.RepoURL,
+				reqCtx.Err(),
+			)
 		}
-
-		vers, err := r.selectChartVersionFn(
-			ctx,
-			sub.RepoURL,
+		return nil, fmt.Errorf(
+			"timed out selecting version of chart %q in repository %q: %w",
 			sub.Name,
-			sub.SemverConstraint,
-			helmCreds,
+			sub.RepoURL,
+			reqCtx.Err(),
 		)
-		if err != nil {
-			if sub.Name == "" {
-				return nil, fmt.Errorf(
-					"error searching for latest version of chart in repository %q: %w",
-					sub.RepoURL,
-					err,
-				)
-			}
+	}
+	if err != nil {
+		if sub.Name == "" {
 			return nil, fmt.Errorf(
-				"error searching for latest version of chart %q in repository %q: %w",
-				sub.Name,
+				"error searching for latest version of chart in repository %q: %w",
 				sub.RepoURL,
 				err,
 			)
 		}
+		return nil, fmt.Errorf(
+			"error searching for latest version of chart %q in repository %q: %w",
+			sub.Name,
+			sub.RepoURL,
+			err,
+		)
+	}
 
-		if vers == "" {
-			logger.Error("found no suitable chart version")
-			if sub.Name == "" {
-				return nil, fmt.Errorf(
-					"found no suitable version of chart in repository %q",
-					sub.RepoURL,
-				)
-			}
+	if vers == "" {
+		logger.Error("found no suitable chart version")
+		if sub.Name == "" {
 			return nil, fmt.Errorf(
-				"found no suitable version of chart %q in repository %q",
-				sub.Name,
+				"found no suitable version of chart in repository %q",
 				sub.RepoURL,
 			)
 		}
-		logger.WithField("version", vers).
-			Debug("found latest suitable chart version")
-
-		charts = append(
-			charts,
-			kargoapi.Chart{
-				RepoURL: sub.RepoURL,
-				Name:    sub.Name,
-				Version: vers,
-			},
+		return nil, fmt.Errorf(
+			"found no suitable version of chart %q in repository %q",
+			sub.Name,
+			sub.RepoURL,
 		)
 	}
+	logger.WithFields(log.Fields{
+		"version":       vers,
+		"selectionMode": sub.SelectionMode,
+	}).Debug("found suitable chart version")
+
+	if sub.NewerVersionsOnly {
+		if current := getChartVersion(lastFreight, sub); current != "" &&
+			isOlderVersion(vers, current) {
+			return nil, &chartDowngradeError{
+				repoURL:        sub.RepoURL,
+				name:           sub.Name,
+				newestVersion:  vers,
+				currentVersion: current,
+			}
+		}
+	}
+
+	return &kargoapi.Chart{
+		RepoURL: sub.RepoURL,
+		Name:    sub.Name,
+		Version: vers,
+	}, nil
+}
 
-	return charts, nil
+// getChartVersionFilter returns a function that reports whether a chart
+// version is permitted by the AllowVersions and IgnoreVersions fields of the
+// provided ChartSubscription. If neither field is specified, nil is returned.
+func getChartVersionFilter(
+	sub *kargoapi.ChartSubscription,
+) (func(string) bool, error) {
+	if sub.AllowVersions == "" && len(sub.IgnoreVersions) == 0 {
+		return nil, nil
+	}
+	var allowRegex *regexp.Regexp
+	if sub.AllowVersions != "" {
+		var err error
+		if allowRegex, err = regexp.Compile(sub.AllowVersions); err != nil {
+			return nil, fmt.Errorf(
+				"error compiling regular expression %q: %w",
+				sub.AllowVersions,
+				err,
+			)
+		}
+	}
+	ignoreRegexes := make([]*regexp.Regexp, len(sub.IgnoreVersions))
+	for i, ignore := range sub.IgnoreVersions {
+		var err error
+		if ignoreRegexes[i], err = regexp.Compile(ignore); err != nil {
+			return nil, fmt.Errorf("error compiling regular expression %q: %w", ignore, err)
+		}
+	}
+	return func(version string) bool {
+		if !allows(version, allowRegex) {
+			return false
+		}
+		for _, ignoreRegex := range ignoreRegexes {
+			if ignoreRegex.MatchString(version) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+// getChartVersion returns the version of the chart matching the provided
+// subscription from the provided FreightReference. If no matching chart is
+// found, an empty string is returned.
+func getChartVersion(
+	freight *kargoapi.FreightReference,
+	sub *kargoapi.ChartSubscription,
+) string {
+	if freight == nil {
+		return ""
+	}
+	for _, chart := range freight.Charts {
+		if chart.RepoURL == sub.RepoURL && chart.Name == sub.Name {
+			return chart.Version
+		}
+	}
+	return ""
+}
+
+// isOlderVersion returns true if the semantic version v is strictly less than
+// the semantic version other. If either cannot be parsed as a semantic version,
+// false is returned.
+func isOlderVersion(v, other string) bool {
+	sv, err := semver.NewVersion(v)
+	if err != nil {
+		return false
+	}
+	otherSV, err := semver.NewVersion(other)
+	if err != nil {
+		return false
+	}
+	return sv.LessThan(otherSV)
 }
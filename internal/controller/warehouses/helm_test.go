@@ -3,25 +3,42 @@ package warehouses
 import (
 	"context"
 	"errors"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/credentials"
 	"github.com/akuity/kargo/internal/helm"
+	"github.com/akuity/kargo/internal/repocache"
 )
 
 func TestSelectCharts(t *testing.T) {
 	testCases := []struct {
 		name                 string
+		newerVersionsOnly    bool
+		allowPrereleases     bool
+		semverConstraint     string
+		allowVersions        string
+		ignoreVersions       []string
+		insecureSkipTLS      bool
+		lastFreight          *kargoapi.FreightReference
 		credentialsDB        credentials.Database
 		selectChartVersionFn func(
 			context.Context,
 			string,
 			string,
 			string,
+			bool,
+			helm.SelectionMode,
+			func(string) bool,
 			*helm.Credentials,
+			bool,
+			string,
+			*repocache.Cache,
 		) (string, error)
 		assertions func(*testing.T, []kargoapi.Chart, error)
 	}{
@@ -61,7 +78,13 @@ func TestSelectCharts(t *testing.T) {
 				string,
 				string,
 				string,
+				bool,
+				helm.SelectionMode,
+				func(string) bool,
 				*helm.Credentials,
+				bool,
+				string,
+				*repocache.Cache,
 			) (string, error) {
 				return "", errors.New("something went wrong")
 			},
@@ -88,7 +111,13 @@ func TestSelectCharts(t *testing.T) {
 				string,
 				string,
 				string,
+				bool,
+				helm.SelectionMode,
+				func(string) bool,
 				*helm.Credentials,
+				bool,
+				string,
+				*repocache.Cache,
 			) (string, error) {
 				return "", nil
 			},
@@ -114,7 +143,13 @@ func TestSelectCharts(t *testing.T) {
 				string,
 				string,
 				string,
+				bool,
+				helm.SelectionMode,
+				func(string) bool,
 				*helm.Credentials,
+				bool,
+				string,
+				*repocache.Cache,
 			) (string, error) {
 				return "1.0.0", nil
 			},
@@ -132,6 +167,324 @@ func TestSelectCharts(t *testing.T) {
 				)
 			},
 		},
+
+		{
+			name:              "chart downgrade prevented",
+			newerVersionsOnly: true,
+			lastFreight: &kargoapi.FreightReference{
+				Charts: []kargoapi.Chart{
+					{
+						RepoURL: "fake-url",
+						Name:    "fake-chart",
+						Version: "1.1.0",
+					},
+				},
+			},
+			credentialsDB: &credentials.FakeDB{
+				GetFn: func(
+					context.Context,
+					string,
+					credentials.Type,
+					string,
+				) (credentials.Credentials, bool, error) {
+					return credentials.Credentials{}, false, nil
+				},
+			},
+			selectChartVersionFn: func(
+				context.Context,
+				string,
+				string,
+				string,
+				bool,
+				helm.SelectionMode,
+				func(string) bool,
+				*helm.Credentials,
+				bool,
+				string,
+				*repocache.Cache,
+			) (string, error) {
+				return "1.0.0", nil
+			},
+			assertions: func(t *testing.T, _ []kargoapi.Chart, err error) {
+				var downgradeErr *chartDowngradeError
+				require.ErrorAs(t, err, &downgradeErr)
+				require.Equal(t, "1.0.0", downgradeErr.newestVersion)
+				require.Equal(t, "1.1.0", downgradeErr.currentVersion)
+			},
+		},
+
+		{
+			name: "older chart version permitted",
+			lastFreight: &kargoapi.FreightReference{
+				Charts: []kargoapi.Chart{
+					{
+						RepoURL: "fake-url",
+						Name:    "fake-chart",
+						Version: "1.1.0",
+					},
+				},
+			},
+			credentialsDB: &credentials.FakeDB{
+				GetFn: func(
+					context.Context,
+					string,
+					credentials.Type,
+					string,
+				) (credentials.Credentials, bool, error) {
+					return credentials.Credentials{}, false, nil
+				},
+			},
+			selectChartVersionFn: func(
+				context.Context,
+				string,
+				string,
+				string,
+				bool,
+				helm.SelectionMode,
+				func(string) bool,
+				*helm.Credentials,
+				bool,
+				string,
+				*repocache.Cache,
+			) (string, error) {
+				return "1.0.0", nil
+			},
+			assertions: func(t *testing.T, charts []kargoapi.Chart, err error) {
+				require.NoError(t, err)
+				require.Len(t, charts, 1)
+				require.Equal(t, "1.0.0", charts[0].Version)
+			},
+		},
+
+		{
+			name:              "same chart version with newer versions only",
+			newerVersionsOnly: true,
+			lastFreight: &kargoapi.FreightReference{
+				Charts: []kargoapi.Chart{
+					{
+						RepoURL: "fake-url",
+						Name:    "fake-chart",
+						Version: "1.0.0",
+					},
+				},
+			},
+			credentialsDB: &credentials.FakeDB{
+				GetFn: func(
+					context.Context,
+					string,
+					credentials.Type,
+					string,
+				) (credentials.Credentials, bool, error) {
+					return credentials.Credentials{}, false, nil
+				},
+			},
+			selectChartVersionFn: func(
+				context.Context,
+				string,
+				string,
+				string,
+				bool,
+				helm.SelectionMode,
+				func(string) bool,
+				*helm.Credentials,
+				bool,
+				string,
+				*repocache.Cache,
+			) (string, error) {
+				return "1.0.0", nil
+			},
+			assertions: func(t *testing.T, charts []kargoapi.Chart, err error) {
+				require.NoError(t, err)
+				require.Len(t, charts, 1)
+				require.Equal(t, "1.0.0", charts[0].Version)
+			},
+		},
+
+		{
+			name:             "prerelease allowed",
+			allowPrereleases: true,
+			credentialsDB: &credentials.FakeDB{
+				GetFn: func(
+					context.Context,
+					string,
+					credentials.Type,
+					string,
+				) (credentials.Credentials, bool, error) {
+					return credentials.Credentials{}, false, nil
+				},
+			},
+			selectChartVersionFn: func(
+				_ context.Context,
+				_ string,
+				_ string,
+				_ string,
+				allowPrereleases bool,
+				_ helm.SelectionMode,
+				_ func(string) bool,
+				_ *helm.Credentials,
+				_ bool,
+				_ string,
+				_ *repocache.Cache,
+			) (string, error) {
+				if allowPrereleases {
+					return "1.1.0-rc.1", nil
+				}
+				return "1.0.0", nil
+			},
+			assertions: func(t *testing.T, charts []kargoapi.Chart, err error) {
+				require.NoError(t, err)
+				require.Len(t, charts, 1)
+				require.Equal(t, "1.1.0-rc.1", charts[0].Version)
+			},
+		},
+
+		{
+			name:          "invalid version filter",
+			allowVersions: "(",
+			credentialsDB: &credentials.FakeDB{
+				GetFn: func(
+					context.Context,
+					string,
+					credentials.Type,
+					string,
+				) (credentials.Credentials, bool, error) {
+					return credentials.Credentials{}, false, nil
+				},
+			},
+			assertions: func(t *testing.T, _ []kargoapi.Chart, err error) {
+				require.ErrorContains(t, err, "error applying version filters")
+				require.ErrorContains(t, err, "error compiling regular expression")
+			},
+		},
+
+		{
+			name:             "version excluded by regex despite matching constraint",
+			semverConstraint: "^1.0.0",
+			ignoreVersions:   []string{`-hotfix$`, `-beta$`},
+			credentialsDB: &credentials.FakeDB{
+				GetFn: func(
+					context.Context,
+					string,
+					credentials.Type,
+					string,
+				) (credentials.Credentials, bool, error) {
+					return credentials.Credentials{}, false, nil
+				},
+			},
+			selectChartVersionFn: func(
+				_ context.Context,
+				_ string,
+				_ string,
+				semverConstraint string,
+				_ bool,
+				_ helm.SelectionMode,
+				filter func(string) bool,
+				_ *helm.Credentials,
+				_ bool,
+				_ string,
+				_ *repocache.Cache,
+			) (string, error) {
+				// Versions are listed in descending order. Any constraint is
+				// treated as permitting only 1.x versions.
+				for _, version := range []string{"1.1.0-beta", "1.0.1-hotfix", "1.0.0", "0.9.0"} {
+					if filter != nil && !filter(version) {
+						continue
+					}
+					if semverConstraint == "" || strings.HasPrefix(version, "1.") {
+						return version, nil
+					}
+				}
+				return "", nil
+			},
+			assertions: func(t *testing.T, charts []kargoapi.Chart, err error) {
+				require.NoError(t, err)
+				require.Len(t, charts, 1)
+				require.Equal(t, "1.0.0", charts[0].Version)
+			},
+		},
+
+		{
+			name:           "only allowed versions considered",
+			allowVersions:  `^0\.`,
+			ignoreVersions: []string{`-beta$`},
+			credentialsDB: &credentials.FakeDB{
+				GetFn: func(
+					context.Context,
+					string,
+					credentials.Type,
+					string,
+				) (credentials.Credentials, bool, error) {
+					return credentials.Credentials{}, false, nil
+				},
+			},
+			selectChartVersionFn: func(
+				_ context.Context,
+				_ string,
+				_ string,
+				semverConstraint string,
+				_ bool,
+				_ helm.SelectionMode,
+				filter func(string) bool,
+				_ *helm.Credentials,
+				_ bool,
+				_ string,
+				_ *repocache.Cache,
+			) (string, error) {
+				// Versions are listed in descending order. Any constraint is
+				// treated as permitting only 1.x versions.
+				for _, version := range []string{"1.1.0-beta", "1.0.1-hotfix", "1.0.0", "0.9.0"} {
+					if filter != nil && !filter(version) {
+						continue
+					}
+					if semverConstraint == "" || strings.HasPrefix(version, "1.") {
+						return version, nil
+					}
+				}
+				return "", nil
+			},
+			assertions: func(t *testing.T, charts []kargoapi.Chart, err error) {
+				require.NoError(t, err)
+				require.Len(t, charts, 1)
+				require.Equal(t, "0.9.0", charts[0].Version)
+			},
+		},
+
+		{
+			name:            "TLS verification skipped for subscription",
+			insecureSkipTLS: true,
+			credentialsDB: &credentials.FakeDB{
+				GetFn: func(
+					context.Context,
+					string,
+					credentials.Type,
+					string,
+				) (credentials.Credentials, bool, error) {
+					return credentials.Credentials{}, false, nil
+				},
+			},
+			selectChartVersionFn: func(
+				_ context.Context,
+				_ string,
+				_ string,
+				_ string,
+				_ bool,
+				_ helm.SelectionMode,
+				_ func(string) bool,
+				_ *helm.Credentials,
+				insecureSkipTLSVerify bool,
+				_ string,
+				_ *repocache.Cache,
+			) (string, error) {
+				if !insecureSkipTLSVerify {
+					return "", errors.New("TLS verification not skipped")
+				}
+				return "1.0.0", nil
+			},
+			assertions: func(t *testing.T, charts []kargoapi.Chart, err error) {
+				require.NoError(t, err)
+				require.Len(t, charts, 1)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -144,13 +497,225 @@ func TestSelectCharts(t *testing.T) {
 				[]kargoapi.RepoSubscription{
 					{
 						Chart: &kargoapi.ChartSubscription{
-							RepoURL: "fake-url",
-							Name:    "fake-chart",
+							RepoURL:               "fake-url",
+							Name:                  "fake-chart",
+							SemverConstraint:      testCase.semverConstraint,
+							NewerVersionsOnly:     testCase.newerVersionsOnly,
+							AllowPrereleases:      testCase.allowPrereleases,
+							AllowVersions:         testCase.allowVersions,
+							IgnoreVersions:        testCase.ignoreVersions,
+							InsecureSkipTLSVerify: testCase.insecureSkipTLS,
 						},
 					},
 				},
+				testCase.lastFreight,
 			)
 			testCase.assertions(t, charts, err)
 		})
 	}
 }
+
+func TestSelectChartsPollsAllSubscriptions(t *testing.T) {
+	var polled sync.Map
+	charts, err := (&reconciler{
+		credentialsDB: &credentials.FakeDB{},
+		selectChartVersionFn: func(
+			_ context.Context,
+			repoURL string,
+			_ string,
+			_ string,
+			_ bool,
+			_ helm.SelectionMode,
+			_ func(string) bool,
+			_ *helm.Credentials,
+			_ bool,
+			_ string,
+			_ *repocache.Cache,
+		) (string, error) {
+			polled.Store(repoURL, struct{}{})
+			if repoURL == "fake-url-b" {
+				return "", errors.New("something went wrong")
+			}
+			return "1.0.0", nil
+		},
+	}).selectCharts(
+		context.Background(),
+		"fake-namespace",
+		[]kargoapi.RepoSubscription{
+			{Chart: &kargoapi.ChartSubscription{RepoURL: "fake-url-a"}},
+			{Git: &kargoapi.GitSubscription{RepoURL: "fake-git-url"}},
+			{Chart: &kargoapi.ChartSubscription{RepoURL: "fake-url-b"}},
+			{Chart: &kargoapi.ChartSubscription{RepoURL: "fake-url-c"}},
+		},
+		nil,
+	)
+	require.ErrorContains(t, err, "error searching for latest version of chart")
+	require.ErrorContains(t, err, "something went wrong")
+	for _, repoURL := range []string{"fake-url-a", "fake-url-b", "fake-url-c"} {
+		_, ok := polled.Load(repoURL)
+		require.True(t, ok, repoURL)
+	}
+	require.Equal(
+		t,
+		[]kargoapi.Chart{
+			{RepoURL: "fake-url-a", Version: "1.0.0"},
+			{RepoURL: "fake-url-c", Version: "1.0.0"},
+		},
+		charts,
+	)
+}
+
+func TestSelectChartsTimeout(t *testing.T) {
+	_, err := (&reconciler{
+		credentialsDB: &credentials.FakeDB{},
+		selectChartVersionFn: func(
+			ctx context.Context,
+			_ string,
+			_ string,
+			_ string,
+			_ bool,
+			_ helm.SelectionMode,
+			_ func(string) bool,
+			_ *helm.Credentials,
+			_ bool,
+			_ string,
+			_ *repocache.Cache,
+		) (string, error) {
+			// Simulate a registry that never responds
+			<-ctx.Done()
+			return "", ctx.Err()
+		},
+	}).selectCharts(
+		contextWithRegistryTimeout(context.Background(), 10*time.Millisecond),
+		"fake-namespace",
+		[]kargoapi.RepoSubscription{
+			{
+				Chart: &kargoapi.ChartSubscription{
+					RepoURL: "fake-url",
+					Name:    "fake-chart",
+				},
+			},
+		},
+		nil,
+	)
+	require.ErrorContains(
+		t,
+		err,
+		`timed out selecting version of chart "fake-chart" in repository "fake-url"`,
+	)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestSelectChartsFromOCIRegistry(t *testing.T) {
+	const testRepoURL = "oci://ghcr.io/example/charts/my-chart"
+	charts, err := (&reconciler{
+		credentialsDB: &credentials.FakeDB{
+			GetFn: func(
+				_ context.Context,
+				_ string,
+				credType credentials.Type,
+				repoURL string,
+			) (credentials.Credentials, bool, error) {
+				// OCI registries hosting charts use Helm credentials
+				require.Equal(t, credentials.TypeHelm, credType)
+				require.Equal(t, testRepoURL, repoURL)
+				return credentials.Credentials{
+					Username: "fake-username",
+					Password: "fake-password",
+				}, true, nil
+			},
+		},
+		selectChartVersionFn: func(
+			_ context.Context,
+			repoURL string,
+			chart string,
+			_ string,
+			_ bool,
+			_ helm.SelectionMode,
+			_ func(string) bool,
+			creds *helm.Credentials,
+			_ bool,
+			_ string,
+			_ *repocache.Cache,
+		) (string, error) {
+			require.Equal(t, testRepoURL, repoURL)
+			// The URL of a repository within an OCI registry points directly at
+			// the chart, so there is no separate chart name.
+			require.Empty(t, chart)
+			require.Equal(
+				t,
+				&helm.Credentials{
+					Username: "fake-username",
+					Password: "fake-password",
+				},
+				creds,
+			)
+			return "1.2.3", nil
+		},
+	}).selectCharts(
+		context.Background(),
+		"fake-namespace",
+		[]kargoapi.RepoSubscription{
+			{
+				Chart: &kargoapi.ChartSubscription{
+					RepoURL: testRepoURL,
+				},
+			},
+		},
+		nil,
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]kargoapi.Chart{
+			{
+				RepoURL: testRepoURL,
+				Version: "1.2.3",
+			},
+		},
+		charts,
+	)
+}
+
+func TestIsOlderVersion(t *testing.T) {
+	testCases := []struct {
+		name     string
+		v        string
+		other    string
+		expected bool
+	}{
+		{
+			name:     "older",
+			v:        "1.0.0",
+			other:    "1.1.0",
+			expected: true,
+		},
+		{
+			name:     "equal",
+			v:        "1.0.0",
+			other:    "1.0.0",
+			expected: false,
+		},
+		{
+			name:     "newer",
+			v:        "1.2.0",
+			other:    "1.1.0",
+			expected: false,
+		},
+		{
+			name:     "unparseable",
+			v:        "1.0.0",
+			other:    "bogus",
+			expected: false,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				isOlderVersion(testCase.v, testCase.other),
+			)
+		})
+	}
+}
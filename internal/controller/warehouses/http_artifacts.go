@@ -0,0 +1,199 @@
+package warehouses
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+
+	"k8s.io/client-go/util/jsonpath"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libHTTP "github.com/akuity/kargo/internal/http"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+const (
+	// maxHTTPArtifactJSONSize is the maximum size of a JSON response from which
+	// a version may be extracted.
+	maxHTTPArtifactJSONSize = 10 << 20 // 10 MiB
+	// maxHTTPArtifactSize is the maximum size of a response whose version is
+	// its digest. Such responses are hashed as they are read.
+	maxHTTPArtifactSize = 1 << 30 // 1 GiB
+)
+
+func (r *reconciler) selectHTTPArtifacts(
+	ctx context.Context,
+	subs []kargoapi.RepoSubscription,
+) ([]kargoapi.HTTPArtifact, error) {
+	return pollSubscriptions(
+		ctx,
+		subs,
+		func(ctx context.Context, s kargoapi.RepoSubscription) ([]kargoapi.HTTPArtifact, error) {
+			if s.HTTPArtifact == nil {
+				return nil, nil
+			}
+			artifact, err := r.selectHTTPArtifact(ctx, s.HTTPArtifact)
+			if err != nil {
+				recordSubscriptionPoll(ctx, s, "", err)
+				return nil, err
+			}
+			recordSubscriptionPoll(ctx, s, artifact.Version, nil)
+			return []kargoapi.HTTPArtifact{*artifact}, nil
+		},
+	)
+}
+
+// selectHTTPArtifact determines the current version of the artifact the
+// provided HTTPArtifactSubscription refers to.
+func (r *reconciler) selectHTTPArtifact(
+	ctx context.Context,
+	sub *kargoapi.HTTPArtifactSubscription,
+) (*kargoapi.HTTPArtifact, error) {
+	logger := logging.LoggerFromContext(ctx).WithField("url", sub.URL)
+	reqCtx, cancel := withRegistryTimeout(ctx)
+	defer cancel()
+	version, err := r.getHTTPArtifactVersionFn(reqCtx, *sub)
+	if timedOut(reqCtx) {
+		return nil, fmt.Errorf(
+			"timed out fetching HTTP artifact %q: %w",
+			sub.URL,
+			reqCtx.Err(),
+		)
+	}
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error determining version of HTTP artifact %q: %w",
+			sub.URL,
+			err,
+		)
+	}
+	logger.WithField("version", version).Debug("found HTTP artifact version")
+	return &kargoapi.HTTPArtifact{
+		URL:     sub.URL,
+		Version: version,
+	}, nil
+}
+
+// getHTTPArtifactVersion fetches the artifact the provided
+// HTTPArtifactSubscription refers to and returns its version. If the
+// subscription specifies a JSONPath expression, the version is the value it
+// extracts from the response. Otherwise, it is the SHA-256 digest of the
+// response body.
+//
+// Since anyone who can create a Warehouse chooses the URL, and the version
+// extracted from the response is visible to them, the artifact may only be
+// fetched from publicly routable addresses or from the networks the
+// reconciler has been configured to allow.
+func (r *reconciler) getHTTPArtifactVersion(
+	ctx context.Context,
+	sub kargoapi.HTTPArtifactSubscription,
+) (string, error) {
+	transport := libHTTP.NewPublicTransport(r.httpArtifactAllowedNetworks)
+	if sub.InsecureSkipTLSVerify {
+		transport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: true, // nolint: gosec
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, sub.URL, nil)
+	if err != nil {
+		return "", fmt.Errorf("error preparing HTTP/S request to %q: %w", sub.URL, err)
+	}
+	res, err := (&http.Client{Transport: transport}).Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error requesting %q: %w", sub.URL, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf(
+			"received unexpected HTTP %d when requesting %q",
+			res.StatusCode,
+			sub.URL,
+		)
+	}
+	if sub.JSONPath == "" {
+		hash := sha256.New()
+		n, err := io.Copy(hash, io.LimitReader(res.Body, maxHTTPArtifactSize+1))
+		if err != nil {
+			return "", fmt.Errorf("error reading response from %q: %w", sub.URL, err)
+		}
+		if n > maxHTTPArtifactSize {
+			return "", fmt.Errorf(
+				"response from %q exceeds the maximum size of %d bytes",
+				sub.URL,
+				maxHTTPArtifactSize,
+			)
+		}
+		return fmt.Sprintf("sha256:%x", hash.Sum(nil)), nil
+	}
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxHTTPArtifactJSONSize+1))
+	if err != nil {
+		return "", fmt.Errorf("error reading response from %q: %w", sub.URL, err)
+	}
+	if len(body) > maxHTTPArtifactJSONSize {
+		return "", fmt.Errorf(
+			"response from %q exceeds the maximum size of %d bytes",
+			sub.URL,
+			maxHTTPArtifactJSONSize,
+		)
+	}
+	return extractJSONPathVersion(body, sub.JSONPath)
+}
+
+// extractJSONPathVersion evaluates the provided JSONPath expression against
+// the provided JSON document and returns the single, non-empty scalar value
+// it matches.
+func extractJSONPathVersion(doc []byte, expr string) (string, error) {
+	path := jsonpath.New("version")
+	if err := path.Parse(expr); err != nil {
+		return "", fmt.Errorf("error parsing JSONPath expression %q: %w", expr, err)
+	}
+	// Numbers are decoded as json.Number so that they are rendered exactly as
+	// they appear in the document.
+	decoder := json.NewDecoder(bytes.NewReader(doc))
+	decoder.UseNumber()
+	var data any
+	if err := decoder.Decode(&data); err != nil {
+		return "", fmt.Errorf("error parsing response as JSON: %w", err)
+	}
+	results, err := path.FindResults(data)
+	if err != nil {
+		return "", fmt.Errorf("error evaluating JSONPath expression %q: %w", expr, err)
+	}
+	var values []reflect.Value
+	for _, result := range results {
+		values = append(values, result...)
+	}
+	switch len(values) {
+	case 0:
+		return "", fmt.Errorf("JSONPath expression %q matched nothing", expr)
+	case 1:
+	default:
+		return "", fmt.Errorf(
+			"JSONPath expression %q matched %d values; expected exactly one",
+			expr,
+			len(values),
+		)
+	}
+	var version string
+	switch value := values[0].Interface().(type) {
+	case string:
+		version = value
+	case json.Number:
+		version = value.String()
+	case bool:
+		version = fmt.Sprint(value)
+	default:
+		return "", fmt.Errorf("JSONPath expression %q did not match a scalar value", expr)
+	}
+	if version == "" {
+		return "", errors.New("extracted version is empty")
+	}
+	return version, nil
+}
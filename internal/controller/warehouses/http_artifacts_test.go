@@ -0,0 +1,248 @@
+package warehouses
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libHTTP "github.com/akuity/kargo/internal/http"
+)
+
+func TestSelectHTTPArtifacts(t *testing.T) {
+	const testURL = "https://example.com/release.json"
+	testSubs := []kargoapi.RepoSubscription{
+		{
+			HTTPArtifact: &kargoapi.HTTPArtifactSubscription{
+				URL: testURL,
+			},
+		},
+		{
+			Image: &kargoapi.ImageSubscription{
+				RepoURL: "fake-registry/fake-image",
+			},
+		},
+	}
+	testCases := []struct {
+		name       string
+		reconciler *reconciler
+		assertions func(*testing.T, []kargoapi.HTTPArtifact, error)
+	}{
+		{
+			name: "error getting version",
+			reconciler: &reconciler{
+				getHTTPArtifactVersionFn: func(
+					context.Context,
+					kargoapi.HTTPArtifactSubscription,
+				) (string, error) {
+					return "", errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ []kargoapi.HTTPArtifact, err error) {
+				require.ErrorContains(t, err, "error determining version of HTTP artifact")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "success",
+			reconciler: &reconciler{
+				getHTTPArtifactVersionFn: func(
+					context.Context,
+					kargoapi.HTTPArtifactSubscription,
+				) (string, error) {
+					return "v1.2.3", nil
+				},
+			},
+			assertions: func(t *testing.T, artifacts []kargoapi.HTTPArtifact, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]kargoapi.HTTPArtifact{
+						{
+							URL:     testURL,
+							Version: "v1.2.3",
+						},
+					},
+					artifacts,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			artifacts, err := testCase.reconciler.selectHTTPArtifacts(
+				context.Background(),
+				testSubs,
+			)
+			testCase.assertions(t, artifacts, err)
+		})
+	}
+}
+
+func TestGetHTTPArtifactVersion(t *testing.T) {
+	body := `{"tag_name":"v1.2.3"}`
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/missing" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte(body))
+		}),
+	)
+	t.Cleanup(server.Close)
+
+	// The test server listens on a loopback address, which must be allowed
+	// explicitly.
+	var allowedNetworks libHTTP.NetworkList
+	require.NoError(t, allowedNetworks.Decode("127.0.0.0/8, ::1/128"))
+	r := &reconciler{httpArtifactAllowedNetworks: allowedNetworks}
+
+	t.Run("unexpected status", func(t *testing.T) {
+		_, err := r.getHTTPArtifactVersion(
+			context.Background(),
+			kargoapi.HTTPArtifactSubscription{URL: server.URL + "/missing"},
+		)
+		require.ErrorContains(t, err, "received unexpected HTTP 404")
+	})
+
+	t.Run("version from digest", func(t *testing.T) {
+		sub := kargoapi.HTTPArtifactSubscription{URL: server.URL}
+		version, err := r.getHTTPArtifactVersion(context.Background(), sub)
+		require.NoError(t, err)
+		require.Equal(t, fmt.Sprintf("sha256:%x", sha256.Sum256([]byte(body))), version)
+
+		// A change to the content should be detected as a new version
+		body = `{"tag_name":"v1.2.4"}`
+		newVersion, err := r.getHTTPArtifactVersion(context.Background(), sub)
+		require.NoError(t, err)
+		require.NotEqual(t, version, newVersion)
+	})
+
+	t.Run("version from JSONPath", func(t *testing.T) {
+		body = `{"tag_name":"v2.0.0"}`
+		version, err := r.getHTTPArtifactVersion(
+			context.Background(),
+			kargoapi.HTTPArtifactSubscription{
+				URL:      server.URL,
+				JSONPath: "{.tag_name}",
+			},
+		)
+		require.NoError(t, err)
+		require.Equal(t, "v2.0.0", version)
+	})
+
+	t.Run("non-public address not allowed", func(t *testing.T) {
+		_, err := (&reconciler{}).getHTTPArtifactVersion(
+			context.Background(),
+			kargoapi.HTTPArtifactSubscription{URL: server.URL},
+		)
+		require.ErrorContains(t, err, "connections to non-public address 127.0.0.1 are not permitted")
+	})
+}
+
+func TestExtractJSONPathVersion(t *testing.T) {
+	testCases := []struct {
+		name       string
+		doc        string
+		expr       string
+		assertions func(*testing.T, string, error)
+	}{
+		{
+			name: "invalid expression",
+			doc:  `{}`,
+			expr: "{.tag_name",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "error parsing JSONPath expression")
+			},
+		},
+		{
+			name: "invalid JSON",
+			doc:  `{`,
+			expr: "{.tag_name}",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "error parsing response as JSON")
+			},
+		},
+		{
+			name: "no match",
+			doc:  `{"name":"foo"}`,
+			expr: "{.tag_name}",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "error evaluating JSONPath expression")
+			},
+		},
+		{
+			name: "multiple matches",
+			doc:  `{"releases":[{"tag":"v1"},{"tag":"v2"}]}`,
+			expr: "{.releases[*].tag}",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "matched 2 values")
+			},
+		},
+		{
+			name: "non-scalar match",
+			doc:  `{"release":{"tag":"v1"}}`,
+			expr: "{.release}",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "did not match a scalar value")
+			},
+		},
+		{
+			name: "empty match",
+			doc:  `{"tag_name":""}`,
+			expr: "{.tag_name}",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "extracted version is empty")
+			},
+		},
+		{
+			name: "string",
+			doc:  `{"tag_name":"v1.2.3"}`,
+			expr: "{.tag_name}",
+			assertions: func(t *testing.T, version string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "v1.2.3", version)
+			},
+		},
+		{
+			name: "number",
+			doc:  `{"build":12345678901234567890}`,
+			expr: "{.build}",
+			assertions: func(t *testing.T, version string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "12345678901234567890", version)
+			},
+		},
+		{
+			name: "bool",
+			doc:  `{"stable":true}`,
+			expr: "{.stable}",
+			assertions: func(t *testing.T, version string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "true", version)
+			},
+		},
+		{
+			name: "nested",
+			doc:  `{"releases":[{"tag":"v2"},{"tag":"v1"}]}`,
+			expr: "{.releases[0].tag}",
+			assertions: func(t *testing.T, version string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "v2", version)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			version, err := extractJSONPathVersion([]byte(testCase.doc), testCase.expr)
+			testCase.assertions(t, version, err)
+		})
+	}
+}
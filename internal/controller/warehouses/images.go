@@ -6,12 +6,15 @@ import (
 	"strings"
 
 	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/credentials"
 	"github.com/akuity/kargo/internal/git"
 	"github.com/akuity/kargo/internal/image"
 	"github.com/akuity/kargo/internal/logging"
+	"github.com/akuity/kargo/internal/repocache"
 )
 
 func (r *reconciler) selectImages(
@@ -19,54 +22,190 @@ func (r *reconciler) selectImages(
 	namespace string,
 	subs []kargoapi.RepoSubscription,
 ) ([]kargoapi.Image, error) {
-	imgs := make([]kargoapi.Image, 0, len(subs))
-	for _, s := range subs {
-		if s.Image == nil {
-			continue
+	return pollSubscriptions(
+		ctx,
+		subs,
+		func(ctx context.Context, s kargoapi.RepoSubscription) ([]kargoapi.Image, error) {
+			if s.Image == nil {
+				return nil, nil
+			}
+			images, err := r.selectImage(ctx, namespace, s.Image)
+			if err != nil {
+				recordSubscriptionPoll(ctx, s, "", err)
+				return nil, err
+			}
+			recordSubscriptionPoll(ctx, s, describeImages(images), nil)
+			return images, nil
+		},
+	)
+}
+
+// describeImages summarizes the provided images, all selected from the same
+// ImageSubscription, for the purpose of reporting the subscription's status.
+// A single image is described by its tag alone. Images selected from multiple
+// discovered repositories are each described by repository and tag.
+func describeImages(images []kargoapi.Image) string {
+	if len(images) == 1 {
+		return images[0].Tag
+	}
+	descs := make([]string, len(images))
+	for i, image := range images {
+		descs[i] = fmt.Sprintf("%s:%s", image.RepoURL, image.Tag)
+	}
+	return strings.Join(descs, ", ")
+}
+
+// selectImage returns the latest suitable image from each repository the
+// provided ImageSubscription refers to.
+func (r *reconciler) selectImage(
+	ctx context.Context,
+	namespace string,
+	sub *kargoapi.ImageSubscription,
+) ([]kargoapi.Image, error) {
+	logger := logging.LoggerFromContext(ctx).WithField("repo", sub.RepoURL)
+
+	creds, ok, err :=
+		r.credentialsDB.Get(ctx, namespace, credentials.TypeImage, sub.RepoURL)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error obtaining credentials for image repo %q: %w",
+			sub.RepoURL,
+			err,
+		)
+	}
+	var regCreds *image.Credentials
+	if ok {
+		regCreds = &image.Credentials{
+			Username: creds.Username,
+			Password: creds.Password,
 		}
+		logger.WithField("credentialsSource", creds.Source).
+			Debug("obtained credentials for image repo")
+	} else {
+		logger.Debug("found no credentials for image repo")
+	}
 
-		sub := s.Image
+	var allowedDigests []string
+	if sub.DigestAllowlist != nil {
+		if allowedDigests, err = r.getDigestAllowlistFn(
+			ctx,
+			namespace,
+			*sub.DigestAllowlist,
+		); err != nil {
+			return nil, fmt.Errorf(
+				"error obtaining digest allowlist for image repo %q: %w",
+				sub.RepoURL,
+				err,
+			)
+		}
+		logger.WithField("digests", len(allowedDigests)).
+			Debug("obtained digest allowlist for image repo")
+	}
 
-		logger := logging.LoggerFromContext(ctx).WithField("repo", sub.RepoURL)
+	var publicKey string
+	if sub.SignatureVerification != nil {
+		if publicKey, err = r.getSignatureVerificationKeyFn(
+			ctx,
+			namespace,
+			*sub.SignatureVerification,
+		); err != nil {
+			return nil, fmt.Errorf(
+				"error obtaining signature verification key for image repo %q: %w",
+				sub.RepoURL,
+				err,
+			)
+		}
+		logger.Debug("obtained signature verification key for image repo")
+	}
 
-		creds, ok, err :=
-			r.credentialsDB.Get(ctx, namespace, credentials.TypeImage, sub.RepoURL)
-		if err != nil {
+	var caBundle string
+	if sub.CABundle != nil {
+		if caBundle, err = r.getCABundleFn(ctx, namespace, *sub.CABundle); err != nil {
 			return nil, fmt.Errorf(
-				"error obtaining credentials for image repo %q: %w",
+				"error obtaining CA bundle for image repo %q: %w",
 				sub.RepoURL,
 				err,
 			)
 		}
-		var regCreds *image.Credentials
-		if ok {
-			regCreds = &image.Credentials{
-				Username: creds.Username,
-				Password: creds.Password,
-			}
-			logger.Debug("obtained credentials for image repo")
-		} else {
-			logger.Debug("found no credentials for image repo")
+		logger.Debug("obtained CA bundle for image repo")
+	}
+
+	repoURLs := []string{sub.RepoURL}
+	if sub.Discovery != nil {
+		reqCtx, cancel := withRegistryTimeout(ctx)
+		defer cancel()
+		repoURLs, err = r.discoverImageReposFn(
+			reqCtx,
+			sub.RepoURL,
+			&image.DiscoveryOptions{
+				Pattern:               sub.Discovery.RepoPattern,
+				MaxRepositories:       int(sub.Discovery.MaxRepositories),
+				Creds:                 regCreds,
+				InsecureSkipTLSVerify: sub.InsecureSkipTLSVerify,
+				CABundle:              caBundle,
+			},
+		)
+		if timedOut(reqCtx) {
+			return nil, fmt.Errorf(
+				"timed out discovering image repos under %q: %w",
+				sub.RepoURL,
+				reqCtx.Err(),
+			)
+		}
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error discovering image repos under %q: %w",
+				sub.RepoURL,
+				err,
+			)
 		}
+		logger.WithField("repos", len(repoURLs)).
+			Debug("discovered image repos")
+	}
 
-		tag, digest, err := r.getImageRefsFn(ctx, *sub, regCreds)
+	imgs := make([]kargoapi.Image, 0, len(repoURLs))
+	for _, repoURL := range repoURLs {
+		repoSub := *sub
+		repoSub.RepoURL = repoURL
+		repoSub.Discovery = nil
+		reqCtx, cancel := withRegistryTimeout(ctx)
+		tag, digest, err :=
+			r.getImageRefsFn(
+				reqCtx,
+				repoSub,
+				regCreds,
+				allowedDigests,
+				publicKey,
+				caBundle,
+				r.listingCache,
+			)
+		if timedOut(reqCtx) {
+			cancel()
+			return nil, fmt.Errorf(
+				"timed out selecting image %q: %w",
+				repoURL,
+				reqCtx.Err(),
+			)
+		}
+		cancel()
 		if err != nil {
 			return nil, fmt.Errorf(
 				"error getting latest suitable image %q: %w",
-				sub.RepoURL,
+				repoURL,
 				err,
 			)
 		}
 		imgs = append(
 			imgs,
 			kargoapi.Image{
-				RepoURL:    sub.RepoURL,
+				RepoURL:    repoURL,
 				GitRepoURL: r.getImageSourceURL(sub.GitRepoURL, tag),
 				Tag:        tag,
 				Digest:     digest,
 			},
 		)
 		logger.WithFields(log.Fields{
+			"image":  repoURL,
 			"tag":    tag,
 			"digest": digest,
 		}).Debug("found latest suitable image")
@@ -76,6 +215,12 @@ func (r *reconciler) selectImages(
 
 const (
 	githubURLPrefix = "https://github.com"
+
+	defaultDigestAllowlistKey = "digests"
+
+	defaultSignatureVerificationKey = "cosign.pub"
+
+	defaultCABundleKey = "ca.crt"
 )
 
 func (r *reconciler) getImageSourceURL(gitRepoURL, tag string) string {
@@ -91,21 +236,130 @@ func getGithubImageSourceURL(gitRepoURL, tag string) string {
 	return fmt.Sprintf("%s/tree/%s", git.NormalizeURL(gitRepoURL), tag)
 }
 
+// getDigestAllowlist returns the image digests listed under the specified key
+// of the specified ConfigMap. The returned slice is never nil, so that an
+// allowlist with no entries is distinguishable from no allowlist at all.
+func (r *reconciler) getDigestAllowlist(
+	ctx context.Context,
+	namespace string,
+	allowlist kargoapi.DigestAllowlist,
+) ([]string, error) {
+	key := allowlist.Key
+	if key == "" {
+		key = defaultDigestAllowlistKey
+	}
+	data, err := r.getConfigMapValue(ctx, namespace, allowlist.ConfigMapName, key)
+	if err != nil {
+		return nil, err
+	}
+	return parseDigestAllowlist(data), nil
+}
+
+// getSignatureVerificationKey returns the PEM-encoded public key found under
+// the specified key of the specified ConfigMap.
+func (r *reconciler) getSignatureVerificationKey(
+	ctx context.Context,
+	namespace string,
+	verification kargoapi.ImageSignatureVerification,
+) (string, error) {
+	key := verification.Key
+	if key == "" {
+		key = defaultSignatureVerificationKey
+	}
+	return r.getConfigMapValue(ctx, namespace, verification.ConfigMapName, key)
+}
+
+// getCABundle returns the PEM-encoded CA certificates found under the
+// specified key of the specified ConfigMap.
+func (r *reconciler) getCABundle(
+	ctx context.Context,
+	namespace string,
+	bundle kargoapi.CABundle,
+) (string, error) {
+	key := bundle.Key
+	if key == "" {
+		key = defaultCABundleKey
+	}
+	return r.getConfigMapValue(ctx, namespace, bundle.ConfigMapName, key)
+}
+
+// getConfigMapValue returns the value of the specified key of the specified
+// ConfigMap.
+func (r *reconciler) getConfigMapValue(
+	ctx context.Context,
+	namespace string,
+	name string,
+	key string,
+) (string, error) {
+	cm := &corev1.ConfigMap{}
+	if err := r.client.Get(
+		ctx,
+		types.NamespacedName{
+			Namespace: namespace,
+			Name:      name,
+		},
+		cm,
+	); err != nil {
+		return "", fmt.Errorf(
+			"error getting ConfigMap %q in namespace %q: %w",
+			name,
+			namespace,
+			err,
+		)
+	}
+	data, ok := cm.Data[key]
+	if !ok {
+		return "", fmt.Errorf(
+			"ConfigMap %q in namespace %q has no key %q",
+			name,
+			namespace,
+			key,
+		)
+	}
+	return data, nil
+}
+
+// parseDigestAllowlist parses a newline-delimited list of digests, ignoring
+// blank lines and lines beginning with '#'.
+func parseDigestAllowlist(data string) []string {
+	digests := []string{}
+	for _, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		digests = append(digests, line)
+	}
+	return digests
+}
+
 func getImageRefs(
 	ctx context.Context,
 	sub kargoapi.ImageSubscription,
 	creds *image.Credentials,
+	allowedDigests []string,
+	publicKey string,
+	caBundle string,
+	tagCache *repocache.Cache,
 ) (string, string, error) {
 	imageSelector, err := image.NewSelector(
 		sub.RepoURL,
 		image.SelectionStrategy(sub.ImageSelectionStrategy),
 		&image.SelectorOptions{
-			Constraint:            sub.SemverConstraint,
-			AllowRegex:            sub.AllowTags,
-			Ignore:                sub.IgnoreTags,
-			Platform:              sub.Platform,
-			Creds:                 creds,
-			InsecureSkipTLSVerify: sub.InsecureSkipTLSVerify,
+			Constraint:               sub.SemverConstraint,
+			AllowRegex:               sub.AllowTags,
+			Ignore:                   sub.IgnoreTags,
+			Platform:                 sub.Platform,
+			Platforms:                sub.Platforms,
+			ExcludePlatforms:         sub.ExcludePlatforms,
+			Creds:                    creds,
+			InsecureSkipTLSVerify:    sub.InsecureSkipTLSVerify,
+			CABundle:                 caBundle,
+			AllowedDigests:           allowedDigests,
+			SelectionMode:            image.SelectionMode(sub.SelectionMode),
+			Digest:                   sub.Digest,
+			SignatureVerificationKey: publicKey,
+			TagCache:                 tagCache,
 		},
 	)
 	if err != nil {
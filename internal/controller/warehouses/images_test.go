@@ -7,18 +7,57 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/credentials"
 	"github.com/akuity/kargo/internal/image"
+	"github.com/akuity/kargo/internal/repocache"
 )
 
 func TestSelectImages(t *testing.T) {
 	testCases := []struct {
-		name       string
-		reconciler *reconciler
-		assertions func(*testing.T, []kargoapi.Image, error)
+		name                  string
+		digestAllowlist       *kargoapi.DigestAllowlist
+		signatureVerification *kargoapi.ImageSignatureVerification
+		caBundle              *kargoapi.CABundle
+		discovery             *kargoapi.ImageRepositoryDiscovery
+		reconciler            *reconciler
+		assertions            func(*testing.T, []kargoapi.Image, error)
 	}{
+		{
+			name: "error getting digest allowlist",
+			digestAllowlist: &kargoapi.DigestAllowlist{
+				ConfigMapName: "fake-configmap",
+			},
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{
+					GetFn: func(
+						context.Context,
+						string,
+						credentials.Type,
+						string,
+					) (credentials.Credentials, bool, error) {
+						return credentials.Credentials{}, false, nil
+					},
+				},
+				getDigestAllowlistFn: func(
+					context.Context,
+					string,
+					kargoapi.DigestAllowlist,
+				) ([]string, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ []kargoapi.Image, err error) {
+				require.ErrorContains(t, err, "error obtaining digest allowlist")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
 		{
 			name: "error getting latest version of an image",
 			reconciler: &reconciler{
@@ -36,6 +75,10 @@ func TestSelectImages(t *testing.T) {
 					context.Context,
 					kargoapi.ImageSubscription,
 					*image.Credentials,
+					[]string,
+					string,
+					string,
+					*repocache.Cache,
 				) (string, string, error) {
 					return "", "", errors.New("something went wrong")
 				},
@@ -63,6 +106,10 @@ func TestSelectImages(t *testing.T) {
 					context.Context,
 					kargoapi.ImageSubscription,
 					*image.Credentials,
+					[]string,
+					string,
+					string,
+					*repocache.Cache,
 				) (string, string, error) {
 					return "fake-tag", "fake-digest", nil
 				},
@@ -81,6 +128,285 @@ func TestSelectImages(t *testing.T) {
 				)
 			},
 		},
+		{
+			name: "success with digest allowlist",
+			digestAllowlist: &kargoapi.DigestAllowlist{
+				ConfigMapName: "fake-configmap",
+			},
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{
+					GetFn: func(
+						context.Context,
+						string,
+						credentials.Type,
+						string,
+					) (credentials.Credentials, bool, error) {
+						return credentials.Credentials{}, false, nil
+					},
+				},
+				getDigestAllowlistFn: func(
+					context.Context,
+					string,
+					kargoapi.DigestAllowlist,
+				) ([]string, error) {
+					return []string{"fake-digest"}, nil
+				},
+				getImageRefsFn: func(
+					_ context.Context,
+					_ kargoapi.ImageSubscription,
+					_ *image.Credentials,
+					allowedDigests []string,
+					_ string,
+					_ string,
+					_ *repocache.Cache,
+				) (string, string, error) {
+					if len(allowedDigests) != 1 || allowedDigests[0] != "fake-digest" {
+						return "", "", errors.New("unexpected allowed digests")
+					}
+					return "fake-tag", "fake-digest", nil
+				},
+			},
+			assertions: func(t *testing.T, images []kargoapi.Image, err error) {
+				require.NoError(t, err)
+				require.Len(t, images, 1)
+				require.Equal(t, "fake-digest", images[0].Digest)
+			},
+		},
+		{
+			name: "error getting signature verification key",
+			signatureVerification: &kargoapi.ImageSignatureVerification{
+				ConfigMapName: "fake-configmap",
+			},
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{
+					GetFn: func(
+						context.Context,
+						string,
+						credentials.Type,
+						string,
+					) (credentials.Credentials, bool, error) {
+						return credentials.Credentials{}, false, nil
+					},
+				},
+				getSignatureVerificationKeyFn: func(
+					context.Context,
+					string,
+					kargoapi.ImageSignatureVerification,
+				) (string, error) {
+					return "", errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ []kargoapi.Image, err error) {
+				require.ErrorContains(t, err, "error obtaining signature verification key")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "success with signature verification",
+			signatureVerification: &kargoapi.ImageSignatureVerification{
+				ConfigMapName: "fake-configmap",
+			},
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{
+					GetFn: func(
+						context.Context,
+						string,
+						credentials.Type,
+						string,
+					) (credentials.Credentials, bool, error) {
+						return credentials.Credentials{}, false, nil
+					},
+				},
+				getSignatureVerificationKeyFn: func(
+					context.Context,
+					string,
+					kargoapi.ImageSignatureVerification,
+				) (string, error) {
+					return "fake-public-key", nil
+				},
+				getImageRefsFn: func(
+					_ context.Context,
+					_ kargoapi.ImageSubscription,
+					_ *image.Credentials,
+					_ []string,
+					publicKey string,
+					_ string,
+					_ *repocache.Cache,
+				) (string, string, error) {
+					if publicKey != "fake-public-key" {
+						return "", "", errors.New("unexpected public key")
+					}
+					return "fake-tag", "fake-digest", nil
+				},
+			},
+			assertions: func(t *testing.T, images []kargoapi.Image, err error) {
+				require.NoError(t, err)
+				require.Len(t, images, 1)
+				require.Equal(t, "fake-tag", images[0].Tag)
+			},
+		},
+		{
+			name: "error getting CA bundle",
+			caBundle: &kargoapi.CABundle{
+				ConfigMapName: "fake-configmap",
+			},
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{
+					GetFn: func(
+						context.Context,
+						string,
+						credentials.Type,
+						string,
+					) (credentials.Credentials, bool, error) {
+						return credentials.Credentials{}, false, nil
+					},
+				},
+				getCABundleFn: func(
+					context.Context,
+					string,
+					kargoapi.CABundle,
+				) (string, error) {
+					return "", errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ []kargoapi.Image, err error) {
+				require.ErrorContains(t, err, "error obtaining CA bundle")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "success with CA bundle",
+			caBundle: &kargoapi.CABundle{
+				ConfigMapName: "fake-configmap",
+			},
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{
+					GetFn: func(
+						context.Context,
+						string,
+						credentials.Type,
+						string,
+					) (credentials.Credentials, bool, error) {
+						return credentials.Credentials{}, false, nil
+					},
+				},
+				getCABundleFn: func(
+					context.Context,
+					string,
+					kargoapi.CABundle,
+				) (string, error) {
+					return "fake-ca-bundle", nil
+				},
+				getImageRefsFn: func(
+					_ context.Context,
+					_ kargoapi.ImageSubscription,
+					_ *image.Credentials,
+					_ []string,
+					_ string,
+					caBundle string,
+					_ *repocache.Cache,
+				) (string, string, error) {
+					if caBundle != "fake-ca-bundle" {
+						return "", "", errors.New("unexpected CA bundle")
+					}
+					return "fake-tag", "fake-digest", nil
+				},
+			},
+			assertions: func(t *testing.T, images []kargoapi.Image, err error) {
+				require.NoError(t, err)
+				require.Len(t, images, 1)
+				require.Equal(t, "fake-tag", images[0].Tag)
+			},
+		},
+		{
+			name:      "error discovering image repos",
+			discovery: &kargoapi.ImageRepositoryDiscovery{},
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{
+					GetFn: func(
+						context.Context,
+						string,
+						credentials.Type,
+						string,
+					) (credentials.Credentials, bool, error) {
+						return credentials.Credentials{}, false, nil
+					},
+				},
+				discoverImageReposFn: func(
+					context.Context,
+					string,
+					*image.DiscoveryOptions,
+				) ([]string, error) {
+					return nil, image.ErrCatalogNotSupported
+				},
+			},
+			assertions: func(t *testing.T, _ []kargoapi.Image, err error) {
+				require.ErrorContains(t, err, "error discovering image repos")
+				require.ErrorIs(t, err, image.ErrCatalogNotSupported)
+			},
+		},
+		{
+			name: "success with discovery",
+			discovery: &kargoapi.ImageRepositoryDiscovery{
+				RepoPattern:     "^fake-url/",
+				MaxRepositories: 5,
+			},
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{
+					GetFn: func(
+						context.Context,
+						string,
+						credentials.Type,
+						string,
+					) (credentials.Credentials, bool, error) {
+						return credentials.Credentials{}, false, nil
+					},
+				},
+				discoverImageReposFn: func(
+					_ context.Context,
+					prefix string,
+					opts *image.DiscoveryOptions,
+				) ([]string, error) {
+					if opts.Pattern != "^fake-url/" || opts.MaxRepositories != 5 {
+						return nil, errors.New("unexpected discovery options")
+					}
+					return []string{prefix + "/a", prefix + "/b"}, nil
+				},
+				getImageRefsFn: func(
+					_ context.Context,
+					sub kargoapi.ImageSubscription,
+					_ *image.Credentials,
+					_ []string,
+					_ string,
+					_ string,
+					_ *repocache.Cache,
+				) (string, string, error) {
+					if sub.Discovery != nil {
+						return "", "", errors.New("unexpected discovery")
+					}
+					return "fake-tag", "fake-digest-" + sub.RepoURL, nil
+				},
+			},
+			assertions: func(t *testing.T, images []kargoapi.Image, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]kargoapi.Image{
+						{
+							RepoURL: "fake-url/a",
+							Tag:     "fake-tag",
+							Digest:  "fake-digest-fake-url/a",
+						},
+						{
+							RepoURL: "fake-url/b",
+							Tag:     "fake-tag",
+							Digest:  "fake-digest-fake-url/b",
+						},
+					},
+					images,
+				)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -90,7 +416,11 @@ func TestSelectImages(t *testing.T) {
 				[]kargoapi.RepoSubscription{
 					{
 						Image: &kargoapi.ImageSubscription{
-							RepoURL: "fake-url",
+							RepoURL:               "fake-url",
+							DigestAllowlist:       testCase.digestAllowlist,
+							SignatureVerification: testCase.signatureVerification,
+							CABundle:              testCase.caBundle,
+							Discovery:             testCase.discovery,
 						},
 					},
 				},
@@ -100,6 +430,184 @@ func TestSelectImages(t *testing.T) {
 	}
 }
 
+func TestGetDigestAllowlist(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	testCases := []struct {
+		name       string
+		allowlist  kargoapi.DigestAllowlist
+		objects    []client.Object
+		assertions func(*testing.T, []string, error)
+	}{
+		{
+			name: "ConfigMap not found",
+			allowlist: kargoapi.DigestAllowlist{
+				ConfigMapName: "fake-configmap",
+			},
+			assertions: func(t *testing.T, _ []string, err error) {
+				require.ErrorContains(t, err, "error getting ConfigMap")
+			},
+		},
+		{
+			name: "key not found",
+			allowlist: kargoapi.DigestAllowlist{
+				ConfigMapName: "fake-configmap",
+				Key:           "fake-key",
+			},
+			objects: []client.Object{
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "fake-configmap",
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ []string, err error) {
+				require.ErrorContains(t, err, "has no key")
+			},
+		},
+		{
+			name: "success with default key",
+			allowlist: kargoapi.DigestAllowlist{
+				ConfigMapName: "fake-configmap",
+			},
+			objects: []client.Object{
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "fake-configmap",
+					},
+					Data: map[string]string{
+						"digests": "sha256:abc\n\n# comment\n  sha256:def  \n",
+					},
+				},
+			},
+			assertions: func(t *testing.T, digests []string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"sha256:abc", "sha256:def"}, digests)
+			},
+		},
+		{
+			name: "success with empty list",
+			allowlist: kargoapi.DigestAllowlist{
+				ConfigMapName: "fake-configmap",
+				Key:           "fake-key",
+			},
+			objects: []client.Object{
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "fake-configmap",
+					},
+					Data: map[string]string{
+						"fake-key": "",
+					},
+				},
+			},
+			assertions: func(t *testing.T, digests []string, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, digests)
+				require.Empty(t, digests)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := &reconciler{
+				client: fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(testCase.objects...).
+					Build(),
+			}
+			digests, err := r.getDigestAllowlist(
+				context.Background(),
+				"fake-namespace",
+				testCase.allowlist,
+			)
+			testCase.assertions(t, digests, err)
+		})
+	}
+}
+
+func TestGetSignatureVerificationKey(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	r := &reconciler{
+		client: fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "fake-configmap",
+					},
+					Data: map[string]string{
+						"cosign.pub": "fake-public-key",
+					},
+				},
+			).
+			Build(),
+	}
+
+	key, err := r.getSignatureVerificationKey(
+		context.Background(),
+		"fake-namespace",
+		kargoapi.ImageSignatureVerification{ConfigMapName: "fake-configmap"},
+	)
+	require.NoError(t, err)
+	require.Equal(t, "fake-public-key", key)
+
+	_, err = r.getSignatureVerificationKey(
+		context.Background(),
+		"fake-namespace",
+		kargoapi.ImageSignatureVerification{
+			ConfigMapName: "fake-configmap",
+			Key:           "fake-key",
+		},
+	)
+	require.ErrorContains(t, err, "has no key")
+}
+
+func TestGetCABundle(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	r := &reconciler{
+		client: fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "fake-configmap",
+					},
+					Data: map[string]string{
+						"ca.crt": "fake-ca-bundle",
+					},
+				},
+			).
+			Build(),
+	}
+
+	bundle, err := r.getCABundle(
+		context.Background(),
+		"fake-namespace",
+		kargoapi.CABundle{ConfigMapName: "fake-configmap"},
+	)
+	require.NoError(t, err)
+	require.Equal(t, "fake-ca-bundle", bundle)
+
+	_, err = r.getCABundle(
+		context.Background(),
+		"fake-namespace",
+		kargoapi.CABundle{
+			ConfigMapName: "fake-configmap",
+			Key:           "fake-key",
+		},
+	)
+	require.ErrorContains(t, err, "has no key")
+}
+
 func TestGetImageSourceURL(t *testing.T) {
 	const testURLPrefix = "fake-url-prefix"
 	testCases := []struct {
@@ -164,3 +672,19 @@ func TestGetGithubImageSourceURL(t *testing.T) {
 		})
 	}
 }
+
+func TestDescribeImages(t *testing.T) {
+	require.Equal(
+		t,
+		"v1.0.0",
+		describeImages([]kargoapi.Image{{RepoURL: "fake-url", Tag: "v1.0.0"}}),
+	)
+	require.Equal(
+		t,
+		"fake-url/a:v1.0.0, fake-url/b:v2.0.0",
+		describeImages([]kargoapi.Image{
+			{RepoURL: "fake-url/a", Tag: "v1.0.0"},
+			{RepoURL: "fake-url/b", Tag: "v2.0.0"},
+		}),
+	)
+}
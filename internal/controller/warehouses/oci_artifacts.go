@@ -0,0 +1,114 @@
+package warehouses
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/image"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+func (r *reconciler) selectOCIArtifacts(
+	ctx context.Context,
+	namespace string,
+	subs []kargoapi.RepoSubscription,
+) ([]kargoapi.OCIArtifact, error) {
+	return pollSubscriptions(
+		ctx,
+		subs,
+		func(ctx context.Context, s kargoapi.RepoSubscription) ([]kargoapi.OCIArtifact, error) {
+			if s.OCIArtifact == nil {
+				return nil, nil
+			}
+			artifact, err := r.selectOCIArtifact(ctx, namespace, s.OCIArtifact)
+			if err != nil {
+				recordSubscriptionPoll(ctx, s, "", err)
+				return nil, err
+			}
+			recordSubscriptionPoll(ctx, s, artifact.Digest, nil)
+			return []kargoapi.OCIArtifact{*artifact}, nil
+		},
+	)
+}
+
+// selectOCIArtifact resolves the OCI artifact the provided
+// OCIArtifactSubscription refers to.
+func (r *reconciler) selectOCIArtifact(
+	ctx context.Context,
+	namespace string,
+	sub *kargoapi.OCIArtifactSubscription,
+) (*kargoapi.OCIArtifact, error) {
+	logger := logging.LoggerFromContext(ctx).WithField("repo", sub.RepoURL)
+
+	// OCI registries don't distinguish between images and other artifacts
+	// for purposes of authentication, so image credentials are used.
+	creds, ok, err :=
+		r.credentialsDB.Get(ctx, namespace, credentials.TypeImage, sub.RepoURL)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error obtaining credentials for OCI artifact repo %q: %w",
+			sub.RepoURL,
+			err,
+		)
+	}
+	var regCreds *image.Credentials
+	if ok {
+		regCreds = &image.Credentials{
+			Username: creds.Username,
+			Password: creds.Password,
+		}
+		logger.WithField("credentialsSource", creds.Source).
+			Debug("obtained credentials for OCI artifact repo")
+	} else {
+		logger.Debug("found no credentials for OCI artifact repo")
+	}
+
+	reqCtx, cancel := withRegistryTimeout(ctx)
+	defer cancel()
+	digest, err := r.resolveArtifactDigestFn(
+		reqCtx,
+		sub.RepoURL,
+		sub.Tag,
+		sub.Digest,
+		&image.ArtifactOptions{
+			Creds:                 regCreds,
+			InsecureSkipTLSVerify: sub.InsecureSkipTLSVerify,
+		},
+	)
+	if timedOut(reqCtx) {
+		return nil, fmt.Errorf(
+			"timed out resolving OCI artifact %q: %w",
+			sub.RepoURL,
+			reqCtx.Err(),
+		)
+	}
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error resolving OCI artifact %q: %w",
+			sub.RepoURL,
+			err,
+		)
+	}
+
+	// The tag is recorded only if it was actually used to resolve the
+	// artifact.
+	var tag string
+	if sub.Digest == "" {
+		if tag = sub.Tag; tag == "" {
+			tag = image.DefaultArtifactTag
+		}
+	}
+	logger.WithFields(log.Fields{
+		"tag":    tag,
+		"digest": digest,
+	}).Debug("resolved OCI artifact")
+	return &kargoapi.OCIArtifact{
+		RepoURL: sub.RepoURL,
+		Tag:     tag,
+		Digest:  digest.String(),
+	}, nil
+}
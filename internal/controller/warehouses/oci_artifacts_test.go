@@ -0,0 +1,172 @@
+package warehouses
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/image"
+)
+
+func TestSelectOCIArtifacts(t *testing.T) {
+	const testRepoURL = "fake-registry/fake-artifact"
+	testDigest := digest.FromString("fake-manifest")
+
+	noCredsDB := &credentials.FakeDB{
+		GetFn: func(
+			context.Context,
+			string,
+			credentials.Type,
+			string,
+		) (credentials.Credentials, bool, error) {
+			return credentials.Credentials{}, false, nil
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		sub        kargoapi.OCIArtifactSubscription
+		reconciler *reconciler
+		assertions func(*testing.T, []kargoapi.OCIArtifact, error)
+	}{
+		{
+			name: "error getting credentials",
+			sub: kargoapi.OCIArtifactSubscription{
+				RepoURL: testRepoURL,
+			},
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{
+					GetFn: func(
+						context.Context,
+						string,
+						credentials.Type,
+						string,
+					) (credentials.Credentials, bool, error) {
+						return credentials.Credentials{}, false, errors.New("something went wrong")
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ []kargoapi.OCIArtifact, err error) {
+				require.ErrorContains(t, err, "error obtaining credentials")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "error resolving artifact",
+			sub: kargoapi.OCIArtifactSubscription{
+				RepoURL: testRepoURL,
+			},
+			reconciler: &reconciler{
+				credentialsDB: noCredsDB,
+				resolveArtifactDigestFn: func(
+					context.Context,
+					string,
+					string,
+					string,
+					*image.ArtifactOptions,
+				) (digest.Digest, error) {
+					return "", errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ []kargoapi.OCIArtifact, err error) {
+				require.ErrorContains(t, err, "error resolving OCI artifact")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "success with default tag",
+			sub: kargoapi.OCIArtifactSubscription{
+				RepoURL: testRepoURL,
+			},
+			reconciler: &reconciler{
+				credentialsDB: noCredsDB,
+				resolveArtifactDigestFn: func(
+					context.Context,
+					string,
+					string,
+					string,
+					*image.ArtifactOptions,
+				) (digest.Digest, error) {
+					return testDigest, nil
+				},
+			},
+			assertions: func(t *testing.T, artifacts []kargoapi.OCIArtifact, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]kargoapi.OCIArtifact{{
+						RepoURL: testRepoURL,
+						Tag:     image.DefaultArtifactTag,
+						Digest:  testDigest.String(),
+					}},
+					artifacts,
+				)
+			},
+		},
+		{
+			name: "success with pinned digest",
+			sub: kargoapi.OCIArtifactSubscription{
+				RepoURL: testRepoURL,
+				Tag:     "fake-tag",
+				Digest:  testDigest.String(),
+			},
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{
+					GetFn: func(
+						context.Context,
+						string,
+						credentials.Type,
+						string,
+					) (credentials.Credentials, bool, error) {
+						return credentials.Credentials{
+							Username: "fake-username",
+							Password: "fake-password",
+						}, true, nil
+					},
+				},
+				resolveArtifactDigestFn: func(
+					_ context.Context,
+					_ string,
+					_ string,
+					d string,
+					opts *image.ArtifactOptions,
+				) (digest.Digest, error) {
+					if opts.Creds == nil || opts.Creds.Username != "fake-username" {
+						return "", errors.New("expected credentials")
+					}
+					return digest.Digest(d), nil
+				},
+			},
+			assertions: func(t *testing.T, artifacts []kargoapi.OCIArtifact, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]kargoapi.OCIArtifact{{
+						RepoURL: testRepoURL,
+						Digest:  testDigest.String(),
+					}},
+					artifacts,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			artifacts, err := testCase.reconciler.selectOCIArtifacts(
+				context.Background(),
+				"fake-namespace",
+				[]kargoapi.RepoSubscription{
+					{
+						OCIArtifact: &testCase.sub,
+					},
+				},
+			)
+			testCase.assertions(t, artifacts, err)
+		})
+	}
+}
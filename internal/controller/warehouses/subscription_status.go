@@ -0,0 +1,138 @@
+package warehouses
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// subscriptionID identifies one of a Warehouse's subscriptions independently
+// of its position in the Warehouse's list of subscriptions.
+type subscriptionID struct {
+	kind    string
+	repoURL string
+	name    string
+}
+
+// getSubscriptionID returns the subscriptionID of the provided subscription.
+func getSubscriptionID(sub kargoapi.RepoSubscription) subscriptionID {
+	switch {
+	case sub.Git != nil:
+		return subscriptionID{kind: "git", repoURL: sub.Git.RepoURL}
+	case sub.Image != nil:
+		return subscriptionID{kind: "image", repoURL: sub.Image.RepoURL}
+	case sub.Chart != nil:
+		return subscriptionID{
+			kind:    "chart",
+			repoURL: sub.Chart.RepoURL,
+			name:    sub.Chart.Name,
+		}
+	case sub.OCIArtifact != nil:
+		return subscriptionID{kind: "ociArtifact", repoURL: sub.OCIArtifact.RepoURL}
+	case sub.HTTPArtifact != nil:
+		return subscriptionID{kind: "httpArtifact", repoURL: sub.HTTPArtifact.URL}
+	}
+	return subscriptionID{}
+}
+
+// subscriptionPoll is the outcome of polling a single subscription.
+type subscriptionPoll struct {
+	value string
+	err   error
+}
+
+// subscriptionPolls collects the outcomes of polling a Warehouse's
+// subscriptions. It is safe for concurrent use.
+type subscriptionPolls struct {
+	mu    sync.Mutex
+	polls map[subscriptionID]subscriptionPoll
+}
+
+// subscriptionPollsKey is the key under which the subscriptionPolls that
+// outcomes are recorded to are stored in a context.
+type subscriptionPollsKey struct{}
+
+// contextWithSubscriptionPolls returns a copy of the provided context that
+// carries the provided subscriptionPolls.
+func contextWithSubscriptionPolls(
+	ctx context.Context,
+	polls *subscriptionPolls,
+) context.Context {
+	return context.WithValue(ctx, subscriptionPollsKey{}, polls)
+}
+
+// recordSubscriptionPoll records the outcome of polling the provided
+// subscription to the subscriptionPolls carried by the provided context. If
+// the context carries none, this is a no-op.
+func recordSubscriptionPoll(
+	ctx context.Context,
+	sub kargoapi.RepoSubscription,
+	value string,
+	err error,
+) {
+	polls, ok := ctx.Value(subscriptionPollsKey{}).(*subscriptionPolls)
+	if !ok {
+		return
+	}
+	polls.mu.Lock()
+	defer polls.mu.Unlock()
+	if polls.polls == nil {
+		polls.polls = map[subscriptionID]subscriptionPoll{}
+	}
+	polls.polls[getSubscriptionID(sub)] = subscriptionPoll{value: value, err: err}
+}
+
+// updateSubscriptionStatuses returns the status of each of the provided
+// subscriptions, in order, after applying the provided poll outcomes to the
+// provided previous statuses. Polled subscriptions are stamped with the time
+// returned by nowFn. The status of a subscription that was not polled is
+// carried over unchanged, as is the last resolved value of a subscription
+// whose poll failed.
+func updateSubscriptionStatuses(
+	prev []kargoapi.SubscriptionStatus,
+	subs []kargoapi.RepoSubscription,
+	polls *subscriptionPolls,
+	nowFn func() time.Time,
+) []kargoapi.SubscriptionStatus {
+	if len(subs) == 0 {
+		return nil
+	}
+	pollTime := metav1.NewTime(nowFn())
+	prevByID := make(map[subscriptionID]kargoapi.SubscriptionStatus, len(prev))
+	for _, status := range prev {
+		prevByID[subscriptionID{
+			kind:    status.Kind,
+			repoURL: status.RepoURL,
+			name:    status.Name,
+		}] = status
+	}
+	polls.mu.Lock()
+	defer polls.mu.Unlock()
+	statuses := make([]kargoapi.SubscriptionStatus, len(subs))
+	for i, sub := range subs {
+		id := getSubscriptionID(sub)
+		status, ok := prevByID[id]
+		if !ok {
+			status = kargoapi.SubscriptionStatus{
+				Kind:    id.kind,
+				RepoURL: id.repoURL,
+				Name:    id.name,
+			}
+		}
+		if poll, polled := polls.polls[id]; polled {
+			status.LastPollTime = pollTime.DeepCopy()
+			if poll.err != nil {
+				status.LastError = poll.err.Error()
+			} else {
+				status.LastError = ""
+				status.LastResolvedValue = poll.value
+			}
+		}
+		statuses[i] = status
+	}
+	return statuses
+}
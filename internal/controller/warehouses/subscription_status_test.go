@@ -0,0 +1,250 @@
+package warehouses
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestRecordSubscriptionPoll(t *testing.T) {
+	sub := kargoapi.RepoSubscription{
+		Chart: &kargoapi.ChartSubscription{
+			RepoURL: "https://fake-url",
+			Name:    "fake-chart",
+		},
+	}
+	// Without subscriptionPolls in the context, this is a no-op
+	recordSubscriptionPoll(context.Background(), sub, "1.0.0", nil)
+
+	polls := &subscriptionPolls{}
+	ctx := contextWithSubscriptionPolls(context.Background(), polls)
+	recordSubscriptionPoll(ctx, sub, "1.0.0", nil)
+	require.Equal(
+		t,
+		map[subscriptionID]subscriptionPoll{
+			{kind: "chart", repoURL: "https://fake-url", name: "fake-chart"}: {
+				value: "1.0.0",
+			},
+		},
+		polls.polls,
+	)
+}
+
+func TestUpdateSubscriptionStatuses(t *testing.T) {
+	prevTime := metav1.NewTime(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC))
+	nowTime := time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)
+	nowFn := func() time.Time { return nowTime }
+
+	gitSub := kargoapi.RepoSubscription{
+		Git: &kargoapi.GitSubscription{RepoURL: "https://fake-git-url"},
+	}
+	imageSub := kargoapi.RepoSubscription{
+		Image: &kargoapi.ImageSubscription{RepoURL: "fake-image-url"},
+	}
+	httpSub := kargoapi.RepoSubscription{
+		HTTPArtifact: &kargoapi.HTTPArtifactSubscription{URL: "https://fake-http-url"},
+	}
+
+	prev := []kargoapi.SubscriptionStatus{
+		{
+			Kind:              "git",
+			RepoURL:           "https://fake-git-url",
+			LastPollTime:      &prevTime,
+			LastResolvedValue: "fake-commit",
+		},
+		{
+			Kind:              "image",
+			RepoURL:           "fake-image-url",
+			LastPollTime:      &prevTime,
+			LastError:         "something went wrong",
+			LastResolvedValue: "v1.0.0",
+		},
+		{
+			// No longer subscribed to
+			Kind:              "ociArtifact",
+			RepoURL:           "fake-oci-url",
+			LastPollTime:      &prevTime,
+			LastResolvedValue: "fake-digest",
+		},
+	}
+
+	polls := &subscriptionPolls{}
+	ctx := contextWithSubscriptionPolls(context.Background(), polls)
+	recordSubscriptionPoll(ctx, gitSub, "", errors.New("something else went wrong"))
+	recordSubscriptionPoll(ctx, imageSub, "v1.1.0", nil)
+
+	statuses := updateSubscriptionStatuses(
+		prev,
+		[]kargoapi.RepoSubscription{httpSub, gitSub, imageSub},
+		polls,
+		nowFn,
+	)
+	require.Equal(
+		t,
+		[]kargoapi.SubscriptionStatus{
+			{
+				// Never polled
+				Kind:    "httpArtifact",
+				RepoURL: "https://fake-http-url",
+			},
+			{
+				// The error is recorded, but the last resolved value is retained
+				Kind:              "git",
+				RepoURL:           "https://fake-git-url",
+				LastPollTime:      &metav1.Time{Time: nowTime},
+				LastError:         "something else went wrong",
+				LastResolvedValue: "fake-commit",
+			},
+			{
+				// The previous error is cleared
+				Kind:              "image",
+				RepoURL:           "fake-image-url",
+				LastPollTime:      &metav1.Time{Time: nowTime},
+				LastResolvedValue: "v1.1.0",
+			},
+		},
+		statuses,
+	)
+
+	// Without any subscriptions, there is nothing to report
+	require.Nil(t, updateSubscriptionStatuses(prev, nil, polls, nowFn))
+}
+
+func TestSyncWarehouseRecordsSubscriptionStatuses(t *testing.T) {
+	nowTime := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	warehouse := &kargoapi.Warehouse{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-warehouse",
+		},
+		Spec: kargoapi.WarehouseSpec{
+			Subscriptions: []kargoapi.RepoSubscription{
+				{
+					HTTPArtifact: &kargoapi.HTTPArtifactSubscription{
+						URL: "https://fake-url/a",
+					},
+				},
+				{
+					HTTPArtifact: &kargoapi.HTTPArtifactSubscription{
+						URL: "https://fake-url/b",
+					},
+				},
+			},
+		},
+	}
+	var failing bool
+	r := &reconciler{
+		nowFn: func() time.Time { return nowTime },
+		selectCommitsFn: func(
+			context.Context,
+			string,
+			[]kargoapi.RepoSubscription,
+			*kargoapi.FreightReference,
+		) ([]kargoapi.GitCommit, error) {
+			return nil, nil
+		},
+		selectImagesFn: func(
+			context.Context,
+			string,
+			[]kargoapi.RepoSubscription,
+		) ([]kargoapi.Image, error) {
+			return nil, nil
+		},
+		selectChartsFn: func(
+			context.Context,
+			string,
+			[]kargoapi.RepoSubscription,
+			*kargoapi.FreightReference,
+		) ([]kargoapi.Chart, error) {
+			return nil, nil
+		},
+		selectOCIArtifactsFn: func(
+			context.Context,
+			string,
+			[]kargoapi.RepoSubscription,
+		) ([]kargoapi.OCIArtifact, error) {
+			return nil, nil
+		},
+		getHTTPArtifactVersionFn: func(
+			_ context.Context,
+			sub kargoapi.HTTPArtifactSubscription,
+		) (string, error) {
+			if failing && sub.URL == "https://fake-url/b" {
+				return "", errors.New("something went wrong")
+			}
+			return "v1.0.0", nil
+		},
+		getFreightFn: func(
+			context.Context,
+			client.Client,
+			types.NamespacedName,
+		) (*kargoapi.Freight, error) {
+			return &kargoapi.Freight{}, nil
+		},
+		createFreightFn: func(
+			context.Context,
+			client.Object,
+			...client.CreateOption,
+		) error {
+			return nil
+		},
+	}
+	r.selectHTTPArtifactsFn = r.selectHTTPArtifacts
+	r.getLatestFreightFromReposFn = r.getLatestFreightFromRepos
+
+	// Success is recorded for every subscription
+	status, err := r.syncWarehouse(context.Background(), warehouse)
+	require.NoError(t, err)
+	require.NotNil(t, status.LastFreight)
+	require.Len(t, status.Subscriptions, 2)
+	for _, subStatus := range status.Subscriptions {
+		require.Equal(t, "httpArtifact", subStatus.Kind)
+		require.Equal(t, nowTime, subStatus.LastPollTime.Time)
+		require.Empty(t, subStatus.LastError)
+		require.Equal(t, "v1.0.0", subStatus.LastResolvedValue)
+	}
+	warehouse.Status = status
+
+	// Polls are recorded even when no new Freight is produced
+	nowTime = nowTime.Add(time.Hour)
+	status, err = r.syncWarehouse(context.Background(), warehouse)
+	require.NoError(t, err)
+	for _, subStatus := range status.Subscriptions {
+		require.Equal(t, nowTime, subStatus.LastPollTime.Time)
+	}
+	warehouse.Status = status
+
+	// An error is recorded only for the subscription that could not be polled
+	nowTime = nowTime.Add(time.Hour)
+	failing = true
+	status, err = r.syncWarehouse(context.Background(), warehouse)
+	require.ErrorContains(t, err, "something went wrong")
+	require.Equal(
+		t,
+		[]kargoapi.SubscriptionStatus{
+			{
+				Kind:              "httpArtifact",
+				RepoURL:           "https://fake-url/a",
+				LastPollTime:      &metav1.Time{Time: nowTime},
+				LastResolvedValue: "v1.0.0",
+			},
+			{
+				Kind:              "httpArtifact",
+				RepoURL:           "https://fake-url/b",
+				LastPollTime:      &metav1.Time{Time: nowTime},
+				LastError:         status.Subscriptions[1].LastError,
+				LastResolvedValue: "v1.0.0",
+			},
+		},
+		status.Subscriptions,
+	)
+	require.Contains(t, status.Subscriptions[1].LastError, "something went wrong")
+}
@@ -2,12 +2,20 @@ package warehouses
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
+	"net"
 	"time"
 
+	"github.com/kelseyhightower/envconfig"
+	"github.com/opencontainers/go-digest"
 	log "github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -19,20 +27,54 @@ import (
 	"github.com/akuity/kargo/internal/controller/git"
 	"github.com/akuity/kargo/internal/credentials"
 	"github.com/akuity/kargo/internal/helm"
+	libHTTP "github.com/akuity/kargo/internal/http"
 	"github.com/akuity/kargo/internal/image"
 	"github.com/akuity/kargo/internal/kargo"
 	"github.com/akuity/kargo/internal/kubeclient"
 	"github.com/akuity/kargo/internal/logging"
+	"github.com/akuity/kargo/internal/repocache"
 )
 
+// ReconcilerConfig represents configuration for the Warehouse reconciler.
+type ReconcilerConfig struct {
+	ShardName string `envconfig:"SHARD_NAME"`
+	// RepoListingCacheTTL is how long listings of image tags and chart
+	// versions retrieved from repositories are reused by all subscriptions to
+	// the same repository, using the same credentials, before being retrieved
+	// again. Zero disables caching.
+	RepoListingCacheTTL time.Duration `envconfig:"REPO_LISTING_CACHE_TTL" default:"0s"`
+	// HTTPArtifactAllowedNetworks lists the networks, in CIDR notation, from
+	// which HTTP artifacts may be fetched even though they are not publicly
+	// routable. By default, loopback, link-local, and private addresses are
+	// off limits.
+	HTTPArtifactAllowedNetworks libHTTP.NetworkList `envconfig:"HTTP_ARTIFACT_ALLOWED_NETWORKS"`
+}
+
+func ReconcilerConfigFromEnv() ReconcilerConfig {
+	var cfg ReconcilerConfig
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
 // reconciler reconciles Warehouse resources.
 type reconciler struct {
 	client                     client.Client
 	credentialsDB              credentials.Database
 	imageSourceURLFnsByBaseURL map[string]func(string, string) string
 
+	// listingCache caches tag and version listings retrieved from
+	// repositories across reconciliations. It is nil when caching is
+	// disabled.
+	listingCache *repocache.Cache
+
+	// httpArtifactAllowedNetworks are the non-public networks from which HTTP
+	// artifacts may nevertheless be fetched.
+	httpArtifactAllowedNetworks []*net.IPNet
+
 	// The following behaviors are overridable for testing purposes:
 
+	nowFn func() time.Time
+
 	getLatestFreightFromReposFn func(
 		context.Context,
 		*kargoapi.Warehouse,
@@ -59,16 +101,45 @@ type reconciler struct {
 		subs []kargoapi.RepoSubscription,
 	) ([]kargoapi.Image, error)
 
+	getDigestAllowlistFn func(
+		ctx context.Context,
+		namespace string,
+		allowlist kargoapi.DigestAllowlist,
+	) ([]string, error)
+
+	getSignatureVerificationKeyFn func(
+		ctx context.Context,
+		namespace string,
+		verification kargoapi.ImageSignatureVerification,
+	) (string, error)
+
+	getCABundleFn func(
+		ctx context.Context,
+		namespace string,
+		bundle kargoapi.CABundle,
+	) (string, error)
+
 	getImageRefsFn func(
 		context.Context,
 		kargoapi.ImageSubscription,
 		*image.Credentials,
+		[]string,
+		string,
+		string,
+		*repocache.Cache,
 	) (string, string, error)
 
+	discoverImageReposFn func(
+		context.Context,
+		string,
+		*image.DiscoveryOptions,
+	) ([]string, error)
+
 	selectChartsFn func(
 		ctx context.Context,
 		namespace string,
 		subs []kargoapi.RepoSubscription,
+		lastFreight *kargoapi.FreightReference,
 	) ([]kargoapi.Chart, error)
 
 	selectChartVersionFn func(
@@ -76,7 +147,37 @@ type reconciler struct {
 		repoURL string,
 		chart string,
 		semverConstraint string,
+		allowPrereleases bool,
+		mode helm.SelectionMode,
+		filter func(version string) bool,
 		creds *helm.Credentials,
+		insecureSkipTLSVerify bool,
+		caBundle string,
+		cache *repocache.Cache,
+	) (string, error)
+
+	selectOCIArtifactsFn func(
+		ctx context.Context,
+		namespace string,
+		subs []kargoapi.RepoSubscription,
+	) ([]kargoapi.OCIArtifact, error)
+
+	resolveArtifactDigestFn func(
+		ctx context.Context,
+		repoURL string,
+		tag string,
+		digest string,
+		opts *image.ArtifactOptions,
+	) (digest.Digest, error)
+
+	selectHTTPArtifactsFn func(
+		ctx context.Context,
+		subs []kargoapi.RepoSubscription,
+	) ([]kargoapi.HTTPArtifact, error)
+
+	getHTTPArtifactVersionFn func(
+		context.Context,
+		kargoapi.HTTPArtifactSubscription,
 	) (string, error)
 
 	selectCommitMetaFn func(
@@ -86,6 +187,12 @@ type reconciler struct {
 		string,
 	) (*gitMeta, error)
 
+	getFreightFn func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Freight, error)
+
 	createFreightFn func(
 		context.Context,
 		client.Object,
@@ -98,10 +205,10 @@ type reconciler struct {
 func SetupReconcilerWithManager(
 	mgr manager.Manager,
 	credentialsDB credentials.Database,
-	shardName string,
+	cfg ReconcilerConfig,
 ) error {
 
-	shardPredicate, err := controller.GetShardPredicate(shardName)
+	shardPredicate, err := controller.GetShardPredicate(cfg.ShardName)
 	if err != nil {
 		return fmt.Errorf("error creating shard selector predicate: %w", err)
 	}
@@ -124,7 +231,7 @@ func SetupReconcilerWithManager(
 		).
 		WithEventFilter(shardPredicate).
 		WithOptions(controller.CommonOptions()).
-		Complete(newReconciler(mgr.GetClient(), credentialsDB)); err != nil {
+		Complete(newReconciler(mgr.GetClient(), credentialsDB, cfg)); err != nil {
 		return fmt.Errorf("error building Warehouse reconciler: %w", err)
 	}
 	return nil
@@ -133,14 +240,18 @@ func SetupReconcilerWithManager(
 func newReconciler(
 	kubeClient client.Client,
 	credentialsDB credentials.Database,
+	cfg ReconcilerConfig,
 ) *reconciler {
 	r := &reconciler{
-		client:        kubeClient,
-		credentialsDB: credentialsDB,
+		client:                      kubeClient,
+		credentialsDB:               credentialsDB,
+		listingCache:                repocache.New(cfg.RepoListingCacheTTL),
+		httpArtifactAllowedNetworks: cfg.HTTPArtifactAllowedNetworks,
 		imageSourceURLFnsByBaseURL: map[string]func(string, string) string{
 			githubURLPrefix: getGithubImageSourceURL,
 		},
 	}
+	r.nowFn = time.Now
 	r.getLatestFreightFromReposFn = r.getLatestFreightFromRepos
 	r.selectCommitsFn = r.selectCommits
 	r.getLastCommitIDFn = r.getLastCommitID
@@ -148,10 +259,19 @@ func newReconciler(
 	r.listTagsFn = r.listTags
 	r.checkoutTagFn = r.checkoutTag
 	r.selectImagesFn = r.selectImages
+	r.getDigestAllowlistFn = r.getDigestAllowlist
+	r.getSignatureVerificationKeyFn = r.getSignatureVerificationKey
+	r.getCABundleFn = r.getCABundle
 	r.getImageRefsFn = getImageRefs
+	r.discoverImageReposFn = image.DiscoverRepositories
 	r.selectChartsFn = r.selectCharts
 	r.selectChartVersionFn = helm.SelectChartVersion
+	r.selectOCIArtifactsFn = r.selectOCIArtifacts
+	r.resolveArtifactDigestFn = image.ResolveArtifactDigest
+	r.selectHTTPArtifactsFn = r.selectHTTPArtifacts
+	r.getHTTPArtifactVersionFn = r.getHTTPArtifactVersion
 	r.selectCommitMetaFn = r.selectCommitMeta
+	r.getFreightFn = kargoapi.GetFreight
 	r.createFreightFn = kubeClient.Create
 	return r
 }
@@ -215,9 +335,71 @@ func (r *reconciler) Reconcile(
 	}
 
 	// Everything succeeded, look for new changes on the defined interval.
-	//
-	// TODO: Make this configurable
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	return ctrl.Result{RequeueAfter: pollingInterval(warehouse)}, nil
+}
+
+// defaultPollingInterval is how often a Warehouse's subscriptions are checked
+// for new artifacts when the Warehouse does not specify an interval of its own.
+const defaultPollingInterval = 5 * time.Minute
+
+// minPollingInterval is the shortest interval at which a Warehouse's
+// subscriptions are checked for new artifacts, whatever the Warehouse itself
+// specifies. The webhook rejects shorter intervals, but Warehouses admitted
+// before it did may still specify them.
+const minPollingInterval = 30 * time.Second
+
+// pollingInterval returns how often the subscriptions of the provided
+// Warehouse should be checked for new artifacts.
+func pollingInterval(warehouse *kargoapi.Warehouse) time.Duration {
+	if interval := warehouse.Spec.PollingInterval; interval != nil && interval.Duration > 0 {
+		return max(interval.Duration, minPollingInterval)
+	}
+	return defaultPollingInterval
+}
+
+// defaultRegistryTimeout is how long any single request to an image registry
+// or chart repository may take when the Warehouse does not specify a timeout
+// of its own.
+const defaultRegistryTimeout = time.Minute
+
+// registryTimeout returns how long any single request to an image registry or
+// chart repository made on behalf of the provided Warehouse may take.
+func registryTimeout(warehouse *kargoapi.Warehouse) time.Duration {
+	if timeout := warehouse.Spec.RegistryTimeout; timeout != nil && timeout.Duration > 0 {
+		return timeout.Duration
+	}
+	return defaultRegistryTimeout
+}
+
+// registryTimeoutKey is the key under which the timeout applying to requests
+// to image registries and chart repositories is stored in a context.
+type registryTimeoutKey struct{}
+
+// contextWithRegistryTimeout returns a copy of the provided context that
+// carries the provided registry request timeout.
+func contextWithRegistryTimeout(
+	ctx context.Context,
+	timeout time.Duration,
+) context.Context {
+	return context.WithValue(ctx, registryTimeoutKey{}, timeout)
+}
+
+// withRegistryTimeout returns a copy of the provided context that is canceled
+// once the registry request timeout carried by the provided context, or
+// defaultRegistryTimeout if it carries none, elapses.
+func withRegistryTimeout(
+	ctx context.Context,
+) (context.Context, context.CancelFunc) {
+	timeout, ok := ctx.Value(registryTimeoutKey{}).(time.Duration)
+	if !ok {
+		timeout = defaultRegistryTimeout
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// timedOut returns true if the provided context's deadline has passed.
+func timedOut(ctx context.Context) bool {
+	return errors.Is(ctx.Err(), context.DeadlineExceeded)
 }
 
 func (r *reconciler) syncWarehouse(
@@ -235,16 +417,76 @@ func (r *reconciler) syncWarehouse(
 
 	logger := logging.LoggerFromContext(ctx)
 
-	freight, err := r.getLatestFreightFromReposFn(ctx, warehouse)
+	// Whatever the outcome, record how polling each subscription went. This
+	// is how users can tell which subscription, if any, is the problem.
+	polls := &subscriptionPolls{}
+	freight, err := r.getLatestFreightFromReposFn(
+		contextWithSubscriptionPolls(ctx, polls),
+		warehouse,
+	)
+	status.Subscriptions = updateSubscriptionStatuses(
+		status.Subscriptions,
+		warehouse.Spec.Subscriptions,
+		polls,
+		r.nowFn,
+	)
 	if err != nil {
+		var downgradeErr *chartDowngradeError
+		if errors.As(err, &downgradeErr) {
+			// This is not a failure. We simply withhold Freight production until a
+			// suitable chart version is available again.
+			logger.Info(downgradeErr.Error())
+			meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+				Type:               kargoapi.WarehouseConditionTypeChartDowngradePrevented,
+				Status:             metav1.ConditionTrue,
+				Reason:             "NewestVersionNotGreater",
+				Message:            downgradeErr.Error(),
+				ObservedGeneration: warehouse.Generation,
+			})
+			return status, nil
+		}
 		return status, fmt.Errorf("error getting latest Freight from repositories: %w", err)
 	}
+	meta.RemoveStatusCondition(
+		&status.Conditions,
+		kargoapi.WarehouseConditionTypeChartDowngradePrevented,
+	)
 	if freight == nil {
 		logger.Debug("found no Freight from repositories")
 		return status, nil
 	}
 	logger.Debug("got latest Freight from repositories")
 
+	// Freight names are derived from their artifacts, so a name matching that
+	// of the last Freight this Warehouse produced means nothing has changed
+	// since. Unless that Freight has since been deleted, there is no need to
+	// even attempt creating it again.
+	if status.LastFreight != nil && status.LastFreight.Name == freight.Name {
+		existing, err := r.getFreightFn(
+			ctx,
+			r.client,
+			types.NamespacedName{
+				Namespace: freight.Namespace,
+				Name:      freight.Name,
+			},
+		)
+		if err != nil {
+			return status, err
+		}
+		if existing != nil {
+			logger.Debugf(
+				"Freight %q is identical to the last Freight produced; nothing to do",
+				freight.Name,
+			)
+			return status, nil
+		}
+		logger.Debugf(
+			"Freight %q is identical to the last Freight produced, but no longer "+
+				"exists; recreating it",
+			freight.Name,
+		)
+	}
+
 	if err = r.createFreightFn(ctx, freight); err != nil {
 		if apierrors.IsAlreadyExists(err) {
 			logger.Debugf(
@@ -252,6 +494,7 @@ func (r *reconciler) syncWarehouse(
 				freight.Name,
 				freight.Namespace,
 			)
+			logSelectedArtifacts(logger, freight, false)
 			return status, nil
 		}
 		return status, fmt.Errorf(
@@ -266,12 +509,16 @@ func (r *reconciler) syncWarehouse(
 		freight.Name,
 		freight.Namespace,
 	)
+	logSelectedArtifacts(logger, freight, true)
 	status.LastFreight = &kargoapi.FreightReference{
-		Name:    freight.Name,
-		Commits: freight.Commits,
-		Images:  freight.Images,
-		Charts:  freight.Charts,
+		Name:          freight.Name,
+		Commits:       freight.Commits,
+		Images:        freight.Images,
+		Charts:        freight.Charts,
+		OCIArtifacts:  freight.OCIArtifacts,
+		HTTPArtifacts: freight.HTTPArtifacts,
 	}
+	status.LastFreightTime = &metav1.Time{Time: r.nowFn()}
 
 	return status, nil
 }
@@ -281,6 +528,7 @@ func (r *reconciler) getLatestFreightFromRepos(
 	warehouse *kargoapi.Warehouse,
 ) (*kargoapi.Freight, error) {
 	logger := logging.LoggerFromContext(ctx)
+	ctx = contextWithRegistryTimeout(ctx, registryTimeout(warehouse))
 
 	selectedCommits, err := r.selectCommitsFn(
 		ctx,
@@ -307,21 +555,128 @@ func (r *reconciler) getLatestFreightFromRepos(
 		ctx,
 		warehouse.Namespace,
 		warehouse.Spec.Subscriptions,
+		warehouse.Status.LastFreight,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("error syncing chart repo subscriptions: %w", err)
 	}
 	logger.Debug("synced chart repo subscriptions")
 
+	selectedOCIArtifacts, err := r.selectOCIArtifactsFn(
+		ctx,
+		warehouse.Namespace,
+		warehouse.Spec.Subscriptions,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error syncing OCI artifact repo subscriptions: %w", err)
+	}
+	logger.Debug("synced OCI artifact repo subscriptions")
+
+	selectedHTTPArtifacts, err := r.selectHTTPArtifactsFn(
+		ctx,
+		warehouse.Spec.Subscriptions,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error syncing HTTP artifact subscriptions: %w", err)
+	}
+	logger.Debug("synced HTTP artifact subscriptions")
+
 	freight := &kargoapi.Freight{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: warehouse.Namespace,
 		},
-		Warehouse: warehouse.Name,
-		Commits:   selectedCommits,
-		Images:    selectedImages,
-		Charts:    selectedCharts,
+		Warehouse:     warehouse.Name,
+		Commits:       selectedCommits,
+		Images:        selectedImages,
+		Charts:        selectedCharts,
+		OCIArtifacts:  selectedOCIArtifacts,
+		HTTPArtifacts: selectedHTTPArtifacts,
+	}
+	if md := warehouse.Spec.FreightMetadata; md != nil {
+		freight.Labels = maps.Clone(md.Labels)
+		freight.Annotations = maps.Clone(md.Annotations)
+		if warehouse.Spec.TrackFreightMetadata {
+			freight.TrackedMetadata = md.DeepCopy()
+		}
 	}
 	freight.Name = freight.GenerateID()
 	return freight, nil
 }
+
+// logSelectedArtifacts emits a single structured log entry summarizing the
+// artifacts that were selected from each of a Warehouse's subscriptions, along
+// with the ID of the resulting Freight and whether it was newly created.
+func logSelectedArtifacts(
+	logger *log.Entry,
+	freight *kargoapi.Freight,
+	created bool,
+) {
+	commits := make([]string, len(freight.Commits))
+	for i, commit := range freight.Commits {
+		commits[i] = fmt.Sprintf("%s@%s", commit.RepoURL, commit.ID)
+		if commit.Tag != "" {
+			commits[i] = fmt.Sprintf("%s (%s)", commits[i], commit.Tag)
+		}
+	}
+	images := make([]string, len(freight.Images))
+	for i, image := range freight.Images {
+		images[i] = fmt.Sprintf("%s:%s", image.RepoURL, image.Tag)
+		if image.Digest != "" {
+			images[i] = fmt.Sprintf("%s@%s", images[i], image.Digest)
+		}
+	}
+	charts := make([]string, len(freight.Charts))
+	for i, chart := range freight.Charts {
+		charts[i] = fmt.Sprintf("%s/%s:%s", chart.RepoURL, chart.Name, chart.Version)
+	}
+	ociArtifacts := make([]string, len(freight.OCIArtifacts))
+	for i, artifact := range freight.OCIArtifacts {
+		ociArtifacts[i] = fmt.Sprintf("%s@%s", artifact.RepoURL, artifact.Digest)
+	}
+	httpArtifacts := make([]string, len(freight.HTTPArtifacts))
+	for i, artifact := range freight.HTTPArtifacts {
+		httpArtifacts[i] = fmt.Sprintf("%s (%s)", artifact.URL, artifact.Version)
+	}
+	logger.WithFields(log.Fields{
+		"freight":       freight.Name,
+		"created":       created,
+		"commits":       commits,
+		"images":        images,
+		"charts":        charts,
+		"ociArtifacts":  ociArtifacts,
+		"httpArtifacts": httpArtifacts,
+	}).Debug("selected artifacts from Warehouse subscriptions")
+}
+
+// maxConcurrentSubscriptionPolls is the maximum number of a Warehouse's
+// subscriptions that may be polled concurrently.
+const maxConcurrentSubscriptionPolls = 8
+
+// pollSubscriptions invokes pollFn for each of the provided subscriptions,
+// polling up to maxConcurrentSubscriptionPolls of them at once, and returns
+// all results in subscription order so that the outcome does not depend on
+// which poll happens to finish first. A failure to poll one subscription does
+// not prevent the others from being polled. Results from all successful polls
+// are returned along with the joined errors of all unsuccessful ones.
+func pollSubscriptions[T any](
+	ctx context.Context,
+	subs []kargoapi.RepoSubscription,
+	pollFn func(context.Context, kargoapi.RepoSubscription) ([]T, error),
+) ([]T, error) {
+	results := make([][]T, len(subs))
+	errs := make([]error, len(subs))
+	var g errgroup.Group
+	g.SetLimit(maxConcurrentSubscriptionPolls)
+	for i, sub := range subs {
+		g.Go(func() error {
+			results[i], errs[i] = pollFn(ctx, sub)
+			return nil
+		})
+	}
+	_ = g.Wait()
+	all := make([]T, 0, len(subs))
+	for _, res := range results {
+		all = append(all, res...)
+	}
+	return all, errors.Join(errs...)
+}
@@ -3,13 +3,18 @@ package warehouses
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -22,21 +27,29 @@ func TestNewReconciler(t *testing.T) {
 	e := newReconciler(
 		kubeClient,
 		&credentials.FakeDB{},
+		ReconcilerConfig{RepoListingCacheTTL: time.Minute},
 	)
 	require.NotNil(t, e.client)
+	require.NotNil(t, e.listingCache)
 	require.NotNil(t, e.credentialsDB)
 	require.NotEmpty(t, e.imageSourceURLFnsByBaseURL)
 
 	// Assert that all overridable behaviors were initialized to a default:
+	require.NotNil(t, e.nowFn)
 	require.NotNil(t, e.getLatestFreightFromReposFn)
 	require.NotNil(t, e.selectCommitsFn)
 	require.NotNil(t, e.getLastCommitIDFn)
 	require.NotNil(t, e.listTagsFn)
 	require.NotNil(t, e.checkoutTagFn)
 	require.NotNil(t, e.selectImagesFn)
+	require.NotNil(t, e.getDigestAllowlistFn)
+	require.NotNil(t, e.getSignatureVerificationKeyFn)
+	require.NotNil(t, e.getCABundleFn)
 	require.NotNil(t, e.getImageRefsFn)
 	require.NotNil(t, e.selectChartsFn)
 	require.NotNil(t, e.selectChartVersionFn)
+	require.NotNil(t, e.selectOCIArtifactsFn)
+	require.NotNil(t, e.resolveArtifactDigestFn)
 	require.NotNil(t, e.selectCommitMetaFn)
 	require.NotNil(t, e.createFreightFn)
 	require.NotNil(t, e.getDiffPathsSinceCommitIDFn)
@@ -49,7 +62,7 @@ func TestSyncWarehouse(t *testing.T) {
 	testCases := []struct {
 		name       string
 		reconciler *reconciler
-		assertions func(*testing.T, error)
+		assertions func(*testing.T, kargoapi.WarehouseStatus, error)
 	}{
 		{
 			name: "error getting latest Freight from repos",
@@ -61,12 +74,46 @@ func TestSyncWarehouse(t *testing.T) {
 					return nil, errors.New("something went wrong")
 				},
 			},
-			assertions: func(t *testing.T, err error) {
+			assertions: func(t *testing.T, _ kargoapi.WarehouseStatus, err error) {
 				require.ErrorContains(t, err, "something went wrong")
 				require.ErrorContains(t, err, "error getting latest Freight from repos")
 			},
 		},
 
+		{
+			name: "chart downgrade prevented",
+			reconciler: &reconciler{
+				getLatestFreightFromReposFn: func(
+					context.Context,
+					*kargoapi.Warehouse,
+				) (*kargoapi.Freight, error) {
+					return nil, fmt.Errorf(
+						"error syncing chart repo subscriptions: %w",
+						&chartDowngradeError{
+							repoURL:        "fake-url",
+							name:           "fake-chart",
+							newestVersion:  "1.0.0",
+							currentVersion: "1.1.0",
+						},
+					)
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				status kargoapi.WarehouseStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+				cond := meta.FindStatusCondition(
+					status.Conditions,
+					kargoapi.WarehouseConditionTypeChartDowngradePrevented,
+				)
+				require.NotNil(t, cond)
+				require.Equal(t, metav1.ConditionTrue, cond.Status)
+				require.Contains(t, cond.Message, "is not greater than current version")
+			},
+		},
+
 		{
 			name: "no latest Freight from repos",
 			reconciler: &reconciler{
@@ -77,7 +124,7 @@ func TestSyncWarehouse(t *testing.T) {
 					return nil, nil
 				},
 			},
-			assertions: func(t *testing.T, err error) {
+			assertions: func(t *testing.T, _ kargoapi.WarehouseStatus, err error) {
 				require.NoError(t, err)
 			},
 		},
@@ -105,7 +152,7 @@ func TestSyncWarehouse(t *testing.T) {
 					)
 				},
 			},
-			assertions: func(t *testing.T, err error) {
+			assertions: func(t *testing.T, _ kargoapi.WarehouseStatus, err error) {
 				require.NoError(t, err)
 			},
 		},
@@ -127,7 +174,7 @@ func TestSyncWarehouse(t *testing.T) {
 					return errors.New("something went wrong")
 				},
 			},
-			assertions: func(t *testing.T, err error) {
+			assertions: func(t *testing.T, _ kargoapi.WarehouseStatus, err error) {
 				require.ErrorContains(t, err, "something went wrong")
 				require.ErrorContains(t, err, "error creating Freight")
 			},
@@ -136,6 +183,9 @@ func TestSyncWarehouse(t *testing.T) {
 		{
 			name: "success creating Freight",
 			reconciler: &reconciler{
+				nowFn: func() time.Time {
+					return time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+				},
 				getLatestFreightFromReposFn: func(
 					context.Context,
 					*kargoapi.Warehouse,
@@ -155,24 +205,148 @@ func TestSyncWarehouse(t *testing.T) {
 					return nil
 				},
 			},
-			assertions: func(t *testing.T, err error) {
+			assertions: func(t *testing.T, status kargoapi.WarehouseStatus, err error) {
 				require.NoError(t, err)
+				require.NotNil(t, status.LastFreight)
+				require.Equal(t, "fake-freight", status.LastFreight.Name)
+				require.NotNil(t, status.LastFreightTime)
+				require.Equal(
+					t,
+					time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+					status.LastFreightTime.Time,
+				)
 			},
 		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			_, err := testCase.reconciler.syncWarehouse(context.Background(), testWarehouse)
-			testCase.assertions(t, err)
+			status, err := testCase.reconciler.syncWarehouse(
+				context.Background(),
+				testWarehouse,
+			)
+			testCase.assertions(t, status, err)
 		})
 	}
 }
 
+func TestSyncWarehouseDeduplicatesFreight(t *testing.T) {
+	var created []string
+	r := &reconciler{
+		nowFn: time.Now,
+		getLatestFreightFromReposFn: func(
+			_ context.Context,
+			warehouse *kargoapi.Warehouse,
+		) (*kargoapi.Freight, error) {
+			// Every discovery finds the same artifacts
+			freight := &kargoapi.Freight{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: warehouse.Namespace,
+				},
+				Images: []kargoapi.Image{
+					{
+						RepoURL: "fake-url",
+						Tag:     "fake-tag",
+						Digest:  "fake-digest",
+					},
+				},
+			}
+			freight.Name = freight.GenerateID()
+			return freight, nil
+		},
+		createFreightFn: func(
+			_ context.Context,
+			obj client.Object,
+			_ ...client.CreateOption,
+		) error {
+			created = append(created, obj.GetName())
+			return nil
+		},
+		getFreightFn: func(
+			context.Context,
+			client.Client,
+			types.NamespacedName,
+		) (*kargoapi.Freight, error) {
+			return &kargoapi.Freight{}, nil
+		},
+	}
+	warehouse := &kargoapi.Warehouse{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-warehouse",
+			Namespace: "fake-namespace",
+		},
+	}
+
+	status, err := r.syncWarehouse(context.Background(), warehouse)
+	require.NoError(t, err)
+	require.NotNil(t, status.LastFreight)
+	lastFreightTime := status.LastFreightTime
+	warehouse.Status = status
+
+	status, err = r.syncWarehouse(context.Background(), warehouse)
+	require.NoError(t, err)
+	require.Len(t, created, 1)
+	require.Equal(t, created[0], status.LastFreight.Name)
+	// The second, identical discovery is not recorded as new Freight
+	require.Equal(t, lastFreightTime, status.LastFreightTime)
+}
+
+func TestSyncWarehouseRecreatesDeletedFreight(t *testing.T) {
+	var created []string
+	r := &reconciler{
+		nowFn: time.Now,
+		getLatestFreightFromReposFn: func(
+			_ context.Context,
+			warehouse *kargoapi.Warehouse,
+		) (*kargoapi.Freight, error) {
+			return &kargoapi.Freight{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: warehouse.Namespace,
+					Name:      "fake-freight",
+				},
+			}, nil
+		},
+		getFreightFn: func(
+			context.Context,
+			client.Client,
+			types.NamespacedName,
+		) (*kargoapi.Freight, error) {
+			// The last Freight produced has been deleted
+			return nil, nil
+		},
+		createFreightFn: func(
+			_ context.Context,
+			obj client.Object,
+			_ ...client.CreateOption,
+		) error {
+			created = append(created, obj.GetName())
+			return nil
+		},
+	}
+	warehouse := &kargoapi.Warehouse{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-warehouse",
+			Namespace: "fake-namespace",
+		},
+		Status: kargoapi.WarehouseStatus{
+			LastFreight: &kargoapi.FreightReference{
+				Name: "fake-freight",
+			},
+		},
+	}
+
+	status, err := r.syncWarehouse(context.Background(), warehouse)
+	require.NoError(t, err)
+	require.Equal(t, []string{"fake-freight"}, created)
+	require.NotNil(t, status.LastFreight)
+	require.Equal(t, "fake-freight", status.LastFreight.Name)
+}
+
 func TestGetLatestFreightFromRepos(t *testing.T) {
 	const testWarehouseName = "fake-warehouse"
 
 	testCases := []struct {
 		name       string
+		spec       kargoapi.WarehouseSpec
 		reconciler *reconciler
 		assertions func(*testing.T, *kargoapi.Freight, error)
 	}{
@@ -241,6 +415,7 @@ func TestGetLatestFreightFromRepos(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.RepoSubscription,
+					*kargoapi.FreightReference,
 				) ([]kargoapi.Chart, error) {
 					return nil, errors.New("something went wrong")
 				},
@@ -251,6 +426,92 @@ func TestGetLatestFreightFromRepos(t *testing.T) {
 			},
 		},
 
+		{
+			name: "error getting latest OCI artifacts",
+			reconciler: &reconciler{
+				selectCommitsFn: func(
+					context.Context,
+					string,
+					[]kargoapi.RepoSubscription,
+					*kargoapi.FreightReference,
+				) ([]kargoapi.GitCommit, error) {
+					return nil, nil
+				},
+				selectImagesFn: func(
+					context.Context,
+					string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.Image, error) {
+					return nil, nil
+				},
+				selectChartsFn: func(
+					context.Context,
+					string,
+					[]kargoapi.RepoSubscription,
+					*kargoapi.FreightReference,
+				) ([]kargoapi.Chart, error) {
+					return nil, nil
+				},
+				selectOCIArtifactsFn: func(
+					context.Context,
+					string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.OCIArtifact, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.Freight, err error) {
+				require.ErrorContains(t, err, "error syncing OCI artifact repo subscriptions")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+
+		{
+			name: "error getting latest HTTP artifacts",
+			reconciler: &reconciler{
+				selectCommitsFn: func(
+					context.Context,
+					string,
+					[]kargoapi.RepoSubscription,
+					*kargoapi.FreightReference,
+				) ([]kargoapi.GitCommit, error) {
+					return nil, nil
+				},
+				selectImagesFn: func(
+					context.Context,
+					string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.Image, error) {
+					return nil, nil
+				},
+				selectChartsFn: func(
+					context.Context,
+					string,
+					[]kargoapi.RepoSubscription,
+					*kargoapi.FreightReference,
+				) ([]kargoapi.Chart, error) {
+					return nil, nil
+				},
+				selectOCIArtifactsFn: func(
+					context.Context,
+					string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.OCIArtifact, error) {
+					return nil, nil
+				},
+				selectHTTPArtifactsFn: func(
+					context.Context,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.HTTPArtifact, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.Freight, err error) {
+				require.ErrorContains(t, err, "error syncing HTTP artifact subscriptions")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+
 		{
 			name: "success",
 			reconciler: &reconciler{
@@ -283,6 +544,7 @@ func TestGetLatestFreightFromRepos(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.RepoSubscription,
+					*kargoapi.FreightReference,
 				) ([]kargoapi.Chart, error) {
 					return []kargoapi.Chart{
 						{
@@ -292,6 +554,30 @@ func TestGetLatestFreightFromRepos(t *testing.T) {
 						},
 					}, nil
 				},
+				selectOCIArtifactsFn: func(
+					context.Context,
+					string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.OCIArtifact, error) {
+					return []kargoapi.OCIArtifact{
+						{
+							RepoURL: "fake-artifact-repo",
+							Tag:     "fake-tag",
+							Digest:  "fake-digest",
+						},
+					}, nil
+				},
+				selectHTTPArtifactsFn: func(
+					context.Context,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.HTTPArtifact, error) {
+					return []kargoapi.HTTPArtifact{
+						{
+							URL:     "https://example.com/release.json",
+							Version: "v1.2.3",
+						},
+					}, nil
+				},
 			},
 			assertions: func(t *testing.T, freight *kargoapi.Freight, err error) {
 				require.NoError(t, err)
@@ -326,11 +612,160 @@ func TestGetLatestFreightFromRepos(t *testing.T) {
 								Version: "fake-version",
 							},
 						},
+						OCIArtifacts: []kargoapi.OCIArtifact{
+							{
+								RepoURL: "fake-artifact-repo",
+								Tag:     "fake-tag",
+								Digest:  "fake-digest",
+							},
+						},
+						HTTPArtifacts: []kargoapi.HTTPArtifact{
+							{
+								URL:     "https://example.com/release.json",
+								Version: "v1.2.3",
+							},
+						},
 					},
 					freight,
 				)
 			},
 		},
+
+		{
+			name: "success with untracked metadata",
+			spec: kargoapi.WarehouseSpec{
+				FreightMetadata: &kargoapi.FreightMetadata{
+					Labels:      map[string]string{"foo": "bar"},
+					Annotations: map[string]string{"bat": "baz"},
+				},
+			},
+			reconciler: &reconciler{
+				selectCommitsFn: func(
+					context.Context,
+					string,
+					[]kargoapi.RepoSubscription,
+					*kargoapi.FreightReference,
+				) ([]kargoapi.GitCommit, error) {
+					return []kargoapi.GitCommit{
+						{
+							RepoURL: "fake-url",
+							ID:      "fake-commit",
+						},
+					}, nil
+				},
+				selectImagesFn: func(
+					context.Context,
+					string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.Image, error) {
+					return nil, nil
+				},
+				selectChartsFn: func(
+					context.Context,
+					string,
+					[]kargoapi.RepoSubscription,
+					*kargoapi.FreightReference,
+				) ([]kargoapi.Chart, error) {
+					return nil, nil
+				},
+				selectOCIArtifactsFn: func(
+					context.Context,
+					string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.OCIArtifact, error) {
+					return nil, nil
+				},
+				selectHTTPArtifactsFn: func(
+					context.Context,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.HTTPArtifact, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.Equal(t, map[string]string{"foo": "bar"}, freight.Labels)
+				require.Equal(t, map[string]string{"bat": "baz"}, freight.Annotations)
+				require.Nil(t, freight.TrackedMetadata)
+				// The ID should be derived from artifacts alone
+				untracked := freight.DeepCopy()
+				untracked.Labels = nil
+				untracked.Annotations = nil
+				require.Equal(t, untracked.GenerateID(), freight.Name)
+			},
+		},
+
+		{
+			name: "success with tracked metadata",
+			spec: kargoapi.WarehouseSpec{
+				FreightMetadata: &kargoapi.FreightMetadata{
+					Labels:      map[string]string{"foo": "bar"},
+					Annotations: map[string]string{"bat": "baz"},
+				},
+				TrackFreightMetadata: true,
+			},
+			reconciler: &reconciler{
+				selectCommitsFn: func(
+					context.Context,
+					string,
+					[]kargoapi.RepoSubscription,
+					*kargoapi.FreightReference,
+				) ([]kargoapi.GitCommit, error) {
+					return []kargoapi.GitCommit{
+						{
+							RepoURL: "fake-url",
+							ID:      "fake-commit",
+						},
+					}, nil
+				},
+				selectImagesFn: func(
+					context.Context,
+					string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.Image, error) {
+					return nil, nil
+				},
+				selectChartsFn: func(
+					context.Context,
+					string,
+					[]kargoapi.RepoSubscription,
+					*kargoapi.FreightReference,
+				) ([]kargoapi.Chart, error) {
+					return nil, nil
+				},
+				selectOCIArtifactsFn: func(
+					context.Context,
+					string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.OCIArtifact, error) {
+					return nil, nil
+				},
+				selectHTTPArtifactsFn: func(
+					context.Context,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.HTTPArtifact, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.Equal(t, map[string]string{"foo": "bar"}, freight.Labels)
+				require.Equal(t, map[string]string{"bat": "baz"}, freight.Annotations)
+				require.Equal(
+					t,
+					&kargoapi.FreightMetadata{
+						Labels:      map[string]string{"foo": "bar"},
+						Annotations: map[string]string{"bat": "baz"},
+					},
+					freight.TrackedMetadata,
+				)
+				// The ID should account for the tracked metadata
+				require.Equal(t, freight.GenerateID(), freight.Name)
+				untracked := freight.DeepCopy()
+				untracked.TrackedMetadata = nil
+				require.NotEqual(t, untracked.GenerateID(), freight.Name)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -341,9 +776,130 @@ func TestGetLatestFreightFromRepos(t *testing.T) {
 						Namespace: "fake-namespace",
 						Name:      testWarehouseName,
 					},
+					Spec: testCase.spec,
 				},
 			)
 			testCase.assertions(t, freight, err)
 		})
 	}
 }
+
+func TestPollingInterval(t *testing.T) {
+	testCases := []struct {
+		name     string
+		interval *metav1.Duration
+		expected time.Duration
+	}{
+		{
+			name:     "not specified",
+			expected: defaultPollingInterval,
+		},
+		{
+			name:     "specified",
+			interval: &metav1.Duration{Duration: time.Hour},
+			expected: time.Hour,
+		},
+		{
+			name:     "not positive",
+			interval: &metav1.Duration{},
+			expected: defaultPollingInterval,
+		},
+		{
+			name:     "below minimum",
+			interval: &metav1.Duration{Duration: time.Second},
+			expected: minPollingInterval,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				pollingInterval(&kargoapi.Warehouse{
+					Spec: kargoapi.WarehouseSpec{
+						PollingInterval: testCase.interval,
+					},
+				}),
+			)
+		})
+	}
+}
+
+func TestRegistryTimeout(t *testing.T) {
+	testCases := []struct {
+		name     string
+		timeout  *metav1.Duration
+		expected time.Duration
+	}{
+		{
+			name:     "not specified",
+			expected: defaultRegistryTimeout,
+		},
+		{
+			name:     "specified",
+			timeout:  &metav1.Duration{Duration: 10 * time.Second},
+			expected: 10 * time.Second,
+		},
+		{
+			name:     "not positive",
+			timeout:  &metav1.Duration{},
+			expected: defaultRegistryTimeout,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				registryTimeout(&kargoapi.Warehouse{
+					Spec: kargoapi.WarehouseSpec{
+						RegistryTimeout: testCase.timeout,
+					},
+				}),
+			)
+		})
+	}
+}
+
+func TestPollSubscriptions(t *testing.T) {
+	subs := make([]kargoapi.RepoSubscription, 3*maxConcurrentSubscriptionPolls)
+	for i := range subs {
+		subs[i] = kargoapi.RepoSubscription{
+			Chart: &kargoapi.ChartSubscription{
+				RepoURL: fmt.Sprintf("fake-url-%d", i),
+			},
+		}
+	}
+
+	var inFlight, maxInFlight atomic.Int32
+	results, err := pollSubscriptions(
+		context.Background(),
+		subs,
+		func(_ context.Context, sub kargoapi.RepoSubscription) ([]string, error) {
+			n := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				m := maxInFlight.Load()
+				if n <= m || maxInFlight.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			if sub.Chart.RepoURL == "fake-url-1" {
+				return nil, errors.New("something went wrong")
+			}
+			return []string{sub.Chart.RepoURL}, nil
+		},
+	)
+	require.ErrorContains(t, err, "something went wrong")
+	require.LessOrEqual(t, maxInFlight.Load(), int32(maxConcurrentSubscriptionPolls))
+
+	// Every other subscription was polled and results are in subscription order
+	expected := make([]string, 0, len(subs)-1)
+	for i := range subs {
+		if i != 1 {
+			expected = append(expected, subs[i].Chart.RepoURL)
+		}
+	}
+	require.Equal(t, expected, results)
+}
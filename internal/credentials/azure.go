@@ -0,0 +1,290 @@
+package credentials
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	// acrRefreshTokenUsername is the username ACR expects to accompany a refresh
+	// token used as a password.
+	acrRefreshTokenUsername = "00000000-0000-0000-0000-000000000000"
+
+	// acrRefreshTokenFallbackLifetime is assumed for refresh tokens whose
+	// expiry cannot be determined.
+	acrRefreshTokenFallbackLifetime = time.Hour
+	// acrRefreshTokenExpiryDelta is how long before its expiry a cached refresh
+	// token is replaced.
+	acrRefreshTokenExpiryDelta = 5 * time.Minute
+	// azureAADTokenExpiryDelta is how long before its expiry a cached AAD
+	// access token is replaced.
+	azureAADTokenExpiryDelta = time.Minute
+
+	azureIMDSTokenURL   = "http://169.254.169.254/metadata/identity/oauth2/token"
+	azureARMResource    = "https://management.azure.com/"
+	azureIMDSAPIVersion = "2018-02-01"
+)
+
+// azureCredentialsProvider supplies credentials for repositories hosted in
+// Azure Container Registry. An AAD access token obtained via managed identity
+// is exchanged for an ACR refresh token, which is cached per registry and
+// replaced shortly before it expires.
+//
+// Tokens are obtained for the controller's own managed identity, so unless
+// projects is non-empty, any Project may use them to access any registry
+// that identity can access.
+type azureCredentialsProvider struct {
+	projects []string
+
+	// mu guards aadToken and tokens. It is never held while tokens are being
+	// obtained, so that a slow registry does not hold up lookups for others.
+	mu       sync.Mutex
+	aadToken *oauth2.Token
+	tokens   map[string]*oauth2.Token
+
+	getAADTokenFn func(ctx context.Context) (*oauth2.Token, error)
+
+	exchangeFn func(
+		ctx context.Context,
+		registry string,
+		aadToken string,
+	) (*oauth2.Token, error)
+}
+
+// newAzureCredentialsProvider returns an azureCredentialsProvider that obtains
+// AAD tokens for the managed identity with the specified client ID or, if no
+// client ID is specified, for the system-assigned managed identity. If any
+// projects are specified, credentials are only supplied to those Projects.
+func newAzureCredentialsProvider(
+	clientID string,
+	projects []string,
+) *azureCredentialsProvider {
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+	return &azureCredentialsProvider{
+		projects: projects,
+		tokens:   map[string]*oauth2.Token{},
+		getAADTokenFn: func(ctx context.Context) (*oauth2.Token, error) {
+			return getAzureManagedIdentityToken(
+				ctx,
+				httpClient,
+				azureIMDSTokenURL,
+				clientID,
+			)
+		},
+		exchangeFn: func(
+			ctx context.Context,
+			registry string,
+			aadToken string,
+		) (*oauth2.Token, error) {
+			return exchangeACRRefreshToken(
+				ctx,
+				httpClient,
+				"https://"+registry,
+				registry,
+				aadToken,
+			)
+		},
+	}
+}
+
+// get returns credentials for the specified repository if it is hosted in
+// Azure Container Registry and the specified Project is permitted to use
+// them. If not, the boolean return value will be false.
+func (a *azureCredentialsProvider) get(
+	ctx context.Context,
+	project string,
+	repoURL string,
+) (Credentials, bool, error) {
+	registry := registryHost(repoURL)
+	if !strings.HasSuffix(registry, ".azurecr.io") {
+		return Credentials{}, false, nil
+	}
+	if len(a.projects) > 0 && !slices.Contains(a.projects, project) {
+		return Credentials{}, false, nil
+	}
+
+	a.mu.Lock()
+	token, aadToken := a.tokens[registry], a.aadToken
+	a.mu.Unlock()
+
+	// Concurrent lookups for the same registry may each obtain a new token.
+	// That is harmless and preferable to making them wait on one another.
+	if !isFreshToken(token, acrRefreshTokenExpiryDelta) {
+		var err error
+		if !isFreshToken(aadToken, azureAADTokenExpiryDelta) {
+			if aadToken, err = a.getAADTokenFn(ctx); err != nil {
+				return Credentials{}, false,
+					fmt.Errorf("error obtaining Azure managed identity token: %w", err)
+			}
+			a.mu.Lock()
+			a.aadToken = aadToken
+			a.mu.Unlock()
+		}
+		if token, err = a.exchangeFn(ctx, registry, aadToken.AccessToken); err != nil {
+			return Credentials{}, false, fmt.Errorf(
+				"error exchanging Azure managed identity token for %s refresh token: %w",
+				registry,
+				err,
+			)
+		}
+		a.mu.Lock()
+		a.tokens[registry] = token
+		a.mu.Unlock()
+	}
+
+	return Credentials{
+		Username: acrRefreshTokenUsername,
+		Password: token.AccessToken,
+		Source:   "Azure managed identity",
+	}, true, nil
+}
+
+// isFreshToken returns a boolean indicating whether the specified token is
+// non-nil and does not expire within the specified delta.
+func isFreshToken(token *oauth2.Token, delta time.Duration) bool {
+	return token != nil && time.Until(token.Expiry) >= delta
+}
+
+// exchangeACRRefreshToken exchanges an AAD access token for a refresh token
+// for the specified registry using the registry's OAuth2 exchange endpoint.
+func exchangeACRRefreshToken(
+	ctx context.Context,
+	httpClient *http.Client,
+	registryURL string,
+	registry string,
+	aadToken string,
+) (*oauth2.Token, error) {
+	form := url.Values{
+		"grant_type":   []string{"access_token"},
+		"service":      []string{registry},
+		"access_token": []string{aadToken},
+	}
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		registryURL+"/oauth2/exchange",
+		strings.NewReader(form.Encode()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error building token exchange request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error sending token exchange request: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf(
+			"token exchange endpoint responded with status %d",
+			resp.StatusCode,
+		)
+	}
+	res := struct {
+		RefreshToken string `json:"refresh_token"`
+	}{}
+	if err = json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return nil, fmt.Errorf("error decoding token exchange response: %w", err)
+	}
+	if res.RefreshToken == "" {
+		return nil, fmt.Errorf("token exchange response did not include a refresh token")
+	}
+	return &oauth2.Token{
+		AccessToken: res.RefreshToken,
+		Expiry:      jwtExpiry(res.RefreshToken),
+	}, nil
+}
+
+// jwtExpiry returns the expiry encoded in the exp claim of the specified JWT.
+// The token's signature is not verified. If the expiry cannot be determined,
+// a conservative expiry is returned instead.
+func jwtExpiry(token string) time.Time {
+	fallback := time.Now().Add(acrRefreshTokenFallbackLifetime)
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return fallback
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return fallback
+	}
+	claims := struct {
+		Exp int64 `json:"exp"`
+	}{}
+	if err = json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return fallback
+	}
+	return time.Unix(claims.Exp, 0)
+}
+
+// getAzureManagedIdentityToken obtains an AAD access token for the managed
+// identity with the specified client ID, or for the system-assigned managed
+// identity if no client ID is specified, from the Azure Instance Metadata
+// Service at the specified URL.
+func getAzureManagedIdentityToken(
+	ctx context.Context,
+	httpClient *http.Client,
+	imdsURL string,
+	clientID string,
+) (*oauth2.Token, error) {
+	query := url.Values{
+		"api-version": []string{azureIMDSAPIVersion},
+		"resource":    []string{azureARMResource},
+	}
+	if clientID != "" {
+		query.Set("client_id", clientID)
+	}
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		imdsURL+"?"+query.Encode(),
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error building instance metadata request: %w", err)
+	}
+	req.Header.Set("Metadata", "true")
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error requesting token from instance metadata service: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf(
+			"instance metadata service responded with status %d",
+			resp.StatusCode,
+		)
+	}
+	// The instance metadata service encodes numeric fields as strings
+	res := struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   string `json:"expires_in"`
+		TokenType   string `json:"token_type"`
+	}{}
+	if err = json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return nil, fmt.Errorf("error decoding instance metadata response: %w", err)
+	}
+	if res.AccessToken == "" {
+		return nil, fmt.Errorf("instance metadata response did not include a token")
+	}
+	expiresIn, err := strconv.ParseInt(res.ExpiresIn, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing token expiry %q: %w", res.ExpiresIn, err)
+	}
+	return &oauth2.Token{
+		AccessToken: res.AccessToken,
+		TokenType:   res.TokenType,
+		Expiry:      time.Now().Add(time.Duration(expiresIn) * time.Second),
+	}, nil
+}
@@ -0,0 +1,288 @@
+package credentials
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+func TestAzureCredentialsProviderGet(t *testing.T) {
+	var exchanges int
+	provider := &azureCredentialsProvider{
+		getAADTokenFn: func(context.Context) (*oauth2.Token, error) {
+			return &oauth2.Token{
+				AccessToken: "fake-aad-token",
+				Expiry:      time.Now().Add(time.Hour),
+			}, nil
+		},
+		tokens: map[string]*oauth2.Token{},
+		exchangeFn: func(
+			_ context.Context,
+			registry string,
+			aadToken string,
+		) (*oauth2.Token, error) {
+			exchanges++
+			require.Equal(t, "fake-aad-token", aadToken)
+			return &oauth2.Token{
+				AccessToken: fmt.Sprintf("%s-refresh-token-%d", registry, exchanges),
+				Expiry:      time.Now().Add(time.Hour),
+			}, nil
+		},
+	}
+
+	// Repositories outside of ACR are not handled
+	_, ok, err := provider.get(context.Background(), "", "ghcr.io/akuity/kargo")
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Zero(t, exchanges)
+
+	// Tokens are cached per registry
+	for i := 0; i < 2; i++ {
+		creds, ok, err := provider.get(
+			context.Background(),
+			"",
+			"fake.azurecr.io/fake-image",
+		)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, acrRefreshTokenUsername, creds.Username)
+		require.Equal(t, "fake.azurecr.io-refresh-token-1", creds.Password)
+	}
+	creds, ok, err := provider.get(
+		context.Background(),
+		"",
+		"oci://another.azurecr.io/charts",
+	)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "another.azurecr.io-refresh-token-2", creds.Password)
+
+	// Tokens nearing expiry are replaced
+	provider.tokens["fake.azurecr.io"].Expiry = time.Now().Add(time.Minute)
+	creds, _, err = provider.get(context.Background(), "", "fake.azurecr.io/fake-image")
+	require.NoError(t, err)
+	require.Equal(t, "fake.azurecr.io-refresh-token-3", creds.Password)
+}
+
+func TestAzureCredentialsProviderGetProjects(t *testing.T) {
+	provider := &azureCredentialsProvider{
+		projects: []string{"allowed-project"},
+		getAADTokenFn: func(context.Context) (*oauth2.Token, error) {
+			return &oauth2.Token{
+				AccessToken: "fake-aad-token",
+				Expiry:      time.Now().Add(time.Hour),
+			}, nil
+		},
+		tokens: map[string]*oauth2.Token{},
+		exchangeFn: func(
+			context.Context,
+			string,
+			string,
+		) (*oauth2.Token, error) {
+			return &oauth2.Token{
+				AccessToken: "fake-refresh-token",
+				Expiry:      time.Now().Add(time.Hour),
+			}, nil
+		},
+	}
+
+	_, ok, err := provider.get(
+		context.Background(),
+		"other-project",
+		"fake.azurecr.io/fake-image",
+	)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Empty(t, provider.tokens)
+
+	creds, ok, err := provider.get(
+		context.Background(),
+		"allowed-project",
+		"fake.azurecr.io/fake-image",
+	)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "fake-refresh-token", creds.Password)
+}
+
+func TestAzureCredentialsProviderGetConcurrentRegistries(t *testing.T) {
+	slowExchangeStarted := make(chan struct{})
+	releaseSlowExchange := make(chan struct{})
+	provider := &azureCredentialsProvider{
+		getAADTokenFn: func(context.Context) (*oauth2.Token, error) {
+			return &oauth2.Token{
+				AccessToken: "fake-aad-token",
+				Expiry:      time.Now().Add(time.Hour),
+			}, nil
+		},
+		tokens: map[string]*oauth2.Token{},
+		exchangeFn: func(
+			_ context.Context,
+			registry string,
+			_ string,
+		) (*oauth2.Token, error) {
+			if registry == "slow.azurecr.io" {
+				close(slowExchangeStarted)
+				<-releaseSlowExchange
+			}
+			return &oauth2.Token{
+				AccessToken: registry + "-refresh-token",
+				Expiry:      time.Now().Add(time.Hour),
+			}, nil
+		},
+	}
+
+	slowDone := make(chan error)
+	go func() {
+		_, _, err := provider.get(
+			context.Background(),
+			"",
+			"slow.azurecr.io/fake-image",
+		)
+		slowDone <- err
+	}()
+	<-slowExchangeStarted
+
+	// A lookup for another registry is not held up by the slow exchange
+	creds, ok, err := provider.get(
+		context.Background(),
+		"",
+		"fast.azurecr.io/fake-image",
+	)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "fast.azurecr.io-refresh-token", creds.Password)
+
+	close(releaseSlowExchange)
+	require.NoError(t, <-slowDone)
+	require.Len(t, provider.tokens, 2)
+}
+
+func TestAzureCredentialsProviderGetError(t *testing.T) {
+	provider := &azureCredentialsProvider{
+		getAADTokenFn: func(context.Context) (*oauth2.Token, error) {
+			return &oauth2.Token{
+				AccessToken: "fake-aad-token",
+				Expiry:      time.Now().Add(time.Hour),
+			}, nil
+		},
+		tokens: map[string]*oauth2.Token{},
+		exchangeFn: func(
+			context.Context,
+			string,
+			string,
+		) (*oauth2.Token, error) {
+			return nil, fmt.Errorf("something went wrong")
+		},
+	}
+	_, ok, err := provider.get(context.Background(), "", "fake.azurecr.io/fake-image")
+	require.ErrorContains(t, err, "something went wrong")
+	require.False(t, ok)
+	require.Empty(t, provider.tokens)
+}
+
+func TestExchangeACRRefreshToken(t *testing.T) {
+	expiry := time.Now().Add(3 * time.Hour).Truncate(time.Second)
+	refreshToken := "header." + base64.RawURLEncoding.EncodeToString(
+		[]byte(fmt.Sprintf(`{"exp":%d}`, expiry.Unix())),
+	) + ".signature"
+
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/oauth2/exchange" ||
+				r.FormValue("grant_type") != "access_token" ||
+				r.FormValue("service") != "fake.azurecr.io" ||
+				r.FormValue("access_token") != "fake-aad-token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = fmt.Fprintf(w, `{"refresh_token":%q}`, refreshToken)
+		},
+	))
+	defer server.Close()
+
+	token, err := exchangeACRRefreshToken(
+		context.Background(),
+		server.Client(),
+		server.URL,
+		"fake.azurecr.io",
+		"fake-aad-token",
+	)
+	require.NoError(t, err)
+	require.Equal(t, refreshToken, token.AccessToken)
+	require.True(t, expiry.Equal(token.Expiry))
+
+	_, err = exchangeACRRefreshToken(
+		context.Background(),
+		server.Client(),
+		server.URL,
+		"fake.azurecr.io",
+		"bogus-token",
+	)
+	require.ErrorContains(t, err, "responded with status 401")
+}
+
+func TestJWTExpiry(t *testing.T) {
+	before := time.Now()
+	require.True(t, jwtExpiry("not-a-jwt").After(before))
+	require.Equal(
+		t,
+		int64(1700000000),
+		jwtExpiry(
+			"h."+base64.RawURLEncoding.EncodeToString([]byte(`{"exp":1700000000}`))+".s",
+		).Unix(),
+	)
+}
+
+func TestGetAzureManagedIdentityToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Metadata") != "true" ||
+				r.URL.Query().Get("resource") != azureARMResource ||
+				r.URL.Query().Get("client_id") != "fake-client-id" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			_, _ = w.Write([]byte(
+				`{"access_token":"fake-aad-token","expires_in":"3599","token_type":"Bearer"}`,
+			))
+		},
+	))
+	defer server.Close()
+
+	token, err := getAzureManagedIdentityToken(
+		context.Background(),
+		server.Client(),
+		server.URL,
+		"fake-client-id",
+	)
+	require.NoError(t, err)
+	require.Equal(t, "fake-aad-token", token.AccessToken)
+	require.WithinDuration(t, time.Now().Add(3599*time.Second), token.Expiry, time.Minute)
+
+	_, err = getAzureManagedIdentityToken(
+		context.Background(),
+		server.Client(),
+		server.URL,
+		"",
+	)
+	require.ErrorContains(t, err, "responded with status 400")
+
+	// The caller's context is honored
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = getAzureManagedIdentityToken(
+		ctx,
+		server.Client(),
+		server.URL,
+		"fake-client-id",
+	)
+	require.ErrorIs(t, err, context.Canceled)
+}
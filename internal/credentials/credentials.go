@@ -2,6 +2,7 @@ package credentials
 
 import (
 	"context"
+	"fmt"
 	"regexp"
 	"sort"
 	"strings"
@@ -40,6 +41,15 @@ const (
 	TypeHelm Type = "helm"
 	// TypeImage represents credentials for an image repository.
 	TypeImage Type = "image"
+	// TypeHTTP represents credentials for a generic HTTP endpoint, such as one
+	// polled by a Stage's health check.
+	TypeHTTP Type = "http"
+	// TypeGitWebhook represents the secret shared with a Git hosting provider
+	// for authenticating the push webhooks it sends for a Git repository.
+	TypeGitWebhook Type = "git-webhook"
+	// TypeImageWebhook represents the secret shared with a container registry
+	// for authenticating the push webhooks it sends for an image repository.
+	TypeImageWebhook Type = "image-webhook"
 )
 
 // Credentials generically represents any type of repository credential.
@@ -53,6 +63,17 @@ type Credentials struct {
 	// SSHPrivateKey is a private key that can be used for access to some remote
 	// repository. This is primarily applicable for Git repositories.
 	SSHPrivateKey string
+	// SigningKey is an ASCII-armored GPG private key that can be used to sign
+	// commits made to some Git repository.
+	SigningKey string
+	// WebhookSecret is a secret shared with a Git hosting provider or container
+	// registry that is used to authenticate the push webhooks it sends for some
+	// repository.
+	WebhookSecret string
+	// Source describes where the credentials were obtained from (e.g. the
+	// namespace and name of a Secret). It never contains sensitive information
+	// and is therefore safe to log.
+	Source string
 }
 
 // Database is an interface for a Credentials store.
@@ -71,12 +92,45 @@ type Database interface {
 type kubernetesDatabase struct {
 	kargoClient client.Client
 	cfg         KubernetesDatabaseConfig
+	// labelRequirements holds the parsed requirements of the configured label
+	// selector, if any.
+	labelRequirements labels.Requirements
+	providers         []credentialsProvider
 }
 
 // KubernetesDatabaseConfig represents configuration for a Kubernetes based
 // implementation of the Database interface.
 type KubernetesDatabaseConfig struct {
 	GlobalCredentialsNamespaces []string `envconfig:"GLOBAL_CREDENTIALS_NAMESPACES" default:""`
+	// LabelSelector optionally narrows the set of Secrets that are considered
+	// when looking up credentials. It is combined with the selector on the
+	// credential type label, so only Secrets matching both are examined.
+	LabelSelector string `envconfig:"CREDENTIALS_LABEL_SELECTOR" default:""`
+	// GCPArtifactRegistryEnabled enables the use of short-lived access tokens
+	// for image and chart repositories hosted in GCP Artifact Registry or
+	// Container Registry when no Secret holds credentials for them.
+	GCPArtifactRegistryEnabled bool `envconfig:"GCP_ARTIFACT_REGISTRY_ENABLED" default:"false"`
+	// GCPServiceAccountKeyFile is the path to a service account key used to
+	// obtain access tokens for GCP registries. If empty, tokens are obtained
+	// from the GCE metadata server instead.
+	GCPServiceAccountKeyFile string `envconfig:"GCP_SERVICE_ACCOUNT_KEY_FILE" default:""`
+	// GCPArtifactRegistryProjects optionally limits which Projects may use
+	// access tokens for GCP registries. Tokens are obtained for the
+	// controller's own GCP identity, so if this is empty, every Project can
+	// access every GCP registry repository that identity can access.
+	GCPArtifactRegistryProjects []string `envconfig:"GCP_ARTIFACT_REGISTRY_PROJECTS" default:""`
+	// AzureContainerRegistryEnabled enables the use of refresh tokens obtained
+	// via managed identity for image and chart repositories hosted in Azure
+	// Container Registry when no Secret holds credentials for them.
+	AzureContainerRegistryEnabled bool `envconfig:"AZURE_CONTAINER_REGISTRY_ENABLED" default:"false"`
+	// AzureManagedIdentityClientID optionally selects a user-assigned managed
+	// identity. If empty, the system-assigned identity is used.
+	AzureManagedIdentityClientID string `envconfig:"AZURE_MANAGED_IDENTITY_CLIENT_ID" default:""`
+	// AzureContainerRegistryProjects optionally limits which Projects may use
+	// refresh tokens for Azure Container Registry. Tokens are obtained for the
+	// controller's own managed identity, so if this is empty, every Project
+	// can access every registry that identity can access.
+	AzureContainerRegistryProjects []string `envconfig:"AZURE_CONTAINER_REGISTRY_PROJECTS" default:""`
 }
 
 func KubernetesDatabaseConfigFromEnv() KubernetesDatabaseConfig {
@@ -88,15 +142,46 @@ func KubernetesDatabaseConfigFromEnv() KubernetesDatabaseConfig {
 
 // NewKubernetesDatabase initializes and returns an implementation of the
 // Database interface that utilizes a Kubernetes controller runtime client to
-// retrieve Credentials stored in Kubernetes Secrets.
+// retrieve Credentials stored in Kubernetes Secrets. An error is returned if
+// the configured label selector is invalid.
 func NewKubernetesDatabase(
 	kargoClient client.Client,
 	cfg KubernetesDatabaseConfig,
-) Database {
-	return &kubernetesDatabase{
+) (Database, error) {
+	k := &kubernetesDatabase{
 		kargoClient: kargoClient,
 		cfg:         cfg,
 	}
+	if cfg.LabelSelector != "" {
+		selector, err := labels.Parse(cfg.LabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error parsing credentials label selector %q: %w",
+				cfg.LabelSelector,
+				err,
+			)
+		}
+		k.labelRequirements, _ = selector.Requirements()
+	}
+	if cfg.GCPArtifactRegistryEnabled {
+		k.providers = append(
+			k.providers,
+			newGCPCredentialsProvider(
+				cfg.GCPServiceAccountKeyFile,
+				cfg.GCPArtifactRegistryProjects,
+			),
+		)
+	}
+	if cfg.AzureContainerRegistryEnabled {
+		k.providers = append(
+			k.providers,
+			newAzureCredentialsProvider(
+				cfg.AzureManagedIdentityClientID,
+				cfg.AzureContainerRegistryProjects,
+			),
+		)
+	}
+	return k, nil
 }
 
 func (k *kubernetesDatabase) Get(
@@ -146,6 +231,14 @@ func (k *kubernetesDatabase) Get(
 	}
 
 	if secret == nil {
+		// Fall back to short-lived credentials for registries that support them
+		if credType == TypeImage || credType == TypeHelm {
+			for _, provider := range k.providers {
+				if creds, ok, err := provider.get(ctx, namespace, repoURL); err != nil || ok {
+					return creds, ok, err
+				}
+			}
+		}
 		return creds, false, nil
 	}
 
@@ -167,10 +260,8 @@ func (k *kubernetesDatabase) getCredentialsSecret(
 		ctx,
 		&secrets,
 		&client.ListOptions{
-			Namespace: namespace,
-			LabelSelector: labels.Set(map[string]string{
-				kargoapi.CredentialTypeLabelKey: credType.String(),
-			}).AsSelector(),
+			Namespace:     namespace,
+			LabelSelector: k.secretSelector(credType),
 		},
 	); err != nil {
 		return nil, err
@@ -182,8 +273,12 @@ func (k *kubernetesDatabase) getCredentialsSecret(
 		return secrets.Items[i].Name < secrets.Items[j].Name
 	})
 
-	// Scan for an exact match
-	for _, secret := range secrets.Items {
+	// Scan for the longest prefix match. An exact match is the longest possible
+	// prefix match. Among Secrets with equally long matches, the first by name
+	// wins.
+	var bestMatch *corev1.Secret
+	var bestMatchLen int
+	for i, secret := range secrets.Items {
 		if secret.Data == nil {
 			continue
 		}
@@ -199,10 +294,17 @@ func (k *kubernetesDatabase) getCredentialsSecret(
 				string(urlBytes),
 			),
 		)
-		if url == repoURL {
-			return &secret, nil
+		// A trailing slash makes no difference to what a prefix matches, so it
+		// must not make a difference to how long the match is considered, either.
+		if matchLen := len(strings.TrimSuffix(url, "/")); isURLPrefix(url, repoURL) &&
+			matchLen > bestMatchLen {
+			bestMatch = &secrets.Items[i]
+			bestMatchLen = matchLen
 		}
 	}
+	if bestMatch != nil {
+		return bestMatch, nil
+	}
 
 	logger := logging.LoggerFromContext(ctx)
 
@@ -234,10 +336,34 @@ func (k *kubernetesDatabase) getCredentialsSecret(
 	return nil, nil
 }
 
+// isURLPrefix returns a boolean indicating whether prefix is equal to repoURL
+// or is a prefix of it that ends at a path segment boundary. This prevents,
+// for instance, a prefix of example.com/team-a from matching
+// example.com/team-ab.
+func isURLPrefix(prefix, repoURL string) bool {
+	prefix = strings.TrimSuffix(prefix, "/")
+	if prefix == "" {
+		return false
+	}
+	return repoURL == prefix || strings.HasPrefix(repoURL, prefix+"/")
+}
+
+// secretSelector returns a labels.Selector that matches Secrets holding
+// credentials of the specified type and, if configured, the additional label
+// selector.
+func (k *kubernetesDatabase) secretSelector(credType Type) labels.Selector {
+	return labels.Set(map[string]string{
+		kargoapi.CredentialTypeLabelKey: credType.String(),
+	}).AsSelector().Add(k.labelRequirements...)
+}
+
 func secretToCreds(secret *corev1.Secret) Credentials {
 	return Credentials{
 		Username:      string(secret.Data["username"]),
 		Password:      string(secret.Data["password"]),
 		SSHPrivateKey: string(secret.Data["sshPrivateKey"]),
+		SigningKey:    string(secret.Data["signingKey"]),
+		WebhookSecret: string(secret.Data["webhookSecret"]),
+		Source:        fmt.Sprintf("Secret %s/%s", secret.Namespace, secret.Name),
 	}
 }
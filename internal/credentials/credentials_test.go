@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -18,12 +19,20 @@ func TestNewKubernetesDatabase(t *testing.T) {
 	testCfg := KubernetesDatabaseConfig{
 		GlobalCredentialsNamespaces: []string{"fake-namespace"},
 	}
-	d := NewKubernetesDatabase(testClient, testCfg)
+	d, err := NewKubernetesDatabase(testClient, testCfg)
+	require.NoError(t, err)
 	require.NotNil(t, d)
 	k, ok := d.(*kubernetesDatabase)
 	require.True(t, ok)
 	require.Same(t, testClient, k.kargoClient)
 	require.Equal(t, testCfg, k.cfg)
+
+	// An invalid label selector is rejected up front
+	_, err = NewKubernetesDatabase(
+		testClient,
+		KubernetesDatabaseConfig{LabelSelector: "team in ("},
+	)
+	require.ErrorContains(t, err, "error parsing credentials label selector")
 }
 
 // TestGet simply validates that, given a set of valid/matching secrets in
@@ -115,11 +124,50 @@ func TestGet(t *testing.T) {
 		},
 	}
 
+	teamCredentialWithRepoURL := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "team-credential-repo-url",
+			Namespace: testProjectNamespace,
+			Labels: map[string]string{
+				kargoapi.CredentialTypeLabelKey: testCredType.String(),
+				"team":                          "fake-team",
+			},
+		},
+		Data: map[string][]byte{
+			FieldRepoURL:  []byte(testRepoURL),
+			FieldUsername: []byte("team-exact"),
+			FieldPassword: []byte("fake-password"),
+		},
+	}
+
+	newPrefixCredential := func(name, prefix string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testProjectNamespace,
+				Labels:    testLabels,
+			},
+			Data: map[string][]byte{
+				FieldRepoURL:  []byte(prefix),
+				FieldUsername: []byte(name),
+				FieldPassword: []byte("fake-password"),
+			},
+		}
+	}
+	hostCredential := newPrefixCredential("host-credential", "https://github.com")
+	orgCredential := newPrefixCredential("org-credential", "https://github.com/akuity/")
+	duplicateOrgCredential := newPrefixCredential("z-org-credential", "https://github.com/akuity")
+	// Normalization leaves trailing slashes of image repository URLs intact
+	imageOrgCredential := newPrefixCredential("image-org-credential", "ghcr.io/akuity/")
+	earlierImageOrgCredential := newPrefixCredential("a-image-org-credential", "ghcr.io/akuity")
+	siblingCredential := newPrefixCredential("sibling-credential", "https://github.com/akuity/kar")
+
 	testCases := []struct {
-		name     string
-		secrets  []client.Object
-		repoURL  string
-		expected *corev1.Secret
+		name          string
+		secrets       []client.Object
+		labelSelector string
+		repoURL       string
+		expected      *corev1.Secret
 	}{
 		{
 			name:     "exact match in project namespace",
@@ -183,6 +231,85 @@ func TestGet(t *testing.T) {
 			repoURL:  "http://github.com/no/secrets/should/match/this.git",
 			expected: nil,
 		},
+		{
+			name:     "prefix match in project namespace",
+			secrets:  []client.Object{hostCredential},
+			repoURL:  testRepoURL,
+			expected: hostCredential,
+		},
+		{
+			name:     "prefix must end at a path segment boundary",
+			secrets:  []client.Object{siblingCredential},
+			repoURL:  testRepoURL,
+			expected: nil,
+		},
+		{
+			name: "precedence: longest prefix match wins",
+			secrets: []client.Object{
+				hostCredential,
+				orgCredential,
+				siblingCredential,
+			},
+			repoURL:  testRepoURL,
+			expected: orgCredential,
+		},
+		{
+			name: "precedence: exact match over prefix match",
+			secrets: []client.Object{
+				hostCredential,
+				orgCredential,
+				projectCredentialWithRepoURL,
+			},
+			repoURL:  testRepoURL,
+			expected: projectCredentialWithRepoURL,
+		},
+		{
+			name: "precedence: prefix match over pattern match",
+			secrets: []client.Object{
+				orgCredential,
+				projectCredentialWithRepoURLPattern,
+			},
+			repoURL:  testRepoURL,
+			expected: orgCredential,
+		},
+		{
+			name: "precedence: equally long prefix matches are tied by name",
+			secrets: []client.Object{
+				duplicateOrgCredential,
+				orgCredential,
+			},
+			repoURL:  testRepoURL,
+			expected: orgCredential,
+		},
+		{
+			name: "precedence: trailing slash does not lengthen a prefix match",
+			secrets: []client.Object{
+				imageOrgCredential,
+				earlierImageOrgCredential,
+			},
+			repoURL:  "ghcr.io/akuity/kargo",
+			expected: earlierImageOrgCredential,
+		},
+		{
+			name: "label selector excludes non-matching secrets",
+			secrets: []client.Object{
+				projectCredentialWithRepoURL,
+				teamCredentialWithRepoURL,
+			},
+			labelSelector: "team=fake-team",
+			repoURL:       testRepoURL,
+			expected:      teamCredentialWithRepoURL,
+		},
+		{
+			name: "label selector matches no secrets",
+			secrets: []client.Object{
+				projectCredentialWithRepoURL,
+				globalCredentialWithRepoURL,
+			},
+			labelSelector: "team=fake-team",
+			repoURL:       testRepoURL,
+			expected:      nil,
+		},
 		{
 			name: "insecure HTTP endpoint",
 			// Would match if not for the insecure URL check
@@ -194,12 +321,15 @@ func TestGet(t *testing.T) {
 
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			creds, found, err := NewKubernetesDatabase(
+			db, err := NewKubernetesDatabase(
 				fake.NewClientBuilder().WithObjects(testCase.secrets...).Build(),
 				KubernetesDatabaseConfig{
 					GlobalCredentialsNamespaces: []string{testGlobalNamespace},
+					LabelSelector:               testCase.labelSelector,
 				},
-			).Get(
+			)
+			require.NoError(t, err)
+			creds, found, err := db.Get(
 				context.Background(),
 				testProjectNamespace,
 				testCredType,
@@ -219,20 +349,110 @@ func TestGet(t *testing.T) {
 				string(testCase.expected.Data["username"]),
 				creds.Username,
 			)
+			require.Equal(
+				t,
+				"Secret "+testCase.expected.Namespace+"/"+testCase.expected.Name,
+				creds.Source,
+			)
+		})
+	}
+}
+
+func TestIsURLPrefix(t *testing.T) {
+	testCases := []struct {
+		prefix   string
+		repoURL  string
+		expected bool
+	}{
+		{"registry.example.com", "registry.example.com", true},
+		{"registry.example.com", "registry.example.com/team-a/image", true},
+		{"registry.example.com/team-a", "registry.example.com/team-a/image", true},
+		{"registry.example.com/team-a/", "registry.example.com/team-a/image", true},
+		{"registry.example.com/team-a", "registry.example.com/team-ab/image", false},
+		{"registry.example.com/team-a/image", "registry.example.com/team-a", false},
+		{"", "registry.example.com", false},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.prefix+" "+testCase.repoURL, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				isURLPrefix(testCase.prefix, testCase.repoURL),
+			)
+		})
+	}
+}
+
+func TestSecretSelector(t *testing.T) {
+	testCases := []struct {
+		name          string
+		labelSelector string
+		assertions    func(*testing.T, labels.Selector)
+	}{
+		{
+			name: "no label selector configured",
+			assertions: func(t *testing.T, selector labels.Selector) {
+				require.Equal(
+					t,
+					kargoapi.CredentialTypeLabelKey+"="+TypeGit.String(),
+					selector.String(),
+				)
+			},
+		},
+		{
+			name:          "label selector configured",
+			labelSelector: "team in (a,b)",
+			assertions: func(t *testing.T, selector labels.Selector) {
+				require.True(t, selector.Matches(labels.Set{
+					kargoapi.CredentialTypeLabelKey: TypeGit.String(),
+					"team":                          "a",
+				}))
+				require.False(t, selector.Matches(labels.Set{
+					kargoapi.CredentialTypeLabelKey: TypeGit.String(),
+					"team":                          "c",
+				}))
+				require.False(t, selector.Matches(labels.Set{
+					kargoapi.CredentialTypeLabelKey: TypeHelm.String(),
+					"team":                          "a",
+				}))
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			d, err := NewKubernetesDatabase(
+				nil,
+				KubernetesDatabaseConfig{
+					LabelSelector: testCase.labelSelector,
+				},
+			)
+			require.NoError(t, err)
+			k, ok := d.(*kubernetesDatabase)
+			require.True(t, ok)
+			testCase.assertions(t, k.secretSelector(TypeGit))
 		})
 	}
 }
 
 func TestSecretToCreds(t *testing.T) {
 	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-secret",
+			Namespace: "fake-namespace",
+		},
 		Data: map[string][]byte{
 			"username":      []byte("fake-username"),
 			"password":      []byte("fake-password"),
 			"sshPrivateKey": []byte("fake-ssh-private-key"),
+			"signingKey":    []byte("fake-signing-key"),
+			"webhookSecret": []byte("fake-webhook-secret"),
 		},
 	}
 	creds := secretToCreds(secret)
 	require.Equal(t, string(secret.Data["username"]), creds.Username)
 	require.Equal(t, string(secret.Data["password"]), creds.Password)
 	require.Equal(t, string(secret.Data["sshPrivateKey"]), creds.SSHPrivateKey)
+	require.Equal(t, string(secret.Data["signingKey"]), creds.SigningKey)
+	require.Equal(t, string(secret.Data["webhookSecret"]), creds.WebhookSecret)
+	require.Equal(t, "Secret fake-namespace/fake-secret", creds.Source)
 }
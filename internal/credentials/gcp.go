@@ -0,0 +1,188 @@
+package credentials
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/jwt"
+)
+
+const (
+	// gcpAccessTokenUsername is the username GCP registries expect to accompany
+	// an OAuth2 access token used as a password.
+	gcpAccessTokenUsername = "oauth2accesstoken"
+
+	// gcpAccessTokenExpiryDelta is how long before its expiry a cached access
+	// token is replaced.
+	gcpAccessTokenExpiryDelta = time.Minute
+
+	gcpCloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+
+	gcpMetadataTokenURL = "http://metadata.google.internal/computeMetadata/v1/" +
+		"instance/service-accounts/default/token"
+)
+
+// gcpCredentialsProvider supplies short-lived credentials for repositories
+// hosted in GCP Artifact Registry or Container Registry. Tokens are only
+// requested on first use and are reused until shortly before they expire.
+//
+// Tokens are obtained for the controller's own GCP identity, so unless
+// projects is non-empty, any Project may use them to access any repository
+// that identity can access.
+type gcpCredentialsProvider struct {
+	projects []string
+	source   string
+
+	mu    sync.Mutex
+	token *oauth2.Token
+
+	getTokenFn func(ctx context.Context) (*oauth2.Token, error)
+}
+
+// newGCPCredentialsProvider returns a gcpCredentialsProvider that obtains
+// tokens using the service account key at the specified path or, if no path
+// is specified, from the GCE metadata server. If any projects are specified,
+// credentials are only supplied to those Projects.
+func newGCPCredentialsProvider(
+	keyFile string,
+	projects []string,
+) *gcpCredentialsProvider {
+	if keyFile != "" {
+		return &gcpCredentialsProvider{
+			projects: projects,
+			source:   fmt.Sprintf("GCP service account key %s", keyFile),
+			getTokenFn: func(ctx context.Context) (*oauth2.Token, error) {
+				return getGCPKeyFileToken(ctx, keyFile)
+			},
+		}
+	}
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+	return &gcpCredentialsProvider{
+		projects: projects,
+		source:   "GCP metadata server",
+		getTokenFn: func(ctx context.Context) (*oauth2.Token, error) {
+			return getGCPMetadataToken(ctx, httpClient, gcpMetadataTokenURL)
+		},
+	}
+}
+
+// get returns credentials for the specified repository if it is hosted in
+// GCP Artifact Registry or Container Registry and the specified Project is
+// permitted to use them. If not, the boolean return value will be false.
+func (g *gcpCredentialsProvider) get(
+	ctx context.Context,
+	project string,
+	repoURL string,
+) (Credentials, bool, error) {
+	if !isGCPRegistry(repoURL) {
+		return Credentials{}, false, nil
+	}
+	if len(g.projects) > 0 && !slices.Contains(g.projects, project) {
+		return Credentials{}, false, nil
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.token == nil || (!g.token.Expiry.IsZero() &&
+		time.Until(g.token.Expiry) < gcpAccessTokenExpiryDelta) {
+		token, err := g.getTokenFn(ctx)
+		if err != nil {
+			return Credentials{}, false,
+				fmt.Errorf("error obtaining GCP access token: %w", err)
+		}
+		g.token = token
+	}
+
+	return Credentials{
+		Username: gcpAccessTokenUsername,
+		Password: g.token.AccessToken,
+		Source:   g.source,
+	}, true, nil
+}
+
+// isGCPRegistry returns a boolean indicating whether the specified repository
+// URL refers to GCP Artifact Registry (*-docker.pkg.dev) or Container
+// Registry (gcr.io and its regional subdomains).
+func isGCPRegistry(repoURL string) bool {
+	host := registryHost(repoURL)
+	return host == "gcr.io" ||
+		strings.HasSuffix(host, ".gcr.io") ||
+		strings.HasSuffix(host, "-docker.pkg.dev")
+}
+
+// getGCPMetadataToken obtains an access token for the default service account
+// from the GCE metadata server at the specified URL.
+func getGCPMetadataToken(
+	ctx context.Context,
+	httpClient *http.Client,
+	url string,
+) (*oauth2.Token, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error building metadata server request: %w", err)
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error requesting token from metadata server: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf(
+			"metadata server responded with status %d",
+			resp.StatusCode,
+		)
+	}
+	res := struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+		TokenType   string `json:"token_type"`
+	}{}
+	if err = json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return nil, fmt.Errorf("error decoding metadata server response: %w", err)
+	}
+	if res.AccessToken == "" {
+		return nil, fmt.Errorf("metadata server response did not include a token")
+	}
+	return &oauth2.Token{
+		AccessToken: res.AccessToken,
+		TokenType:   res.TokenType,
+		Expiry:      time.Now().Add(time.Duration(res.ExpiresIn) * time.Second),
+	}, nil
+}
+
+// getGCPKeyFileToken obtains an access token using the service account key
+// file at the specified path. The file is read each time a token is requested
+// so that a rotated key is picked up without a restart.
+func getGCPKeyFileToken(ctx context.Context, path string) (*oauth2.Token, error) {
+	keyBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading service account key file: %w", err)
+	}
+	key := struct {
+		ClientEmail  string `json:"client_email"`
+		PrivateKey   string `json:"private_key"`
+		PrivateKeyID string `json:"private_key_id"`
+		TokenURI     string `json:"token_uri"`
+	}{}
+	if err = json.Unmarshal(keyBytes, &key); err != nil {
+		return nil, fmt.Errorf("error parsing service account key file: %w", err)
+	}
+	cfg := &jwt.Config{
+		Email:        key.ClientEmail,
+		PrivateKey:   []byte(key.PrivateKey),
+		PrivateKeyID: key.PrivateKeyID,
+		Scopes:       []string{gcpCloudPlatformScope},
+		TokenURL:     key.TokenURI,
+	}
+	return cfg.TokenSource(ctx).Token()
+}
@@ -0,0 +1,223 @@
+package credentials
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestIsGCPRegistry(t *testing.T) {
+	testCases := []struct {
+		repoURL  string
+		expected bool
+	}{
+		{repoURL: "gcr.io/fake-project/fake-image", expected: true},
+		{repoURL: "eu.gcr.io/fake-project/fake-image", expected: true},
+		{repoURL: "us-docker.pkg.dev/fake-project/fake-repo/fake-image", expected: true},
+		{repoURL: "oci://europe-west1-docker.pkg.dev/fake-project/charts", expected: true},
+		{repoURL: "us-docker.pkg.dev:443/fake-project/fake-repo", expected: true},
+		{repoURL: "ghcr.io/akuity/kargo", expected: false},
+		{repoURL: "us-python.pkg.dev/fake-project/fake-repo", expected: false},
+		{repoURL: "fakegcr.io/fake-image", expected: false},
+		{repoURL: "nginx", expected: false},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.repoURL, func(t *testing.T) {
+			require.Equal(t, testCase.expected, isGCPRegistry(testCase.repoURL))
+		})
+	}
+}
+
+func TestGCPCredentialsProviderGet(t *testing.T) {
+	staticTokenFn := func(context.Context) (*oauth2.Token, error) {
+		return &oauth2.Token{AccessToken: "fake-token"}, nil
+	}
+	testCases := []struct {
+		name       string
+		provider   *gcpCredentialsProvider
+		project    string
+		repoURL    string
+		assertions func(*testing.T, Credentials, bool, error)
+	}{
+		{
+			name:     "not a GCP registry",
+			provider: &gcpCredentialsProvider{getTokenFn: staticTokenFn},
+			repoURL:  "ghcr.io/akuity/kargo",
+			assertions: func(t *testing.T, _ Credentials, ok bool, err error) {
+				require.NoError(t, err)
+				require.False(t, ok)
+			},
+		},
+		{
+			name: "project not permitted",
+			provider: &gcpCredentialsProvider{
+				projects:   []string{"fake-project"},
+				getTokenFn: staticTokenFn,
+			},
+			project: "another-project",
+			repoURL: "gcr.io/fake-project/fake-image",
+			assertions: func(t *testing.T, _ Credentials, ok bool, err error) {
+				require.NoError(t, err)
+				require.False(t, ok)
+			},
+		},
+		{
+			name: "error obtaining token",
+			provider: &gcpCredentialsProvider{
+				getTokenFn: func(context.Context) (*oauth2.Token, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			repoURL: "gcr.io/fake-project/fake-image",
+			assertions: func(t *testing.T, _ Credentials, ok bool, err error) {
+				require.ErrorContains(t, err, "error obtaining GCP access token")
+				require.ErrorContains(t, err, "something went wrong")
+				require.False(t, ok)
+			},
+		},
+		{
+			name: "success",
+			provider: &gcpCredentialsProvider{
+				projects:   []string{"fake-project"},
+				source:     "fake-source",
+				getTokenFn: staticTokenFn,
+			},
+			project: "fake-project",
+			repoURL: "us-docker.pkg.dev/fake-project/fake-repo/fake-image",
+			assertions: func(t *testing.T, creds Credentials, ok bool, err error) {
+				require.NoError(t, err)
+				require.True(t, ok)
+				require.Equal(
+					t,
+					Credentials{
+						Username: gcpAccessTokenUsername,
+						Password: "fake-token",
+						Source:   "fake-source",
+					},
+					creds,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			creds, ok, err := testCase.provider.get(
+				context.Background(),
+				testCase.project,
+				testCase.repoURL,
+			)
+			testCase.assertions(t, creds, ok, err)
+		})
+	}
+}
+
+func TestGCPCredentialsProviderCaching(t *testing.T) {
+	var requests int
+	provider := &gcpCredentialsProvider{
+		getTokenFn: func(context.Context) (*oauth2.Token, error) {
+			requests++
+			return &oauth2.Token{
+				AccessToken: fmt.Sprintf("fake-token-%d", requests),
+				Expiry:      time.Now().Add(time.Hour),
+			}, nil
+		},
+	}
+
+	// No token should be requested until one is needed
+	require.Zero(t, requests)
+
+	for i := 0; i < 3; i++ {
+		creds, ok, err := provider.get(context.Background(), "", "gcr.io/fake-project/fake-image")
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, "fake-token-1", creds.Password)
+	}
+	// The token should have been cached
+	require.Equal(t, 1, requests)
+
+	// A token that is about to expire should be replaced
+	provider.token.Expiry = time.Now().Add(time.Second)
+	creds, _, err := provider.get(context.Background(), "", "gcr.io/fake-project/fake-image")
+	require.NoError(t, err)
+	require.Equal(t, "fake-token-2", creds.Password)
+}
+
+func TestGetGCPMetadataToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Metadata-Flavor") != "Google" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			_, _ = w.Write(
+				[]byte(`{"access_token":"fake-token","expires_in":3600,"token_type":"Bearer"}`),
+			)
+		},
+	))
+	defer server.Close()
+
+	token, err := getGCPMetadataToken(context.Background(), server.Client(), server.URL)
+	require.NoError(t, err)
+	require.Equal(t, "fake-token", token.AccessToken)
+	require.Equal(t, "Bearer", token.TokenType)
+	require.WithinDuration(t, time.Now().Add(time.Hour), token.Expiry, time.Minute)
+
+	// The request should be bound to the caller's context
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = getGCPMetadataToken(ctx, server.Client(), server.URL)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestGetGCPMetadataTokenError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		},
+	))
+	defer server.Close()
+	_, err := getGCPMetadataToken(context.Background(), server.Client(), server.URL)
+	require.ErrorContains(t, err, "metadata server responded with status 404")
+}
+
+func TestGetFallsBackToProviders(t *testing.T) {
+	d := &kubernetesDatabase{
+		kargoClient: fake.NewClientBuilder().Build(),
+		providers: []credentialsProvider{&gcpCredentialsProvider{
+			getTokenFn: func(context.Context) (*oauth2.Token, error) {
+				return &oauth2.Token{
+					AccessToken: "fake-token",
+					Expiry:      time.Now().Add(time.Hour),
+				}, nil
+			},
+		}},
+	}
+
+	creds, ok, err := d.Get(
+		context.Background(),
+		"fake-namespace",
+		TypeImage,
+		"us-docker.pkg.dev/fake-project/fake-repo/fake-image",
+	)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "fake-token", creds.Password)
+
+	// Git repositories are never hosted in GCP registries
+	_, ok, err = d.Get(
+		context.Background(),
+		"fake-namespace",
+		TypeGit,
+		"https://gcr.io/fake-project/fake-repo.git",
+	)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
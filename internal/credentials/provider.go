@@ -0,0 +1,34 @@
+package credentials
+
+import (
+	"context"
+	"strings"
+)
+
+// credentialsProvider is an interface for components that can supply
+// credentials for some subset of repositories without those credentials
+// being stored in a Secret. Typically, these are short-lived credentials
+// obtained from a cloud provider.
+type credentialsProvider interface {
+	// get returns credentials for the specified repository on behalf of the
+	// specified Project. If the provider does not handle the repository or
+	// does not supply credentials to the Project, the boolean return value
+	// will be false.
+	get(ctx context.Context, project string, repoURL string) (Credentials, bool, error)
+}
+
+// registryHost extracts the lowercased hostname, without any port, from an
+// image or OCI chart repository URL.
+func registryHost(repoURL string) string {
+	host := repoURL
+	if i := strings.Index(host, "://"); i >= 0 {
+		host = host[i+3:]
+	}
+	if i := strings.Index(host, "/"); i >= 0 {
+		host = host[:i]
+	}
+	if i := strings.LastIndex(host, ":"); i >= 0 {
+		host = host[:i]
+	}
+	return strings.ToLower(host)
+}
@@ -0,0 +1,377 @@
+package credentials
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+
+	libHTTP "github.com/akuity/kargo/internal/http"
+)
+
+// ValidationStatus is a string type used to represent the outcome of
+// validating Credentials against the repository they are meant for.
+type ValidationStatus string
+
+const (
+	// ValidationStatusValid indicates the repository accepted the credentials.
+	ValidationStatusValid ValidationStatus = "Valid"
+	// ValidationStatusNotFound indicates no credentials were found for the
+	// repository.
+	ValidationStatusNotFound ValidationStatus = "NotFound"
+	// ValidationStatusAuthenticationFailed indicates the repository was
+	// reachable but rejected the credentials.
+	ValidationStatusAuthenticationFailed ValidationStatus = "AuthenticationFailed"
+	// ValidationStatusNetworkError indicates the repository could not be
+	// reached.
+	ValidationStatusNetworkError ValidationStatus = "NetworkError"
+	// ValidationStatusUnknown indicates the repository responded in an
+	// unexpected way or the credentials could not be validated at all.
+	ValidationStatusUnknown ValidationStatus = "Unknown"
+)
+
+const validationTimeout = 30 * time.Second
+
+// ValidationResult describes the outcome of validating Credentials.
+type ValidationResult struct {
+	// Status is the outcome of the validation.
+	Status ValidationStatus
+	// Message is a human-readable explanation of the outcome.
+	Message string
+	// Source describes where the validated credentials were obtained from. It
+	// is empty if no credentials were found.
+	Source string
+}
+
+// ValidationConfig represents configuration for validating Credentials.
+type ValidationConfig struct {
+	// AllowedNetworks lists the networks, in CIDR notation, in which
+	// repositories may be reached for validation even though they are not
+	// publicly routable. By default, loopback, link-local, and private
+	// addresses are off limits, since the repository URL is chosen by the
+	// caller and the outcome is reported back to them.
+	AllowedNetworks libHTTP.NetworkList `envconfig:"CREDENTIALS_VALIDATION_ALLOWED_NETWORKS"`
+	// AllowedTokenHosts lists the hosts, other than a registry itself, whose
+	// token services may be sent a registry's credentials when the registry
+	// names them in its authentication challenge.
+	AllowedTokenHosts []string `envconfig:"CREDENTIALS_VALIDATION_ALLOWED_TOKEN_HOSTS" default:"auth.docker.io"`
+}
+
+func ValidationConfigFromEnv() ValidationConfig {
+	cfg := ValidationConfig{}
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
+// Validator validates Credentials against the repositories they are meant
+// for.
+type Validator struct {
+	httpClient        *http.Client
+	allowedTokenHosts []string
+}
+
+// NewValidator returns a Validator that only reaches repositories at publicly
+// routable addresses or in the configured networks.
+func NewValidator(cfg ValidationConfig) *Validator {
+	allowedTokenHosts := make([]string, len(cfg.AllowedTokenHosts))
+	for i, host := range cfg.AllowedTokenHosts {
+		allowedTokenHosts[i] = strings.ToLower(strings.TrimSpace(host))
+	}
+	return &Validator{
+		httpClient: &http.Client{
+			Transport: libHTTP.NewPublicTransport(cfg.AllowedNetworks),
+		},
+		allowedTokenHosts: allowedTokenHosts,
+	}
+}
+
+// Validate looks up Credentials for the specified repository exactly as any
+// other consumer of the Database would and then makes a lightweight
+// authenticated request to the repository to determine whether they work.
+// A non-nil error is only returned if the credentials could not be looked up.
+// Problems reaching or authenticating to the repository are instead reflected
+// in the returned ValidationResult.
+func (v *Validator) Validate(
+	ctx context.Context,
+	db Database,
+	namespace string,
+	credType Type,
+	repoURL string,
+) (ValidationResult, error) {
+	creds, ok, err := db.Get(ctx, namespace, credType, repoURL)
+	if err != nil {
+		return ValidationResult{}, fmt.Errorf(
+			"error obtaining credentials for %s: %w",
+			repoURL,
+			err,
+		)
+	}
+	if !ok {
+		return ValidationResult{
+			Status:  ValidationStatusNotFound,
+			Message: fmt.Sprintf("no %s credentials found for %s", credType, repoURL),
+		}, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, validationTimeout)
+	defer cancel()
+	res := probe(ctx, v.httpClient, v.allowedTokenHosts, credType, repoURL, creds)
+	res.Source = creds.Source
+	return res, nil
+}
+
+// probe makes a lightweight authenticated request appropriate to the type of
+// the specified repository.
+func probe(
+	ctx context.Context,
+	httpClient *http.Client,
+	allowedTokenHosts []string,
+	credType Type,
+	repoURL string,
+	creds Credentials,
+) ValidationResult {
+	switch credType {
+	case TypeGit:
+		if !strings.HasPrefix(repoURL, "https://") {
+			return ValidationResult{
+				Status:  ValidationStatusUnknown,
+				Message: "only credentials for Git repositories accessed via HTTPS can be validated",
+			}
+		}
+		return probeHTTP(
+			ctx,
+			httpClient,
+			strings.TrimSuffix(repoURL, "/")+"/info/refs?service=git-upload-pack",
+			creds,
+		)
+	case TypeHelm:
+		if strings.HasPrefix(repoURL, "oci://") {
+			return probeRegistry(ctx, httpClient, allowedTokenHosts, repoURL, creds)
+		}
+		return probeHTTP(
+			ctx,
+			httpClient,
+			strings.TrimSuffix(repoURL, "/")+"/index.yaml",
+			creds,
+		)
+	case TypeImage:
+		return probeRegistry(ctx, httpClient, allowedTokenHosts, repoURL, creds)
+	case TypeHTTP:
+		return probeHTTP(ctx, httpClient, repoURL, creds)
+	default:
+		return ValidationResult{
+			Status:  ValidationStatusUnknown,
+			Message: fmt.Sprintf("unsupported credential type %q", credType),
+		}
+	}
+}
+
+// probeHTTP sends a GET request to the specified URL using basic auth.
+func probeHTTP(
+	ctx context.Context,
+	httpClient *http.Client,
+	target string,
+	creds Credentials,
+) ValidationResult {
+	resp, res, ok := doProbeRequest(ctx, httpClient, target, creds)
+	if !ok {
+		return res
+	}
+	defer resp.Body.Close()
+	return resultFromStatus(target, resp.StatusCode)
+}
+
+// probeRegistry validates credentials against an OCI distribution registry
+// by requesting a pull token for the repository from the registry's token
+// service or, if the registry uses basic auth directly, by authenticating
+// against its API root. Credentials are only sent to a token service that is
+// reached via HTTPS and that is hosted by the registry itself or by one of
+// the allowed token hosts.
+func probeRegistry(
+	ctx context.Context,
+	httpClient *http.Client,
+	allowedTokenHosts []string,
+	repoURL string,
+	creds Credentials,
+) ValidationResult {
+	host, repo := splitRegistryRepo(repoURL)
+	apiRoot := fmt.Sprintf("https://%s/v2/", host)
+
+	// Make an anonymous request first to discover how the registry
+	// authenticates clients.
+	resp, res, ok := doProbeRequest(ctx, httpClient, apiRoot, Credentials{})
+	if !ok {
+		return res
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return ValidationResult{
+			Status: ValidationStatusValid,
+			Message: fmt.Sprintf(
+				"registry %s does not require authentication",
+				host,
+			),
+		}
+	}
+	if resp.StatusCode != http.StatusUnauthorized {
+		return resultFromStatus(apiRoot, resp.StatusCode)
+	}
+
+	challenge := resp.Header.Get("WWW-Authenticate")
+	scheme, params := parseAuthChallenge(challenge)
+	switch scheme {
+	case "basic":
+		return probeHTTP(ctx, httpClient, apiRoot, creds)
+	case "bearer":
+		realm := params["realm"]
+		if realm == "" {
+			break
+		}
+		tokenURL, err := url.Parse(realm)
+		if err != nil {
+			break
+		}
+		if tokenURL.Scheme != "https" {
+			return ValidationResult{
+				Status: ValidationStatusUnknown,
+				Message: fmt.Sprintf(
+					"registry %s named token service %s, which does not use HTTPS; "+
+						"refusing to send it credentials",
+					host,
+					redactURL(realm),
+				),
+			}
+		}
+		tokenHost := strings.ToLower(tokenURL.Hostname())
+		if tokenHost != strings.ToLower((&url.URL{Host: host}).Hostname()) &&
+			!slices.Contains(allowedTokenHosts, tokenHost) {
+			return ValidationResult{
+				Status: ValidationStatusUnknown,
+				Message: fmt.Sprintf(
+					"registry %s named token service %s on another host that is "+
+						"not allowed; refusing to send it credentials",
+					host,
+					redactURL(realm),
+				),
+			}
+		}
+		q := tokenURL.Query()
+		if service := params["service"]; service != "" {
+			q.Set("service", service)
+		}
+		q.Set("scope", fmt.Sprintf("repository:%s:pull", repo))
+		tokenURL.RawQuery = q.Encode()
+		return probeHTTP(ctx, httpClient, tokenURL.String(), creds)
+	}
+	return ValidationResult{
+		Status: ValidationStatusUnknown,
+		Message: fmt.Sprintf(
+			"registry %s responded with an unsupported authentication challenge %q",
+			host,
+			challenge,
+		),
+	}
+}
+
+func doProbeRequest(
+	ctx context.Context,
+	httpClient *http.Client,
+	target string,
+	creds Credentials,
+) (*http.Response, ValidationResult, bool) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, ValidationResult{
+			Status:  ValidationStatusUnknown,
+			Message: fmt.Sprintf("error building request for %s: %s", redactURL(target), err),
+		}, false
+	}
+	if creds.Username != "" || creds.Password != "" {
+		req.SetBasicAuth(creds.Username, creds.Password)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, ValidationResult{
+			Status:  ValidationStatusNetworkError,
+			Message: fmt.Sprintf("error reaching %s: %s", redactURL(target), err),
+		}, false
+	}
+	return resp, ValidationResult{}, true
+}
+
+func resultFromStatus(target string, statusCode int) ValidationResult {
+	target = redactURL(target)
+	switch {
+	case statusCode >= 200 && statusCode < 300:
+		return ValidationResult{
+			Status:  ValidationStatusValid,
+			Message: fmt.Sprintf("%s accepted the credentials", target),
+		}
+	case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden:
+		return ValidationResult{
+			Status: ValidationStatusAuthenticationFailed,
+			Message: fmt.Sprintf(
+				"%s rejected the credentials with status %d",
+				target,
+				statusCode,
+			),
+		}
+	default:
+		return ValidationResult{
+			Status:  ValidationStatusUnknown,
+			Message: fmt.Sprintf("%s responded with unexpected status %d", target, statusCode),
+		}
+	}
+}
+
+// redactURL strips the query string from a URL so that it is safe to include
+// in messages.
+func redactURL(target string) string {
+	if i := strings.Index(target, "?"); i >= 0 {
+		return target[:i]
+	}
+	return target
+}
+
+// splitRegistryRepo splits an image or OCI chart repository URL into the
+// hostname of its registry and the path of the repository within it. Images
+// without an explicit registry are assumed to be hosted on Docker Hub.
+func splitRegistryRepo(repoURL string) (string, string) {
+	repoURL = strings.TrimPrefix(repoURL, "oci://")
+	host, repo, found := strings.Cut(repoURL, "/")
+	if !found ||
+		(!strings.ContainsAny(host, ".:") && host != "localhost") {
+		host, repo = "registry-1.docker.io", repoURL
+		if !strings.Contains(repo, "/") {
+			repo = "library/" + repo
+		}
+	}
+	if host == "docker.io" || host == "index.docker.io" {
+		host = "registry-1.docker.io"
+	}
+	return host, repo
+}
+
+// parseAuthChallenge parses a WWW-Authenticate header value into its
+// lowercased scheme and its parameters.
+func parseAuthChallenge(challenge string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(challenge), " ")
+	params := map[string]string{}
+	for rest != "" {
+		var key, value string
+		key, rest, _ = strings.Cut(strings.TrimLeft(rest, " ,"), "=")
+		if strings.HasPrefix(rest, `"`) {
+			value, rest, _ = strings.Cut(rest[1:], `"`)
+		} else {
+			value, rest, _ = strings.Cut(rest, ",")
+		}
+		if key = strings.ToLower(strings.TrimSpace(key)); key != "" {
+			params[key] = value
+		}
+	}
+	return strings.ToLower(scheme), params
+}
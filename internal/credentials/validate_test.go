@@ -0,0 +1,375 @@
+package credentials
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		db         Database
+		assertions func(*testing.T, ValidationResult, error)
+	}{
+		{
+			name: "error getting credentials",
+			db: &FakeDB{
+				GetFn: func(context.Context, string, Type, string) (Credentials, bool, error) {
+					return Credentials{}, false, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ ValidationResult, err error) {
+				require.ErrorContains(t, err, "error obtaining credentials")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "credentials not found",
+			db:   &FakeDB{},
+			assertions: func(t *testing.T, res ValidationResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, ValidationStatusNotFound, res.Status)
+				require.Empty(t, res.Source)
+			},
+		},
+		{
+			name: "credentials found",
+			db: &FakeDB{
+				GetFn: func(context.Context, string, Type, string) (Credentials, bool, error) {
+					return Credentials{Source: "fake-source"}, true, nil
+				},
+			},
+			assertions: func(t *testing.T, res ValidationResult, err error) {
+				require.NoError(t, err)
+				// SSH URLs can't be validated, which conveniently means no request
+				// is made
+				require.Equal(t, ValidationStatusUnknown, res.Status)
+				require.Equal(t, "fake-source", res.Source)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res, err := NewValidator(ValidationConfig{}).Validate(
+				context.Background(),
+				testCase.db,
+				"fake-namespace",
+				TypeGit,
+				"ssh://git@github.com/akuity/kargo.git",
+			)
+			testCase.assertions(t, res, err)
+		})
+	}
+}
+
+func TestProbe(t *testing.T) {
+	const (
+		testUsername = "fake-user"
+		testPassword = "fake-password"
+	)
+	authorized := func(r *http.Request) bool {
+		username, password, ok := r.BasicAuth()
+		return ok && username == testUsername && password == testPassword
+	}
+
+	server := httptest.NewTLSServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.URL.Path == "/akuity/kargo/info/refs",
+				r.URL.Path == "/charts/index.yaml",
+				r.URL.Path == "/basic/v2/":
+				if !authorized(r) {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+			case r.URL.Path == "/v2/":
+				w.Header().Set(
+					"WWW-Authenticate",
+					fmt.Sprintf(
+						`Bearer realm="https://%s/token",service="fake-registry"`,
+						r.Host,
+					),
+				)
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			case r.URL.Path == "/token":
+				if r.URL.Query().Get("service") != "fake-registry" ||
+					r.URL.Query().Get("scope") != "repository:fake/image:pull" {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				if !authorized(r) {
+					w.WriteHeader(http.StatusForbidden)
+					return
+				}
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte("{}"))
+		},
+	))
+	defer server.Close()
+	host := strings.TrimPrefix(server.URL, "https://")
+
+	goodCreds := Credentials{Username: testUsername, Password: testPassword}
+	badCreds := Credentials{Username: testUsername, Password: "wrong"}
+
+	testCases := []struct {
+		name     string
+		credType Type
+		repoURL  string
+		creds    Credentials
+		expected ValidationStatus
+	}{
+		{
+			name:     "git valid",
+			credType: TypeGit,
+			repoURL:  server.URL + "/akuity/kargo",
+			creds:    goodCreds,
+			expected: ValidationStatusValid,
+		},
+		{
+			name:     "git rejected",
+			credType: TypeGit,
+			repoURL:  server.URL + "/akuity/kargo",
+			creds:    badCreds,
+			expected: ValidationStatusAuthenticationFailed,
+		},
+		{
+			name:     "git repository not found",
+			credType: TypeGit,
+			repoURL:  server.URL + "/akuity/bogus",
+			creds:    goodCreds,
+			expected: ValidationStatusUnknown,
+		},
+		{
+			name:     "helm classic repository valid",
+			credType: TypeHelm,
+			repoURL:  server.URL + "/charts",
+			creds:    goodCreds,
+			expected: ValidationStatusValid,
+		},
+		{
+			name:     "helm classic repository rejected",
+			credType: TypeHelm,
+			repoURL:  server.URL + "/charts/",
+			creds:    badCreds,
+			expected: ValidationStatusAuthenticationFailed,
+		},
+		{
+			name:     "image valid",
+			credType: TypeImage,
+			repoURL:  host + "/fake/image",
+			creds:    goodCreds,
+			expected: ValidationStatusValid,
+		},
+		{
+			name:     "OCI chart rejected",
+			credType: TypeHelm,
+			repoURL:  "oci://" + host + "/fake/image",
+			creds:    badCreds,
+			expected: ValidationStatusAuthenticationFailed,
+		},
+		{
+			name:     "network error",
+			credType: TypeImage,
+			repoURL:  "127.0.0.1:1/fake/image",
+			creds:    goodCreds,
+			expected: ValidationStatusNetworkError,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res := probe(
+				context.Background(),
+				server.Client(),
+				nil,
+				testCase.credType,
+				testCase.repoURL,
+				testCase.creds,
+			)
+			require.Equal(t, testCase.expected, res.Status, res.Message)
+			require.NotContains(t, res.Message, testPassword)
+		})
+	}
+}
+
+func TestProbeRegistryBasicAuth(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if username, _, ok := r.BasicAuth(); !ok || username != "fake-user" {
+				w.Header().Set("WWW-Authenticate", `Basic realm="fake"`)
+				w.WriteHeader(http.StatusUnauthorized)
+			}
+		},
+	))
+	defer server.Close()
+	res := probeRegistry(
+		context.Background(),
+		server.Client(),
+		nil,
+		strings.TrimPrefix(server.URL, "https://")+"/fake/image",
+		Credentials{Username: "fake-user"},
+	)
+	require.Equal(t, ValidationStatusValid, res.Status)
+}
+
+func TestProbeRegistryTokenService(t *testing.T) {
+	var credentialsSent bool
+	tokenServer := httptest.NewTLSServer(http.HandlerFunc(
+		func(_ http.ResponseWriter, r *http.Request) {
+			_, _, credentialsSent = r.BasicAuth()
+		},
+	))
+	defer tokenServer.Close()
+
+	var realm string
+	registry := httptest.NewTLSServer(http.HandlerFunc(
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm=%q`, realm))
+			w.WriteHeader(http.StatusUnauthorized)
+		},
+	))
+	defer registry.Close()
+	// Both servers listen on 127.0.0.1, so the token service is told apart from
+	// the registry by name.
+	_, tokenServerPort, err := net.SplitHostPort(
+		strings.TrimPrefix(tokenServer.URL, "https://"),
+	)
+	require.NoError(t, err)
+	httpClient := registry.Client()
+	httpClient.Transport.(*http.Transport).DialContext = func( // nolint: forcetypeassert
+		ctx context.Context,
+		network string,
+		addr string,
+	) (net.Conn, error) {
+		if strings.HasPrefix(addr, "token.example.com:") {
+			addr = "127.0.0.1:" + tokenServerPort
+		}
+		return (&net.Dialer{}).DialContext(ctx, network, addr)
+	}
+	tokenServerURL := "https://token.example.com:" + tokenServerPort + "/token"
+
+	testCases := []struct {
+		name              string
+		realm             string
+		allowedTokenHosts []string
+		assertions        func(*testing.T, ValidationResult)
+	}{
+		{
+			name:  "token service does not use HTTPS",
+			realm: "http://token.example.com:" + tokenServerPort + "/token",
+			assertions: func(t *testing.T, res ValidationResult) {
+				require.Equal(t, ValidationStatusUnknown, res.Status)
+				require.Contains(t, res.Message, "does not use HTTPS")
+			},
+		},
+		{
+			name:  "token service on another host",
+			realm: tokenServerURL,
+			assertions: func(t *testing.T, res ValidationResult) {
+				require.Equal(t, ValidationStatusUnknown, res.Status)
+				require.Contains(t, res.Message, "not allowed")
+			},
+		},
+		{
+			name:              "token service on an allowed host",
+			realm:             tokenServerURL,
+			allowedTokenHosts: []string{"token.example.com"},
+			assertions: func(t *testing.T, res ValidationResult) {
+				require.Equal(t, ValidationStatusValid, res.Status, res.Message)
+				require.True(t, credentialsSent)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			realm = testCase.realm
+			credentialsSent = false
+			res := probeRegistry(
+				context.Background(),
+				httpClient,
+				testCase.allowedTokenHosts,
+				strings.TrimPrefix(registry.URL, "https://")+"/fake/image",
+				Credentials{Username: "fake-user", Password: "fake-password"},
+			)
+			testCase.assertions(t, res)
+			if res.Status != ValidationStatusValid {
+				require.False(t, credentialsSent)
+			}
+		})
+	}
+}
+
+func TestValidatorRefusesNonPublicAddresses(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(
+		func(http.ResponseWriter, *http.Request) {},
+	))
+	defer server.Close()
+	res, err := NewValidator(ValidationConfig{}).Validate(
+		context.Background(),
+		&FakeDB{
+			GetFn: func(context.Context, string, Type, string) (Credentials, bool, error) {
+				return Credentials{Username: "fake-user"}, true, nil
+			},
+		},
+		"fake-namespace",
+		TypeHTTP,
+		server.URL,
+	)
+	require.NoError(t, err)
+	require.Equal(t, ValidationStatusNetworkError, res.Status)
+	require.Contains(t, res.Message, "connections to non-public address 127.0.0.1 are not permitted")
+}
+
+func TestSplitRegistryRepo(t *testing.T) {
+	testCases := []struct {
+		repoURL      string
+		expectedHost string
+		expectedRepo string
+	}{
+		{"nginx", "registry-1.docker.io", "library/nginx"},
+		{"akuity/kargo", "registry-1.docker.io", "akuity/kargo"},
+		{"docker.io/akuity/kargo", "registry-1.docker.io", "akuity/kargo"},
+		{"ghcr.io/akuity/kargo", "ghcr.io", "akuity/kargo"},
+		{"oci://ghcr.io/akuity/kargo-charts/kargo", "ghcr.io", "akuity/kargo-charts/kargo"},
+		{"localhost:5000/fake/image", "localhost:5000", "fake/image"},
+		{"localhost/fake/image", "localhost", "fake/image"},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.repoURL, func(t *testing.T) {
+			host, repo := splitRegistryRepo(testCase.repoURL)
+			require.Equal(t, testCase.expectedHost, host)
+			require.Equal(t, testCase.expectedRepo, repo)
+		})
+	}
+}
+
+func TestParseAuthChallenge(t *testing.T) {
+	scheme, params := parseAuthChallenge(
+		`Bearer realm="https://auth.docker.io/token",service="registry.docker.io",scope="repository:a/b:pull"`,
+	)
+	require.Equal(t, "bearer", scheme)
+	require.Equal(
+		t,
+		map[string]string{
+			"realm":   "https://auth.docker.io/token",
+			"service": "registry.docker.io",
+			"scope":   "repository:a/b:pull",
+		},
+		params,
+	)
+
+	scheme, params = parseAuthChallenge(`Basic realm="Registry Realm"`)
+	require.Equal(t, "basic", scheme)
+	require.Equal(t, map[string]string{"realm": "Registry Realm"}, params)
+}
@@ -32,6 +32,12 @@ type CollectorConfig struct {
 	// MinPromotionDeletionAge specifies the minimum age Promotions must be before
 	// considered eligible for garbage collection.
 	MinPromotionDeletionAge time.Duration `envconfig:"MIN_PROMOTION_DELETION_AGE" default:"336h"` // 2 weeks
+	// MaxPromotionAge optionally specifies an age beyond which Promotions OLDER
+	// than the oldest in a non-terminal state (associated with each Stage) are
+	// deleted, even if that would leave fewer than MaxRetainedPromotions such
+	// Promotions. A value of zero, the default, disables this. Promotions
+	// younger than MinPromotionDeletionAge are always spared.
+	MaxPromotionAge time.Duration `envconfig:"MAX_PROMOTION_AGE" default:"0"`
 	// MaxRetainedFreight specifies the ideal maximum number of Freight OLDER than
 	// the oldest still in use (from each Warehouse) that may be spared by the
 	// garbage collector. The ACTUAL number of older Freight spared may exceed
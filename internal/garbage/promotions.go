@@ -17,8 +17,12 @@ import (
 // cleanProjectPromotions steps through all Stages in the specified Project and,
 // for each, deletes all Promotions meeting the following criteria:
 //   - More than some configurable number of generations older than the oldest
-//     Promotion (from the same Stage) in a non-terminal phase.
+//     Promotion (from the same Stage) in a non-terminal phase, OR older than
+//     that Promotion and older than some optional, configurable maximum age.
 //   - Older than some configurable minimum age.
+//
+// Note that the outcomes of recent Promotions remain recorded in each Stage's
+// status after the Promotions themselves have been deleted.
 func (c *collector) cleanProjectPromotions(ctx context.Context, project string) error {
 	logger := logging.LoggerFromContext(ctx).WithField("project", project)
 
@@ -79,7 +83,7 @@ func (c *collector) cleanStagePromotions(
 		)
 	}
 
-	if len(promos.Items) <= c.cfg.MaxRetainedPromotions {
+	if len(promos.Items) <= c.cfg.MaxRetainedPromotions && c.cfg.MaxPromotionAge == 0 {
 		return nil // Done
 	}
 
@@ -95,16 +99,21 @@ func (c *collector) cleanStagePromotions(
 	}
 
 	firstToDeleteIndex := oldestNonTerminalIndex + c.cfg.MaxRetainedPromotions + 1
-	if firstToDeleteIndex >= len(promos.Items) {
+	if firstToDeleteIndex >= len(promos.Items) && c.cfg.MaxPromotionAge == 0 {
 		return nil // Done
 	}
 
 	var deleteErrCount int
-	for i := firstToDeleteIndex; i < len(promos.Items); i++ {
+	for i := oldestNonTerminalIndex + 1; i < len(promos.Items); i++ {
 		promo := promos.Items[i]
-		if time.Since(promo.CreationTimestamp.Time) < c.cfg.MinPromotionDeletionAge {
+		age := time.Since(promo.CreationTimestamp.Time)
+		if age < c.cfg.MinPromotionDeletionAge {
 			continue // Not old enough
 		}
+		if i < firstToDeleteIndex &&
+			(c.cfg.MaxPromotionAge == 0 || age < c.cfg.MaxPromotionAge) {
+			continue // Retained
+		}
 		promoLogger := logger.WithField("promotion", promo.Name)
 		if err := c.deletePromotionFn(ctx, &promo); err != nil {
 			promoLogger.Errorf("error deleting Promotion: %s", err)
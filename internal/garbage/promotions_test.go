@@ -3,6 +3,7 @@ package garbage
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -231,6 +232,77 @@ func TestCleanStagePromotions(t *testing.T) {
 				require.NoError(t, err)
 			},
 		},
+		{
+			name: "Promotions older than max age are deleted",
+			collector: &collector{
+				cfg: CollectorConfig{
+					MaxRetainedPromotions:   5,
+					MinPromotionDeletionAge: time.Minute,
+					MaxPromotionAge:         90 * time.Minute,
+				},
+				listPromotionsFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					promos, ok := objList.(*kargoapi.PromotionList)
+					require.True(t, ok)
+					now := metav1.Now()
+					promos.Items = []kargoapi.Promotion{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:              "newer-than-running",
+								CreationTimestamp: metav1.NewTime(now.Add(-10 * time.Minute)),
+							},
+							Status: kargoapi.PromotionStatus{
+								Phase: kargoapi.PromotionPhaseSucceeded,
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:              "running",
+								CreationTimestamp: metav1.NewTime(now.Add(-30 * time.Minute)),
+							},
+							Status: kargoapi.PromotionStatus{
+								Phase: kargoapi.PromotionPhaseRunning,
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:              "younger-than-max-age",
+								CreationTimestamp: metav1.NewTime(now.Add(-1 * time.Hour)),
+							},
+							Status: kargoapi.PromotionStatus{
+								Phase: kargoapi.PromotionPhaseSucceeded,
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:              "older-than-max-age",
+								CreationTimestamp: metav1.NewTime(now.Add(-4 * time.Hour)),
+							},
+							Status: kargoapi.PromotionStatus{
+								Phase: kargoapi.PromotionPhaseFailed,
+							},
+						},
+					}
+					return nil
+				},
+				deletePromotionFn: func(
+					_ context.Context,
+					obj client.Object,
+					_ ...client.DeleteOption,
+				) error {
+					if obj.GetName() != "older-than-max-age" {
+						return fmt.Errorf("unexpected deletion of Promotion %q", obj.GetName())
+					}
+					return nil
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -11,6 +11,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	"github.com/akuity/kargo/internal/gitprovider"
+	"github.com/akuity/kargo/internal/logging"
 )
 
 const (
@@ -66,6 +67,10 @@ func (g *GitHubProvider) CreatePullRequest(
 		return nil, err
 	}
 
+	body := opts.Description
+	if body == "" {
+		body = opts.Title
+	}
 	ghPR, _, err := g.client.PullRequests.Create(ctx,
 		owner,
 		repo,
@@ -73,13 +78,31 @@ func (g *GitHubProvider) CreatePullRequest(
 			Title:               &opts.Title,
 			Head:                &opts.Head,
 			Base:                &opts.Base,
-			Body:                &opts.Title,
+			Body:                &body,
 			MaintainerCanModify: github.Bool(false),
 		},
 	)
 	if err != nil {
 		return nil, err
 	}
+	if len(opts.Labels) > 0 {
+		// GitHub treats pull requests as issues where labels are concerned.
+		// Failing to label the pull request must not fail its creation, since
+		// the pull request already exists and would otherwise be lost track of.
+		if _, _, err = g.client.Issues.AddLabelsToIssue(
+			ctx,
+			owner,
+			repo,
+			ghPR.GetNumber(),
+			opts.Labels,
+		); err != nil {
+			logging.LoggerFromContext(ctx).Errorf(
+				"error adding labels to pull request %d: %v",
+				ghPR.GetNumber(),
+				err,
+			)
+		}
+	}
 	return convertGithubPR(ghPR), nil
 }
 
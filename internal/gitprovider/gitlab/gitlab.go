@@ -95,13 +95,22 @@ func (g *GitLabProvider) CreatePullRequest(
 		return nil, err
 	}
 
-	glMR, _, err := g.client.MergeRequests.CreateMergeRequest(projectName, &gitlab.CreateMergeRequestOptions{
+	description := opts.Description
+	if description == "" {
+		description = opts.Title
+	}
+	createOpts := &gitlab.CreateMergeRequestOptions{
 		Title:              &opts.Title,
-		Description:        &opts.Description,
+		Description:        &description,
 		SourceBranch:       &opts.Head,
 		TargetBranch:       &opts.Base,
 		RemoveSourceBranch: gitlab.Ptr(true),
-	})
+	}
+	if len(opts.Labels) > 0 {
+		labels := gitlab.LabelOptions(opts.Labels)
+		createOpts.Labels = &labels
+	}
+	glMR, _, err := g.client.MergeRequests.CreateMergeRequest(projectName, createOpts)
 	if err != nil {
 		return nil, err
 	}
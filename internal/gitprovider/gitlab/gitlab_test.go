@@ -72,11 +72,24 @@ func TestCreatePullRequest(t *testing.T) {
 	require.Equal(t, opts.Base, *mockClient.createOpts.TargetBranch)
 	require.Equal(t, opts.Title, *mockClient.createOpts.Title)
 	require.Equal(t, opts.Description, *mockClient.createOpts.Description)
+	require.Nil(t, mockClient.createOpts.Labels)
 
 	require.Equal(t, int64(mockClient.mr.IID), pr.Number)
 	require.Equal(t, mockClient.mr.MergeCommitSHA, pr.MergeCommitSHA)
 	require.Equal(t, mockClient.mr.WebURL, pr.URL)
 	require.Equal(t, gitprovider.PullRequestStateClosed, pr.State)
+
+	opts.Labels = []string{"kargo", "promotion"}
+	_, err = g.CreatePullRequest(context.Background(), "https://gitlab.com/group/project.git", opts)
+	require.NoError(t, err)
+	require.NotNil(t, mockClient.createOpts.Labels)
+	require.Equal(t, gitlab.LabelOptions{"kargo", "promotion"}, *mockClient.createOpts.Labels)
+
+	// An empty description falls back to the title
+	opts.Description = ""
+	_, err = g.CreatePullRequest(context.Background(), "https://gitlab.com/group/project.git", opts)
+	require.NoError(t, err)
+	require.Equal(t, opts.Title, *mockClient.createOpts.Description)
 }
 
 func TestGetPullRequest(t *testing.T) {
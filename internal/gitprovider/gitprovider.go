@@ -28,6 +28,8 @@ type CreatePullRequestOpts struct {
 	Base        string
 	Title       string
 	Description string
+	// Labels are applied to the pull request after it has been created
+	Labels []string
 }
 
 type ListPullRequestOpts struct {
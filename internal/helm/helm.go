@@ -2,21 +2,39 @@ package helm
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
 	"os/exec"
+	"regexp"
 	"sort"
 	"strings"
+	"sync/atomic"
 
 	"github.com/Masterminds/semver/v3"
+	"github.com/hashicorp/go-cleanhttp"
 	"gopkg.in/yaml.v3"
 	"oras.land/oras-go/pkg/registry"
 	"oras.land/oras-go/pkg/registry/remote"
 	"oras.land/oras-go/pkg/registry/remote/auth"
 
 	libExec "github.com/akuity/kargo/internal/exec"
+	libHTTP "github.com/akuity/kargo/internal/http"
+	"github.com/akuity/kargo/internal/repocache"
+)
+
+// SelectionMode represents which of the chart versions that satisfy a semver
+// constraint should be selected.
+type SelectionMode string
+
+const (
+	// SelectionModeNewest selects the semantically greatest eligible version.
+	// This is the default.
+	SelectionModeNewest SelectionMode = "Newest"
+	// SelectionModeOldest selects the semantically least eligible version.
+	SelectionModeOldest SelectionMode = "Oldest"
 )
 
 // SelectChartVersion connects to the Helm chart repository specified by
@@ -29,29 +47,61 @@ import (
 // specific chart and the name argument must be empty. If no semverConstraint is
 // provided (empty string is passed), then the version that is semantically
 // greatest will be returned. If a semverConstraint is specified, then the
-// semantically greatest version satisfying that constraint will be returned. If
-// no version satisfies the constraint, the empty string is returned. Provided
-// credentials may be nil for public repositories, but must be non-nil for
-// private repositories.
+// semantically greatest version satisfying that constraint will be returned.
+// If mode is SelectionModeOldest, the semantically least version (satisfying
+// the semverConstraint, if any) is returned instead. Prerelease versions only
+// satisfy a semverConstraint if allowPrereleases is true. If no version
+// satisfies the constraint, the empty string is returned. If a non-nil filter
+// is provided, only versions for which it returns true are considered at all.
+// Provided credentials may be nil for public repositories, but must be non-nil
+// for private repositories. If insecureSkipTLSVerify is true, the repository's
+// certificate is not verified. Otherwise, if a non-empty PEM-encoded CA bundle
+// is provided, the certificates it contains are trusted in addition to the
+// system's root certificates. If a non-nil
+// cache is provided, available versions are retrieved from it when possible
+// instead of from the repository itself.
 func SelectChartVersion(
 	ctx context.Context,
 	repoURL string,
 	chart string,
 	semverConstraint string,
+	allowPrereleases bool,
+	mode SelectionMode,
+	filter func(version string) bool,
 	creds *Credentials,
+	insecureSkipTLSVerify bool,
+	caBundle string,
+	cache *repocache.Cache,
 ) (string, error) {
-	var versions []string
-	var err error
+	tlsConfig, err := libHTTP.NewTLSConfig(insecureSkipTLSVerify, caBundle)
+	if err != nil {
+		return "", fmt.Errorf("error configuring TLS for repository %q: %w", repoURL, err)
+	}
+	httpClient := newHTTPClient(tlsConfig)
+	var listFn func(context.Context) ([]string, error)
 	if strings.HasPrefix(repoURL, "http://") ||
 		strings.HasPrefix(repoURL, "https://") {
-		versions, err =
-			getChartVersionsFromClassicRepo(repoURL, chart, creds)
+		listFn = func(ctx context.Context) ([]string, error) {
+			return getChartVersionsFromClassicRepo(ctx, httpClient, repoURL, chart, creds)
+		}
 	} else if strings.HasPrefix(repoURL, "oci://") {
-		versions, err =
-			getChartVersionsFromOCIRepo(ctx, repoURL, creds)
+		listFn = func(ctx context.Context) ([]string, error) {
+			return getChartVersionsFromOCIRepo(ctx, httpClient, repoURL, creds, newOCIRepository)
+		}
 	} else {
 		return "", fmt.Errorf("repository URL %q is invalid", repoURL)
 	}
+	var username, password string
+	if creds != nil {
+		username, password = creds.Username, creds.Password
+	}
+	// A classic repository serves many charts, so the chart name is part of
+	// what identifies the listing.
+	versions, err := cache.Get(
+		ctx,
+		repocache.Key(repoURL+"#"+chart, username, password, insecureSkipTLSVerify, caBundle),
+		listFn,
+	)
 	if err != nil {
 		return "", fmt.Errorf(
 			"error retrieving versions of chart %q from repository %q: %w",
@@ -60,37 +110,83 @@ func SelectChartVersion(
 			err,
 		)
 	}
-	latestVersion, err := getLatestVersion(versions, semverConstraint)
-	if err != nil {
-		return "", fmt.Errorf(
-			"error determining latest version of chart %q from repository %q: %w",
-			chart,
-			repoURL,
-			err,
-		)
+	versions = filterVersions(versions, filter)
+	switch mode {
+	case SelectionModeNewest, "":
+		latestVersion, err := getLatestVersion(versions, semverConstraint, allowPrereleases)
+		if err != nil {
+			return "", fmt.Errorf(
+				"error determining latest version of chart %q from repository %q: %w",
+				chart,
+				repoURL,
+				err,
+			)
+		}
+		return latestVersion, nil
+	case SelectionModeOldest:
+		oldestVersion, err := getOldestVersion(versions, semverConstraint, allowPrereleases)
+		if err != nil {
+			return "", fmt.Errorf(
+				"error determining oldest version of chart %q from repository %q: %w",
+				chart,
+				repoURL,
+				err,
+			)
+		}
+		return oldestVersion, nil
+	default:
+		return "", fmt.Errorf("invalid chart version selection mode %q", mode)
 	}
-	return latestVersion, nil
+}
+
+// newHTTPClient returns an HTTP client that uses the provided TLS
+// configuration. If the configuration is nil, http.DefaultClient is returned.
+func newHTTPClient(tlsConfig *tls.Config) *http.Client {
+	if tlsConfig == nil {
+		return http.DefaultClient
+	}
+	transport := cleanhttp.DefaultTransport()
+	transport.TLSClientConfig = tlsConfig
+	return &http.Client{Transport: transport}
+}
+
+// filterVersions returns those of the provided versions for which the provided
+// filter returns true. If the filter is nil, the versions are returned as is.
+func filterVersions(versions []string, filter func(string) bool) []string {
+	if filter == nil {
+		return versions
+	}
+	filtered := make([]string, 0, len(versions))
+	for _, version := range versions {
+		if filter(version) {
+			filtered = append(filtered, version)
+		}
+	}
+	return filtered
 }
 
 // getChartVersionsFromClassicRepo connects to the classic (HTTP/S) chart
 // repository specified by repoURL and retrieves all available versions of the
 // specified chart. The provided repoURL MUST begin with protocol http:// or
 // https://. Provided credentials may be nil for public repositories, but must
-// be non-nil for private repositories.
+// be non-nil for private repositories. Requests are made using the provided
+// HTTP client.
 func getChartVersionsFromClassicRepo(
+	ctx context.Context,
+	httpClient *http.Client,
 	repoURL string,
 	chart string,
 	creds *Credentials,
 ) ([]string, error) {
 	indexURL := fmt.Sprintf("%s/index.yaml", strings.TrimSuffix(repoURL, "/"))
-	req, err := http.NewRequest(http.MethodGet, indexURL, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, indexURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error preparing HTTP/S request to %q: %w", indexURL, err)
 	}
 	if creds != nil {
 		req.SetBasicAuth(creds.Username, creds.Password)
 	}
-	res, err := http.DefaultClient.Do(req)
+	res, err := httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("error querying repository index at %q: %w", indexURL, err)
 	}
@@ -132,19 +228,86 @@ func getChartVersionsFromClassicRepo(
 // getChartVersionsFromOCIRepo connects to the OCI repository specified by
 // repoURL and retrieves all available versions of the specified chart. Provided
 // credentials may be nil for public repositories, but must be non-nil for
-// private repositories.
+// private repositories. If credentials are provided, but the registry rejects
+// them as unauthorized or forbidden, an anonymous attempt is made before giving
+// up, since some registries permit anonymous pulls, but reject credentials
+// that are, for instance, expired or scoped to other repositories. Requests are
+// made using the provided HTTP client against repositories obtained from the
+// provided newRepoFn.
 func getChartVersionsFromOCIRepo(
 	ctx context.Context,
+	httpClient *http.Client,
 	repoURL string,
 	creds *Credentials,
+	newRepoFn func(registry.Reference, *http.Client, *Credentials) *remote.Repository,
 ) ([]string, error) {
 	ref, err := registry.ParseReference(strings.TrimPrefix(repoURL, "oci://"))
 	if err != nil {
 		return nil, fmt.Errorf("error parsing repository URL %q: %w", repoURL, err)
 	}
-	rep := &remote.Repository{
+	// The registry client does not expose the status codes underlying the
+	// errors it returns, so they are recorded as responses are received.
+	transport := &statusRecordingTransport{base: httpClient.Transport}
+	recordingClient := *httpClient
+	recordingClient.Transport = transport
+	versions, err := getOCIRepoTags(ctx, newRepoFn(ref, &recordingClient, creds))
+	if err == nil {
+		return versions, nil
+	}
+	if creds == nil || !transport.lastStatusWasAuthFailure() {
+		return nil, fmt.Errorf("error retrieving versions of chart from repository %q: %w", repoURL, err)
+	}
+	versions, anonErr := getOCIRepoTags(ctx, newRepoFn(ref, httpClient, nil))
+	if anonErr != nil {
+		return nil, fmt.Errorf(
+			"error retrieving versions of chart from repository %q: %w; "+
+				"anonymous attempt also failed: %w",
+			repoURL,
+			err,
+			anonErr,
+		)
+	}
+	return versions, nil
+}
+
+// statusRecordingTransport is an http.RoundTripper that records the status
+// code of the last response received.
+type statusRecordingTransport struct {
+	base       http.RoundTripper
+	lastStatus atomic.Int32
+}
+
+func (s *statusRecordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := s.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	res, err := base.RoundTrip(req)
+	if err == nil {
+		s.lastStatus.Store(int32(res.StatusCode)) // nolint: gosec
+	}
+	return res, err
+}
+
+// lastStatusWasAuthFailure returns true if the last response received was a
+// 401 or 403.
+func (s *statusRecordingTransport) lastStatusWasAuthFailure() bool {
+	status := int(s.lastStatus.Load())
+	return status == http.StatusUnauthorized || status == http.StatusForbidden
+}
+
+// newOCIRepository returns a remote.Repository for the provided reference that
+// makes requests using the provided HTTP client and authenticates using the
+// provided credentials, if any.
+func newOCIRepository(
+	ref registry.Reference,
+	httpClient *http.Client,
+	creds *Credentials,
+) *remote.Repository {
+	return &remote.Repository{
 		Reference: ref,
 		Client: &auth.Client{
+			Client: httpClient,
 			Credential: func(context.Context, string) (auth.Credential, error) {
 				if creds != nil {
 					return auth.Credential{
@@ -156,23 +319,31 @@ func getChartVersionsFromOCIRepo(
 			},
 		},
 	}
+}
 
-	versions := make([]string, 0, rep.TagListPageSize)
+// getOCIRepoTags lists all tags in the provided repository.
+func getOCIRepoTags(ctx context.Context, rep *remote.Repository) ([]string, error) {
+	tags := make([]string, 0, rep.TagListPageSize)
 	if err := rep.Tags(ctx, func(t []string) error {
-		versions = append(versions, t...)
+		tags = append(tags, t...)
 		return nil
 	}); err != nil {
-		return nil, fmt.Errorf("error retrieving versions of chart from repository %q: %w", repoURL, err)
+		return nil, err
 	}
-	return versions, nil
+	return tags, nil
 }
 
 // getLatestVersion returns the semantically greatest version from the versions
 // provided which satisfies the provided constraints. If no constraints are
 // specified (the empty string is passed), the absolute semantically greatest
-// version will be returned. The empty string will be returned when the provided
+// version will be returned. Prerelease versions only satisfy constraints if
+// allowPrereleases is true. The empty string will be returned when the provided
 // list of versions is nil or empty.
-func getLatestVersion(versions []string, constraintStr string) (string, error) {
+func getLatestVersion(
+	versions []string,
+	constraintStr string,
+	allowPrereleases bool,
+) (string, error) {
 	semvers := make([]*semver.Version, 0, len(versions))
 	for _, version := range versions {
 		if semverVersion, err := semver.NewVersion(version); err == nil {
@@ -182,7 +353,7 @@ func getLatestVersion(versions []string, constraintStr string) (string, error) {
 	if len(semvers) == 0 {
 		return "", nil
 	}
-	sort.Sort(semver.Collection(semvers))
+	sortVersions(semvers)
 	if constraintStr == "" {
 		return semvers[len(semvers)-1].String(), nil
 	}
@@ -191,13 +362,121 @@ func getLatestVersion(versions []string, constraintStr string) (string, error) {
 		return "", fmt.Errorf("error parsing constraint %q: %w", constraintStr, err)
 	}
 	for i := len(semvers) - 1; i >= 0; i-- {
-		if constraint.Check(semvers[i]) {
+		if satisfiesConstraint(
+			constraintStr,
+			constraint,
+			semvers[i],
+			allowPrereleases,
+		) {
 			return semvers[i].String(), nil
 		}
 	}
 	return "", nil
 }
 
+// getOldestVersion returns the semantically least version from the versions
+// provided which satisfies the provided constraints. If no constraints are
+// specified (the empty string is passed), the absolute semantically least
+// version will be returned. Prerelease versions only satisfy constraints if
+// allowPrereleases is true. The empty string will be returned when the provided
+// list of versions is nil or empty.
+func getOldestVersion(
+	versions []string,
+	constraintStr string,
+	allowPrereleases bool,
+) (string, error) {
+	semvers := make([]*semver.Version, 0, len(versions))
+	for _, version := range versions {
+		if semverVersion, err := semver.NewVersion(version); err == nil {
+			semvers = append(semvers, semverVersion)
+		}
+	}
+	if len(semvers) == 0 {
+		return "", nil
+	}
+	sortVersions(semvers)
+	if constraintStr == "" {
+		return semvers[0].String(), nil
+	}
+	constraint, err := semver.NewConstraint(constraintStr)
+	if err != nil {
+		return "", fmt.Errorf("error parsing constraint %q: %w", constraintStr, err)
+	}
+	for _, sv := range semvers {
+		if satisfiesConstraint(constraintStr, constraint, sv, allowPrereleases) {
+			return sv.String(), nil
+		}
+	}
+	return "", nil
+}
+
+// constraintVersionRegex matches the versions, including partial and wildcard
+// versions, within a semver constraint.
+var constraintVersionRegex = regexp.MustCompile(
+	`v?[0-9xX*]+(\.[0-9xX*]+){0,2}` +
+		`(-[0-9A-Za-z-]+(\.[0-9A-Za-z-]+)*)?` +
+		`(\+[0-9A-Za-z-]+(\.[0-9A-Za-z-]+)*)?`,
+)
+
+// satisfiesConstraint returns true if the provided semver satisfies the
+// provided constraint, which was parsed from constraintStr. Constraints never
+// match prerelease versions unless they themselves include a prerelease. When
+// allowPrereleases is true, a prerelease version is instead checked against a
+// prerelease-inclusive form of the constraint, so that it is ordered exactly
+// as semver orders it: 1.3.0-rc.1 satisfies ^1.2.0 and 1.2.0-rc.1 satisfies
+// <1.2.0, but 1.2.0-rc.1 does not satisfy >=1.2.0.
+func satisfiesConstraint(
+	constraintStr string,
+	constraint *semver.Constraints,
+	sv *semver.Version,
+	allowPrereleases bool,
+) bool {
+	if constraint.Check(sv) {
+		return true
+	}
+	if !allowPrereleases || sv.Prerelease() == "" {
+		return false
+	}
+	// Every version in the constraint that lacks a prerelease is given one that
+	// sorts just above the version being checked. This opts the constraint into
+	// matching prereleases without changing the outcome of any comparison
+	// except those against the release the version precedes, which it now
+	// correctly sorts below.
+	suffix := "-" + sv.Prerelease() + ".0"
+	prereleaseConstraintStr := constraintVersionRegex.ReplaceAllStringFunc(
+		constraintStr,
+		func(version string) string {
+			core, build, _ := strings.Cut(version, "+")
+			if strings.Contains(core, "-") {
+				return version
+			}
+			if build != "" {
+				return core + suffix + "+" + build
+			}
+			return core + suffix
+		},
+	)
+	prereleaseConstraint, err := semver.NewConstraint(prereleaseConstraintStr)
+	if err != nil {
+		return false
+	}
+	return prereleaseConstraint.Check(sv)
+}
+
+// sortVersions sorts the provided semvers in place, in ascending order. Ties
+// between semantically equivalent versions (e.g. v1.2.3 and 1.2.3, or versions
+// differing only in build metadata) are broken lexically using the original
+// strings the semvers were parsed from, so the same version is selected
+// regardless of the order in which the repository lists them.
+func sortVersions(semvers []*semver.Version) {
+	sort.SliceStable(semvers, func(i, j int) bool {
+		if comp := semvers[i].Compare(semvers[j]); comp != 0 {
+			return comp < 0
+		}
+		return semvers[i].Original() < semvers[j].Original()
+	})
+}
+
 func UpdateChartDependencies(homePath, chartPath string) error {
 	cmd := exec.Command("helm", "dependency", "update", chartPath)
 	cmd.Env = append(cmd.Env, os.Environ()...)
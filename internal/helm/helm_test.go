@@ -2,12 +2,20 @@ package helm
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/pkg/registry"
+	"oras.land/oras-go/pkg/registry/remote"
+
+	"github.com/akuity/kargo/internal/repocache"
 )
 
 func TestGetChartVersionsFromClassicRepo(t *testing.T) {
@@ -82,6 +90,8 @@ func TestGetChartVersionsFromClassicRepo(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			versions, err := getChartVersionsFromClassicRepo(
+				context.Background(),
+				http.DefaultClient,
 				testCase.repoURL,
 				testCase.chart,
 				nil,
@@ -91,24 +101,279 @@ func TestGetChartVersionsFromClassicRepo(t *testing.T) {
 	}
 }
 
+func TestGetChartVersionsFromClassicRepoWithTLS(t *testing.T) {
+	testServer := httptest.NewTLSServer(
+		http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte("entries:\n  fake-chart:\n    - version: 1.0.0\n"))
+		}),
+	)
+	defer testServer.Close()
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(testServer.Certificate())
+
+	testCases := []struct {
+		name       string
+		tlsConfig  *tls.Config
+		assertions func(*testing.T, *http.Client, []string, error)
+	}{
+		{
+			name: "default TLS configuration",
+			assertions: func(t *testing.T, httpClient *http.Client, _ []string, err error) {
+				require.Same(t, http.DefaultClient, httpClient)
+				require.ErrorContains(t, err, "certificate")
+			},
+		},
+		{
+			name:      "certificate verification skipped",
+			tlsConfig: &tls.Config{InsecureSkipVerify: true}, // nolint: gosec
+			assertions: func(t *testing.T, httpClient *http.Client, versions []string, err error) {
+				transport, ok := httpClient.Transport.(*http.Transport)
+				require.True(t, ok)
+				require.True(t, transport.TLSClientConfig.InsecureSkipVerify)
+				require.NoError(t, err)
+				require.Equal(t, []string{"1.0.0"}, versions)
+			},
+		},
+		{
+			name:      "server certificate trusted",
+			tlsConfig: &tls.Config{RootCAs: rootCAs}, // nolint: gosec
+			assertions: func(t *testing.T, httpClient *http.Client, versions []string, err error) {
+				transport, ok := httpClient.Transport.(*http.Transport)
+				require.True(t, ok)
+				require.Same(t, rootCAs, transport.TLSClientConfig.RootCAs)
+				require.NoError(t, err)
+				require.Equal(t, []string{"1.0.0"}, versions)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			httpClient := newHTTPClient(testCase.tlsConfig)
+			versions, err := getChartVersionsFromClassicRepo(
+				context.Background(),
+				httpClient,
+				testServer.URL,
+				"fake-chart",
+				nil,
+			)
+			testCase.assertions(t, httpClient, versions, err)
+		})
+	}
+}
+
+func TestSelectChartVersionCachesPerTLSSettings(t *testing.T) {
+	testServer := httptest.NewTLSServer(
+		http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte("entries:\n  fake-chart:\n    - version: 1.0.0\n"))
+		}),
+	)
+	defer testServer.Close()
+	cache := repocache.New(time.Minute)
+
+	// A listing retrieved without verifying the server's certificate...
+	version, err := SelectChartVersion(
+		context.Background(),
+		testServer.URL,
+		"fake-chart",
+		"",
+		false,
+		SelectionModeNewest,
+		nil,
+		nil,
+		true,
+		"",
+		cache,
+	)
+	require.NoError(t, err)
+	require.Equal(t, "1.0.0", version)
+
+	// ...must not be served to a subscription that verifies it
+	_, err = SelectChartVersion(
+		context.Background(),
+		testServer.URL,
+		"fake-chart",
+		"",
+		false,
+		SelectionModeNewest,
+		nil,
+		nil,
+		false,
+		"",
+		cache,
+	)
+	require.ErrorContains(t, err, "certificate")
+}
+
 func TestGetChartVersionsFromOCIRepo(t *testing.T) {
 	// Instead of mocking out an OCI registry, it's more expedient to use Kargo's
 	// own chart repo on ghcr.io to test this.
 	versions, err := getChartVersionsFromOCIRepo(
 		context.Background(),
+		http.DefaultClient,
 		"oci://ghcr.io/akuity/kargo-charts/kargo",
 		nil,
+		newOCIRepository,
 	)
 	require.NoError(t, err)
 	require.NotEmpty(t, versions)
 }
 
+func TestGetChartVersionsFromOCIRepoWithCredentials(t *testing.T) {
+	// This is a mock registry that, like many public registries, requires a
+	// bearer token for all requests, but will issue an anonymous token to
+	// clients that do not present credentials. Clients that present invalid
+	// credentials are refused a token.
+	var testServer *httptest.Server
+	testServer = httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				switch r.URL.Path {
+				case "/token":
+					username, password, ok := r.BasicAuth()
+					if ok && (username != "fake-user" || password != "fake-password") {
+						w.WriteHeader(http.StatusUnauthorized)
+						return
+					}
+					_, _ = w.Write([]byte(`{"token":"fake-token"}`))
+				case "/v2/fake-chart/tags/list":
+					if r.Header.Get("Authorization") != "Bearer fake-token" {
+						w.Header().Set(
+							"WWW-Authenticate",
+							fmt.Sprintf(
+								`Bearer realm="%s/token",service="fake-registry",scope="repository:fake-chart:pull"`,
+								testServer.URL,
+							),
+						)
+						w.WriteHeader(http.StatusUnauthorized)
+						return
+					}
+					_, _ = w.Write([]byte(`{"name":"fake-chart","tags":["1.0.0","1.1.0"]}`))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			},
+		),
+	)
+	t.Cleanup(testServer.Close)
+
+	repoURL := fmt.Sprintf(
+		"oci://%s/fake-chart",
+		strings.TrimPrefix(testServer.URL, "http://"),
+	)
+
+	testCases := []struct {
+		name  string
+		creds *Credentials
+	}{
+		{
+			name: "valid credentials",
+			creds: &Credentials{
+				Username: "fake-user",
+				Password: "fake-password",
+			},
+		},
+		{
+			name: "invalid credentials fall back to anonymous access",
+			creds: &Credentials{
+				Username: "fake-user",
+				Password: "wrong-password",
+			},
+		},
+		{
+			name: "no credentials",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			versions, err := getChartVersionsFromOCIRepo(
+				context.Background(),
+				http.DefaultClient,
+				repoURL,
+				testCase.creds,
+				newPlainHTTPOCIRepository,
+			)
+			require.NoError(t, err)
+			require.Equal(t, []string{"1.0.0", "1.1.0"}, versions)
+		})
+	}
+}
+
+func TestGetChartVersionsFromOCIRepoWithoutAnonymousFallback(t *testing.T) {
+	// This is a mock registry that fails for reasons other than the credentials
+	// used. Retrying anonymously would be pointless.
+	testServer := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+		),
+	)
+	t.Cleanup(testServer.Close)
+
+	var anonymousAttempts int
+	_, err := getChartVersionsFromOCIRepo(
+		context.Background(),
+		http.DefaultClient,
+		fmt.Sprintf(
+			"oci://%s/fake-chart",
+			strings.TrimPrefix(testServer.URL, "http://"),
+		),
+		&Credentials{
+			Username: "fake-user",
+			Password: "fake-password",
+		},
+		func(
+			ref registry.Reference,
+			httpClient *http.Client,
+			creds *Credentials,
+		) *remote.Repository {
+			if creds == nil {
+				anonymousAttempts++
+			}
+			return newPlainHTTPOCIRepository(ref, httpClient, creds)
+		},
+	)
+	require.ErrorContains(t, err, "error retrieving versions of chart")
+	require.ErrorContains(t, err, "500")
+	require.Zero(t, anonymousAttempts)
+}
+
+// newPlainHTTPOCIRepository is like newOCIRepository, but returns a
+// remote.Repository suitable for use with an httptest.Server.
+func newPlainHTTPOCIRepository(
+	ref registry.Reference,
+	httpClient *http.Client,
+	creds *Credentials,
+) *remote.Repository {
+	rep := newOCIRepository(ref, httpClient, creds)
+	rep.PlainHTTP = true
+	return rep
+}
+
+func TestFilterVersions(t *testing.T) {
+	versions := []string{"1.0.0", "1.1.0-rc.1", "1.1.0"}
+	require.Equal(t, versions, filterVersions(versions, nil))
+	require.Equal(
+		t,
+		[]string{"1.0.0", "1.1.0"},
+		filterVersions(versions, func(version string) bool {
+			return !strings.Contains(version, "-rc")
+		}),
+	)
+	require.Empty(
+		t,
+		filterVersions(versions, func(string) bool { return false }),
+	)
+}
+
 func TestGetLatestVersion(t *testing.T) {
 	testCases := []struct {
-		name       string
-		unsorted   []string
-		constraint string
-		assertions func(t *testing.T, latest string, err error)
+		name             string
+		unsorted         []string
+		constraint       string
+		allowPrereleases bool
+		assertions       func(t *testing.T, latest string, err error)
 	}{
 		{
 			name:     "success with invalid version ignored",
@@ -152,11 +417,153 @@ func TestGetLatestVersion(t *testing.T) {
 				require.Equal(t, "", latest)
 			},
 		},
+		{
+			name:       "prerelease excluded by constraint",
+			unsorted:   []string{"1.0.0", "1.1.0-rc.1"},
+			constraint: "^1.0.0",
+			assertions: func(t *testing.T, latest string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "1.0.0", latest)
+			},
+		},
+		{
+			name:             "prerelease allowed",
+			unsorted:         []string{"1.0.0", "1.1.0-rc.1", "2.0.0-rc.1"},
+			constraint:       "^1.0.0",
+			allowPrereleases: true,
+			assertions: func(t *testing.T, latest string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "1.1.0-rc.1", latest)
+			},
+		},
+		{
+			name:             "prerelease allowed below release it precedes",
+			unsorted:         []string{"1.1.0", "1.2.0-rc.1", "1.2.0"},
+			constraint:       "<1.2.0",
+			allowPrereleases: true,
+			assertions: func(t *testing.T, latest string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "1.2.0-rc.1", latest)
+			},
+		},
+		{
+			name:     "equivalent versions with different build metadata",
+			unsorted: []string{"1.2.3+b", "1.2.3+a", "1.0.0"},
+			assertions: func(t *testing.T, latest string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "1.2.3+b", latest)
+			},
+		},
+		{
+			name:     "equivalent versions with different build metadata in reverse order",
+			unsorted: []string{"1.0.0", "1.2.3+a", "1.2.3+b"},
+			assertions: func(t *testing.T, latest string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "1.2.3+b", latest)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			latest, err := getLatestVersion(testCase.unsorted, testCase.constraint)
+			latest, err := getLatestVersion(
+				testCase.unsorted,
+				testCase.constraint,
+				testCase.allowPrereleases,
+			)
 			testCase.assertions(t, latest, err)
 		})
 	}
 }
+
+func TestGetOldestVersion(t *testing.T) {
+	testCases := []struct {
+		name             string
+		unsorted         []string
+		constraint       string
+		allowPrereleases bool
+		assertions       func(t *testing.T, oldest string, err error)
+	}{
+		{
+			name:     "success with invalid version ignored",
+			unsorted: []string{"1.0.0", "not-semantic", "2.0.0"},
+			assertions: func(t *testing.T, oldest string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "1.0.0", oldest)
+			},
+		},
+		{
+			name:       "error parsing constraint",
+			unsorted:   []string{"1.0.0"},
+			constraint: "invalid",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "error parsing constraint")
+			},
+		},
+		{
+			name:       "success with constraint",
+			unsorted:   []string{"2.0.0", "1.0.0", "1.1.0", "1.2.0"},
+			constraint: ">=1.1.0",
+			assertions: func(t *testing.T, oldest string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "1.1.0", oldest)
+			},
+		},
+		{
+			name:     "success with no constraint",
+			unsorted: []string{"2.0.0", "1.0.0", "1.1.0"},
+			assertions: func(t *testing.T, oldest string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "1.0.0", oldest)
+			},
+		},
+		{
+			name:             "prerelease allowed",
+			unsorted:         []string{"1.2.0", "1.0.0", "1.1.5-rc.1"},
+			constraint:       ">=1.1.0",
+			allowPrereleases: true,
+			assertions: func(t *testing.T, oldest string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "1.1.5-rc.1", oldest)
+			},
+		},
+		{
+			name:             "prerelease below lower bound",
+			unsorted:         []string{"1.2.0", "1.0.0", "1.1.0-rc.1"},
+			constraint:       ">=1.1.0",
+			allowPrereleases: true,
+			assertions: func(t *testing.T, oldest string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "1.2.0", oldest)
+			},
+		},
+		{
+			name:             "prerelease below caret lower bound",
+			unsorted:         []string{"1.2.1", "1.2.0-rc.1", "1.2.0"},
+			constraint:       "^1.2.0",
+			allowPrereleases: true,
+			assertions: func(t *testing.T, oldest string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "1.2.0", oldest)
+			},
+		},
+		{
+			name:       "no version satisfies constraint",
+			unsorted:   []string{"2.0.0", "1.0.0", "1.1.0"},
+			constraint: "^3.0.0",
+			assertions: func(t *testing.T, oldest string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "", oldest)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			oldest, err := getOldestVersion(
+				testCase.unsorted,
+				testCase.constraint,
+				testCase.allowPrereleases,
+			)
+			testCase.assertions(t, oldest, err)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package http
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+// NetworkList is a list of IP networks that can be decoded from a
+// comma-separated list of CIDRs.
+type NetworkList []*net.IPNet
+
+// Decode implements envconfig.Decoder.
+func (n *NetworkList) Decode(value string) error {
+	var networks NetworkList
+	for _, cidr := range strings.Split(value, ",") {
+		if cidr = strings.TrimSpace(cidr); cidr == "" {
+			continue
+		}
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return fmt.Errorf("error parsing network %q: %w", cidr, err)
+		}
+		networks = append(networks, network)
+	}
+	*n = networks
+	return nil
+}
+
+// PublicDialControl returns a function for use as the Control function of a
+// net.Dialer that refuses connections to addresses that are not publicly
+// routable, unless they belong to one of the provided networks. Since it is
+// invoked with the address actually being connected to, it also applies to
+// redirects and to host names that resolve to such addresses.
+func PublicDialControl(
+	allowedNetworks []*net.IPNet,
+) func(string, string, syscall.RawConn) error {
+	return func(_, address string, _ syscall.RawConn) error {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			return fmt.Errorf("error parsing address %q: %w", address, err)
+		}
+		ip := net.ParseIP(host)
+		if ip == nil {
+			return fmt.Errorf("address %q is not an IP address", host)
+		}
+		for _, network := range allowedNetworks {
+			if network.Contains(ip) {
+				return nil
+			}
+		}
+		if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+			ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+			ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+			return fmt.Errorf(
+				"connections to non-public address %s are not permitted",
+				ip,
+			)
+		}
+		return nil
+	}
+}
+
+// NewPublicTransport returns an http.Transport that only connects to publicly routable addresses or to addresses belonging to one of
+// the provided networks. It is meant for requests to URLs chosen by users who
+// should not be able to use Kargo to probe the network it runs in.
+func NewPublicTransport(allowedNetworks []*net.IPNet) *http.Transport {
+	transport := cleanhttp.DefaultTransport()
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   PublicDialControl(allowedNetworks),
+	}
+	transport.DialContext = dialer.DialContext
+	return transport
+}
@@ -0,0 +1,49 @@
+package http
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublicDialControl(t *testing.T) {
+	var allowedNetworks NetworkList
+	require.NoError(t, allowedNetworks.Decode("10.1.0.0/16"))
+	control := PublicDialControl(allowedNetworks)
+	testCases := []struct {
+		address string
+		allowed bool
+	}{
+		{address: "93.184.216.34:443", allowed: true},
+		{address: "[2606:2800:220:1:248:1893:25c8:1946]:443", allowed: true},
+		{address: "10.1.2.3:443", allowed: true},
+		{address: "10.2.3.4:443", allowed: false},
+		{address: "127.0.0.1:80", allowed: false},
+		{address: "[::1]:80", allowed: false},
+		{address: "169.254.169.254:80", allowed: false},
+		{address: "[fe80::1]:80", allowed: false},
+		{address: "172.16.0.1:80", allowed: false},
+		{address: "192.168.1.1:80", allowed: false},
+		{address: "[fd00::1]:80", allowed: false},
+		{address: "0.0.0.0:80", allowed: false},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.address, func(t *testing.T) {
+			err := control("tcp", testCase.address, nil)
+			if testCase.allowed {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, "are not permitted")
+		})
+	}
+}
+
+func TestNetworkListDecode(t *testing.T) {
+	var networks NetworkList
+	require.NoError(t, networks.Decode(""))
+	require.Empty(t, networks)
+	require.NoError(t, networks.Decode("10.0.0.0/8, fd00::/8"))
+	require.Len(t, networks, 2)
+	require.ErrorContains(t, networks.Decode("10.0.0.0"), "error parsing network")
+}
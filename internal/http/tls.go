@@ -0,0 +1,36 @@
+package http
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+)
+
+// NewTLSConfig returns a TLS configuration for connecting to a single server,
+// such as a repository a Warehouse subscribes to, that skips verification of
+// the server's certificate if insecureSkipTLSVerify is true and, otherwise,
+// trusts the certificates in the provided PEM-encoded CA bundle in addition to
+// the system's root certificates. If insecureSkipTLSVerify is false and the
+// CA bundle is empty, nil is returned, which signals that the defaults should
+// be used.
+func NewTLSConfig(insecureSkipTLSVerify bool, caBundle string) (*tls.Config, error) {
+	if insecureSkipTLSVerify {
+		return &tls.Config{
+			InsecureSkipVerify: true, // nolint: gosec
+		}, nil
+	}
+	if caBundle == "" {
+		return nil, nil
+	}
+	rootCAs, err := x509.SystemCertPool()
+	if err != nil {
+		return nil, fmt.Errorf("error loading system root certificates: %w", err)
+	}
+	if ok := rootCAs.AppendCertsFromPEM([]byte(caBundle)); !ok {
+		return nil, errors.New("CA bundle contains no valid PEM-encoded certificates")
+	}
+	return &tls.Config{
+		RootCAs: rootCAs,
+	}, nil
+}
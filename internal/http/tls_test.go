@@ -0,0 +1,86 @@
+package http
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTLSConfig(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "fake-ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	testCABundle := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}))
+	testCert, err := x509.ParseCertificate(certDER)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name                  string
+		insecureSkipTLSVerify bool
+		caBundle              string
+		assertions            func(*testing.T, *tls.Config, error)
+	}{
+		{
+			name: "defaults",
+			assertions: func(t *testing.T, cfg *tls.Config, err error) {
+				require.NoError(t, err)
+				require.Nil(t, cfg)
+			},
+		},
+		{
+			name:                  "skip verification",
+			insecureSkipTLSVerify: true,
+			caBundle:              testCABundle,
+			assertions: func(t *testing.T, cfg *tls.Config, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, cfg)
+				require.True(t, cfg.InsecureSkipVerify)
+				require.Nil(t, cfg.RootCAs)
+			},
+		},
+		{
+			name:     "invalid CA bundle",
+			caBundle: "not a certificate",
+			assertions: func(t *testing.T, _ *tls.Config, err error) {
+				require.ErrorContains(t, err, "no valid PEM-encoded certificates")
+			},
+		},
+		{
+			name:     "CA bundle",
+			caBundle: testCABundle,
+			assertions: func(t *testing.T, cfg *tls.Config, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, cfg)
+				require.False(t, cfg.InsecureSkipVerify)
+				require.NotNil(t, cfg.RootCAs)
+				_, err = testCert.Verify(x509.VerifyOptions{Roots: cfg.RootCAs})
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, err := NewTLSConfig(testCase.insecureSkipTLSVerify, testCase.caBundle)
+			testCase.assertions(t, cfg, err)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package image
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opencontainers/go-digest"
+
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// DefaultArtifactTag is the tag that is resolved when neither a tag nor a
+// digest is specified.
+const DefaultArtifactTag = "latest"
+
+// ArtifactOptions represents options for resolving an arbitrary artifact
+// stored in an OCI registry.
+type ArtifactOptions struct {
+	// Creds holds optional credentials for authenticating to the repository.
+	Creds *Credentials
+	// InsecureSkipTLSVerify is an optional flag, that if set to true, will
+	// disable verification of the repository's TLS certificate.
+	InsecureSkipTLSVerify bool
+}
+
+// ResolveArtifactDigest returns the digest of the manifest of an arbitrary
+// artifact in the repository specified by repoURL. If a digest is provided, it
+// is verified to exist in the repository and returned. Otherwise, the provided
+// tag (or "latest" if the tag is empty) is resolved to a digest. Unlike the
+// Selector implementations, no attempt is made to interpret the manifest as
+// describing a container image, so this is suitable for OCI artifacts of any
+// kind.
+func ResolveArtifactDigest(
+	ctx context.Context,
+	repoURL string,
+	tag string,
+	d string,
+	opts *ArtifactOptions,
+) (digest.Digest, error) {
+	if opts == nil {
+		opts = &ArtifactOptions{}
+	}
+	repoClient, err := newRepositoryClient(repoURL, opts.InsecureSkipTLSVerify, "", opts.Creds)
+	if err != nil {
+		return "", fmt.Errorf(
+			"error creating repository client for artifact %q: %w",
+			repoURL,
+			err,
+		)
+	}
+	return repoClient.resolveArtifactDigest(ctx, tag, d)
+}
+
+// resolveArtifactDigest returns the digest of the manifest referenced by the
+// provided digest or, if that is empty, by the provided tag.
+func (r *repositoryClient) resolveArtifactDigest(
+	ctx context.Context,
+	tag string,
+	d string,
+) (digest.Digest, error) {
+	logger := logging.LoggerFromContext(ctx)
+
+	if d != "" {
+		parsed, err := digest.Parse(d)
+		if err != nil {
+			return "", fmt.Errorf("error parsing digest %q: %w", d, err)
+		}
+		logger.Tracef("verifying artifact manifest %s exists", parsed)
+		if _, err = r.getManifestByDigestFn(ctx, parsed); err != nil {
+			return "", fmt.Errorf("error retrieving manifest %s: %w", parsed, err)
+		}
+		return parsed, nil
+	}
+
+	if tag == "" {
+		tag = DefaultArtifactTag
+	}
+	manifest, err := r.getManifestByTagFn(ctx, tag)
+	if err != nil {
+		return "", fmt.Errorf("error retrieving manifest for tag %q: %w", tag, err)
+	}
+	_, payload, err := manifest.Payload()
+	if err != nil {
+		return "", fmt.Errorf("error getting payload of manifest for tag %q: %w", tag, err)
+	}
+	return digest.FromBytes(payload), nil
+}
@@ -0,0 +1,146 @@
+package image
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/distribution/distribution/v3"
+	"github.com/distribution/distribution/v3/manifest/ocischema"
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveArtifactDigest(t *testing.T) {
+	testManifest, err := ocischema.FromStruct(ocischema.Manifest{
+		Versioned: ocischema.SchemaVersion,
+		Config: distribution.Descriptor{
+			MediaType: "application/vnd.example.config.v1+json",
+			Digest:    digest.FromString("fake-config"),
+			Size:      11,
+		},
+	})
+	require.NoError(t, err)
+	_, testPayload, err := testManifest.Payload()
+	require.NoError(t, err)
+	testDigest := digest.FromBytes(testPayload)
+
+	testCases := []struct {
+		name       string
+		tag        string
+		digest     string
+		client     *repositoryClient
+		assertions func(*testing.T, digest.Digest, error)
+	}{
+		{
+			name:   "invalid digest",
+			digest: "fake-digest",
+			client: &repositoryClient{},
+			assertions: func(t *testing.T, _ digest.Digest, err error) {
+				require.ErrorContains(t, err, "error parsing digest")
+			},
+		},
+		{
+			name:   "error getting manifest for digest",
+			digest: testDigest.String(),
+			client: &repositoryClient{
+				getManifestByDigestFn: func(
+					context.Context,
+					digest.Digest,
+				) (distribution.Manifest, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ digest.Digest, err error) {
+				require.ErrorContains(t, err, "error retrieving manifest")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name:   "success with digest",
+			tag:    "ignored-tag",
+			digest: testDigest.String(),
+			client: &repositoryClient{
+				getManifestByDigestFn: func(
+					context.Context,
+					digest.Digest,
+				) (distribution.Manifest, error) {
+					return testManifest, nil
+				},
+				getManifestByTagFn: func(
+					context.Context,
+					string,
+				) (distribution.Manifest, error) {
+					return nil, errors.New("tag should not have been resolved")
+				},
+			},
+			assertions: func(t *testing.T, d digest.Digest, err error) {
+				require.NoError(t, err)
+				require.Equal(t, testDigest, d)
+			},
+		},
+		{
+			name: "error getting manifest for tag",
+			tag:  "fake-tag",
+			client: &repositoryClient{
+				getManifestByTagFn: func(
+					context.Context,
+					string,
+				) (distribution.Manifest, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ digest.Digest, err error) {
+				require.ErrorContains(t, err, "error retrieving manifest for tag")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "success with tag",
+			tag:  "fake-tag",
+			client: &repositoryClient{
+				getManifestByTagFn: func(
+					_ context.Context,
+					tag string,
+				) (distribution.Manifest, error) {
+					if tag != "fake-tag" {
+						return nil, errors.New("unexpected tag")
+					}
+					return testManifest, nil
+				},
+			},
+			assertions: func(t *testing.T, d digest.Digest, err error) {
+				require.NoError(t, err)
+				require.Equal(t, testDigest, d)
+			},
+		},
+		{
+			name: "success with default tag",
+			client: &repositoryClient{
+				getManifestByTagFn: func(
+					_ context.Context,
+					tag string,
+				) (distribution.Manifest, error) {
+					if tag != DefaultArtifactTag {
+						return nil, errors.New("unexpected tag")
+					}
+					return testManifest, nil
+				},
+			},
+			assertions: func(t *testing.T, d digest.Digest, err error) {
+				require.NoError(t, err)
+				require.Equal(t, testDigest, d)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			d, err := testCase.client.resolveArtifactDigest(
+				context.Background(),
+				testCase.tag,
+				testCase.digest,
+			)
+			testCase.assertions(t, d, err)
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package image
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/distribution/distribution/v3/registry/client/auth"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// catalogPageSize is the maximum number of repository names requested from a
+// registry's catalog endpoint per request.
+const catalogPageSize = 100
+
+// ErrCatalogNotSupported is returned when a registry does not support listing
+// its catalog, or does not permit the requesting client to do so.
+var ErrCatalogNotSupported = errors.New("registry does not support catalog listing")
+
+// DiscoveryOptions represents options for discovering image repositories within
+// a registry.
+type DiscoveryOptions struct {
+	// Pattern is an optional regular expression. When specified, only
+	// repositories whose full names within the registry match the expression
+	// are discovered.
+	Pattern string
+	// MaxRepositories is the maximum number of repositories to discover. When
+	// this is less than one, no limit is applied.
+	MaxRepositories int
+	// Creds holds optional credentials for accessing the registry.
+	Creds *Credentials
+	// InsecureSkipTLSVerify specifies whether certificate verification errors
+	// should be ignored when connecting to the registry.
+	InsecureSkipTLSVerify bool
+	// CABundle is an optional PEM-encoded bundle of CA certificates that are
+	// trusted, in addition to the system's root certificates, when connecting
+	// to the registry.
+	CABundle string
+}
+
+// DiscoverRepositories lists the catalog of the registry identified by the
+// provided prefix and returns the URLs of all repositories found therein whose
+// names begin with the prefix's path (if any) and that satisfy the provided
+// options. The prefix MUST begin with a registry hostname, e.g.
+// "ghcr.io/example". Results are returned in the order the registry lists
+// them. If the registry does not support listing its catalog, the returned
+// error wraps ErrCatalogNotSupported.
+func DiscoverRepositories(
+	ctx context.Context,
+	prefix string,
+	opts *DiscoveryOptions,
+) ([]string, error) {
+	if opts == nil {
+		opts = &DiscoveryOptions{}
+	}
+
+	host, pathPrefix, err := parseRepositoryPrefix(prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var pattern *regexp.Regexp
+	if opts.Pattern != "" {
+		if pattern, err = regexp.Compile(opts.Pattern); err != nil {
+			return nil, fmt.Errorf(
+				"error compiling repository pattern %q: %w",
+				opts.Pattern,
+				err,
+			)
+		}
+	}
+
+	logger := logging.LoggerFromContext(ctx).WithFields(log.Fields{
+		"registry": host,
+		"prefix":   pathPrefix,
+	})
+
+	reg := getRegistry(host)
+	rt, err := newAuthorizedRoundTripper(
+		reg,
+		opts.InsecureSkipTLSVerify,
+		opts.CABundle,
+		opts.Creds,
+		auth.RegistryScope{
+			Name:    "catalog",
+			Actions: []string{"*"},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	httpClient := &http.Client{Transport: rt}
+	apiAddress := strings.TrimSuffix(reg.apiAddress, "/")
+
+	var repoURLs []string
+	var last string
+	for {
+		names, more, err := getCatalogPage(ctx, httpClient, apiAddress, last)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error listing catalog of registry %q: %w",
+				host,
+				err,
+			)
+		}
+		for _, name := range names {
+			if pathPrefix != "" && !strings.HasPrefix(name, pathPrefix+"/") {
+				continue
+			}
+			if pattern != nil && !pattern.MatchString(name) {
+				continue
+			}
+			if opts.MaxRepositories > 0 && len(repoURLs) == opts.MaxRepositories {
+				logger.WithField("max", opts.MaxRepositories).
+					Warn("discovered maximum number of repositories; ignoring the rest")
+				return repoURLs, nil
+			}
+			repoURLs = append(repoURLs, fmt.Sprintf("%s/%s", host, name))
+		}
+		if !more || len(names) == 0 {
+			break
+		}
+		last = names[len(names)-1]
+	}
+	logger.Tracef("discovered %d repositories", len(repoURLs))
+	return repoURLs, nil
+}
+
+// parseRepositoryPrefix splits the provided prefix into a registry hostname
+// and an optional repository path prefix.
+func parseRepositoryPrefix(prefix string) (string, string, error) {
+	host, pathPrefix, _ := strings.Cut(strings.TrimSuffix(prefix, "/"), "/")
+	if host != "localhost" && !strings.ContainsAny(host, ".:") {
+		return "", "", fmt.Errorf(
+			"repository prefix %q does not begin with a registry hostname",
+			prefix,
+		)
+	}
+	return host, pathPrefix, nil
+}
+
+// getCatalogPage retrieves a single page of repository names from the catalog
+// endpoint at the specified API address, starting after the specified
+// repository name. It also returns a boolean indicating whether the registry
+// has more names to list.
+func getCatalogPage(
+	ctx context.Context,
+	httpClient *http.Client,
+	apiAddress string,
+	last string,
+) ([]string, bool, error) {
+	query := url.Values{}
+	query.Set("n", fmt.Sprintf("%d", catalogPageSize))
+	if last != "" {
+		query.Set("last", last)
+	}
+	catalogURL := fmt.Sprintf("%s/v2/_catalog?%s", apiAddress, query.Encode())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, catalogURL, nil)
+	if err != nil {
+		return nil, false, fmt.Errorf("error creating request: %w", err)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, false, fmt.Errorf("error requesting %s: %w", catalogURL, err)
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound,
+		http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return nil, false, fmt.Errorf(
+			"GET %s returned an HTTP %d status code: %w",
+			catalogURL,
+			resp.StatusCode,
+			ErrCatalogNotSupported,
+		)
+	default:
+		return nil, false, fmt.Errorf(
+			"GET %s returned an unexpected HTTP %d status code",
+			catalogURL,
+			resp.StatusCode,
+		)
+	}
+	catalog := struct {
+		Repositories []string `json:"repositories"`
+	}{}
+	if err = json.NewDecoder(resp.Body).Decode(&catalog); err != nil {
+		return nil, false, fmt.Errorf("error decoding catalog: %w", err)
+	}
+	return catalog.Repositories, resp.Header.Get("Link") != "", nil
+}
@@ -0,0 +1,152 @@
+package image
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscoverRepositories(t *testing.T) {
+	catalog := []string{
+		"example/api",
+		"example/api-legacy",
+		"example/web",
+		"other/api",
+	}
+	testCases := []struct {
+		name       string
+		catalog    bool
+		prefix     string
+		opts       *DiscoveryOptions
+		assertions func(t *testing.T, host string, repoURLs []string, err error)
+	}{
+		{
+			name:    "prefix without registry hostname",
+			catalog: true,
+			prefix:  "example",
+			assertions: func(t *testing.T, _ string, _ []string, err error) {
+				require.ErrorContains(t, err, "does not begin with a registry hostname")
+			},
+		},
+		{
+			name:    "invalid pattern",
+			catalog: true,
+			opts:    &DiscoveryOptions{Pattern: "("},
+			assertions: func(t *testing.T, _ string, _ []string, err error) {
+				require.ErrorContains(t, err, "error compiling repository pattern")
+			},
+		},
+		{
+			name:    "registry without catalog support",
+			catalog: false,
+			assertions: func(t *testing.T, _ string, _ []string, err error) {
+				require.ErrorIs(t, err, ErrCatalogNotSupported)
+			},
+		},
+		{
+			name:    "whole registry",
+			catalog: true,
+			assertions: func(t *testing.T, host string, repoURLs []string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{
+						host + "/example/api",
+						host + "/example/api-legacy",
+						host + "/example/web",
+						host + "/other/api",
+					},
+					repoURLs,
+				)
+			},
+		},
+		{
+			name:    "prefix and pattern",
+			catalog: true,
+			prefix:  "/example",
+			opts:    &DiscoveryOptions{Pattern: `/api`},
+			assertions: func(t *testing.T, host string, repoURLs []string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{
+						host + "/example/api",
+						host + "/example/api-legacy",
+					},
+					repoURLs,
+				)
+			},
+		},
+		{
+			name:    "max repositories",
+			catalog: true,
+			prefix:  "/example",
+			opts:    &DiscoveryOptions{MaxRepositories: 2},
+			assertions: func(t *testing.T, host string, repoURLs []string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{
+						host + "/example/api",
+						host + "/example/api-legacy",
+					},
+					repoURLs,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			srv := httptest.NewTLSServer(
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					switch r.URL.Path {
+					case "/v2/":
+						w.WriteHeader(http.StatusOK)
+					case "/v2/_catalog":
+						if !testCase.catalog {
+							w.WriteHeader(http.StatusNotFound)
+							return
+						}
+						// Serve the catalog two entries at a time to exercise
+						// pagination.
+						start := 0
+						if last := r.URL.Query().Get("last"); last != "" {
+							for i, name := range catalog {
+								if name == last {
+									start = i + 1
+								}
+							}
+						}
+						end := min(start+2, len(catalog))
+						if end < len(catalog) {
+							w.Header().Set("Link", `</v2/_catalog>; rel="next"`)
+						}
+						_ = json.NewEncoder(w).Encode(map[string][]string{
+							"repositories": catalog[start:end],
+						})
+					default:
+						w.WriteHeader(http.StatusNotFound)
+					}
+				}),
+			)
+			defer srv.Close()
+			host := strings.TrimPrefix(srv.URL, "https://")
+			prefix := testCase.prefix
+			if !strings.HasPrefix(prefix, "example") {
+				prefix = host + prefix
+			}
+			opts := testCase.opts
+			if opts == nil {
+				opts = &DiscoveryOptions{}
+			}
+			opts.InsecureSkipTLSVerify = true
+			repoURLs, err := DiscoverRepositories(context.Background(), prefix, opts)
+			testCase.assertions(t, host, repoURLs, err)
+		})
+	}
+}
@@ -4,33 +4,54 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path"
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/opencontainers/go-digest"
+
 	"github.com/akuity/kargo/internal/logging"
 )
 
 // digestSelector implements the Selector interface for SelectionStrategyDigest.
 type digestSelector struct {
-	repoClient *repositoryClient
-	constraint string
-	platform   *platformConstraint
+	repoClient        *repositoryClient
+	constraint        string
+	pinnedDigest      digest.Digest
+	platform          *platformConstraint
+	excludedPlatforms []platformConstraint
+	allowedDigests    map[string]struct{}
 }
 
 // newDigestSelector returns an implementation of the Selector interface for
-// SelectionStrategyDigest.
+// SelectionStrategyDigest. If pinnedDigest is non-empty, the returned Selector
+// always selects the image with that digest and the constraint is ignored.
 func newDigestSelector(
 	repoClient *repositoryClient,
 	constraint string,
+	pinnedDigest string,
 	platform *platformConstraint,
+	excludedPlatforms []platformConstraint,
+	allowedDigests map[string]struct{},
 ) (Selector, error) {
-	if constraint == "" {
-		return nil, errors.New("digest selection strategy requires a constraint")
+	var pinned digest.Digest
+	if pinnedDigest != "" {
+		var err error
+		if pinned, err = digest.Parse(pinnedDigest); err != nil {
+			return nil, fmt.Errorf("error parsing digest %q: %w", pinnedDigest, err)
+		}
+	} else if constraint == "" {
+		return nil, errors.New(
+			"digest selection strategy requires a constraint or a digest",
+		)
 	}
 	return &digestSelector{
-		repoClient: repoClient,
-		constraint: constraint,
-		platform:   platform,
+		repoClient:        repoClient,
+		constraint:        constraint,
+		pinnedDigest:      pinned,
+		platform:          platform,
+		excludedPlatforms: excludedPlatforms,
+		allowedDigests:    allowedDigests,
 	}, nil
 }
 
@@ -46,6 +67,10 @@ func (d *digestSelector) Select(ctx context.Context) (*Image, error) {
 
 	ctx = logging.ContextWithLogger(ctx, logger)
 
+	if d.pinnedDigest != "" {
+		return d.selectPinned(ctx)
+	}
+
 	tags, err := d.repoClient.getTags(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error listing tags: %w", err)
@@ -65,12 +90,43 @@ func (d *digestSelector) Select(ctx context.Context) (*Image, error) {
 			return nil, fmt.Errorf("error retrieving image with tag %q: %w", tag, err)
 		}
 		if image == nil {
-			logger.Tracef(
-				"image with tag %q was found, but did not match platform constraint",
+			// Unlike the other strategies, this one has no other tags to fall
+			// back on, so rather than silently selecting nothing, say why.
+			// Without a platform constraint, the only reason for finding no
+			// image is that the tag does not refer to one at all, e.g. because
+			// it refers to an attestation.
+			ref := fmt.Sprintf(
+				"%s:%s",
+				path.Join(d.repoClient.registry.imagePrefix, d.repoClient.image),
 				tag,
 			)
+			if d.platform == nil {
+				return nil, fmt.Errorf("%s does not refer to an image", ref)
+			}
+			return nil, fmt.Errorf(
+				"platform %s not available for %s",
+				d.platform.String(),
+				ref,
+			)
+		}
+		if !allowsDigest(image.Digest, d.allowedDigests) {
+			logger.WithFields(log.Fields{
+				"tag":    image.Tag,
+				"digest": image.Digest.String(),
+			}).Debug("skipping image because its digest is not in the allowlist")
 			return nil, nil
 		}
+		if p := excludedPlatform(image, d.excludedPlatforms); p != nil {
+			logger.WithFields(log.Fields{
+				"tag":      image.Tag,
+				"digest":   image.Digest.String(),
+				"platform": p.String(),
+			}).Debug("skipping image because it is available for an excluded platform")
+			return nil, nil
+		}
+		if err = d.requireVerifiedSignature(ctx, image); err != nil {
+			return nil, err
+		}
 		logger.WithFields(log.Fields{
 			"tag":    image.Tag,
 			"digest": image.Digest.String(),
@@ -81,3 +137,61 @@ func (d *digestSelector) Select(ctx context.Context) (*Image, error) {
 	logger.Trace("no images matched criteria")
 	return nil, nil
 }
+
+// selectPinned retrieves the image with the pinned digest, provided it
+// satisfies the selector's other constraints.
+func (d *digestSelector) selectPinned(ctx context.Context) (*Image, error) {
+	logger := logging.LoggerFromContext(ctx).
+		WithField("digest", d.pinnedDigest.String())
+	image, err := d.repoClient.getImageByDigestFn(ctx, d.pinnedDigest, d.platform)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving image with digest %s: %w",
+			d.pinnedDigest,
+			err,
+		)
+	}
+	if image == nil {
+		logger.Trace("pinned image did not match platform constraint")
+		return nil, nil
+	}
+	if !allowsDigest(image.Digest, d.allowedDigests) {
+		logger.Debug("skipping image because its digest is not in the allowlist")
+		return nil, nil
+	}
+	if p := excludedPlatform(image, d.excludedPlatforms); p != nil {
+		logger.WithField("platform", p.String()).
+			Debug("skipping image because it is available for an excluded platform")
+		return nil, nil
+	}
+	if err = d.requireVerifiedSignature(ctx, image); err != nil {
+		return nil, err
+	}
+	logger.Trace("found pinned image")
+	return image, nil
+}
+
+// requireVerifiedSignature returns an error wrapping errNoVerifiedTags if the
+// repository client requires images to have verified signatures and the
+// provided image does not.
+func (d *digestSelector) requireVerifiedSignature(
+	ctx context.Context,
+	image *Image,
+) error {
+	verified, err := d.repoClient.hasVerifiedSignature(ctx, image.Digest)
+	if err != nil {
+		return fmt.Errorf(
+			"error verifying signature of image with digest %s: %w",
+			image.Digest,
+			err,
+		)
+	}
+	if !verified {
+		return fmt.Errorf(
+			"%w: image with digest %s does not have a valid signature",
+			errNoVerifiedTags,
+			image.Digest,
+		)
+	}
+	return nil
+}
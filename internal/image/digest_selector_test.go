@@ -1,9 +1,18 @@
 package image
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/distribution/distribution/v3"
+	"github.com/distribution/distribution/v3/manifest/ocischema"
+	"github.com/distribution/distribution/v3/manifest/schema2"
+	"github.com/opencontainers/go-digest"
 	"github.com/stretchr/testify/require"
+
+	"github.com/akuity/kargo/internal/repocache"
 )
 
 func TestNewDigestSelector(t *testing.T) {
@@ -12,10 +21,205 @@ func TestNewDigestSelector(t *testing.T) {
 		os:   "linux",
 		arch: "amd64",
 	}
-	s, err := newDigestSelector(nil, testConstraint, testPlatform)
+	testExcludedPlatforms := []platformConstraint{{os: "linux", arch: "arm64"}}
+	testAllowedDigests := map[string]struct{}{"fake-digest": {}}
+	s, err := newDigestSelector(
+		nil,
+		testConstraint,
+		"",
+		testPlatform,
+		testExcludedPlatforms,
+		testAllowedDigests,
+	)
 	require.NoError(t, err)
 	selector, ok := s.(*digestSelector)
 	require.True(t, ok)
 	require.Equal(t, testConstraint, selector.constraint)
 	require.Equal(t, testPlatform, selector.platform)
+	require.Equal(t, testExcludedPlatforms, selector.excludedPlatforms)
+	require.Equal(t, testAllowedDigests, selector.allowedDigests)
+}
+
+func TestNewDigestSelectorWithPinnedDigest(t *testing.T) {
+	const testDigest = "sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"
+
+	s, err := newDigestSelector(nil, "", testDigest, nil, nil, nil)
+	require.NoError(t, err)
+	selector, ok := s.(*digestSelector)
+	require.True(t, ok)
+	require.Equal(t, digest.Digest(testDigest), selector.pinnedDigest)
+
+	_, err = newDigestSelector(nil, "", "bogus", nil, nil, nil)
+	require.ErrorContains(t, err, "error parsing digest")
+
+	_, err = newDigestSelector(nil, "", "", nil, nil, nil)
+	require.ErrorContains(t, err, "requires a constraint or a digest")
+}
+
+func TestDigestSelectorSelectPinned(t *testing.T) {
+	const testDigest = digest.Digest(
+		"sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+	)
+	testCases := []struct {
+		name           string
+		getImageFn     func(context.Context, digest.Digest, *platformConstraint) (*Image, error)
+		allowedDigests map[string]struct{}
+		assertions     func(*testing.T, *Image, error)
+	}{
+		{
+			name: "error retrieving image",
+			getImageFn: func(context.Context, digest.Digest, *platformConstraint) (*Image, error) {
+				return nil, errors.New("something went wrong")
+			},
+			assertions: func(t *testing.T, _ *Image, err error) {
+				require.ErrorContains(t, err, "error retrieving image with digest")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "image does not match platform",
+			getImageFn: func(context.Context, digest.Digest, *platformConstraint) (*Image, error) {
+				return nil, nil
+			},
+			assertions: func(t *testing.T, image *Image, err error) {
+				require.NoError(t, err)
+				require.Nil(t, image)
+			},
+		},
+		{
+			name: "digest not in allowlist",
+			getImageFn: func(_ context.Context, d digest.Digest, _ *platformConstraint) (*Image, error) {
+				return &Image{Digest: d}, nil
+			},
+			allowedDigests: map[string]struct{}{},
+			assertions: func(t *testing.T, image *Image, err error) {
+				require.NoError(t, err)
+				require.Nil(t, image)
+			},
+		},
+		{
+			name: "success",
+			getImageFn: func(_ context.Context, d digest.Digest, _ *platformConstraint) (*Image, error) {
+				return &Image{Digest: d}, nil
+			},
+			assertions: func(t *testing.T, image *Image, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, image)
+				require.Equal(t, testDigest, image.Digest)
+				require.Empty(t, image.Tag)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			selector := &digestSelector{
+				repoClient: &repositoryClient{
+					registry:           &registry{name: "fake-registry"},
+					image:              "fake-image",
+					getImageByDigestFn: testCase.getImageFn,
+				},
+				pinnedDigest:   testDigest,
+				allowedDigests: testCase.allowedDigests,
+			}
+			image, err := selector.Select(context.Background())
+			testCase.assertions(t, image, err)
+		})
+	}
+}
+
+func TestDigestSelectorSelect(t *testing.T) {
+	// newTestRepoClient returns a repositoryClient for a repository with a single
+	// tag that refers to the provided single-arch manifest, whose config is the
+	// provided one.
+	newTestRepoClient := func(t *testing.T, manifest distribution.Manifest, config string) *repositoryClient {
+		tagCache := repocache.New(time.Minute)
+		_, err := tagCache.Get(context.Background(), "fake-key", func(context.Context) ([]string, error) {
+			return []string{"latest"}, nil
+		})
+		require.NoError(t, err)
+		c := &repositoryClient{
+			registry:    &registry{imagePrefix: "registry.example.com"},
+			image:       "fake-image",
+			tagCache:    tagCache,
+			tagCacheKey: "fake-key",
+			getManifestByTagFn: func(context.Context, string) (distribution.Manifest, error) {
+				return manifest, nil
+			},
+			getBlobFn: func(context.Context, digest.Digest) ([]byte, error) {
+				return []byte(config), nil
+			},
+		}
+		c.extractImageFromManifestFn = c.extractImageFromManifest
+		c.extractImageFromV2ManifestFn = c.extractImageFromV2Manifest
+		c.extractImageFromOCIManifestFn = c.extractImageFromOCIManifest
+		return c
+	}
+	const linuxAMD64Config = `{"os": "linux", "architecture": "amd64"}`
+	testCases := []struct {
+		name       string
+		manifest   distribution.Manifest
+		config     string
+		platform   *platformConstraint
+		assertions func(*testing.T, *Image, error)
+	}{
+		{
+			name:     "requested platform not available",
+			manifest: &schema2.DeserializedManifest{},
+			config:   linuxAMD64Config,
+			platform: &platformConstraint{os: "linux", arch: "arm64"},
+			assertions: func(t *testing.T, image *Image, err error) {
+				require.EqualError(
+					t,
+					err,
+					"platform linux/arm64 not available for registry.example.com/fake-image:latest",
+				)
+				require.Nil(t, image)
+			},
+		},
+		{
+			name:     "requested platform available",
+			manifest: &schema2.DeserializedManifest{},
+			config:   linuxAMD64Config,
+			platform: &platformConstraint{os: "linux", arch: "amd64"},
+			assertions: func(t *testing.T, image *Image, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, image)
+				require.Equal(t, "latest", image.Tag)
+			},
+		},
+		{
+			name:     "no platform requested",
+			manifest: &schema2.DeserializedManifest{},
+			config:   linuxAMD64Config,
+			assertions: func(t *testing.T, image *Image, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, image)
+				require.Equal(t, "latest", image.Tag)
+			},
+		},
+		{
+			name:     "no platform requested and tag does not refer to an image",
+			manifest: &ocischema.DeserializedManifest{},
+			config:   `{}`,
+			assertions: func(t *testing.T, image *Image, err error) {
+				require.EqualError(
+					t,
+					err,
+					"registry.example.com/fake-image:latest does not refer to an image",
+				)
+				require.Nil(t, image)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			selector := &digestSelector{
+				repoClient: newTestRepoClient(t, testCase.manifest, testCase.config),
+				constraint: "latest",
+				platform:   testCase.platform,
+			}
+			image, err := selector.Select(context.Background())
+			testCase.assertions(t, image, err)
+		})
+	}
 }
@@ -13,6 +13,9 @@ type Image struct {
 	Digest    digest.Digest
 	CreatedAt *time.Time
 	semVer    *semver.Version
+	// platforms holds the platforms the image is available for. For a manifest
+	// list or index, this includes every platform referenced by the collection.
+	platforms []platformConstraint
 }
 
 // newImage initializes and returns an Image.
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
 	"sort"
 
 	log "github.com/sirupsen/logrus"
@@ -14,10 +15,13 @@ import (
 // lexicalSelector implements the Selector interface for
 // SelectionStrategyLexical.
 type lexicalSelector struct {
-	repoClient *repositoryClient
-	allowRegex *regexp.Regexp
-	ignore     []string
-	platform   *platformConstraint
+	repoClient        *repositoryClient
+	allowRegex        *regexp.Regexp
+	ignore            []string
+	platform          *platformConstraint
+	excludedPlatforms []platformConstraint
+	allowedDigests    map[string]struct{}
+	mode              SelectionMode
 }
 
 // newLexicalSelector returns an implementation of the Selector interface for
@@ -27,12 +31,18 @@ func newLexicalSelector(
 	allowRegex *regexp.Regexp,
 	ignore []string,
 	platform *platformConstraint,
+	excludedPlatforms []platformConstraint,
+	allowedDigests map[string]struct{},
+	mode SelectionMode,
 ) Selector {
 	return &lexicalSelector{
-		repoClient: repoClient,
-		allowRegex: allowRegex,
-		ignore:     ignore,
-		platform:   platform,
+		repoClient:        repoClient,
+		allowRegex:        allowRegex,
+		ignore:            ignore,
+		platform:          platform,
+		excludedPlatforms: excludedPlatforms,
+		allowedDigests:    allowedDigests,
+		mode:              mode,
 	}
 }
 
@@ -41,6 +51,7 @@ func (l *lexicalSelector) Select(ctx context.Context) (*Image, error) {
 	logger := logging.LoggerFromContext(ctx).WithFields(log.Fields{
 		"registry":            l.repoClient.registry.name,
 		"image":               l.repoClient.image,
+		"selectionMode":       l.mode,
 		"selectionStrategy":   SelectionStrategyLexical,
 		"platformConstrained": l.platform != nil,
 	})
@@ -58,35 +69,28 @@ func (l *lexicalSelector) Select(ctx context.Context) (*Image, error) {
 	}
 	logger.Trace("got all tags")
 
-	if l.allowRegex != nil || len(l.ignore) > 0 {
-		matchedTags := make([]string, 0, len(tags))
-		for _, tag := range tags {
-			if allowsTag(tag, l.allowRegex) && !ignoresTag(tag, l.ignore) {
-				matchedTags = append(matchedTags, tag)
-			}
-		}
-		if len(matchedTags) == 0 {
-			logger.Trace("no tags matched criteria")
-			return nil, nil
-		}
-		tags = matchedTags
+	if tags = filterTags(tags, l.allowRegex, l.ignore); len(tags) == 0 {
+		logger.Trace("no tags matched criteria")
+		return nil, nil
 	}
 	logger.Tracef("%d tags matched criteria", len(tags))
 
 	logger.Trace("sorting tags lexically")
 	sortTagsLexically(tags)
-
-	tag := tags[0]
-	image, err := l.repoClient.getImageByTag(ctx, tag, l.platform)
-	if err != nil {
-		return nil, fmt.Errorf("error retrieving image with tag %q: %w", tag, err)
+	if l.mode == SelectionModeOldest {
+		slices.Reverse(tags)
 	}
-	if image == nil {
-		logger.Tracef(
-			"image with tag %q was found, but did not match platform constraint",
-			tag,
-		)
-		return nil, nil
+
+	image, err := getFirstAllowedImageByTag(
+		ctx,
+		l.repoClient,
+		tags,
+		l.platform,
+		l.excludedPlatforms,
+		l.allowedDigests,
+	)
+	if err != nil || image == nil {
+		return nil, err
 	}
 
 	logger.WithFields(log.Fields{
@@ -14,12 +14,25 @@ func TestNewLexicalSelector(t *testing.T) {
 		os:   "linux",
 		arch: "amd64",
 	}
-	s := newLexicalSelector(nil, testAllowRegex, testIgnore, testPlatform)
+	testExcludedPlatforms := []platformConstraint{{os: "linux", arch: "arm64"}}
+	testAllowedDigests := map[string]struct{}{"fake-digest": {}}
+	s := newLexicalSelector(
+		nil,
+		testAllowRegex,
+		testIgnore,
+		testPlatform,
+		testExcludedPlatforms,
+		testAllowedDigests,
+		SelectionModeOldest,
+	)
 	selector, ok := s.(*lexicalSelector)
 	require.True(t, ok)
 	require.Equal(t, testAllowRegex, selector.allowRegex)
 	require.Equal(t, testIgnore, selector.ignore)
 	require.Equal(t, testPlatform, selector.platform)
+	require.Equal(t, testExcludedPlatforms, selector.excludedPlatforms)
+	require.Equal(t, testAllowedDigests, selector.allowedDigests)
+	require.Equal(t, SelectionModeOldest, selector.mode)
 }
 
 func TestSortTagsLexically(t *testing.T) {
@@ -31,3 +44,31 @@ func TestSortTagsLexically(t *testing.T) {
 		tags,
 	)
 }
+
+func TestSortTagsLexicallyMixed(t *testing.T) {
+	// Date-based and build-number tags aren't valid semantic versions, but sort
+	// cleanly as long as they're zero-padded. Digits sort before letters.
+	tags := []string{
+		"2024.01.15", "build-009", "2023.12.31", "latest", "build-010", "2024.01.02",
+	}
+	sortTagsLexically(tags)
+	require.Equal(
+		t,
+		[]string{
+			"latest", "build-010", "build-009", "2024.01.15", "2024.01.02", "2023.12.31",
+		},
+		tags,
+	)
+}
+
+func TestFilterThenSortTagsLexically(t *testing.T) {
+	// Ignored tags must be removed before sorting so that they can never be
+	// selected, even when they're lexically greatest.
+	tags := filterTags(
+		[]string{"20240102-abc", "latest", "20240115-def", "20231231-fed"},
+		regexp.MustCompile(`^[0-9]{8}-`),
+		[]string{"20240115-def"},
+	)
+	sortTagsLexically(tags)
+	require.Equal(t, []string{"20240102-abc", "20231231-fed"}, tags)
+}
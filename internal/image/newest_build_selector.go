@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
 	"sort"
 	"sync"
 
@@ -15,10 +16,13 @@ import (
 // newestBuildSelector implements the Selector interface for
 // SelectionStrategyNewestBuild.
 type newestBuildSelector struct {
-	repoClient *repositoryClient
-	allowRegex *regexp.Regexp
-	ignore     []string
-	platform   *platformConstraint
+	repoClient        *repositoryClient
+	allowRegex        *regexp.Regexp
+	ignore            []string
+	platform          *platformConstraint
+	excludedPlatforms []platformConstraint
+	allowedDigests    map[string]struct{}
+	mode              SelectionMode
 }
 
 // newNewestBuildSelector returns an implementation of the Selector interface
@@ -28,12 +32,18 @@ func newNewestBuildSelector(
 	allowRegex *regexp.Regexp,
 	ignore []string,
 	platform *platformConstraint,
+	excludedPlatforms []platformConstraint,
+	allowedDigests map[string]struct{},
+	mode SelectionMode,
 ) Selector {
 	return &newestBuildSelector{
-		repoClient: repoClient,
-		allowRegex: allowRegex,
-		ignore:     ignore,
-		platform:   platform,
+		repoClient:        repoClient,
+		allowRegex:        allowRegex,
+		ignore:            ignore,
+		platform:          platform,
+		excludedPlatforms: excludedPlatforms,
+		allowedDigests:    allowedDigests,
+		mode:              mode,
 	}
 }
 
@@ -42,6 +52,7 @@ func (n *newestBuildSelector) Select(ctx context.Context) (*Image, error) {
 	logger := logging.LoggerFromContext(ctx).WithFields(log.Fields{
 		"registry":            n.repoClient.registry.name,
 		"image":               n.repoClient.image,
+		"selectionMode":       n.mode,
 		"selectionStrategy":   SelectionStrategyNewestBuild,
 		"platformConstrained": n.platform != nil,
 	})
@@ -59,18 +70,9 @@ func (n *newestBuildSelector) Select(ctx context.Context) (*Image, error) {
 	}
 	logger.Trace("got all tags")
 
-	if n.allowRegex != nil || len(n.ignore) > 0 {
-		matchedTags := make([]string, 0, len(tags))
-		for _, tag := range tags {
-			if allowsTag(tag, n.allowRegex) && !ignoresTag(tag, n.ignore) {
-				matchedTags = append(matchedTags, tag)
-			}
-		}
-		if len(matchedTags) == 0 {
-			logger.Trace("no tags matched criteria")
-			return nil, nil
-		}
-		tags = matchedTags
+	if tags = filterTags(tags, n.allowRegex, n.ignore); len(tags) == 0 {
+		logger.Trace("no tags matched criteria")
+		return nil, nil
 	}
 	logger.Tracef("%d tags matched criteria", len(tags))
 
@@ -83,9 +85,67 @@ func (n *newestBuildSelector) Select(ctx context.Context) (*Image, error) {
 		// This shouldn't happen
 		return nil, nil
 	}
+	for _, image := range images {
+		if image.CreatedAt == nil {
+			return nil, fmt.Errorf(
+				"registry %q did not report a creation timestamp for tag %q of "+
+					"image %q; the NewestBuild selection strategy cannot be used "+
+					"with images that lack creation timestamps",
+				n.repoClient.registry.name,
+				image.Tag,
+				n.repoClient.image,
+			)
+		}
+	}
+
+	if n.allowedDigests != nil {
+		allowedImages := make([]Image, 0, len(images))
+		for _, image := range images {
+			if !allowsDigest(image.Digest, n.allowedDigests) {
+				logger.WithFields(log.Fields{
+					"tag":    image.Tag,
+					"digest": image.Digest.String(),
+				}).Debug("skipping image because its digest is not in the allowlist")
+				continue
+			}
+			allowedImages = append(allowedImages, image)
+		}
+		if len(allowedImages) == 0 {
+			logger.Trace("no image with an allowed digest matched criteria")
+			return nil, nil
+		}
+		images = allowedImages
+	}
+
+	if len(n.excludedPlatforms) > 0 {
+		allowedImages := make([]Image, 0, len(images))
+		for _, image := range images {
+			if p := excludedPlatform(&image, n.excludedPlatforms); p != nil {
+				logger.WithFields(log.Fields{
+					"tag":      image.Tag,
+					"digest":   image.Digest.String(),
+					"platform": p.String(),
+				}).Debug("skipping image because it is available for an excluded platform")
+				continue
+			}
+			allowedImages = append(allowedImages, image)
+		}
+		if len(allowedImages) == 0 {
+			logger.Trace("no image matched criteria after excluding platforms")
+			return nil, nil
+		}
+		images = allowedImages
+	}
 
 	logger.Trace("sorting images by date")
 	sortImagesByDate(images)
+	if n.mode == SelectionModeOldest {
+		slices.Reverse(images)
+	}
+
+	if images, err = skipUnverifiedImages(ctx, n.repoClient, images); err != nil {
+		return nil, err
+	}
 
 	if n.platform == nil {
 		image := images[0]
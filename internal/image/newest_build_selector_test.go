@@ -15,12 +15,25 @@ func TestNewNewestBuildSelector(t *testing.T) {
 		os:   "linux",
 		arch: "amd64",
 	}
-	s := newNewestBuildSelector(nil, testAllowRegex, testIgnore, testPlatform)
+	testExcludedPlatforms := []platformConstraint{{os: "linux", arch: "arm64"}}
+	testAllowedDigests := map[string]struct{}{"fake-digest": {}}
+	s := newNewestBuildSelector(
+		nil,
+		testAllowRegex,
+		testIgnore,
+		testPlatform,
+		testExcludedPlatforms,
+		testAllowedDigests,
+		SelectionModeOldest,
+	)
 	selector, ok := s.(*newestBuildSelector)
 	require.True(t, ok)
 	require.Equal(t, testAllowRegex, selector.allowRegex)
 	require.Equal(t, testIgnore, selector.ignore)
 	require.Equal(t, testPlatform, selector.platform)
+	require.Equal(t, testExcludedPlatforms, selector.excludedPlatforms)
+	require.Equal(t, testAllowedDigests, selector.allowedDigests)
+	require.Equal(t, SelectionModeOldest, selector.mode)
 }
 
 func TestSortImagesByDate(t *testing.T) {
@@ -52,3 +52,30 @@ func (p *platformConstraint) matches(os, arch, variant string) bool {
 		p.arch == arch &&
 		p.variant == variant
 }
+
+// parsePlatformConstraints parses each of the provided platform constraint
+// strings and returns the corresponding platformConstraint structs.
+func parsePlatformConstraints(platformStrs []string) ([]platformConstraint, error) {
+	if len(platformStrs) == 0 {
+		return nil, nil
+	}
+	platforms := make([]platformConstraint, len(platformStrs))
+	for i, platformStr := range platformStrs {
+		p, err := parsePlatformConstraint(platformStr)
+		if err != nil {
+			return nil, err
+		}
+		platforms[i] = p
+	}
+	return platforms, nil
+}
+
+// excludes returns a boolean indicating whether the provided operating system,
+// system architecture, and variant fall within the platform constraint when it
+// is used to exclude images. Unlike matches, a constraint that does not specify
+// a variant excludes all variants of its operating system and architecture.
+func (p *platformConstraint) excludes(os, arch, variant string) bool {
+	return p.os == os &&
+		p.arch == arch &&
+		(p.variant == "" || p.variant == variant)
+}
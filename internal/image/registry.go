@@ -6,6 +6,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/distribution/distribution/v3/reference"
 	"github.com/patrickmn/go-cache"
 	"go.uber.org/ratelimit"
 )
@@ -88,3 +89,17 @@ func (r *registry) normalizeImageName(image string) string {
 	}
 	return image
 }
+
+// NormalizeRepoURL returns the fully qualified name of the image repository
+// with the provided URL, such that different spellings of the same repository
+// compare equal. For example, "debian", "docker.io/debian", and
+// "docker.io/library/debian" are all normalized to "docker.io/library/debian".
+// URLs that cannot be parsed are only lowercased and trimmed.
+func NormalizeRepoURL(repoURL string) string {
+	repoURL = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(repoURL)), "/")
+	repoRef, err := reference.ParseNormalizedNamed(repoURL)
+	if err != nil {
+		return repoURL
+	}
+	return repoRef.Name()
+}
@@ -100,3 +100,23 @@ func TestNormalizeImageName(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizeRepoURL(t *testing.T) {
+	testCases := []struct {
+		repoURL  string
+		expected string
+	}{
+		{repoURL: "debian", expected: "docker.io/library/debian"},
+		{repoURL: "docker.io/debian", expected: "docker.io/library/debian"},
+		{repoURL: "docker.io/library/debian", expected: "docker.io/library/debian"},
+		{repoURL: "example/image", expected: "docker.io/example/image"},
+		{repoURL: " GHCR.io/Example/Image/ ", expected: "ghcr.io/example/image"},
+		{repoURL: "registry.example.com:5000/image", expected: "registry.example.com:5000/image"},
+		{repoURL: "not a valid URL", expected: "not a valid url"},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.repoURL, func(t *testing.T) {
+			require.Equal(t, testCase.expected, NormalizeRepoURL(testCase.repoURL))
+		})
+	}
+}
@@ -2,7 +2,6 @@ package image
 
 import (
 	"context"
-	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -26,7 +25,9 @@ import (
 	"go.uber.org/ratelimit"
 	"golang.org/x/sync/semaphore"
 
+	libHTTP "github.com/akuity/kargo/internal/http"
 	"github.com/akuity/kargo/internal/logging"
+	"github.com/akuity/kargo/internal/repocache"
 )
 
 const (
@@ -61,6 +62,16 @@ type repositoryClient struct {
 	image    string
 	repo     distribution.Repository
 
+	// signatureVerifier, if non-nil, is used to verify the signatures of
+	// images before they may be selected.
+	signatureVerifier *signatureVerifier
+
+	// tagCache, if non-nil, is consulted before retrieving the repository's
+	// tags from the registry. Its entries for this repository are stored
+	// under tagCacheKey.
+	tagCache    *repocache.Cache
+	tagCacheKey string
+
 	// The following behaviors are overridable for testing purposes:
 
 	getImageByTagFn func(
@@ -119,10 +130,12 @@ type repositoryClient struct {
 
 // newRepositoryClient parses the provided repository URL to infer registry
 // information and image name. This information is used to initialize and
-// return a new repository client.
+// return a new repository client. The provided CA bundle, if any, is trusted
+// only by the returned client.
 func newRepositoryClient(
 	repoURL string,
 	insecureSkipTLSVerify bool,
+	caBundle string,
 	creds *Credentials,
 ) (*repositoryClient, error) {
 	repoRef, err := reference.ParseNormalizedNamed(repoURL)
@@ -134,43 +147,18 @@ func newRepositoryClient(
 	image := reg.normalizeImageName(reference.Path(repoRef))
 	apiAddress := strings.TrimSuffix(reg.apiAddress, "/")
 
-	httpTransport := cleanhttp.DefaultTransport()
-	if insecureSkipTLSVerify {
-		httpTransport.TLSClientConfig = &tls.Config{
-			InsecureSkipVerify: insecureSkipTLSVerify, // nolint: gosec
-		}
-	}
-
-	challengeManager, err := getChallengeManager(
-		apiAddress,
-		&rateLimitedRoundTripper{
-			limiter:              reg.rateLimiter,
-			internalRoundTripper: httpTransport,
+	rlt, err := newAuthorizedRoundTripper(
+		reg,
+		insecureSkipTLSVerify,
+		caBundle,
+		creds,
+		auth.RepositoryScope{
+			Repository: image,
+			Actions:    []string{"pull"},
 		},
 	)
 	if err != nil {
-		return nil, fmt.Errorf("error getting challenge manager for %s: %w", apiAddress, err)
-	}
-
-	if creds == nil {
-		creds = &Credentials{}
-	}
-
-	rlt := &rateLimitedRoundTripper{
-		limiter: reg.rateLimiter,
-		internalRoundTripper: transport.NewTransport(
-			httpTransport,
-			auth.NewAuthorizer(
-				challengeManager,
-				auth.NewTokenHandler(
-					httpTransport,
-					creds,
-					image,
-					"pull",
-				),
-				auth.NewBasicHandler(creds),
-			),
-		),
+		return nil, err
 	}
 
 	imageRef, err := reference.WithName(image)
@@ -207,6 +195,63 @@ func newRepositoryClient(
 	return r, nil
 }
 
+// newAuthorizedRoundTripper returns a rate limited http.RoundTripper for
+// communicating with the specified registry. Requests made using the returned
+// http.RoundTripper are authorized for the specified scope using the provided
+// credentials, if any. Certificate verification is skipped if
+// insecureSkipTLSVerify is true. Otherwise, the certificates in the provided
+// PEM-encoded CA bundle, if any, are trusted in addition to the system's root
+// certificates.
+func newAuthorizedRoundTripper(
+	reg *registry,
+	insecureSkipTLSVerify bool,
+	caBundle string,
+	creds *Credentials,
+	scope auth.Scope,
+) (http.RoundTripper, error) {
+	apiAddress := strings.TrimSuffix(reg.apiAddress, "/")
+
+	tlsConfig, err := libHTTP.NewTLSConfig(insecureSkipTLSVerify, caBundle)
+	if err != nil {
+		return nil, fmt.Errorf("error configuring TLS for %s: %w", apiAddress, err)
+	}
+	httpTransport := cleanhttp.DefaultTransport()
+	if tlsConfig != nil {
+		httpTransport.TLSClientConfig = tlsConfig
+	}
+
+	challengeManager, err := getChallengeManager(
+		apiAddress,
+		&rateLimitedRoundTripper{
+			limiter:              reg.rateLimiter,
+			internalRoundTripper: httpTransport,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error getting challenge manager for %s: %w", apiAddress, err)
+	}
+
+	if creds == nil {
+		creds = &Credentials{}
+	}
+
+	return &rateLimitedRoundTripper{
+		limiter: reg.rateLimiter,
+		internalRoundTripper: transport.NewTransport(
+			httpTransport,
+			auth.NewAuthorizer(
+				challengeManager,
+				auth.NewTokenHandlerWithOptions(auth.TokenHandlerOptions{
+					Transport:   httpTransport,
+					Credentials: creds,
+					Scopes:      []auth.Scope{scope},
+				}),
+				auth.NewBasicHandler(creds),
+			),
+		),
+	}, nil
+}
+
 // getChallengeManager makes an initial request to a registry's API v2 endpoint.
 // The response is used to configure a challenge manager, which is returned.
 //
@@ -244,9 +289,10 @@ var getChallengeManager = func(
 // getTags retrieves a list of all tags from the repository.
 func (r *repositoryClient) getTags(ctx context.Context) ([]string, error) {
 	logger := logging.LoggerFromContext(ctx)
-	logger.Trace("retrieving tags for image")
-	tagSvc := r.repo.Tags(ctx)
-	tags, err := tagSvc.All(ctx)
+	tags, err := r.tagCache.Get(ctx, r.tagCacheKey, func(ctx context.Context) ([]string, error) {
+		logger.Trace("retrieving tags for image")
+		return r.repo.Tags(ctx).All(ctx)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("error retrieving tags from repository: %w", err)
 	}
@@ -418,7 +464,7 @@ func (r *repositoryClient) extractImageFromV1Manifest(
 		return nil, nil
 	}
 
-	createdAt, err := time.Parse(time.RFC3339Nano, info.Created)
+	createdAt, err := parseCreatedAt(info.Created)
 	if err != nil {
 		return nil, fmt.Errorf(
 			"error parsing createdAt timestamp from V1 manifest %s: %w",
@@ -429,7 +475,12 @@ func (r *repositoryClient) extractImageFromV1Manifest(
 
 	return &Image{
 		Digest:    digest,
-		CreatedAt: &createdAt,
+		CreatedAt: createdAt,
+		platforms: []platformConstraint{{
+			os:      info.OS,
+			arch:    info.Arch,
+			variant: info.Variant,
+		}},
 	}, nil
 }
 
@@ -477,7 +528,7 @@ func (r *repositoryClient) extractImageFromV2Manifest(
 		return nil, nil
 	}
 
-	createdAt, err := time.Parse(time.RFC3339Nano, info.Created)
+	createdAt, err := parseCreatedAt(info.Created)
 	if err != nil {
 		return nil, fmt.Errorf(
 			"error parsing createdAt timestamp from blob %s referenced by V2 manifest %s: %w",
@@ -489,7 +540,12 @@ func (r *repositoryClient) extractImageFromV2Manifest(
 
 	return &Image{
 		Digest:    digest,
-		CreatedAt: &createdAt,
+		CreatedAt: createdAt,
+		platforms: []platformConstraint{{
+			os:      info.OS,
+			arch:    info.Arch,
+			variant: info.Variant,
+		}},
 	}, nil
 }
 
@@ -543,7 +599,7 @@ func (r *repositoryClient) extractImageFromOCIManifest(
 		return nil, nil
 	}
 
-	createdAt, err := time.Parse(time.RFC3339Nano, info.Created)
+	createdAt, err := parseCreatedAt(info.Created)
 	if err != nil {
 		return nil, fmt.Errorf(
 			"error parsing createdAt timestamp from blob %s referenced by OCI manifest %s: %w",
@@ -555,7 +611,12 @@ func (r *repositoryClient) extractImageFromOCIManifest(
 
 	return &Image{
 		Digest:    digest,
-		CreatedAt: &createdAt,
+		CreatedAt: createdAt,
+		platforms: []platformConstraint{{
+			os:      info.OS,
+			arch:    info.Arch,
+			variant: info.Variant,
+		}},
 	}, nil
 }
 
@@ -601,6 +662,15 @@ func (r *repositoryClient) extractImageFromCollection(
 		)
 	}
 
+	platforms := make([]platformConstraint, len(refs))
+	for i, ref := range refs {
+		platforms[i] = platformConstraint{
+			os:      ref.Platform.OS,
+			arch:    ref.Platform.Architecture,
+			variant: ref.Platform.Variant,
+		}
+	}
+
 	// If there's a platform constraint, find the ref that matches it and
 	// that's the information we're really after.
 	if platform != nil {
@@ -646,6 +716,7 @@ func (r *repositoryClient) extractImageFromCollection(
 			)
 		}
 		image.Digest = digest
+		image.platforms = platforms
 		return image, nil
 	}
 
@@ -671,6 +742,9 @@ func (r *repositoryClient) extractImageFromCollection(
 				ref.Digest,
 			)
 		}
+		if image.CreatedAt == nil {
+			continue
+		}
 		if createdAt == nil || image.CreatedAt.After(*createdAt) {
 			createdAt = image.CreatedAt
 		}
@@ -679,9 +753,24 @@ func (r *repositoryClient) extractImageFromCollection(
 	return &Image{
 		Digest:    digest,
 		CreatedAt: createdAt,
+		platforms: platforms,
 	}, nil
 }
 
+// parseCreatedAt parses the creation timestamp found in an image's
+// configuration. Since the timestamp is optional, an empty string results in a
+// nil time and no error.
+func parseCreatedAt(created string) (*time.Time, error) {
+	if created == "" {
+		return nil, nil
+	}
+	createdAt, err := time.Parse(time.RFC3339Nano, created)
+	if err != nil {
+		return nil, err
+	}
+	return &createdAt, nil
+}
+
 // getBlob retrieves a blob from the repository.
 func (r *repositoryClient) getBlob(
 	ctx context.Context,
@@ -2,8 +2,11 @@ package image
 
 import (
 	"context"
+	"encoding/pem"
 	"errors"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,10 +15,13 @@ import (
 	"github.com/distribution/distribution/v3/manifest/ocischema"
 	"github.com/distribution/distribution/v3/manifest/schema1" // nolint: staticcheck
 	"github.com/distribution/distribution/v3/manifest/schema2"
+	"github.com/distribution/distribution/v3/registry/client/auth"
 	"github.com/distribution/distribution/v3/registry/client/auth/challenge"
 	"github.com/opencontainers/go-digest"
 	"github.com/patrickmn/go-cache"
 	"github.com/stretchr/testify/require"
+
+	"github.com/akuity/kargo/internal/repocache"
 )
 
 func TestNewRepository(t *testing.T) {
@@ -30,7 +36,7 @@ func TestNewRepository(t *testing.T) {
 		getChallengeManager = getChallengeManagerBackup
 	}()
 
-	client, err := newRepositoryClient("debian", false, nil)
+	client, err := newRepositoryClient("debian", false, "", nil)
 	require.NoError(t, err)
 	require.NotNil(t, client)
 	require.NotNil(t, client.registry)
@@ -49,6 +55,92 @@ func TestNewRepository(t *testing.T) {
 	require.NotNil(t, client.getBlobFn)
 }
 
+func TestNewAuthorizedRoundTripper(t *testing.T) {
+	srv := httptest.NewTLSServer(
+		http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}),
+	)
+	defer srv.Close()
+	testCABundle := string(pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: srv.Certificate().Raw,
+	}))
+	reg := newRegistry(strings.TrimPrefix(srv.URL, "https://"))
+
+	testCases := []struct {
+		name                  string
+		insecureSkipTLSVerify bool
+		caBundle              string
+		assertions            func(*testing.T, http.RoundTripper, error)
+	}{
+		{
+			name: "server certificate not trusted",
+			assertions: func(t *testing.T, _ http.RoundTripper, err error) {
+				require.ErrorContains(t, err, "certificate")
+			},
+		},
+		{
+			name:     "invalid CA bundle",
+			caBundle: "not a certificate",
+			assertions: func(t *testing.T, _ http.RoundTripper, err error) {
+				require.ErrorContains(t, err, "error configuring TLS")
+			},
+		},
+		{
+			name:                  "certificate verification skipped",
+			insecureSkipTLSVerify: true,
+			assertions: func(t *testing.T, rt http.RoundTripper, err error) {
+				require.NoError(t, err)
+				res, err := (&http.Client{Transport: rt}).Get(srv.URL + "/v2/")
+				require.NoError(t, err)
+				defer res.Body.Close()
+				require.Equal(t, http.StatusOK, res.StatusCode)
+			},
+		},
+		{
+			name:     "server certificate trusted via CA bundle",
+			caBundle: testCABundle,
+			assertions: func(t *testing.T, rt http.RoundTripper, err error) {
+				require.NoError(t, err)
+				res, err := (&http.Client{Transport: rt}).Get(srv.URL + "/v2/")
+				require.NoError(t, err)
+				defer res.Body.Close()
+				require.Equal(t, http.StatusOK, res.StatusCode)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			rt, err := newAuthorizedRoundTripper(
+				reg,
+				testCase.insecureSkipTLSVerify,
+				testCase.caBundle,
+				nil,
+				auth.RegistryScope{Name: "catalog", Actions: []string{"*"}},
+			)
+			testCase.assertions(t, rt, err)
+		})
+	}
+}
+
+func TestGetTagsFromCache(t *testing.T) {
+	tagCache := repocache.New(time.Minute)
+	_, err := tagCache.Get(context.Background(), "fake-key", func(context.Context) ([]string, error) {
+		return []string{"v1.0.0"}, nil
+	})
+	require.NoError(t, err)
+	// The client has no underlying repository, so any attempt to reach the
+	// registry would panic.
+	client := &repositoryClient{
+		tagCache:    tagCache,
+		tagCacheKey: "fake-key",
+	}
+	tags, err := client.getTags(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"v1.0.0"}, tags)
+}
+
 func TestGetImageByTag(t *testing.T) {
 	timePtr := func(t time.Time) *time.Time {
 		return &t
@@ -422,6 +514,25 @@ func TestExtractImageFromV1Manifest(t *testing.T) {
 				require.ErrorContains(t, err, "error parsing createdAt timestamp")
 			},
 		},
+		{
+			name: "no timestamp",
+			// nolint: staticcheck
+			manifest: &schema1.SignedManifest{
+				Manifest: schema1.Manifest{
+					History: []schema1.History{
+						{
+							V1Compatibility: `{"os": "linux", "architecture": "amd64"}`,
+						},
+					},
+				},
+			},
+			client: &repositoryClient{},
+			assertions: func(t *testing.T, image *Image, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, image)
+				require.Nil(t, image.CreatedAt)
+			},
+		},
 		{
 			name: "success",
 			// nolint: staticcheck
@@ -441,6 +552,11 @@ func TestExtractImageFromV1Manifest(t *testing.T) {
 				require.NotNil(t, image.CreatedAt)
 				require.Equal(t, testTime, *image.CreatedAt)
 				require.NotNil(t, image.Digest)
+				require.Equal(
+					t,
+					[]platformConstraint{{os: "linux", arch: "amd64"}},
+					image.platforms,
+				)
 			},
 		},
 	}
@@ -796,6 +912,13 @@ func TestExtractImageFromCollection(t *testing.T) {
 								Architecture: "amd64",
 							},
 						},
+						{
+							Platform: manifestlist.PlatformSpec{
+								OS:           "linux",
+								Architecture: "arm64",
+								Variant:      "v8",
+							},
+						},
 					},
 				},
 			},
@@ -819,6 +942,14 @@ func TestExtractImageFromCollection(t *testing.T) {
 				require.NotNil(t, image)
 				require.NotNil(t, image.CreatedAt)
 				require.Equal(t, testNow, *image.CreatedAt)
+				require.Equal(
+					t,
+					[]platformConstraint{
+						{os: "linux", arch: "amd64"},
+						{os: "linux", arch: "arm64", variant: "v8"},
+					},
+					image.platforms,
+				)
 			},
 		},
 		{
@@ -905,6 +1036,11 @@ func TestExtractImageFromCollection(t *testing.T) {
 				require.NotNil(t, image)
 				require.NotNil(t, image.CreatedAt)
 				require.Equal(t, testNow, *image.CreatedAt)
+				require.Equal(
+					t,
+					[]platformConstraint{{os: "linux", arch: "amd64"}},
+					image.platforms,
+				)
 			},
 		},
 	}
@@ -919,3 +1055,42 @@ func TestExtractImageFromCollection(t *testing.T) {
 		})
 	}
 }
+
+func TestParseCreatedAt(t *testing.T) {
+	testTime := time.Now().UTC()
+	testCases := []struct {
+		name       string
+		created    string
+		assertions func(*testing.T, *time.Time, error)
+	}{
+		{
+			name: "empty",
+			assertions: func(t *testing.T, createdAt *time.Time, err error) {
+				require.NoError(t, err)
+				require.Nil(t, createdAt)
+			},
+		},
+		{
+			name:    "invalid",
+			created: "junk",
+			assertions: func(t *testing.T, _ *time.Time, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name:    "valid",
+			created: testTime.Format(time.RFC3339Nano),
+			assertions: func(t *testing.T, createdAt *time.Time, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, createdAt)
+				require.True(t, testTime.Equal(*createdAt))
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			createdAt, err := parseCreatedAt(testCase.created)
+			testCase.assertions(t, createdAt, err)
+		})
+	}
+}
@@ -2,8 +2,15 @@ package image
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"regexp"
+
+	"github.com/opencontainers/go-digest"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/akuity/kargo/internal/logging"
+	"github.com/akuity/kargo/internal/repocache"
 )
 
 // SelectionStrategy represents a strategy for selecting a single image from a
@@ -15,7 +22,8 @@ const (
 	// useful for finding the digest of a container image that is currently
 	// referenced by a mutable tag, e.g. latest. This strategy requires the use of
 	// a constraint that must exactly match the name of a, presumably, mutable
-	// tag.
+	// tag, unless a specific digest is pinned, in which case the image with that
+	// digest is always selected.
 	SelectionStrategyDigest SelectionStrategy = "Digest"
 	// SelectionStrategyLexical represents an image selection strategy that is
 	// useful for finding the the image referenced by the tag that is lexically
@@ -38,6 +46,20 @@ const (
 	SelectionStrategySemVer SelectionStrategy = "SemVer"
 )
 
+// SelectionMode represents which of the images that satisfy all other criteria
+// should be selected.
+type SelectionMode string
+
+const (
+	// SelectionModeNewest selects the newest of the eligible images, as defined
+	// by the applicable SelectionStrategy. This is the default.
+	SelectionModeNewest SelectionMode = "Newest"
+	// SelectionModeOldest selects the oldest of the eligible images, as defined
+	// by the applicable SelectionStrategy. It has no effect on
+	// SelectionStrategyDigest.
+	SelectionModeOldest SelectionMode = "Oldest"
+)
+
 // Selector is an interface for selecting a single image from a container image
 // repository.
 type Selector interface {
@@ -60,12 +82,50 @@ type SelectorOptions struct {
 	// image must match the platform constraint or Selector implementations will
 	// return nil a image.
 	Platform string
+	// Platforms is an optional list of platform constraints that may be used
+	// instead of Platform when an image must be available for more than one
+	// platform. The first is applied exactly as Platform would be. If the
+	// selected image is not also available for all of the others, Select
+	// returns an error. Platform and Platforms are mutually exclusive.
+	Platforms []string
+	// ExcludePlatforms is an optional list of platform constraints. If
+	// specified, Selector implementations will skip any image that is available
+	// for any of these platforms. For a manifest list or index, this means any
+	// image whose list or index references a matching platform is skipped, even
+	// if the platform specified by Platform is also available. A constraint
+	// without a variant matches all variants of the OS and architecture.
+	ExcludePlatforms []string
 	// Creds holds optional credentials for authenticating to the image
 	// repository.
 	Creds *Credentials
 	// InsecureSkipTLSVerify is an optional flag, that if set to true, will
 	// disable verification of the image repository's TLS certificate.
 	InsecureSkipTLSVerify bool
+	// CABundle is an optional PEM-encoded bundle of CA certificates that are
+	// trusted, in addition to the system's root certificates, when connecting
+	// to the image repository.
+	CABundle string
+	// AllowedDigests is an optional list of digests. If non-nil, Selector
+	// implementations will skip any image whose digest is not in the list. Note
+	// that an empty, non-nil list permits no images at all.
+	AllowedDigests []string
+	// SelectionMode optionally specifies whether the newest or oldest eligible
+	// image should be selected. When left unspecified, SelectionModeNewest is
+	// used.
+	SelectionMode SelectionMode
+	// Digest optionally pins selection to the image with the specified digest.
+	// It is only used by SelectionStrategyDigest, in which case it takes
+	// precedence over Constraint.
+	Digest string
+	// SignatureVerificationKey is an optional PEM-encoded public key. If
+	// specified, Selector implementations will skip any image that lacks a
+	// cosign signature that verifies against it, and will return an error if
+	// no image that satisfied all other criteria could be verified.
+	SignatureVerificationKey string
+	// TagCache is an optional cache of tag listings. If specified, the tags of
+	// the image repository are retrieved from it when possible, instead of
+	// from the repository itself.
+	TagCache *repocache.Cache
 }
 
 // NewSelector returns some implementation of the Selector interface that
@@ -80,6 +140,15 @@ func NewSelector(
 		opts = &SelectorOptions{}
 	}
 
+	mode := opts.SelectionMode
+	switch mode {
+	case "":
+		mode = SelectionModeNewest
+	case SelectionModeNewest, SelectionModeOldest:
+	default:
+		return nil, fmt.Errorf("invalid image selection mode %q", mode)
+	}
+
 	var allowRegex *regexp.Regexp
 	if opts.AllowRegex != "" {
 		var err error
@@ -92,16 +161,54 @@ func NewSelector(
 		}
 	}
 
+	platformStr := opts.Platform
+	var requiredPlatforms []platformConstraint
+	if len(opts.Platforms) > 0 {
+		if opts.Platform != "" {
+			return nil, errors.New("platform and platforms are mutually exclusive")
+		}
+		platformStr = opts.Platforms[0]
+		var err error
+		if requiredPlatforms, err = parsePlatformConstraints(opts.Platforms[1:]); err != nil {
+			return nil, fmt.Errorf("error parsing platforms: %w", err)
+		}
+	}
+
 	var platform *platformConstraint
-	if opts.Platform != "" {
-		p, err := parsePlatformConstraint(opts.Platform)
+	if platformStr != "" {
+		p, err := parsePlatformConstraint(platformStr)
 		if err != nil {
-			return nil, fmt.Errorf("error parsing platform constraint %q: %w", opts.Platform, err)
+			return nil, fmt.Errorf("error parsing platform constraint %q: %w", platformStr, err)
 		}
 		platform = &p
 	}
 
-	repoClient, err := newRepositoryClient(repoURL, opts.InsecureSkipTLSVerify, opts.Creds)
+	excludedPlatforms, err := parsePlatformConstraints(opts.ExcludePlatforms)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing excluded platforms: %w", err)
+	}
+
+	var allowedDigests map[string]struct{}
+	if opts.AllowedDigests != nil {
+		allowedDigests = make(map[string]struct{}, len(opts.AllowedDigests))
+		for _, d := range opts.AllowedDigests {
+			allowedDigests[d] = struct{}{}
+		}
+	}
+
+	var verifier *signatureVerifier
+	if opts.SignatureVerificationKey != "" {
+		if verifier, err = newSignatureVerifier(opts.SignatureVerificationKey); err != nil {
+			return nil, fmt.Errorf("error parsing signature verification key: %w", err)
+		}
+	}
+
+	repoClient, err := newRepositoryClient(
+		repoURL,
+		opts.InsecureSkipTLSVerify,
+		opts.CABundle,
+		opts.Creds,
+	)
 	if err != nil {
 		return nil, fmt.Errorf(
 			"error creating repository client for image %q: %w",
@@ -109,16 +216,73 @@ func NewSelector(
 			err,
 		)
 	}
+	repoClient.signatureVerifier = verifier
+	if opts.TagCache != nil {
+		var username, password string
+		if opts.Creds != nil {
+			username, password = opts.Creds.Username, opts.Creds.Password
+		}
+		repoClient.tagCache = opts.TagCache
+		repoClient.tagCacheKey = repocache.Key(
+			fmt.Sprintf("%s/%s", repoClient.registry.apiAddress, repoClient.image),
+			username,
+			password,
+			opts.InsecureSkipTLSVerify,
+			opts.CABundle,
+		)
+	}
 
+	selector, err := newStrategySelector(
+		repoClient,
+		strategy,
+		opts,
+		mode,
+		allowRegex,
+		platform,
+		excludedPlatforms,
+		allowedDigests,
+	)
+	if err != nil || len(requiredPlatforms) == 0 {
+		return selector, err
+	}
+	return &multiPlatformSelector{
+		Selector:          selector,
+		repoURL:           repoURL,
+		requiredPlatforms: requiredPlatforms,
+	}, nil
+}
+
+// newStrategySelector returns the implementation of the Selector interface for
+// the specified selection strategy.
+func newStrategySelector(
+	repoClient *repositoryClient,
+	strategy SelectionStrategy,
+	opts *SelectorOptions,
+	mode SelectionMode,
+	allowRegex *regexp.Regexp,
+	platform *platformConstraint,
+	excludedPlatforms []platformConstraint,
+	allowedDigests map[string]struct{},
+) (Selector, error) {
 	switch strategy {
 	case SelectionStrategyDigest:
-		return newDigestSelector(repoClient, opts.Constraint, platform)
+		return newDigestSelector(
+			repoClient,
+			opts.Constraint,
+			opts.Digest,
+			platform,
+			excludedPlatforms,
+			allowedDigests,
+		)
 	case SelectionStrategyLexical:
 		return newLexicalSelector(
 			repoClient,
 			allowRegex,
 			opts.Ignore,
 			platform,
+			excludedPlatforms,
+			allowedDigests,
+			mode,
 		), nil
 	case SelectionStrategyNewestBuild:
 		return newNewestBuildSelector(
@@ -126,6 +290,9 @@ func NewSelector(
 			allowRegex,
 			opts.Ignore,
 			platform,
+			excludedPlatforms,
+			allowedDigests,
+			mode,
 		), nil
 	case SelectionStrategySemVer, "":
 		return newSemVerSelector(
@@ -134,12 +301,53 @@ func NewSelector(
 			opts.Ignore,
 			opts.Constraint,
 			platform,
+			excludedPlatforms,
+			allowedDigests,
+			mode,
 		)
 	default:
 		return nil, fmt.Errorf("invalid image selection strategy %q", strategy)
 	}
 }
 
+// multiPlatformSelector is an implementation of the Selector interface that
+// wraps another Selector and verifies the image it selects is available for
+// every one of a set of required platforms.
+type multiPlatformSelector struct {
+	Selector
+	repoURL           string
+	requiredPlatforms []platformConstraint
+}
+
+// Select implements the Selector interface.
+func (m *multiPlatformSelector) Select(ctx context.Context) (*Image, error) {
+	image, err := m.Selector.Select(ctx)
+	if err != nil || image == nil {
+		return image, err
+	}
+	for _, required := range m.requiredPlatforms {
+		if !availableForPlatform(image, required) {
+			ref := fmt.Sprintf("%s:%s", m.repoURL, image.Tag)
+			if image.Tag == "" {
+				ref = fmt.Sprintf("%s@%s", m.repoURL, image.Digest)
+			}
+			return nil, fmt.Errorf("image %s missing platform %s", ref, required.String())
+		}
+	}
+	return image, nil
+}
+
+// availableForPlatform returns true if the given image is available for the
+// given platform.
+func availableForPlatform(image *Image, platform platformConstraint) bool {
+	for _, p := range image.platforms {
+		if platform.matches(p.os, p.arch, p.variant) {
+			return true
+		}
+	}
+	return false
+}
+
 // allowsTag returns true if the given tag matches the given regular expression
 // or if the regular expression is nil. It returns false otherwise.
 func allowsTag(tag string, allowRegex *regexp.Regexp) bool {
@@ -149,6 +357,41 @@ func allowsTag(tag string, allowRegex *regexp.Regexp) bool {
 	return allowRegex.MatchString(tag)
 }
 
+// TagAllowed returns true if the given tag is one that a Selector created with
+// the given AllowRegex and Ignore options would consider for selection. An
+// error is returned if the regular expression does not compile.
+func TagAllowed(tag string, allowRegex string, ignore []string) (bool, error) {
+	var regex *regexp.Regexp
+	if allowRegex != "" {
+		var err error
+		if regex, err = regexp.Compile(allowRegex); err != nil {
+			return false, fmt.Errorf(
+				"error compiling regular expression %q: %w",
+				allowRegex,
+				err,
+			)
+		}
+	}
+	return allowsTag(tag, regex) && !ignoresTag(tag, ignore), nil
+}
+
+// filterTags returns the subset of the given tags that are allowed by the given
+// regular expression and are not in the given list of ignored tags. The
+// relative order of the tags is preserved. If there is no regular expression
+// and no ignored tags, the given tags are returned as is.
+func filterTags(tags []string, allowRegex *regexp.Regexp, ignore []string) []string {
+	if allowRegex == nil && len(ignore) == 0 {
+		return tags
+	}
+	matchedTags := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if allowsTag(tag, allowRegex) && !ignoresTag(tag, ignore) {
+			matchedTags = append(matchedTags, tag)
+		}
+	}
+	return matchedTags
+}
+
 // ignoresTag returns true if the given tag is in the given list of ignored
 // tags. It returns false otherwise.
 func ignoresTag(tag string, ignore []string) bool {
@@ -159,3 +402,107 @@ func ignoresTag(tag string, ignore []string) bool {
 	}
 	return false
 }
+
+// allowsDigest returns true if the given digest is in the given set of allowed
+// digests or if the set is nil. It returns false otherwise.
+func allowsDigest(d digest.Digest, allowedDigests map[string]struct{}) bool {
+	if allowedDigests == nil {
+		return true
+	}
+	_, ok := allowedDigests[d.String()]
+	return ok
+}
+
+// excludedPlatform returns the first of the provided excluded platforms that
+// the given image is available for. It returns nil if the image is not
+// available for any of them.
+func excludedPlatform(
+	image *Image,
+	excludedPlatforms []platformConstraint,
+) *platformConstraint {
+	for i := range excludedPlatforms {
+		for _, p := range image.platforms {
+			if excludedPlatforms[i].excludes(p.os, p.arch, p.variant) {
+				return &excludedPlatforms[i]
+			}
+		}
+	}
+	return nil
+}
+
+// getFirstAllowedImageByTag retrieves images for the provided tags, in order,
+// and returns the first one whose digest is in the given set of allowed
+// digests and that is not available for any of the given excluded platforms.
+// Images with digests that are not allowed or that are available for an
+// excluded platform are skipped, as are images without a verified signature
+// when the repository client requires one. If the
+// image for any tag does not match the platform constraint, nil is returned,
+// since this indicates the repository does not contain the image we are
+// looking for. If no image is found, nil is returned, unless images were
+// skipped only for lack of a verified signature, in which case an error
+// wrapping errNoVerifiedTags is returned.
+func getFirstAllowedImageByTag(
+	ctx context.Context,
+	repoClient *repositoryClient,
+	tags []string,
+	platform *platformConstraint,
+	excludedPlatforms []platformConstraint,
+	allowedDigests map[string]struct{},
+) (*Image, error) {
+	logger := logging.LoggerFromContext(ctx)
+	var unverified int
+	for _, tag := range tags {
+		image, err := repoClient.getImageByTag(ctx, tag, platform)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving image with tag %q: %w", tag, err)
+		}
+		if image == nil {
+			logger.Tracef(
+				"image with tag %q was found, but did not match platform constraint",
+				tag,
+			)
+			return nil, nil
+		}
+		if !allowsDigest(image.Digest, allowedDigests) {
+			logger.WithFields(log.Fields{
+				"tag":    tag,
+				"digest": image.Digest.String(),
+			}).Debug("skipping image because its digest is not in the allowlist")
+			continue
+		}
+		if p := excludedPlatform(image, excludedPlatforms); p != nil {
+			logger.WithFields(log.Fields{
+				"tag":      tag,
+				"digest":   image.Digest.String(),
+				"platform": p.String(),
+			}).Debug("skipping image because it is available for an excluded platform")
+			continue
+		}
+		verified, err := repoClient.hasVerifiedSignature(ctx, image.Digest)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error verifying signature of image with tag %q: %w",
+				tag,
+				err,
+			)
+		}
+		if !verified {
+			logger.WithFields(log.Fields{
+				"tag":    tag,
+				"digest": image.Digest.String(),
+			}).Debug("skipping image because its signature could not be verified")
+			unverified++
+			continue
+		}
+		return image, nil
+	}
+	if unverified > 0 {
+		return nil, fmt.Errorf(
+			"%w: none of %d candidate images had a valid signature",
+			errNoVerifiedTags,
+			unverified,
+		)
+	}
+	logger.Trace("no allowed image matched criteria")
+	return nil, nil
+}
@@ -1,11 +1,16 @@
 package image
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"regexp"
 	"testing"
 
+	"github.com/distribution/distribution/v3"
+	"github.com/distribution/distribution/v3/manifest/ocischema"
 	"github.com/distribution/distribution/v3/registry/client/auth/challenge"
+	"github.com/opencontainers/go-digest"
 	"github.com/stretchr/testify/require"
 )
 
@@ -48,6 +53,47 @@ func TestNewSelector(t *testing.T) {
 				require.ErrorContains(t, err, "error parsing platform constraint")
 			},
 		},
+		{
+			name:    "platform and platforms both specified",
+			repoURL: "debian",
+			opts: &SelectorOptions{
+				Platform:  "linux/amd64",
+				Platforms: []string{"linux/amd64", "linux/arm64"},
+			},
+			assertions: func(t *testing.T, _ Selector, err error) {
+				require.ErrorContains(t, err, "mutually exclusive")
+			},
+		},
+		{
+			name:    "invalid additional platform",
+			repoURL: "debian",
+			opts: &SelectorOptions{
+				Platforms: []string{"linux/amd64", "invalid"},
+			},
+			assertions: func(t *testing.T, _ Selector, err error) {
+				require.ErrorContains(t, err, "error parsing platforms")
+			},
+		},
+		{
+			name:    "invalid excluded platform",
+			repoURL: "debian",
+			opts: &SelectorOptions{
+				ExcludePlatforms: []string{"linux/arm64", "invalid"},
+			},
+			assertions: func(t *testing.T, _ Selector, err error) {
+				require.ErrorContains(t, err, "error parsing excluded platforms")
+			},
+		},
+		{
+			name:    "invalid selection mode",
+			repoURL: "debian",
+			opts: &SelectorOptions{
+				SelectionMode: SelectionMode("invalid"),
+			},
+			assertions: func(t *testing.T, _ Selector, err error) {
+				require.ErrorContains(t, err, "invalid image selection mode")
+			},
+		},
 		{
 			name:     "invalid selection strategy",
 			strategy: SelectionStrategy("invalid"),
@@ -96,6 +142,57 @@ func TestNewSelector(t *testing.T) {
 			assertions: func(t *testing.T, selector Selector, err error) {
 				require.NoError(t, err)
 				require.IsType(t, &semVerSelector{}, selector)
+				// Selection mode defaults to newest
+				require.Equal(t, SelectionModeNewest, selector.(*semVerSelector).mode) // nolint: forcetypeassert
+			},
+		},
+		{
+			name:     "success with a single platform",
+			strategy: SelectionStrategySemVer,
+			repoURL:  "debian",
+			opts: &SelectorOptions{
+				Platforms: []string{"linux/amd64"},
+			},
+			assertions: func(t *testing.T, selector Selector, err error) {
+				require.NoError(t, err)
+				require.IsType(t, &semVerSelector{}, selector)
+				require.Equal(
+					t,
+					&platformConstraint{os: "linux", arch: "amd64"},
+					selector.(*semVerSelector).platform, // nolint: forcetypeassert
+				)
+			},
+		},
+		{
+			name:     "success with multiple platforms",
+			strategy: SelectionStrategySemVer,
+			repoURL:  "debian",
+			opts: &SelectorOptions{
+				Platforms: []string{"linux/amd64", "linux/arm64"},
+			},
+			assertions: func(t *testing.T, selector Selector, err error) {
+				require.NoError(t, err)
+				require.IsType(t, &multiPlatformSelector{}, selector)
+				mps := selector.(*multiPlatformSelector) // nolint: forcetypeassert
+				require.IsType(t, &semVerSelector{}, mps.Selector)
+				require.Equal(
+					t,
+					[]platformConstraint{{os: "linux", arch: "arm64"}},
+					mps.requiredPlatforms,
+				)
+			},
+		},
+		{
+			name:     "success with oldest selection mode",
+			strategy: SelectionStrategySemVer,
+			repoURL:  "debian",
+			opts: &SelectorOptions{
+				SelectionMode: SelectionModeOldest,
+			},
+			assertions: func(t *testing.T, selector Selector, err error) {
+				require.NoError(t, err)
+				require.IsType(t, &semVerSelector{}, selector)
+				require.Equal(t, SelectionModeOldest, selector.(*semVerSelector).mode) // nolint: forcetypeassert
 			},
 		},
 	}
@@ -111,6 +208,80 @@ func TestNewSelector(t *testing.T) {
 	}
 }
 
+// fakeSelector is a fake implementation of the Selector interface used for
+// testing.
+type fakeSelector struct {
+	image *Image
+	err   error
+}
+
+// Select implements the Selector interface.
+func (f *fakeSelector) Select(context.Context) (*Image, error) {
+	return f.image, f.err
+}
+
+func TestMultiPlatformSelectorSelect(t *testing.T) {
+	testCases := []struct {
+		name       string
+		selector   *fakeSelector
+		assertions func(*testing.T, *Image, error)
+	}{
+		{
+			name:     "error selecting image",
+			selector: &fakeSelector{err: errors.New("something went wrong")},
+			assertions: func(t *testing.T, _ *Image, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name:     "no image selected",
+			selector: &fakeSelector{},
+			assertions: func(t *testing.T, image *Image, err error) {
+				require.NoError(t, err)
+				require.Nil(t, image)
+			},
+		},
+		{
+			name: "image missing a platform",
+			selector: &fakeSelector{
+				image: &Image{
+					Tag:       "v1.0.0",
+					platforms: []platformConstraint{{os: "linux", arch: "amd64"}},
+				},
+			},
+			assertions: func(t *testing.T, _ *Image, err error) {
+				require.EqualError(t, err, "image debian:v1.0.0 missing platform linux/arm64")
+			},
+		},
+		{
+			name: "image available for all platforms",
+			selector: &fakeSelector{
+				image: &Image{
+					Tag: "v1.0.0",
+					platforms: []platformConstraint{
+						{os: "linux", arch: "amd64"},
+						{os: "linux", arch: "arm64"},
+					},
+				},
+			},
+			assertions: func(t *testing.T, image *Image, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "v1.0.0", image.Tag)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			image, err := (&multiPlatformSelector{
+				Selector:          testCase.selector,
+				repoURL:           "debian",
+				requiredPlatforms: []platformConstraint{{os: "linux", arch: "arm64"}},
+			}).Select(context.Background())
+			testCase.assertions(t, image, err)
+		})
+	}
+}
+
 func TestAllowsTag(t *testing.T) {
 	testRegex := regexp.MustCompile("^[a-z]*$")
 	testCases := []struct {
@@ -140,6 +311,72 @@ func TestAllowsTag(t *testing.T) {
 	}
 }
 
+func TestTagAllowed(t *testing.T) {
+	testCases := []struct {
+		name       string
+		tag        string
+		allowRegex string
+		ignore     []string
+		assertions func(*testing.T, bool, error)
+	}{
+		{
+			name: "no criteria",
+			tag:  "latest",
+			assertions: func(t *testing.T, allowed bool, err error) {
+				require.NoError(t, err)
+				require.True(t, allowed)
+			},
+		},
+		{
+			name:       "invalid regular expression",
+			tag:        "latest",
+			allowRegex: "(",
+			assertions: func(t *testing.T, _ bool, err error) {
+				require.ErrorContains(t, err, "error compiling regular expression")
+			},
+		},
+		{
+			name:       "tag isn't allowed",
+			tag:        "latest",
+			allowRegex: `^v\d+`,
+			assertions: func(t *testing.T, allowed bool, err error) {
+				require.NoError(t, err)
+				require.False(t, allowed)
+			},
+		},
+		{
+			name:       "tag is ignored",
+			tag:        "v1.0.0",
+			allowRegex: `^v\d+`,
+			ignore:     []string{"v1.0.0"},
+			assertions: func(t *testing.T, allowed bool, err error) {
+				require.NoError(t, err)
+				require.False(t, allowed)
+			},
+		},
+		{
+			name:       "tag is allowed",
+			tag:        "v1.0.1",
+			allowRegex: `^v\d+`,
+			ignore:     []string{"v1.0.0"},
+			assertions: func(t *testing.T, allowed bool, err error) {
+				require.NoError(t, err)
+				require.True(t, allowed)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			allowed, err := TagAllowed(
+				testCase.tag,
+				testCase.allowRegex,
+				testCase.ignore,
+			)
+			testCase.assertions(t, allowed, err)
+		})
+	}
+}
+
 func TestIgnoresTag(t *testing.T) {
 	testIgnore := []string{"ignore-me"}
 	testCases := []struct {
@@ -168,3 +405,300 @@ func TestIgnoresTag(t *testing.T) {
 		})
 	}
 }
+
+func TestFilterTags(t *testing.T) {
+	testTags := []string{"build-10", "build-9", "latest", "build-11"}
+	testCases := []struct {
+		name       string
+		allowRegex *regexp.Regexp
+		ignore     []string
+		expected   []string
+	}{
+		{
+			name:     "no criteria",
+			expected: testTags,
+		},
+		{
+			name:       "allow regex only",
+			allowRegex: regexp.MustCompile(`^build-`),
+			expected:   []string{"build-10", "build-9", "build-11"},
+		},
+		{
+			name:     "ignore only",
+			ignore:   []string{"latest", "build-9"},
+			expected: []string{"build-10", "build-11"},
+		},
+		{
+			name:       "allow regex and ignore",
+			allowRegex: regexp.MustCompile(`^build-1`),
+			ignore:     []string{"build-11"},
+			expected:   []string{"build-10"},
+		},
+		{
+			name:       "nothing matches",
+			allowRegex: regexp.MustCompile(`^v`),
+			expected:   []string{},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				filterTags(testTags, testCase.allowRegex, testCase.ignore),
+			)
+		})
+	}
+}
+
+func TestAllowsDigest(t *testing.T) {
+	testCases := []struct {
+		name           string
+		allowedDigests map[string]struct{}
+		digest         digest.Digest
+		allowed        bool
+	}{
+		{
+			name:    "no allowlist",
+			digest:  "sha256:abc",
+			allowed: true,
+		},
+		{
+			name:           "empty allowlist",
+			allowedDigests: map[string]struct{}{},
+			digest:         "sha256:abc",
+			allowed:        false,
+		},
+		{
+			name:           "digest isn't allowed",
+			allowedDigests: map[string]struct{}{"sha256:def": {}},
+			digest:         "sha256:abc",
+			allowed:        false,
+		},
+		{
+			name:           "digest is allowed",
+			allowedDigests: map[string]struct{}{"sha256:abc": {}},
+			digest:         "sha256:abc",
+			allowed:        true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.allowed,
+				allowsDigest(testCase.digest, testCase.allowedDigests),
+			)
+		})
+	}
+}
+
+func TestExcludedPlatform(t *testing.T) {
+	image := &Image{
+		platforms: []platformConstraint{
+			{os: "linux", arch: "amd64"},
+			{os: "linux", arch: "arm", variant: "v7"},
+		},
+	}
+	testCases := []struct {
+		name              string
+		excludedPlatforms []platformConstraint
+		expected          *platformConstraint
+	}{
+		{
+			name:     "no excluded platforms",
+			expected: nil,
+		},
+		{
+			name:              "no matching excluded platform",
+			excludedPlatforms: []platformConstraint{{os: "linux", arch: "arm64"}},
+			expected:          nil,
+		},
+		{
+			name: "variant does not match",
+			excludedPlatforms: []platformConstraint{
+				{os: "linux", arch: "arm", variant: "v6"},
+			},
+			expected: nil,
+		},
+		{
+			name:              "excluded platform without variant matches any variant",
+			excludedPlatforms: []platformConstraint{{os: "linux", arch: "arm"}},
+			expected:          &platformConstraint{os: "linux", arch: "arm"},
+		},
+		{
+			name: "exact match",
+			excludedPlatforms: []platformConstraint{
+				{os: "linux", arch: "arm64"},
+				{os: "linux", arch: "amd64"},
+			},
+			expected: &platformConstraint{os: "linux", arch: "amd64"},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				excludedPlatform(image, testCase.excludedPlatforms),
+			)
+		})
+	}
+}
+
+func TestGetFirstAllowedImageByTag(t *testing.T) {
+	// newTestRepoClient returns a repositoryClient that resolves each tag to the
+	// digest and platforms in the provided maps. Tags absent from the digest map
+	// are treated as not matching the platform constraint.
+	newTestRepoClient := func(
+		digests map[string]digest.Digest,
+		platforms map[string][]platformConstraint,
+	) *repositoryClient {
+		var lastTag string
+		return &repositoryClient{
+			getManifestByTagFn: func(
+				_ context.Context,
+				tag string,
+			) (distribution.Manifest, error) {
+				if tag == "error" {
+					return nil, errors.New("something went wrong")
+				}
+				lastTag = tag
+				return &ocischema.DeserializedManifest{}, nil
+			},
+			extractImageFromManifestFn: func(
+				context.Context,
+				distribution.Manifest,
+				*platformConstraint,
+			) (*Image, error) {
+				d, ok := digests[lastTag]
+				if !ok {
+					return nil, nil
+				}
+				return &Image{
+					Digest:    d,
+					platforms: platforms[lastTag],
+				}, nil
+			},
+		}
+	}
+
+	testCases := []struct {
+		name              string
+		tags              []string
+		digests           map[string]digest.Digest
+		platforms         map[string][]platformConstraint
+		excludedPlatforms []platformConstraint
+		allowedDigests    map[string]struct{}
+		assertions        func(*testing.T, *Image, error)
+	}{
+		{
+			name: "error retrieving image",
+			tags: []string{"error"},
+			assertions: func(t *testing.T, _ *Image, err error) {
+				require.ErrorContains(t, err, "error retrieving image with tag")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "image does not match platform constraint",
+			tags: []string{"v1.0.0"},
+			assertions: func(t *testing.T, image *Image, err error) {
+				require.NoError(t, err)
+				require.Nil(t, image)
+			},
+		},
+		{
+			name: "no allowlist",
+			tags: []string{"v2.0.0", "v1.0.0"},
+			digests: map[string]digest.Digest{
+				"v2.0.0": "sha256:def",
+				"v1.0.0": "sha256:abc",
+			},
+			assertions: func(t *testing.T, image *Image, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, image)
+				require.Equal(t, "v2.0.0", image.Tag)
+			},
+		},
+		{
+			name: "disallowed digests are skipped",
+			tags: []string{"v2.0.0", "v1.0.0"},
+			digests: map[string]digest.Digest{
+				"v2.0.0": "sha256:def",
+				"v1.0.0": "sha256:abc",
+			},
+			allowedDigests: map[string]struct{}{"sha256:abc": {}},
+			assertions: func(t *testing.T, image *Image, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, image)
+				require.Equal(t, "v1.0.0", image.Tag)
+				require.Equal(t, digest.Digest("sha256:abc"), image.Digest)
+			},
+		},
+		{
+			name: "no digests are allowed",
+			tags: []string{"v2.0.0", "v1.0.0"},
+			digests: map[string]digest.Digest{
+				"v2.0.0": "sha256:def",
+				"v1.0.0": "sha256:abc",
+			},
+			allowedDigests: map[string]struct{}{},
+			assertions: func(t *testing.T, image *Image, err error) {
+				require.NoError(t, err)
+				require.Nil(t, image)
+			},
+		},
+		{
+			name: "images available for excluded platforms are skipped",
+			tags: []string{"v2.0.0", "v1.0.0"},
+			digests: map[string]digest.Digest{
+				"v2.0.0": "sha256:def",
+				"v1.0.0": "sha256:abc",
+			},
+			platforms: map[string][]platformConstraint{
+				"v2.0.0": {
+					{os: "linux", arch: "amd64"},
+					{os: "linux", arch: "arm64", variant: "v8"},
+				},
+				"v1.0.0": {
+					{os: "linux", arch: "amd64"},
+				},
+			},
+			excludedPlatforms: []platformConstraint{{os: "linux", arch: "arm64"}},
+			assertions: func(t *testing.T, image *Image, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, image)
+				require.Equal(t, "v1.0.0", image.Tag)
+			},
+		},
+		{
+			name: "all images available for excluded platforms",
+			tags: []string{"v1.0.0"},
+			digests: map[string]digest.Digest{
+				"v1.0.0": "sha256:abc",
+			},
+			platforms: map[string][]platformConstraint{
+				"v1.0.0": {{os: "linux", arch: "arm64"}},
+			},
+			excludedPlatforms: []platformConstraint{{os: "linux", arch: "arm64"}},
+			assertions: func(t *testing.T, image *Image, err error) {
+				require.NoError(t, err)
+				require.Nil(t, image)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			image, err := getFirstAllowedImageByTag(
+				context.Background(),
+				newTestRepoClient(testCase.digests, testCase.platforms),
+				testCase.tags,
+				nil,
+				testCase.excludedPlatforms,
+				testCase.allowedDigests,
+			)
+			testCase.assertions(t, image, err)
+		})
+	}
+}
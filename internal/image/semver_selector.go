@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
 	"sort"
 
 	"github.com/Masterminds/semver/v3"
@@ -14,11 +15,14 @@ import (
 
 // semVerSelector implements the Selector interface for SelectionStrategySemVer.
 type semVerSelector struct {
-	repoClient *repositoryClient
-	allowRegex *regexp.Regexp
-	ignore     []string
-	constraint *semver.Constraints
-	platform   *platformConstraint
+	repoClient        *repositoryClient
+	allowRegex        *regexp.Regexp
+	ignore            []string
+	constraint        *semver.Constraints
+	platform          *platformConstraint
+	excludedPlatforms []platformConstraint
+	allowedDigests    map[string]struct{}
+	mode              SelectionMode
 }
 
 // newSemVerSelector returns an implementation of the Selector interface for
@@ -29,6 +33,9 @@ func newSemVerSelector(
 	ignore []string,
 	constraint string,
 	platform *platformConstraint,
+	excludedPlatforms []platformConstraint,
+	allowedDigests map[string]struct{},
+	mode SelectionMode,
 ) (Selector, error) {
 	var semverConstraint *semver.Constraints
 	if constraint != "" {
@@ -42,11 +49,14 @@ func newSemVerSelector(
 		}
 	}
 	return &semVerSelector{
-		repoClient: repoClient,
-		allowRegex: allowRegex,
-		ignore:     ignore,
-		constraint: semverConstraint,
-		platform:   platform,
+		repoClient:        repoClient,
+		allowRegex:        allowRegex,
+		ignore:            ignore,
+		constraint:        semverConstraint,
+		platform:          platform,
+		excludedPlatforms: excludedPlatforms,
+		allowedDigests:    allowedDigests,
+		mode:              mode,
 	}, nil
 }
 
@@ -55,6 +65,7 @@ func (s *semVerSelector) Select(ctx context.Context) (*Image, error) {
 	logger := logging.LoggerFromContext(ctx).WithFields(log.Fields{
 		"registry":            s.repoClient.registry.name,
 		"image":               s.repoClient.image,
+		"selectionMode":       s.mode,
 		"selectionStrategy":   SelectionStrategySemVer,
 		"platformConstrained": s.platform != nil,
 	})
@@ -99,18 +110,24 @@ func (s *semVerSelector) Select(ctx context.Context) (*Image, error) {
 
 	logger.Trace("sorting images by semantic version")
 	sortImagesBySemVer(images)
+	if s.mode == SelectionModeOldest {
+		slices.Reverse(images)
+	}
 
-	tag := images[0].Tag
-	image, err := s.repoClient.getImageByTag(ctx, tag, s.platform)
-	if err != nil {
-		return nil, fmt.Errorf("error retrieving image with tag %q: %w", tag, err)
+	tags = make([]string, len(images))
+	for i, image := range images {
+		tags[i] = image.Tag
 	}
-	if image == nil {
-		logger.Tracef(
-			"image with tag %q was found, but did not match platform constraint",
-			tag,
-		)
-		return nil, nil
+	image, err := getFirstAllowedImageByTag(
+		ctx,
+		s.repoClient,
+		tags,
+		s.platform,
+		s.excludedPlatforms,
+		s.allowedDigests,
+	)
+	if err != nil || image == nil {
+		return nil, err
 	}
 
 	logger.WithFields(log.Fields{
@@ -129,7 +146,9 @@ func sortImagesBySemVer(images []Image) {
 		}
 		// If the semvers tie, break the tie lexically using the original strings
 		// used to construct the semvers. This ensures a deterministic comparison
-		// of equivalent semvers, e.g., 1.0 and 1.0.0.
+		// of equivalent semvers, e.g., 1.0 and 1.0.0. Since "v" sorts after any
+		// digit, this also means a v-prefixed tag such as v1.2.3 is preferred
+		// over its unprefixed equivalent, 1.2.3.
 		return images[i].semVer.Original() > images[j].semVer.Original()
 	})
 }
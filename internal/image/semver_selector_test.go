@@ -50,6 +50,7 @@ func TestNewSemVerSelector(t *testing.T) {
 				require.Equal(t, testIgnore, selector.ignore)
 				require.NotNil(t, selector.constraint)
 				require.Equal(t, testPlatform, selector.platform)
+				require.Equal(t, SelectionModeOldest, selector.mode)
 			},
 		},
 	}
@@ -61,6 +62,9 @@ func TestNewSemVerSelector(t *testing.T) {
 				testIgnore,
 				testCase.constraint,
 				testPlatform,
+				nil,
+				nil,
+				SelectionModeOldest,
 			)
 			testCase.assertions(t, s, err)
 		})
@@ -94,3 +98,26 @@ func TestSortImagesBySemver(t *testing.T) {
 		images,
 	)
 }
+
+func TestSortImagesBySemverWithEquivalentTags(t *testing.T) {
+	expected := []Image{
+		newImage("v1.2.3", nil, ""),
+		newImage("1.2.3", nil, ""),
+		newImage("1.2", nil, ""),
+		newImage("1.0.0", nil, ""),
+	}
+	// Regardless of the order in which the tags are listed, the result should
+	// be the same.
+	for _, tags := range [][]string{
+		{"1.2.3", "v1.2.3", "1.0.0", "1.2"},
+		{"v1.2.3", "1.2", "1.2.3", "1.0.0"},
+		{"1.0.0", "1.2", "1.2.3", "v1.2.3"},
+	} {
+		images := make([]Image, len(tags))
+		for i, tag := range tags {
+			images[i] = newImage(tag, nil, "")
+		}
+		sortImagesBySemVer(images)
+		require.Equal(t, expected, images)
+	}
+}
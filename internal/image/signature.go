@@ -0,0 +1,185 @@
+package image
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/opencontainers/go-digest"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// cosignSignatureAnnotation is the annotation cosign attaches to each layer
+// of a signature manifest. Its value is the base64-encoded signature of the
+// layer's content.
+const cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+
+// errNoVerifiedTags is returned by Selector implementations when signature
+// verification is enabled and no image that satisfied all other criteria had
+// a signature that verified.
+var errNoVerifiedTags = errors.New("no verified tags found")
+
+// signatureVerifier verifies cosign signatures of images using a public key.
+type signatureVerifier struct {
+	publicKey crypto.PublicKey
+}
+
+// newSignatureVerifier returns a signatureVerifier for the provided
+// PEM-encoded public key. ECDSA, RSA, and Ed25519 keys are supported.
+func newSignatureVerifier(publicKeyPEM string) (*signatureVerifier, error) {
+	block, _ := pem.Decode([]byte(publicKeyPEM))
+	if block == nil {
+		return nil, errors.New("no PEM-encoded public key found")
+	}
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing public key: %w", err)
+	}
+	switch publicKey.(type) {
+	case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+	return &signatureVerifier{publicKey: publicKey}, nil
+}
+
+// cosignPayload is a struct used for unmarshaling the parts of a cosign
+// simple signing payload that are relevant to verification.
+type cosignPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// verify returns true if the repository holds a cosign signature for the
+// image with the specified digest that verifies against the verifier's public
+// key. Images for which no signature can be retrieved are treated as unsigned.
+func (s *signatureVerifier) verify(
+	ctx context.Context,
+	repoClient *repositoryClient,
+	d digest.Digest,
+) (bool, error) {
+	logger := logging.LoggerFromContext(ctx).WithField("digest", d.String())
+	// cosign stores signatures in the image's repository under a tag derived
+	// from the digest of the signed manifest.
+	sigTag := fmt.Sprintf("%s-%s.sig", d.Algorithm(), d.Encoded())
+	manifest, err := repoClient.getManifestByTagFn(ctx, sigTag)
+	if err != nil {
+		logger.WithError(err).Debug("could not retrieve signature manifest")
+		return false, nil
+	}
+	for _, layer := range manifest.References() {
+		encodedSig, ok := layer.Annotations[cosignSignatureAnnotation]
+		if !ok {
+			continue
+		}
+		sig, err := base64.StdEncoding.DecodeString(encodedSig)
+		if err != nil {
+			logger.WithError(err).Debug("skipping malformed signature")
+			continue
+		}
+		payload, err := repoClient.getBlobFn(ctx, layer.Digest)
+		if err != nil {
+			return false, fmt.Errorf(
+				"error fetching signature payload %s: %w",
+				layer.Digest,
+				err,
+			)
+		}
+		if !s.verifyPayload(payload, sig) {
+			logger.WithField("payload", layer.Digest.String()).
+				Debug("signature did not verify")
+			continue
+		}
+		var p cosignPayload
+		if err = json.Unmarshal(payload, &p); err != nil {
+			logger.WithError(err).Debug("skipping malformed signature payload")
+			continue
+		}
+		// A valid signature for some other image must not count.
+		if p.Critical.Image.DockerManifestDigest != d.String() {
+			logger.WithFields(log.Fields{
+				"payload":      layer.Digest.String(),
+				"signedDigest": p.Critical.Image.DockerManifestDigest,
+			}).Debug("signature is for a different image")
+			continue
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// verifyPayload returns true if sig is a signature of payload made with the
+// private key corresponding to the verifier's public key.
+func (s *signatureVerifier) verifyPayload(payload, sig []byte) bool {
+	hash := sha256.Sum256(payload)
+	switch key := s.publicKey.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(key, hash[:], sig)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(key, crypto.SHA256, hash[:], sig) == nil
+	case ed25519.PublicKey:
+		return ed25519.Verify(key, payload, sig)
+	default:
+		return false
+	}
+}
+
+// hasVerifiedSignature returns true if the image with the specified digest
+// has a verified signature or if the repository client does not require one.
+func (r *repositoryClient) hasVerifiedSignature(
+	ctx context.Context,
+	d digest.Digest,
+) (bool, error) {
+	if r.signatureVerifier == nil {
+		return true, nil
+	}
+	return r.signatureVerifier.verify(ctx, r, d)
+}
+
+// skipUnverifiedImages returns the provided images, minus any that precede
+// the first one with a verified signature. Only as many signatures as
+// necessary are verified. If no image has a verified signature, an error
+// wrapping errNoVerifiedTags is returned.
+func skipUnverifiedImages(
+	ctx context.Context,
+	repoClient *repositoryClient,
+	images []Image,
+) ([]Image, error) {
+	logger := logging.LoggerFromContext(ctx)
+	for i, image := range images {
+		verified, err := repoClient.hasVerifiedSignature(ctx, image.Digest)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error verifying signature of image with tag %q: %w",
+				image.Tag,
+				err,
+			)
+		}
+		if verified {
+			return images[i:], nil
+		}
+		logger.WithFields(log.Fields{
+			"tag":    image.Tag,
+			"digest": image.Digest.String(),
+		}).Debug("skipping image because its signature could not be verified")
+	}
+	return nil, fmt.Errorf(
+		"%w: none of %d candidate images had a valid signature",
+		errNoVerifiedTags,
+		len(images),
+	)
+}
@@ -0,0 +1,284 @@
+package image
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/distribution/distribution/v3"
+	"github.com/distribution/distribution/v3/manifest/ocischema"
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/require"
+)
+
+// encodePublicKey returns the PEM encoding of the provided public key.
+func encodePublicKey(t *testing.T, publicKey crypto.PublicKey) string {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+// signedPayload returns a cosign simple signing payload for the image with the
+// specified digest and its ECDSA signature made with the provided key.
+func signedPayload(
+	t *testing.T,
+	key *ecdsa.PrivateKey,
+	d digest.Digest,
+) ([]byte, string) {
+	payload := []byte(fmt.Sprintf(
+		`{"critical":{"identity":{"docker-reference":"fake-image"},`+
+			`"image":{"docker-manifest-digest":%q},`+
+			`"type":"cosign container image signature"},"optional":null}`,
+		d,
+	))
+	hash := sha256.Sum256(payload)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+	require.NoError(t, err)
+	return payload, base64.StdEncoding.EncodeToString(sig)
+}
+
+// newSignatureTestRepoClient returns a repositoryClient that serves cosign
+// signature manifests for the provided digests. Each signature's payload is
+// served as a blob.
+func newSignatureTestRepoClient(
+	t *testing.T,
+	verifier *signatureVerifier,
+	payloads map[digest.Digest][]byte,
+	sigs map[digest.Digest]string,
+) *repositoryClient {
+	blobs := map[digest.Digest][]byte{}
+	return &repositoryClient{
+		signatureVerifier: verifier,
+		getManifestByTagFn: func(
+			_ context.Context,
+			tag string,
+		) (distribution.Manifest, error) {
+			for d, payload := range payloads {
+				if tag != fmt.Sprintf("sha256-%s.sig", d.Encoded()) {
+					continue
+				}
+				payloadDigest := digest.FromBytes(payload)
+				blobs[payloadDigest] = payload
+				manifest, err := ocischema.FromStruct(ocischema.Manifest{
+					Versioned: ocischema.SchemaVersion,
+					Layers: []distribution.Descriptor{{
+						MediaType: "application/vnd.dev.cosign.simplesigning.v1+json",
+						Digest:    payloadDigest,
+						Size:      int64(len(payload)),
+						Annotations: map[string]string{
+							cosignSignatureAnnotation: sigs[d],
+						},
+					}},
+				})
+				require.NoError(t, err)
+				return manifest, nil
+			}
+			return nil, errors.New("manifest unknown")
+		},
+		getBlobFn: func(_ context.Context, d digest.Digest) ([]byte, error) {
+			blob, ok := blobs[d]
+			if !ok {
+				return nil, errors.New("blob unknown")
+			}
+			return blob, nil
+		},
+	}
+}
+
+func TestNewSignatureVerifier(t *testing.T) {
+	_, err := newSignatureVerifier("not a key")
+	require.ErrorContains(t, err, "no PEM-encoded public key found")
+
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	verifier, err := newSignatureVerifier(encodePublicKey(t, ecdsaKey.Public()))
+	require.NoError(t, err)
+	require.IsType(t, &ecdsa.PublicKey{}, verifier.publicKey)
+
+	ed25519Key, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	verifier, err = newSignatureVerifier(encodePublicKey(t, ed25519Key))
+	require.NoError(t, err)
+	require.IsType(t, ed25519.PublicKey{}, verifier.publicKey)
+}
+
+func TestSignatureVerifierVerify(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	verifier, err := newSignatureVerifier(encodePublicKey(t, key.Public()))
+	require.NoError(t, err)
+
+	signedDigest := digest.FromString("signed")
+	otherKeyDigest := digest.FromString("signed-with-other-key")
+	wrongImageDigest := digest.FromString("signature-for-wrong-image")
+
+	payloads := map[digest.Digest][]byte{}
+	sigs := map[digest.Digest]string{}
+	payloads[signedDigest], sigs[signedDigest] = signedPayload(t, key, signedDigest)
+	payloads[otherKeyDigest], sigs[otherKeyDigest] =
+		signedPayload(t, otherKey, otherKeyDigest)
+	payloads[wrongImageDigest], sigs[wrongImageDigest] =
+		signedPayload(t, key, signedDigest)
+	repoClient := newSignatureTestRepoClient(t, verifier, payloads, sigs)
+
+	testCases := []struct {
+		name     string
+		digest   digest.Digest
+		expected bool
+	}{
+		{
+			name:     "valid signature",
+			digest:   signedDigest,
+			expected: true,
+		},
+		{
+			name:   "unsigned",
+			digest: digest.FromString("unsigned"),
+		},
+		{
+			name:   "signed with another key",
+			digest: otherKeyDigest,
+		},
+		{
+			name:   "signature for another image",
+			digest: wrongImageDigest,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			verified, err := verifier.verify(
+				context.Background(),
+				repoClient,
+				testCase.digest,
+			)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, verified)
+		})
+	}
+
+	t.Run("error fetching payload", func(t *testing.T) {
+		repoClient.getBlobFn = func(context.Context, digest.Digest) ([]byte, error) {
+			return nil, errors.New("something went wrong")
+		}
+		_, err := verifier.verify(context.Background(), repoClient, signedDigest)
+		require.ErrorContains(t, err, "error fetching signature payload")
+		require.ErrorContains(t, err, "something went wrong")
+	})
+}
+
+func TestSkipUnverifiedImages(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	verifier, err := newSignatureVerifier(encodePublicKey(t, key.Public()))
+	require.NoError(t, err)
+
+	signedDigest := digest.FromString("signed")
+	payload, sig := signedPayload(t, key, signedDigest)
+	repoClient := newSignatureTestRepoClient(
+		t,
+		verifier,
+		map[digest.Digest][]byte{signedDigest: payload},
+		map[digest.Digest]string{signedDigest: sig},
+	)
+
+	unsigned := Image{Tag: "v2.0.0", Digest: digest.FromString("unsigned")}
+	signed := Image{Tag: "v1.0.0", Digest: signedDigest}
+
+	images, err := skipUnverifiedImages(
+		context.Background(),
+		repoClient,
+		[]Image{unsigned, signed},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []Image{signed}, images)
+
+	_, err = skipUnverifiedImages(
+		context.Background(),
+		repoClient,
+		[]Image{unsigned},
+	)
+	require.ErrorIs(t, err, errNoVerifiedTags)
+
+	// Without a verifier, nothing is skipped
+	repoClient.signatureVerifier = nil
+	images, err = skipUnverifiedImages(
+		context.Background(),
+		repoClient,
+		[]Image{unsigned, signed},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []Image{unsigned, signed}, images)
+}
+
+func TestGetFirstAllowedImageByTagWithSignatureVerification(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	verifier, err := newSignatureVerifier(encodePublicKey(t, key.Public()))
+	require.NoError(t, err)
+
+	digests := map[string]digest.Digest{
+		"v3.0.0": digest.FromString("unsigned"),
+		"v2.0.0": digest.FromString("signed"),
+	}
+	payload, sig := signedPayload(t, key, digests["v2.0.0"])
+	repoClient := newSignatureTestRepoClient(
+		t,
+		verifier,
+		map[digest.Digest][]byte{digests["v2.0.0"]: payload},
+		map[digest.Digest]string{digests["v2.0.0"]: sig},
+	)
+	getSignatureManifest := repoClient.getManifestByTagFn
+	var lastTag string
+	repoClient.getManifestByTagFn = func(
+		ctx context.Context,
+		tag string,
+	) (distribution.Manifest, error) {
+		if _, ok := digests[tag]; ok {
+			lastTag = tag
+			return &ocischema.DeserializedManifest{}, nil
+		}
+		return getSignatureManifest(ctx, tag)
+	}
+	repoClient.extractImageFromManifestFn = func(
+		context.Context,
+		distribution.Manifest,
+		*platformConstraint,
+	) (*Image, error) {
+		return &Image{Digest: digests[lastTag]}, nil
+	}
+
+	image, err := getFirstAllowedImageByTag(
+		context.Background(),
+		repoClient,
+		[]string{"v3.0.0", "v2.0.0"},
+		nil,
+		nil,
+		nil,
+	)
+	require.NoError(t, err)
+	require.NotNil(t, image)
+	require.Equal(t, "v2.0.0", image.Tag)
+
+	_, err = getFirstAllowedImageByTag(
+		context.Background(),
+		repoClient,
+		[]string{"v3.0.0"},
+		nil,
+		nil,
+		nil,
+	)
+	require.ErrorIs(t, err, errNoVerifiedTags)
+	require.ErrorContains(t, err, "no verified tags found")
+}
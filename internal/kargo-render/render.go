@@ -38,6 +38,9 @@ type Request struct {
 	// Images specifies images to incorporate into environment-specific
 	// manifests.
 	Images []string `json:"images,omitempty"`
+	// AllowEmpty specifies whether rendering is permitted to produce no
+	// manifests.
+	AllowEmpty bool `json:"allowEmpty,omitempty"`
 	// RepoCreds encapsulates read/write credentials for the remote GitOps
 	// repository referenced by the RepoURL field.
 	RepoCreds git.RepoCredentials `json:"repoCreds,omitempty"`
@@ -67,6 +70,9 @@ func RenderManifests(req Request) error { // nolint: revive
 		"--output",
 		"json",
 	}
+	if req.AllowEmpty {
+		cmdTokens = append(cmdTokens, "--allow-empty")
+	}
 	for _, image := range req.Images {
 		cmdTokens = append(cmdTokens, "--image", image)
 	}
@@ -30,6 +30,7 @@ const (
 	NonTerminalPromotionsByStageIndexField = "stage"
 
 	RunningPromotionsByArgoCDApplicationsIndexField = "applications"
+	PromotionsByArgoCDApplicationsIndexField        = "argocdApplications"
 
 	PromotionPoliciesByStageIndexField   = "stage"
 	StagesByAnalysisRunIndexField        = "analysisRun"
@@ -130,12 +131,8 @@ func indexStagesByArgoCDApplications(shardName string) client.IndexerFunc {
 			return nil
 		}
 		apps := make([]string, len(stage.Spec.PromotionMechanisms.ArgoCDAppUpdates))
-		for i, appCheck := range stage.Spec.PromotionMechanisms.ArgoCDAppUpdates {
-			namespace := appCheck.AppNamespace
-			if namespace == "" {
-				namespace = libargocd.Namespace()
-			}
-			apps[i] = fmt.Sprintf("%s:%s", namespace, appCheck.AppName)
+		for i, appUpdate := range stage.Spec.PromotionMechanisms.ArgoCDAppUpdates {
+			apps[i] = argoCDAppUpdateKey(appUpdate)
 		}
 		return apps
 	}
@@ -227,41 +224,124 @@ func indexRunningPromotionsByArgoCDApplications(
 			return nil
 		}
 
-		stage := kargoapi.Stage{}
-		if err := c.Get(
-			ctx,
-			client.ObjectKey{
-				Namespace: promo.Namespace,
-				Name:      promo.Spec.Stage,
-			},
-			&stage,
-		); err != nil {
-			err = fmt.Errorf("can not get Stage for running Promotion %q in namespace %q: %w",
-				promo.Name, promo.Namespace, err)
+		res, err := promotionArgoCDApplicationKeys(ctx, c, promo)
+		if err != nil {
 			logger.Errorf("failed to index running Promotion by Argo CD Applications: %v", err)
 			return nil
 		}
+		return res
+	}
+}
 
-		if stage.Spec.PromotionMechanisms == nil || len(stage.Spec.PromotionMechanisms.ArgoCDAppUpdates) == 0 {
-			// If the Stage has no Argo CD Application promotion mechanisms,
-			// then we have nothing to index.
-			return nil
-		}
+// IndexPromotionsByArgoCDApplications indexes Promotions, irrespective of
+// their phase or shard, by the Argo CD Applications that the Promotion has
+// updated, as recorded in its status. Unlike the Promotion's Stage, this
+// record cannot change out from under the index.
+func IndexPromotionsByArgoCDApplications(
+	ctx context.Context,
+	mgr ctrl.Manager,
+) error {
+	return mgr.GetFieldIndexer().IndexField(
+		ctx,
+		&kargoapi.Promotion{},
+		PromotionsByArgoCDApplicationsIndexField,
+		indexPromotionsByArgoCDApplications,
+	)
+}
 
-		res := make([]string, len(stage.Spec.PromotionMechanisms.ArgoCDAppUpdates))
-		for i, appUpdate := range stage.Spec.PromotionMechanisms.ArgoCDAppUpdates {
-			namespace := appUpdate.AppNamespace
-			if namespace == "" {
-				namespace = libargocd.Namespace()
-			}
-			res[i] = fmt.Sprintf("%s:%s", namespace, appUpdate.AppName)
+func indexPromotionsByArgoCDApplications(obj client.Object) []string {
+	promo, ok := obj.(*kargoapi.Promotion)
+	if !ok {
+		return nil
+	}
+	var res []string
+	for _, result := range promo.Status.Mechanisms {
+		if result.Type != kargoapi.MechanismTypeArgoCDAppUpdate {
+			continue
+		}
+		// The target is the Application's namespace and name, prefixed with
+		// the name of the Argo CD instance that manages it, if that is not the
+		// default instance.
+		switch parts := strings.Split(result.Target, "/"); len(parts) {
+		case 2:
+			res = append(res, ArgoCDApplicationKey(parts[0], parts[1]))
+		case 3:
+			res = append(res, ArgoCDInstanceApplicationKey(parts[0], parts[1], parts[2]))
 		}
-		return res
 	}
+	return res
+}
+
+// promotionArgoCDApplicationKeys returns index keys for all Argo CD
+// Applications updated by the Stage referenced by the given Promotion.
+func promotionArgoCDApplicationKeys(
+	ctx context.Context,
+	c client.Client,
+	promo *kargoapi.Promotion,
+) ([]string, error) {
+	stage := kargoapi.Stage{}
+	if err := c.Get(
+		ctx,
+		client.ObjectKey{
+			Namespace: promo.Namespace,
+			Name:      promo.Spec.Stage,
+		},
+		&stage,
+	); err != nil {
+		return nil, fmt.Errorf(
+			"can not get Stage for Promotion %q in namespace %q: %w",
+			promo.Name, promo.Namespace, err,
+		)
+	}
+
+	if stage.Spec.PromotionMechanisms == nil || len(stage.Spec.PromotionMechanisms.ArgoCDAppUpdates) == 0 {
+		// If the Stage has no Argo CD Application promotion mechanisms,
+		// then we have nothing to index.
+		return nil, nil
+	}
+
+	res := make([]string, len(stage.Spec.PromotionMechanisms.ArgoCDAppUpdates))
+	for i, appUpdate := range stage.Spec.PromotionMechanisms.ArgoCDAppUpdates {
+		res[i] = argoCDAppUpdateKey(appUpdate)
+	}
+	return res, nil
+}
+
+// ArgoCDApplicationKey returns the key used to index Stages and Promotions by
+// the Argo CD Application with the given namespace and name. When looking up
+// Stages or Promotions affected by a change to an Application, the namespace
+// must be that of the Application itself, which need not be the namespace Argo
+// CD is installed in.
+func ArgoCDApplicationKey(namespace, name string) string {
+	return fmt.Sprintf("%s:%s", namespace, name)
+}
+
+// ArgoCDInstanceApplicationKey is like ArgoCDApplicationKey, but for an Argo
+// CD Application managed by the named Argo CD instance. An empty instance name
+// denotes the default Argo CD instance, whose Applications are keyed exactly
+// as by ArgoCDApplicationKey.
+func ArgoCDInstanceApplicationKey(instance, namespace, name string) string {
+	if instance == "" {
+		return ArgoCDApplicationKey(namespace, name)
+	}
+	return fmt.Sprintf("%s/%s", instance, ArgoCDApplicationKey(namespace, name))
+}
+
+// argoCDAppUpdateKey returns the index key for the Argo CD Application
+// targeted by the provided ArgoCDAppUpdate. Applications for which no
+// namespace is specified are assumed to live in Argo CD's own namespace.
+func argoCDAppUpdateKey(update kargoapi.ArgoCDAppUpdate) string {
+	namespace := update.AppNamespace
+	if namespace == "" {
+		namespace = libargocd.Namespace()
+	}
+	return ArgoCDInstanceApplicationKey(update.Instance, namespace, update.AppName)
 }
 
 // IndexPromotionsByStageAndFreight indexes Promotions by the Freight + Stage
-// they reference.
+// they reference. A Promotion referencing the "latest" Freight is indexed by
+// the Freight it resolved to, once it has been resolved. For as long as it is
+// in a non-terminal phase, it is also indexed by "latest".
 func IndexPromotionsByStageAndFreight(
 	ctx context.Context,
 	mgr ctrl.Manager,
@@ -276,9 +356,25 @@ func IndexPromotionsByStageAndFreight(
 
 func indexPromotionsByStageAndFreight(obj client.Object) []string {
 	promo := obj.(*kargoapi.Promotion) // nolint: forcetypeassert
-	return []string{
-		StageAndFreightKey(promo.Spec.Stage, promo.Spec.Freight),
+	if promo.Spec.Freight != kargoapi.PromotionFreightLatest {
+		return []string{
+			StageAndFreightKey(promo.Spec.Stage, promo.Spec.Freight),
+		}
+	}
+	var keys []string
+	if promo.Status.Freight != nil && promo.Status.Freight.Name != "" {
+		keys = append(
+			keys,
+			StageAndFreightKey(promo.Spec.Stage, promo.Status.Freight.Name),
+		)
+	}
+	if !promo.Status.Phase.IsTerminal() {
+		keys = append(
+			keys,
+			StageAndFreightKey(promo.Spec.Stage, kargoapi.PromotionFreightLatest),
+		)
 	}
+	return keys
 }
 
 func StageAndFreightKey(stage, freight string) string {
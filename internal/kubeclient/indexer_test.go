@@ -218,6 +218,37 @@ func TestIndexStagesByApp(t *testing.T) {
 				)
 			},
 		},
+		{
+			name:                "Stage updates Applications of another Argo CD instance",
+			controllerShardName: "",
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+							{
+								AppNamespace: "fake-namespace",
+								AppName:      "fake-app",
+							},
+							{
+								Instance:     "fake-instance",
+								AppNamespace: "fake-namespace",
+								AppName:      "fake-app",
+							},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, res []string) {
+				require.Equal(
+					t,
+					[]string{
+						"fake-namespace:fake-app",
+						"fake-instance/fake-namespace:fake-app",
+					},
+					res,
+				)
+			},
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -295,6 +326,68 @@ func TestIndexPromotionsByStage(t *testing.T) {
 	}
 }
 
+func TestIndexPromotionsByStageAndFreight(t *testing.T) {
+	testCases := map[string]struct {
+		input    *kargoapi.Promotion
+		expected []string
+	}{
+		"specific Freight": {
+			input: &kargoapi.Promotion{
+				Spec: kargoapi.PromotionSpec{
+					Stage:   "fake-stage",
+					Freight: "fake-freight",
+				},
+			},
+			expected: []string{"fake-stage:fake-freight"},
+		},
+		"latest Freight not yet resolved": {
+			input: &kargoapi.Promotion{
+				Spec: kargoapi.PromotionSpec{
+					Stage:   "fake-stage",
+					Freight: kargoapi.PromotionFreightLatest,
+				},
+				Status: kargoapi.PromotionStatus{
+					Phase: kargoapi.PromotionPhasePending,
+				},
+			},
+			expected: []string{"fake-stage:latest"},
+		},
+		"latest Freight resolved/non-terminal phase": {
+			input: &kargoapi.Promotion{
+				Spec: kargoapi.PromotionSpec{
+					Stage:   "fake-stage",
+					Freight: kargoapi.PromotionFreightLatest,
+				},
+				Status: kargoapi.PromotionStatus{
+					Phase:   kargoapi.PromotionPhaseRunning,
+					Freight: &kargoapi.FreightReference{Name: "fake-freight"},
+				},
+			},
+			expected: []string{"fake-stage:fake-freight", "fake-stage:latest"},
+		},
+		"latest Freight resolved/terminal phase": {
+			input: &kargoapi.Promotion{
+				Spec: kargoapi.PromotionSpec{
+					Stage:   "fake-stage",
+					Freight: kargoapi.PromotionFreightLatest,
+				},
+				Status: kargoapi.PromotionStatus{
+					Phase:   kargoapi.PromotionPhaseSucceeded,
+					Freight: &kargoapi.FreightReference{Name: "fake-freight"},
+				},
+			},
+			expected: []string{"fake-stage:fake-freight"},
+		},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			require.ElementsMatch(t, tc.expected, indexPromotionsByStageAndFreight(tc.input))
+		})
+	}
+}
+
 func TestIndexRunningPromotionsByArgoCDApplications(t *testing.T) {
 	const testShardName = "test-shard"
 
@@ -439,6 +532,65 @@ func TestIndexRunningPromotionsByArgoCDApplications(t *testing.T) {
 	}
 }
 
+func TestIndexPromotionsByArgoCDApplications(t *testing.T) {
+	testCases := []struct {
+		name     string
+		obj      client.Object
+		expected []string
+	}{
+		{
+			name:     "Object is not a Promotion",
+			obj:      &kargoapi.Stage{},
+			expected: nil,
+		},
+		{
+			name: "Promotion has not updated any Argo CD Applications",
+			obj: &kargoapi.Promotion{
+				Status: kargoapi.PromotionStatus{
+					Mechanisms: []kargoapi.MechanismResult{
+						{
+							Type:   kargoapi.MechanismTypeGitRepoUpdate,
+							Target: "https://github.com/example/repo.git",
+						},
+					},
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "Promotion has updated Argo CD Applications",
+			obj: &kargoapi.Promotion{
+				Status: kargoapi.PromotionStatus{
+					Phase: kargoapi.PromotionPhaseSucceeded,
+					Mechanisms: []kargoapi.MechanismResult{
+						{
+							Type:   kargoapi.MechanismTypeArgoCDAppUpdate,
+							Target: "fake-app-namespace/fake-app-name",
+						},
+						{
+							Type:   kargoapi.MechanismTypeArgoCDAppUpdate,
+							Target: "fake-instance/fake-app-namespace/fake-app-name",
+						},
+					},
+				},
+			},
+			expected: []string{
+				"fake-app-namespace:fake-app-name",
+				"fake-instance/fake-app-namespace:fake-app-name",
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				indexPromotionsByArgoCDApplications(testCase.obj),
+			)
+		})
+	}
+}
+
 func TestIndexFreightByVerifiedStages(t *testing.T) {
 	testCases := []struct {
 		name     string
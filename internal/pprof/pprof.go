@@ -0,0 +1,133 @@
+package pprof
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"strings"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// ServerConfig represents configuration for a server that exposes runtime
+// profiling data in the format expected by the pprof visualization tool.
+type ServerConfig struct {
+	// BindAddress is the TCP address the server listens on. When empty, the
+	// server is disabled.
+	BindAddress string `envconfig:"PPROF_BIND_ADDRESS"`
+	// AuthToken is the bearer token that every request to the server must
+	// present. It is required when the server is enabled.
+	AuthToken string `envconfig:"PPROF_AUTH_TOKEN"`
+}
+
+// ServerConfigFromEnv returns a ServerConfig populated from environment
+// variables.
+func ServerConfigFromEnv() ServerConfig {
+	cfg := ServerConfig{}
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
+// Enabled returns true if the server is enabled.
+func (c ServerConfig) Enabled() bool {
+	return c.BindAddress != ""
+}
+
+// server is an implementation of the manager.Runnable interface that serves
+// net/http/pprof handlers for as long as the manager it has been added to is
+// running.
+type server struct {
+	cfg ServerConfig
+}
+
+// NewServer returns a manager.Runnable that serves net/http/pprof handlers on
+// the configured address. All requests must be authenticated using the
+// configured bearer token.
+func NewServer(cfg ServerConfig) (manager.Runnable, error) {
+	if cfg.AuthToken == "" {
+		return nil, errors.New(
+			"an auth token is required when the profiling server is enabled",
+		)
+	}
+	return &server{cfg: cfg}, nil
+}
+
+// Start implements manager.Runnable.
+func (s *server) Start(ctx context.Context) error {
+	logger := logging.LoggerFromContext(ctx).
+		WithField("address", s.cfg.BindAddress)
+
+	ln, err := net.Listen("tcp", s.cfg.BindAddress)
+	if err != nil {
+		return fmt.Errorf(
+			"error listening on %q for profiling server: %w",
+			s.cfg.BindAddress,
+			err,
+		)
+	}
+
+	srv := &http.Server{
+		Handler:           s.handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		logger.Info("serving profiling endpoints")
+		errCh <- srv.Serve(ln)
+	}()
+
+	select {
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err = srv.Shutdown(shutdownCtx); err != nil {
+			return fmt.Errorf("error shutting down profiling server: %w", err)
+		}
+		return nil
+	case err = <-errCh:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return fmt.Errorf("error serving profiling endpoints: %w", err)
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. Profiles are
+// useful from any replica, so the server runs regardless of leadership.
+func (s *server) NeedLeaderElection() bool {
+	return false
+}
+
+// handler returns an http.Handler that serves the net/http/pprof handlers
+// under /debug/pprof/, behind bearer token authentication.
+func (s *server) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	return s.authenticate(mux)
+}
+
+// authenticate wraps the provided http.Handler such that requests not bearing
+// the configured token are rejected.
+func (s *server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(s.cfg.AuthToken)) != 1 {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
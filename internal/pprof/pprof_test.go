@@ -0,0 +1,87 @@
+package pprof
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewServer(t *testing.T) {
+	testCases := []struct {
+		name       string
+		cfg        ServerConfig
+		assertions func(*testing.T, error)
+	}{
+		{
+			name: "no auth token",
+			cfg: ServerConfig{
+				BindAddress: "localhost:6060",
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "an auth token is required")
+			},
+		},
+		{
+			name: "success",
+			cfg: ServerConfig{
+				BindAddress: "localhost:6060",
+				AuthToken:   "fake-token",
+			},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := NewServer(testCase.cfg)
+			testCase.assertions(t, err)
+		})
+	}
+}
+
+func TestServerHandler(t *testing.T) {
+	s := &server{
+		cfg: ServerConfig{
+			AuthToken: "fake-token",
+		},
+	}
+	testCases := []struct {
+		name           string
+		authHeader     string
+		expectedStatus int
+	}{
+		{
+			name:           "no auth header",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "wrong scheme",
+			authHeader:     "Basic fake-token",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "wrong token",
+			authHeader:     "Bearer wrong-token",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "valid token",
+			authHeader:     "Bearer fake-token",
+			expectedStatus: http.StatusOK,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/debug/pprof/", nil)
+			if testCase.authHeader != "" {
+				req.Header.Set("Authorization", testCase.authHeader)
+			}
+			rr := httptest.NewRecorder()
+			s.handler().ServeHTTP(rr, req)
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package repocache
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"golang.org/x/sync/singleflight"
+)
+
+// defaultFetchTimeout is how long a listing may take to retrieve before the
+// attempt is abandoned.
+const defaultFetchTimeout = time.Minute
+
+// Cache caches listings, such as image tags or chart versions, retrieved from
+// remote repositories so that repeated requests for the same listing within a
+// configurable TTL do not each result in a round trip to the repository. A nil
+// *Cache is valid and caches nothing.
+type Cache struct {
+	cache *cache.Cache
+	group singleflight.Group
+	// fetchTimeout bounds each shared retrieval of a listing.
+	fetchTimeout time.Duration
+}
+
+// New returns a Cache whose entries expire after the provided TTL. If the TTL
+// is not positive, nil is returned, which disables caching.
+func New(ttl time.Duration) *Cache {
+	if ttl <= 0 {
+		return nil
+	}
+	return &Cache{
+		cache:        cache.New(ttl, 2*ttl),
+		fetchTimeout: defaultFetchTimeout,
+	}
+}
+
+// Key returns the key under which to cache a listing retrieved from the
+// repository with the provided URL using the provided username and password
+// and TLS settings. Because a listing may differ depending on whose
+// credentials were used to retrieve it, listings retrieved with different
+// credentials are cached separately. So are listings retrieved with different
+// TLS settings, so that one retrieved without verifying the repository's
+// certificate, or by trusting a particular CA bundle, is never served to a
+// subscription that would not have been able to retrieve it. The credentials
+// and CA bundle are hashed so that they are never retained in the clear.
+func Key(
+	repoURL string,
+	username string,
+	password string,
+	insecureSkipTLSVerify bool,
+	caBundle string,
+) string {
+	credsHash := sha256.Sum256([]byte(username + "\x00" + password))
+	caBundleHash := sha256.Sum256([]byte(caBundle))
+	return fmt.Sprintf(
+		"%s#%x#%t#%x",
+		repoURL,
+		credsHash,
+		insecureSkipTLSVerify,
+		caBundleHash,
+	)
+}
+
+// Get returns the listing cached under the provided key. If no unexpired
+// listing is cached, listFn is invoked to retrieve one and, if it succeeds,
+// the result is cached. Concurrent calls for the same key share a single
+// invocation of listFn. Because that invocation serves every caller, it is not
+// bound to any one caller's context. It receives a context that carries the
+// values of the provided one, but is only canceled once its own timeout has
+// elapsed. Each caller stops waiting for it when its own context is done.
+// Callers receive their own copy of the listing and may modify it freely.
+func (c *Cache) Get(
+	ctx context.Context,
+	key string,
+	listFn func(context.Context) ([]string, error),
+) ([]string, error) {
+	if c == nil {
+		return listFn(ctx)
+	}
+	if listing, ok := c.cache.Get(key); ok {
+		return slices.Clone(listing.([]string)), nil // nolint: forcetypeassert
+	}
+	resCh := c.group.DoChan(key, func() (any, error) {
+		fetchCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), c.fetchTimeout)
+		defer cancel()
+		listing, err := listFn(fetchCtx)
+		if err != nil {
+			return nil, err
+		}
+		c.cache.SetDefault(key, listing)
+		return listing, nil
+	})
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-resCh:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return slices.Clone(res.Val.([]string)), nil // nolint: forcetypeassert
+	}
+}
@@ -0,0 +1,146 @@
+package repocache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	require.Nil(t, New(0))
+	require.Nil(t, New(-time.Minute))
+	require.NotNil(t, New(time.Minute))
+}
+
+func TestKey(t *testing.T) {
+	key := Key("fake-url", "fake-user", "fake-password", false, "")
+	require.Equal(t, key, Key("fake-url", "fake-user", "fake-password", false, ""))
+	require.NotContains(t, key, "fake-password")
+	require.NotEqual(t, key, Key("other-url", "fake-user", "fake-password", false, ""))
+	require.NotEqual(t, key, Key("fake-url", "other-user", "fake-password", false, ""))
+	require.NotEqual(t, key, Key("fake-url", "fake-user", "other-password", false, ""))
+	require.NotEqual(t, key, Key("fake-url", "", "", false, ""))
+	require.NotEqual(t, key, Key("fake-url", "fake-user", "fake-password", true, ""))
+	caKey := Key("fake-url", "fake-user", "fake-password", false, "fake-ca-bundle")
+	require.NotEqual(t, key, caKey)
+	require.NotContains(t, caKey, "fake-ca-bundle")
+	require.NotEqual(t, caKey, Key("fake-url", "fake-user", "fake-password", false, "other-ca-bundle"))
+}
+
+func TestGet(t *testing.T) {
+	var calls int
+	listFn := func(context.Context) ([]string, error) {
+		calls++
+		return []string{"v1.0.0", "v1.1.0"}, nil
+	}
+	key := Key("fake-url", "fake-user", "fake-password", false, "")
+
+	t.Run("nil cache", func(t *testing.T) {
+		calls = 0
+		var c *Cache
+		for range 2 {
+			listing, err := c.Get(context.Background(), key, listFn)
+			require.NoError(t, err)
+			require.Equal(t, []string{"v1.0.0", "v1.1.0"}, listing)
+		}
+		require.Equal(t, 2, calls)
+	})
+
+	t.Run("miss then hit", func(t *testing.T) {
+		calls = 0
+		c := New(time.Minute)
+		listing, err := c.Get(context.Background(), key, listFn)
+		require.NoError(t, err)
+		require.Equal(t, []string{"v1.0.0", "v1.1.0"}, listing)
+		// Modifying the returned listing must not affect the cached one
+		listing[0] = "bogus"
+		listing, err = c.Get(context.Background(), key, listFn)
+		require.NoError(t, err)
+		require.Equal(t, []string{"v1.0.0", "v1.1.0"}, listing)
+		require.Equal(t, 1, calls)
+	})
+
+	t.Run("different credentials miss", func(t *testing.T) {
+		calls = 0
+		c := New(time.Minute)
+		_, err := c.Get(context.Background(), key, listFn)
+		require.NoError(t, err)
+		_, err = c.Get(context.Background(), Key("fake-url", "other-user", "other-password", false, ""), listFn)
+		require.NoError(t, err)
+		require.Equal(t, 2, calls)
+	})
+
+	t.Run("expiry", func(t *testing.T) {
+		calls = 0
+		c := New(10 * time.Millisecond)
+		_, err := c.Get(context.Background(), key, listFn)
+		require.NoError(t, err)
+		time.Sleep(20 * time.Millisecond)
+		_, err = c.Get(context.Background(), key, listFn)
+		require.NoError(t, err)
+		require.Equal(t, 2, calls)
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		calls = 0
+		c := New(time.Minute)
+		_, err := c.Get(context.Background(), key, func(context.Context) ([]string, error) {
+			calls++
+			return nil, errors.New("something went wrong")
+		})
+		require.ErrorContains(t, err, "something went wrong")
+		listing, err := c.Get(context.Background(), key, listFn)
+		require.NoError(t, err)
+		require.Equal(t, []string{"v1.0.0", "v1.1.0"}, listing)
+		require.Equal(t, 2, calls)
+	})
+	t.Run("canceled caller does not fail others", func(t *testing.T) {
+		c := New(time.Minute)
+		started := make(chan struct{})
+		release := make(chan struct{})
+		slowListFn := func(ctx context.Context) ([]string, error) {
+			close(started)
+			select {
+			case <-release:
+				return []string{"v1.0.0"}, ctx.Err()
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+		firstCtx, cancelFirst := context.WithCancel(context.Background())
+		firstErrCh := make(chan error, 1)
+		go func() {
+			_, err := c.Get(firstCtx, key, slowListFn)
+			firstErrCh <- err
+		}()
+		<-started
+		secondCh := make(chan []string, 1)
+		go func() {
+			listing, err := c.Get(context.Background(), key, slowListFn)
+			require.NoError(t, err)
+			secondCh <- listing
+		}()
+		// The first caller gives up, but the shared retrieval carries on
+		cancelFirst()
+		require.ErrorIs(t, <-firstErrCh, context.Canceled)
+		close(release)
+		require.Equal(t, []string{"v1.0.0"}, <-secondCh)
+	})
+
+	t.Run("retrieval times out", func(t *testing.T) {
+		c := New(time.Minute)
+		c.fetchTimeout = 10 * time.Millisecond
+		_, err := c.Get(
+			context.Background(),
+			key,
+			func(ctx context.Context) ([]string, error) {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			},
+		)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
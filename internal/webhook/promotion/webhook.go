@@ -7,6 +7,7 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	authzv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -211,10 +212,30 @@ func (w *webhook) ValidateCreate(
 		return nil, err
 	}
 
+	if errs := w.validateSpec(field.NewPath("spec"), &promo.Spec); len(errs) > 0 {
+		return nil, apierrors.NewInvalid(promotionGroupKind, promo.Name, errs)
+	}
+
 	if err := w.authorizeFn(ctx, promo, "create"); err != nil {
 		return nil, err
 	}
 
+	// The Freight a Promotion of the latest Freight promotes isn't known until
+	// the Promotion begins running, so there is nothing to check yet.
+	var freight *kargoapi.Freight
+	if promo.Spec.Freight != kargoapi.PromotionFreightLatest {
+		var err error
+		if freight, err = w.getFreightFn(ctx, w.client, types.NamespacedName{
+			Namespace: promo.Namespace,
+			Name:      promo.Spec.Freight,
+		}); err != nil {
+			return nil, fmt.Errorf("get freight: %w", err)
+		}
+		if err = w.validateFreightAvailable(ctx, promo, freight); err != nil {
+			return nil, err
+		}
+	}
+
 	req, err := w.admissionRequestFromContextFn(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("get admission request from context: %w", err)
@@ -222,18 +243,71 @@ func (w *webhook) ValidateCreate(
 
 	// Record Promotion created event if the request doesn't come from Kargo controlplane
 	if !w.isRequestFromKargoControlplaneFn(req) {
-		freight, err := w.getFreightFn(ctx, w.client, types.NamespacedName{
-			Namespace: promo.Namespace,
-			Name:      promo.Spec.Freight,
-		})
-		if err != nil {
-			return nil, fmt.Errorf("get freight: %w", err)
-		}
 		w.recordPromotionCreatedEvent(ctx, req, promo, freight)
 	}
 	return nil, nil
 }
 
+// validateFreightAvailable returns an error if the provided Freight does not
+// exist or, unless the provided Promotion is forced, is not available to the
+// Stage the Promotion targets. Catching this at admission time spares users
+// from discovering it only once the Promotion has failed.
+func (w *webhook) validateFreightAvailable(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+	freight *kargoapi.Freight,
+) error {
+	f := field.NewPath("spec", "freight")
+	if freight == nil {
+		return apierrors.NewInvalid(
+			promotionGroupKind,
+			promo.Name,
+			field.ErrorList{field.NotFound(f, promo.Spec.Freight)},
+		)
+	}
+	if promo.Spec.Force {
+		return nil
+	}
+	stage, err := w.getStageFn(ctx, w.client, types.NamespacedName{
+		Namespace: promo.Namespace,
+		Name:      promo.Spec.Stage,
+	})
+	if err != nil {
+		return fmt.Errorf("get stage: %w", err)
+	}
+	if stage == nil {
+		return apierrors.NewInvalid(
+			promotionGroupKind,
+			promo.Name,
+			field.ErrorList{
+				field.NotFound(field.NewPath("spec", "stage"), promo.Spec.Stage),
+			},
+		)
+	}
+	upstreamStages := make([]string, len(stage.Spec.Subscriptions.UpstreamStages))
+	for i, upstreamStage := range stage.Spec.Subscriptions.UpstreamStages {
+		upstreamStages[i] = upstreamStage.Name
+	}
+	if !kargoapi.IsFreightAvailable(freight, stage.Name, upstreamStages) {
+		return apierrors.NewInvalid(
+			promotionGroupKind,
+			promo.Name,
+			field.ErrorList{
+				field.Invalid(
+					f,
+					promo.Spec.Freight,
+					fmt.Sprintf(
+						"Freight %q is not available to Stage %q",
+						promo.Spec.Freight,
+						promo.Spec.Stage,
+					),
+				),
+			},
+		)
+	}
+	return nil
+}
+
 func (w *webhook) ValidateUpdate(
 	ctx context.Context,
 	oldObj runtime.Object,
@@ -245,7 +319,7 @@ func (w *webhook) ValidateUpdate(
 	}
 
 	// PromotionSpecs are meant to be immutable
-	if promo.Spec != (oldObj.(*kargoapi.Promotion).Spec) { // nolint: forcetypeassert
+	if !equality.Semantic.DeepEqual(promo.Spec, oldObj.(*kargoapi.Promotion).Spec) { // nolint: forcetypeassert
 		return nil, apierrors.NewInvalid(
 			promotionGroupKind,
 			promo.Name,
@@ -261,6 +335,24 @@ func (w *webhook) ValidateUpdate(
 	return nil, nil
 }
 
+func (w *webhook) validateSpec(
+	f *field.Path,
+	spec *kargoapi.PromotionSpec,
+) field.ErrorList {
+	var errs field.ErrorList
+	if spec.Timeout != nil && spec.Timeout.Duration <= 0 {
+		errs = append(
+			errs,
+			field.Invalid(
+				f.Child("timeout"),
+				spec.Timeout.Duration.String(),
+				"timeout must be positive",
+			),
+		)
+	}
+	return errs
+}
+
 func (w *webhook) ValidateDelete(
 	ctx context.Context,
 	obj runtime.Object,
@@ -5,13 +5,16 @@ import (
 	"errors"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	authnv1 "k8s.io/api/authentication/v1"
 	authzv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apiserver/pkg/authentication/serviceaccount"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -140,9 +143,37 @@ func TestDefault(t *testing.T) {
 }
 
 func TestValidateCreate(t *testing.T) {
+	availableFreight := func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Freight, error) {
+		return &kargoapi.Freight{
+			ObjectMeta: v1.ObjectMeta{Name: "fake-freight"},
+			Status: kargoapi.FreightStatus{
+				ApprovedFor: map[string]kargoapi.ApprovedStage{"fake-stage": {}},
+			},
+		}, nil
+	}
+	getStage := func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Stage, error) {
+		return &kargoapi.Stage{
+			ObjectMeta: v1.ObjectMeta{Name: "fake-stage"},
+			Spec: kargoapi.StageSpec{
+				Subscriptions: kargoapi.Subscriptions{
+					UpstreamStages: []kargoapi.StageSubscription{{Name: "fake-upstream-stage"}},
+				},
+			},
+		}, nil
+	}
 	testCases := []struct {
 		name       string
 		webhook    *webhook
+		freight    string
+		force      bool
 		userInfo   *authnv1.UserInfo
 		assertions func(*testing.T, *fakeevent.EventRecorder, error)
 	}{
@@ -184,7 +215,7 @@ func TestValidateCreate(t *testing.T) {
 			},
 		},
 		{
-			name: "record promotion created event on non-controlplane request",
+			name: "Freight not found",
 			webhook: &webhook{
 				validateProjectFn: func(
 					context.Context,
@@ -197,7 +228,6 @@ func TestValidateCreate(t *testing.T) {
 				authorizeFn: func(context.Context, *kargoapi.Promotion, string) error {
 					return nil
 				},
-				admissionRequestFromContextFn: admission.RequestFromContext,
 				getFreightFn: func(
 					context.Context,
 					client.Client,
@@ -205,6 +235,122 @@ func TestValidateCreate(t *testing.T) {
 				) (*kargoapi.Freight, error) {
 					return nil, nil
 				},
+			},
+			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, err error) {
+				require.True(t, apierrors.IsInvalid(err))
+				require.ErrorContains(t, err, "spec.freight: Not found")
+			},
+		},
+		{
+			name: "Freight not available to Stage",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return nil
+				},
+				authorizeFn: func(context.Context, *kargoapi.Promotion, string) error {
+					return nil
+				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: v1.ObjectMeta{Name: "fake-freight"},
+					}, nil
+				},
+				getStageFn: getStage,
+			},
+			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, err error) {
+				require.True(t, apierrors.IsInvalid(err))
+				require.ErrorContains(
+					t,
+					err,
+					`Freight "fake-freight" is not available to Stage "fake-stage"`,
+				)
+			},
+		},
+		{
+			name:  "Freight not available to Stage but Promotion forced",
+			force: true,
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return nil
+				},
+				authorizeFn: func(context.Context, *kargoapi.Promotion, string) error {
+					return nil
+				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: v1.ObjectMeta{Name: "fake-freight"},
+					}, nil
+				},
+				admissionRequestFromContextFn: admission.RequestFromContext,
+				isRequestFromKargoControlplaneFn: func(admission.Request) bool {
+					return true
+				},
+			},
+			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			// getFreightFn and getStageFn are left nil because the Freight is not
+			// known until the Promotion begins running
+			name:    "latest Freight",
+			freight: kargoapi.PromotionFreightLatest,
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return nil
+				},
+				authorizeFn: func(context.Context, *kargoapi.Promotion, string) error {
+					return nil
+				},
+				admissionRequestFromContextFn: admission.RequestFromContext,
+				isRequestFromKargoControlplaneFn: func(admission.Request) bool {
+					return true
+				},
+			},
+			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "record promotion created event on non-controlplane request",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return nil
+				},
+				authorizeFn: func(context.Context, *kargoapi.Promotion, string) error {
+					return nil
+				},
+				admissionRequestFromContextFn: admission.RequestFromContext,
+				getFreightFn:                  availableFreight,
+				getStageFn:                    getStage,
 				isRequestFromKargoControlplaneFn: libWebhook.IsRequestFromKargoControlplane(
 					regexp.MustCompile("^system:serviceaccount:kargo:(kargo-api|kargo-controller)$"),
 				),
@@ -234,6 +380,8 @@ func TestValidateCreate(t *testing.T) {
 					return nil
 				},
 				admissionRequestFromContextFn: admission.RequestFromContext,
+				getFreightFn:                  availableFreight,
+				getStageFn:                    getStage,
 				isRequestFromKargoControlplaneFn: libWebhook.IsRequestFromKargoControlplane(
 					regexp.MustCompile("^system:serviceaccount:kargo:(kargo-api|kargo-controller)$"),
 				),
@@ -258,11 +406,17 @@ func TestValidateCreate(t *testing.T) {
 			}
 			ctx := admission.NewContextWithRequest(context.Background(), req)
 
+			freight := testCase.freight
+			if freight == "" {
+				freight = "fake-freight"
+			}
 			_, err := testCase.webhook.ValidateCreate(
 				ctx,
 				&kargoapi.Promotion{
 					Spec: kargoapi.PromotionSpec{
-						Freight: "fake-freight",
+						Stage:   "fake-stage",
+						Freight: freight,
+						Force:   testCase.force,
 					},
 				},
 			)
@@ -332,6 +486,7 @@ func TestValidateUpdate(t *testing.T) {
 					Spec: kargoapi.PromotionSpec{
 						Stage:   "fake-stage",
 						Freight: "fake-freight",
+						Timeout: &v1.Duration{Duration: time.Hour},
 					},
 				}
 				newPromo := oldPromo.DeepCopy()
@@ -357,6 +512,69 @@ func TestValidateUpdate(t *testing.T) {
 	}
 }
 
+func TestValidateSpec(t *testing.T) {
+	testCases := []struct {
+		name       string
+		spec       kargoapi.PromotionSpec
+		assertions func(*testing.T, field.ErrorList)
+	}{
+		{
+			name: "no timeout",
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+		{
+			name: "positive timeout",
+			spec: kargoapi.PromotionSpec{
+				Timeout: &v1.Duration{Duration: time.Minute},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+		{
+			name: "zero timeout",
+			spec: kargoapi.PromotionSpec{
+				Timeout: &v1.Duration{},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "spec.timeout",
+							BadValue: "0s",
+							Detail:   "timeout must be positive",
+						},
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "negative timeout",
+			spec: kargoapi.PromotionSpec{
+				Timeout: &v1.Duration{Duration: -time.Minute},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "spec.timeout", errs[0].Field)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				w.validateSpec(field.NewPath("spec"), &testCase.spec),
+			)
+		})
+	}
+}
+
 func TestValidateDelete(t *testing.T) {
 	testCases := []struct {
 		name       string
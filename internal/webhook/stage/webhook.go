@@ -2,9 +2,14 @@ package stage
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	authzv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -14,14 +19,24 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/logging"
 	libWebhook "github.com/akuity/kargo/internal/webhook"
 )
 
+// maxHTTPHealthCheckTimeout is the longest a Stage's HTTP health check may
+// wait for its endpoint to respond. Checks are performed while the Stage is
+// being reconciled, so a long timeout would tie up the controller.
+const maxHTTPHealthCheckTimeout = time.Minute
+
 var (
 	stageGroupKind = schema.GroupKind{
 		Group: kargoapi.GroupVersion.Group,
 		Kind:  "Stage",
 	}
+	stageGroupResource = schema.GroupResource{
+		Group:    kargoapi.GroupVersion.Group,
+		Resource: "stages",
+	}
 )
 
 type webhook struct {
@@ -43,6 +58,14 @@ type webhook struct {
 
 	validateSpecFn func(*field.Path, *kargoapi.StageSpec) field.ErrorList
 
+	authorizeJobsFn func(context.Context, *kargoapi.Stage) error
+
+	createSubjectAccessReviewFn func(
+		context.Context,
+		client.Object,
+		...client.CreateOption,
+	) error
+
 	isRequestFromKargoControlplaneFn libWebhook.IsRequestFromKargoControlplaneFn
 }
 
@@ -75,6 +98,8 @@ func newWebhook(
 	w.validateProjectFn = libWebhook.ValidateProject
 	w.validateCreateOrUpdateFn = w.validateCreateOrUpdate
 	w.validateSpecFn = w.validateSpec
+	w.authorizeJobsFn = w.authorizeJobs
+	w.createSubjectAccessReviewFn = w.client.Create
 	w.isRequestFromKargoControlplaneFn =
 		libWebhook.IsRequestFromKargoControlplane(cfg.ControlplaneUserRegex)
 	return w
@@ -158,16 +183,39 @@ func (w *webhook) ValidateCreate(
 		w.validateProjectFn(ctx, w.client, stageGroupKind, stage); err != nil {
 		return nil, err
 	}
-	return w.validateCreateOrUpdateFn(stage)
+	warnings, err := w.validateCreateOrUpdateFn(stage)
+	if err != nil {
+		return warnings, err
+	}
+	if len(stageJobs(stage)) > 0 {
+		if err = w.authorizeJobsFn(ctx, stage); err != nil {
+			return warnings, err
+		}
+	}
+	return warnings, nil
 }
 
 func (w *webhook) ValidateUpdate(
-	_ context.Context,
-	_ runtime.Object,
+	ctx context.Context,
+	oldObj runtime.Object,
 	newObj runtime.Object,
 ) (admission.Warnings, error) {
 	stage := newObj.(*kargoapi.Stage) // nolint: forcetypeassert
-	return w.validateCreateOrUpdateFn(stage)
+	warnings, err := w.validateCreateOrUpdateFn(stage)
+	if err != nil {
+		return warnings, err
+	}
+	// Only changes to the Jobs call for authorization. Otherwise, anyone
+	// permitted to update a Stage at all would need to be permitted to run its
+	// Jobs as well.
+	oldStage, _ := oldObj.(*kargoapi.Stage)
+	if jobs := stageJobs(stage); len(jobs) > 0 &&
+		(oldStage == nil || !equality.Semantic.DeepEqual(jobs, stageJobs(oldStage))) {
+		if err = w.authorizeJobsFn(ctx, stage); err != nil {
+			return warnings, err
+		}
+	}
+	return warnings, nil
 }
 
 func (w *webhook) ValidateDelete(
@@ -195,13 +243,38 @@ func (w *webhook) validateSpec(
 		return nil
 	}
 	errs := w.validateSubs(f.Child("subscriptions"), &spec.Subscriptions)
-	return append(
+	errs = append(
 		errs,
 		w.validatePromotionMechanisms(
 			f.Child("promotionMechanisms"),
 			spec.PromotionMechanisms,
 		)...,
 	)
+	if retention := spec.HistoryRetention; retention != nil &&
+		retention.MaxAge != nil && retention.MaxAge.Duration <= 0 {
+		errs = append(
+			errs,
+			field.Invalid(
+				f.Child("historyRetention", "maxAge"),
+				retention.MaxAge.Duration.String(),
+				"must be positive",
+			),
+		)
+	}
+	if hc := spec.HealthCheck; hc != nil && hc.HTTP != nil && hc.HTTP.Timeout != nil {
+		if timeout := hc.HTTP.Timeout.Duration; timeout <= 0 ||
+			timeout > maxHTTPHealthCheckTimeout {
+			errs = append(
+				errs,
+				field.Invalid(
+					f.Child("healthCheck", "http", "timeout"),
+					timeout.String(),
+					fmt.Sprintf("must be positive and at most %s", maxHTTPHealthCheckTimeout),
+				),
+			)
+		}
+	}
+	return errs
 }
 
 func (w *webhook) validateSubs(
@@ -238,24 +311,270 @@ func (w *webhook) validatePromotionMechanisms(
 	}
 	// Must define at least one mechanism
 	if len(promoMechs.GitRepoUpdates) == 0 &&
-		len(promoMechs.ArgoCDAppUpdates) == 0 {
+		len(promoMechs.ArgoCDAppUpdates) == 0 &&
+		len(promoMechs.Jobs) == 0 {
 		return field.ErrorList{
 			field.Invalid(
 				f,
 				promoMechs,
 				fmt.Sprintf(
-					"at least one of %s.gitRepoUpdates or %s.argoCDAppUpdates must "+
-						"be non-empty",
+					"at least one of %s.gitRepoUpdates, %s.argoCDAppUpdates, or "+
+						"%s.jobs must be non-empty",
+					f.String(),
 					f.String(),
 					f.String(),
 				),
 			),
 		}
 	}
-	return w.validateGitRepoUpdates(
+	errs := w.validateGitRepoUpdates(
 		f.Child("gitRepoUpdates"),
 		promoMechs.GitRepoUpdates,
 	)
+	errs = append(
+		errs,
+		w.validateArgoCDAppUpdates(
+			f.Child("argoCDAppUpdates"),
+			promoMechs.ArgoCDAppUpdates,
+		)...,
+	)
+	errs = append(errs, w.validateJobs(f.Child("jobs"), promoMechs.Jobs)...)
+	return append(errs, w.validateStepDependencies(f, promoMechs)...)
+}
+
+// promotionStep is a single step of a Stage's promotion mechanisms, as seen
+// for the purposes of validating the dependencies between such steps.
+type promotionStep struct {
+	path      *field.Path
+	name      string
+	dependsOn []string
+	isJob     bool
+}
+
+// validateStepDependencies verifies that step names are unique across all
+// kinds of promotion mechanisms, that every dependency refers to a named step
+// and that the dependencies between steps do not form a cycle.
+func (w *webhook) validateStepDependencies(
+	f *field.Path,
+	promoMechs *kargoapi.PromotionMechanisms,
+) field.ErrorList {
+	steps := make(
+		[]promotionStep,
+		0,
+		len(promoMechs.GitRepoUpdates)+len(promoMechs.Jobs)+len(promoMechs.ArgoCDAppUpdates),
+	)
+	for i, update := range promoMechs.GitRepoUpdates {
+		steps = append(steps, promotionStep{
+			path:      f.Child("gitRepoUpdates").Index(i),
+			name:      update.Name,
+			dependsOn: update.DependsOn,
+		})
+	}
+	for i, job := range promoMechs.Jobs {
+		steps = append(steps, promotionStep{
+			path:      f.Child("jobs").Index(i),
+			name:      job.Name,
+			dependsOn: job.DependsOn,
+			isJob:     true,
+		})
+	}
+	for i, update := range promoMechs.ArgoCDAppUpdates {
+		steps = append(steps, promotionStep{
+			path:      f.Child("argoCDAppUpdates").Index(i),
+			name:      update.Name,
+			dependsOn: update.DependsOn,
+		})
+	}
+
+	var errs field.ErrorList
+	stepsByName := make(map[string]promotionStep, len(steps))
+	for _, step := range steps {
+		if step.name == "" {
+			continue
+		}
+		if existing, ok := stepsByName[step.name]; ok {
+			// Duplicates among Jobs are already reported by validateJobs
+			if !step.isJob || !existing.isJob {
+				errs = append(errs, field.Duplicate(step.path.Child("name"), step.name))
+			}
+			continue
+		}
+		stepsByName[step.name] = step
+	}
+	for _, step := range steps {
+		for i, dep := range step.dependsOn {
+			if _, ok := stepsByName[dep]; !ok {
+				errs = append(errs, field.NotFound(step.path.Child("dependsOn").Index(i), dep))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+
+	// Depth-first search for cycles. Only named steps can be depended upon, so
+	// only they can be part of a cycle.
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(stepsByName))
+	var cycle []string
+	var visit func(name string, trail []string) bool
+	visit = func(name string, trail []string) bool {
+		switch state[name] {
+		case visiting:
+			for i, n := range trail {
+				if n == name {
+					cycle = append(append([]string{}, trail[i:]...), name)
+					break
+				}
+			}
+			return true
+		case visited:
+			return false
+		}
+		state[name] = visiting
+		for _, dep := range stepsByName[name].dependsOn {
+			if visit(dep, append(trail, name)) {
+				return true
+			}
+		}
+		state[name] = visited
+		return false
+	}
+	for _, step := range steps {
+		if step.name == "" || state[step.name] != unvisited {
+			continue
+		}
+		if visit(step.name, nil) {
+			return field.ErrorList{
+				field.Invalid(
+					stepsByName[cycle[0]].path.Child("dependsOn"),
+					stepsByName[cycle[0]].dependsOn,
+					fmt.Sprintf(
+						"promotion steps form a dependency cycle: %s",
+						strings.Join(cycle, " -> "),
+					),
+				),
+			}
+		}
+	}
+	return nil
+}
+
+func (w *webhook) validateJobs(
+	f *field.Path,
+	jobs []kargoapi.PromotionJob,
+) field.ErrorList {
+	var errs field.ErrorList
+	names := make(map[string]struct{}, len(jobs))
+	for i, job := range jobs {
+		if _, ok := names[job.Name]; ok {
+			errs = append(errs, field.Duplicate(f.Index(i).Child("name"), job.Name))
+		}
+		names[job.Name] = struct{}{}
+	}
+	return errs
+}
+
+// stageJobs returns the Jobs among the promotion mechanisms of the specified
+// Stage, if any.
+func stageJobs(stage *kargoapi.Stage) []kargoapi.PromotionJob {
+	if stage.Spec.PromotionMechanisms == nil {
+		return nil
+	}
+	return stage.Spec.PromotionMechanisms.Jobs
+}
+
+// authorizeJobs verifies that the subject of the admission request could run
+// the specified Stage's Jobs itself. Because the controller creates these Jobs
+// on the subject's behalf, the subject must be permitted to create Jobs in the
+// Stage's namespace and to impersonate each ServiceAccount those Jobs run as.
+// Without this, anyone permitted to edit a Stage could run arbitrary
+// containers with the permissions of any ServiceAccount in its namespace.
+func (w *webhook) authorizeJobs(ctx context.Context, stage *kargoapi.Stage) error {
+	logger := logging.LoggerFromContext(ctx)
+
+	req, err := w.admissionRequestFromContextFn(ctx)
+	if err != nil {
+		logger.Error(err)
+		return apierrors.NewForbidden(
+			stageGroupResource,
+			stage.Name,
+			errors.New(
+				"error retrieving admission request from context; refusing to "+
+					"admit Stage with Jobs",
+			),
+		)
+	}
+
+	attrs := []authzv1.ResourceAttributes{{
+		Group:     "batch",
+		Resource:  "jobs",
+		Verb:      "create",
+		Namespace: stage.Namespace,
+	}}
+	serviceAccounts := map[string]struct{}{}
+	for _, job := range stageJobs(stage) {
+		serviceAccount := job.ServiceAccountName
+		if serviceAccount == "" {
+			serviceAccount = "default"
+		}
+		if _, ok := serviceAccounts[serviceAccount]; ok {
+			continue
+		}
+		serviceAccounts[serviceAccount] = struct{}{}
+		attrs = append(attrs, authzv1.ResourceAttributes{
+			Resource:  "serviceaccounts",
+			Name:      serviceAccount,
+			Verb:      "impersonate",
+			Namespace: stage.Namespace,
+		})
+	}
+
+	for i := range attrs {
+		accessReview := &authzv1.SubjectAccessReview{
+			Spec: authzv1.SubjectAccessReviewSpec{
+				User:               req.UserInfo.Username,
+				Groups:             req.UserInfo.Groups,
+				UID:                req.UserInfo.UID,
+				ResourceAttributes: &attrs[i],
+			},
+		}
+		if err = w.createSubjectAccessReviewFn(ctx, accessReview); err != nil {
+			logger.Error(err)
+			return apierrors.NewForbidden(
+				stageGroupResource,
+				stage.Name,
+				errors.New(
+					"error creating SubjectAccessReview; refusing to admit Stage "+
+						"with Jobs",
+				),
+			)
+		}
+		if !accessReview.Status.Allowed {
+			target := attrs[i].Resource
+			if attrs[i].Name != "" {
+				target = fmt.Sprintf("%s %q", target, attrs[i].Name)
+			}
+			return apierrors.NewForbidden(
+				stageGroupResource,
+				stage.Name,
+				fmt.Errorf(
+					"subject %q is not permitted to %s %s in namespace %q, which "+
+						"is required to run the Stage's Jobs",
+					req.UserInfo.Username,
+					attrs[i].Verb,
+					target,
+					stage.Namespace,
+				),
+			)
+		}
+	}
+
+	return nil
 }
 
 func (w *webhook) validateGitRepoUpdates(
@@ -283,14 +602,18 @@ func (w *webhook) validateGitRepoUpdate(
 	if update.Helm != nil {
 		count++
 	}
+	if update.YAML != nil {
+		count++
+	}
 	if count > 1 {
 		return field.ErrorList{
 			field.Invalid(
 				f,
 				update,
 				fmt.Sprintf(
-					"no more than one of %s.render, or %s.kustomize, or %s.helm may "+
-						"be defined",
+					"no more than one of %s.render, or %s.kustomize, or %s.helm, or "+
+						"%s.yaml may be defined",
+					f.String(),
 					f.String(),
 					f.String(),
 					f.String(),
@@ -298,7 +621,77 @@ func (w *webhook) validateGitRepoUpdate(
 			),
 		}
 	}
-	return w.validateHelmPromotionMechanism(f.Child("helm"), update.Helm)
+	errs := w.validateKargoRenderPromotionMechanism(
+		f.Child("render"),
+		update.Render,
+	)
+	errs = append(
+		errs,
+		w.validateKustomizePromotionMechanism(
+			f.Child("kustomize"),
+			update.Kustomize,
+		)...,
+	)
+	errs = append(
+		errs,
+		w.validateHelmPromotionMechanism(f.Child("helm"), update.Helm)...,
+	)
+	return append(
+		errs,
+		w.validateYAMLPromotionMechanism(f.Child("yaml"), update.YAML)...,
+	)
+}
+
+func (w *webhook) validateKargoRenderPromotionMechanism(
+	f *field.Path,
+	promoMech *kargoapi.KargoRenderPromotionMechanism,
+) field.ErrorList {
+	if promoMech == nil {
+		return nil
+	}
+	if promoMech.UseDigests && len(promoMech.Images) > 0 {
+		return field.ErrorList{
+			field.Invalid(
+				f,
+				promoMech,
+				fmt.Sprintf(
+					"%s.useDigests and %s.images are mutually exclusive; use the "+
+						"useDigest field of individual images instead",
+					f.String(),
+					f.String(),
+				),
+			),
+		}
+	}
+	return nil
+}
+
+func (w *webhook) validateKustomizePromotionMechanism(
+	f *field.Path,
+	promoMech *kargoapi.KustomizePromotionMechanism,
+) field.ErrorList {
+	if promoMech == nil {
+		return nil
+	}
+	var errs field.ErrorList
+	for i, update := range promoMech.Images {
+		if update.Image == "" && update.Name == "" {
+			imagePath := f.Child("images").Index(i)
+			errs = append(
+				errs,
+				field.Invalid(
+					imagePath,
+					update,
+					fmt.Sprintf(
+						"at least one of %s.image or %s.name must be specified",
+						imagePath.String(),
+						imagePath.String(),
+					),
+				),
+			)
+		}
+	}
+	return errs
 }
 
 func (w *webhook) validateHelmPromotionMechanism(
@@ -309,18 +702,130 @@ func (w *webhook) validateHelmPromotionMechanism(
 		return nil
 	}
 	// This mechanism must define at least one change to apply
-	if len(promoMech.Images) == 0 && len(promoMech.Charts) == 0 {
+	if len(promoMech.Images) == 0 &&
+		len(promoMech.Charts) == 0 &&
+		len(promoMech.OCIArtifacts) == 0 {
 		return field.ErrorList{
 			field.Invalid(
 				f,
 				promoMech,
 				fmt.Sprintf(
-					"at least one of %s.images or %s.charts must be non-empty",
+					"at least one of %s.images, %s.charts, or %s.ociArtifacts must be "+
+						"non-empty",
+					f.String(),
 					f.String(),
 					f.String(),
 				),
 			),
 		}
 	}
+	var errs field.ErrorList
+	for i, update := range promoMech.Images {
+		if err := validateImageUpdateValueType(
+			f.Child("images").Index(i).Child("value"),
+			update.Value,
+		); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for i, update := range promoMech.OCIArtifacts {
+		if err := validateImageUpdateValueType(
+			f.Child("ociArtifacts").Index(i).Child("value"),
+			update.Value,
+		); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+func (w *webhook) validateYAMLPromotionMechanism(
+	f *field.Path,
+	promoMech *kargoapi.YAMLPromotionMechanism,
+) field.ErrorList {
+	if promoMech == nil {
+		return nil
+	}
+	var errs field.ErrorList
+	for i, update := range promoMech.Images {
+		if err := validateImageUpdateValueType(
+			f.Child("images").Index(i).Child("value"),
+			update.Value,
+		); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+func (w *webhook) validateArgoCDAppUpdates(
+	f *field.Path,
+	updates []kargoapi.ArgoCDAppUpdate,
+) field.ErrorList {
+	var errs field.ErrorList
+	for i, update := range updates {
+		if update.WaitForHealthy && update.Sync != nil &&
+			update.Sync.Wait != nil && !*update.Sync.Wait {
+			errs = append(
+				errs,
+				field.Invalid(
+					f.Index(i).Child("waitForHealthy"),
+					update.WaitForHealthy,
+					"cannot wait for the Application to become healthy without waiting for it to sync",
+				),
+			)
+		}
+		if update.WaitForHealthy && (update.Timeout == nil || update.Timeout.Duration <= 0) {
+			// Without a timeout, a Promotion waiting on an Application that
+			// never becomes healthy would never conclude.
+			errs = append(
+				errs,
+				field.Required(
+					f.Index(i).Child("timeout"),
+					"a timeout is required when waiting for the Application to become healthy",
+				),
+			)
+		}
+		if update.Sync != nil {
+			optionsPath := f.Index(i).Child("sync").Child("options")
+			for j, opt := range update.Sync.Options {
+				if key, _, ok := strings.Cut(opt, "="); !ok || key == "" {
+					errs = append(
+						errs,
+						field.Invalid(optionsPath.Index(j), opt, "must be of the form <key>=<value>"),
+					)
+				}
+			}
+		}
+		for j, srcUpdate := range update.SourceUpdates {
+			if srcUpdate.Helm == nil {
+				continue
+			}
+			imagesPath := f.Index(i).Child("sourceUpdates").Index(j).
+				Child("helm").Child("images")
+			for k, imageUpdate := range srcUpdate.Helm.Images {
+				if err := validateImageUpdateValueType(
+					imagesPath.Index(k).Child("value"),
+					imageUpdate.Value,
+				); err != nil {
+					errs = append(errs, err)
+				}
+			}
+		}
+	}
+	return errs
+}
+
+func validateImageUpdateValueType(
+	f *field.Path,
+	value kargoapi.ImageUpdateValueType,
+) *field.Error {
+	if _, err := kargoapi.ParseImageUpdateValueType(string(value)); err != nil {
+		validValues := make([]string, len(kargoapi.ImageUpdateValueTypes))
+		for i, valueType := range kargoapi.ImageUpdateValueTypes {
+			validValues[i] = string(valueType)
+		}
+		return field.NotSupported(f, value, validValues)
+	}
 	return nil
 }
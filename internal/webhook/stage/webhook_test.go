@@ -5,14 +5,18 @@ import (
 	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	admissionv1 "k8s.io/api/admission/v1"
 	authnv1 "k8s.io/api/authentication/v1"
+	authzv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -33,6 +37,8 @@ func TestNewWebhook(t *testing.T) {
 	require.NotNil(t, w.validateProjectFn)
 	require.NotNil(t, w.validateCreateOrUpdateFn)
 	require.NotNil(t, w.validateSpecFn)
+	require.NotNil(t, w.authorizeJobsFn)
+	require.NotNil(t, w.createSubjectAccessReviewFn)
 	require.NotNil(t, w.isRequestFromKargoControlplaneFn)
 }
 
@@ -835,9 +841,17 @@ func TestDefault(t *testing.T) {
 }
 
 func TestValidateCreate(t *testing.T) {
+	stageWithJobs := &kargoapi.Stage{
+		Spec: kargoapi.StageSpec{
+			PromotionMechanisms: &kargoapi.PromotionMechanisms{
+				Jobs: []kargoapi.PromotionJob{{Name: "deploy", Image: "fake-image"}},
+			},
+		},
+	}
 	testCases := []struct {
 		name       string
 		webhook    *webhook
+		stage      *kargoapi.Stage
 		assertions func(*testing.T, error)
 	}{
 		{
@@ -879,6 +893,32 @@ func TestValidateCreate(t *testing.T) {
 				require.Equal(t, "something went wrong", err.Error())
 			},
 		},
+		{
+			name: "error authorizing jobs",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return nil
+				},
+				validateCreateOrUpdateFn: func(
+					*kargoapi.Stage,
+				) (admission.Warnings, error) {
+					return nil, nil
+				},
+				authorizeJobsFn: func(context.Context, *kargoapi.Stage) error {
+					return errors.New("something went wrong")
+				},
+			},
+			stage: stageWithJobs,
+			assertions: func(t *testing.T, err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
 		{
 			name: "success",
 			webhook: &webhook{
@@ -895,7 +935,11 @@ func TestValidateCreate(t *testing.T) {
 				) (admission.Warnings, error) {
 					return nil, nil
 				},
+				authorizeJobsFn: func(context.Context, *kargoapi.Stage) error {
+					return nil
+				},
 			},
+			stage: stageWithJobs,
 			assertions: func(t *testing.T, err error) {
 				require.NoError(t, err)
 			},
@@ -903,9 +947,13 @@ func TestValidateCreate(t *testing.T) {
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
+			stage := testCase.stage
+			if stage == nil {
+				stage = &kargoapi.Stage{}
+			}
 			_, err := testCase.webhook.ValidateCreate(
 				context.Background(),
-				&kargoapi.Stage{},
+				stage,
 			)
 			testCase.assertions(t, err)
 		})
@@ -913,9 +961,18 @@ func TestValidateCreate(t *testing.T) {
 }
 
 func TestValidateUpdate(t *testing.T) {
+	stageWithJobs := &kargoapi.Stage{
+		Spec: kargoapi.StageSpec{
+			PromotionMechanisms: &kargoapi.PromotionMechanisms{
+				Jobs: []kargoapi.PromotionJob{{Name: "deploy", Image: "fake-image"}},
+			},
+		},
+	}
 	testCases := []struct {
 		name       string
 		webhook    *webhook
+		oldStage   *kargoapi.Stage
+		stage      *kargoapi.Stage
 		assertions func(*testing.T, error)
 	}{
 		{
@@ -932,6 +989,43 @@ func TestValidateUpdate(t *testing.T) {
 				require.Equal(t, "something went wrong", err.Error())
 			},
 		},
+		{
+			name: "error authorizing changed jobs",
+			webhook: &webhook{
+				validateCreateOrUpdateFn: func(
+					*kargoapi.Stage,
+				) (admission.Warnings, error) {
+					return nil, nil
+				},
+				authorizeJobsFn: func(context.Context, *kargoapi.Stage) error {
+					return errors.New("something went wrong")
+				},
+			},
+			oldStage: &kargoapi.Stage{},
+			stage:    stageWithJobs,
+			assertions: func(t *testing.T, err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "unchanged jobs are not authorized again",
+			webhook: &webhook{
+				validateCreateOrUpdateFn: func(
+					*kargoapi.Stage,
+				) (admission.Warnings, error) {
+					return nil, nil
+				},
+				authorizeJobsFn: func(context.Context, *kargoapi.Stage) error {
+					return errors.New("something went wrong")
+				},
+			},
+			oldStage: stageWithJobs.DeepCopy(),
+			stage:    stageWithJobs,
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
 		{
 			name: "success",
 			webhook: &webhook{
@@ -941,6 +1035,8 @@ func TestValidateUpdate(t *testing.T) {
 					return nil, nil
 				},
 			},
+			oldStage: &kargoapi.Stage{},
+			stage:    &kargoapi.Stage{},
 			assertions: func(t *testing.T, err error) {
 				require.NoError(t, err)
 			},
@@ -950,14 +1046,160 @@ func TestValidateUpdate(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			_, err := testCase.webhook.ValidateUpdate(
 				context.Background(),
-				nil,
-				&kargoapi.Stage{},
+				testCase.oldStage,
+				testCase.stage,
 			)
 			testCase.assertions(t, err)
 		})
 	}
 }
 
+func TestAuthorizeJobs(t *testing.T) {
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		},
+		Spec: kargoapi.StageSpec{
+			PromotionMechanisms: &kargoapi.PromotionMechanisms{
+				Jobs: []kargoapi.PromotionJob{
+					{Name: "deploy", Image: "fake-image"},
+					{Name: "test", Image: "fake-image", ServiceAccountName: "tester"},
+					{Name: "notify", Image: "fake-image", ServiceAccountName: "tester"},
+				},
+			},
+		},
+	}
+	testCases := []struct {
+		name                          string
+		admissionRequestFromContextFn func(
+			context.Context,
+		) (admission.Request, error)
+		allowed    func(*authzv1.ResourceAttributes) bool
+		reviewErr  error
+		assertions func(*testing.T, []authzv1.ResourceAttributes, error)
+	}{
+		{
+			name: "error getting admission request bound to context",
+			admissionRequestFromContextFn: func(
+				context.Context,
+			) (admission.Request, error) {
+				return admission.Request{}, errors.New("something went wrong")
+			},
+			assertions: func(t *testing.T, _ []authzv1.ResourceAttributes, err error) {
+				require.ErrorContains(
+					t, err, "error retrieving admission request from context; refusing to",
+				)
+			},
+		},
+		{
+			name: "error creating subject access review",
+			admissionRequestFromContextFn: func(
+				context.Context,
+			) (admission.Request, error) {
+				return admission.Request{}, nil
+			},
+			reviewErr: errors.New("something went wrong"),
+			assertions: func(t *testing.T, _ []authzv1.ResourceAttributes, err error) {
+				require.ErrorContains(t, err, "error creating SubjectAccessReview")
+			},
+		},
+		{
+			name: "subject may not create jobs",
+			admissionRequestFromContextFn: func(
+				context.Context,
+			) (admission.Request, error) {
+				return admission.Request{}, nil
+			},
+			allowed: func(*authzv1.ResourceAttributes) bool {
+				return false
+			},
+			assertions: func(t *testing.T, _ []authzv1.ResourceAttributes, err error) {
+				require.True(t, apierrors.IsForbidden(err))
+				require.ErrorContains(t, err, "is not permitted to create jobs")
+			},
+		},
+		{
+			name: "subject may not use a service account",
+			admissionRequestFromContextFn: func(
+				context.Context,
+			) (admission.Request, error) {
+				return admission.Request{}, nil
+			},
+			allowed: func(attrs *authzv1.ResourceAttributes) bool {
+				return attrs.Name != "tester"
+			},
+			assertions: func(t *testing.T, _ []authzv1.ResourceAttributes, err error) {
+				require.True(t, apierrors.IsForbidden(err))
+				require.ErrorContains(
+					t, err, `is not permitted to impersonate serviceaccounts "tester"`,
+				)
+			},
+		},
+		{
+			name: "subject is authorized",
+			admissionRequestFromContextFn: func(
+				context.Context,
+			) (admission.Request, error) {
+				return admission.Request{}, nil
+			},
+			allowed: func(*authzv1.ResourceAttributes) bool {
+				return true
+			},
+			assertions: func(t *testing.T, reviewed []authzv1.ResourceAttributes, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]authzv1.ResourceAttributes{
+						{
+							Group:     "batch",
+							Resource:  "jobs",
+							Verb:      "create",
+							Namespace: "fake-namespace",
+						},
+						{
+							Resource:  "serviceaccounts",
+							Name:      "default",
+							Verb:      "impersonate",
+							Namespace: "fake-namespace",
+						},
+						{
+							Resource:  "serviceaccounts",
+							Name:      "tester",
+							Verb:      "impersonate",
+							Namespace: "fake-namespace",
+						},
+					},
+					reviewed,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var reviewed []authzv1.ResourceAttributes
+			w := &webhook{
+				admissionRequestFromContextFn: testCase.admissionRequestFromContextFn,
+				createSubjectAccessReviewFn: func(
+					_ context.Context,
+					obj client.Object,
+					_ ...client.CreateOption,
+				) error {
+					if testCase.reviewErr != nil {
+						return testCase.reviewErr
+					}
+					review := obj.(*authzv1.SubjectAccessReview) // nolint: forcetypeassert
+					reviewed = append(reviewed, *review.Spec.ResourceAttributes)
+					review.Status.Allowed = testCase.allowed(review.Spec.ResourceAttributes)
+					return nil
+				},
+			}
+			err := w.authorizeJobs(context.Background(), stage)
+			testCase.assertions(t, reviewed, err)
+		})
+	}
+}
+
 func TestValidateDelete(t *testing.T) {
 	w := &webhook{}
 	_, err := w.ValidateDelete(context.Background(), nil)
@@ -1051,8 +1293,9 @@ func TestValidateSpec(t *testing.T) {
 							Field:    "spec.promotionMechanisms",
 							BadValue: spec.PromotionMechanisms,
 							Detail: "at least one of " +
-								"spec.promotionMechanisms.gitRepoUpdates or " +
-								"spec.promotionMechanisms.argoCDAppUpdates must be non-empty",
+								"spec.promotionMechanisms.gitRepoUpdates, " +
+								"spec.promotionMechanisms.argoCDAppUpdates, or " +
+								"spec.promotionMechanisms.jobs must be non-empty",
 						},
 					},
 					errs,
@@ -1060,12 +1303,92 @@ func TestValidateSpec(t *testing.T) {
 			},
 		},
 
+		{
+			name: "history retention max age not positive",
+			spec: &kargoapi.StageSpec{
+				Subscriptions: kargoapi.Subscriptions{
+					Warehouse: "test-warehouse",
+				},
+				HistoryRetention: &kargoapi.HistoryRetention{
+					MaxAge: &metav1.Duration{},
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.StageSpec, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "spec.historyRetention.maxAge",
+							BadValue: "0s",
+							Detail:   "must be positive",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "HTTP health check timeout too long",
+			spec: &kargoapi.StageSpec{
+				Subscriptions: kargoapi.Subscriptions{
+					Warehouse: "test-warehouse",
+				},
+				HealthCheck: &kargoapi.HealthCheck{
+					HTTP: &kargoapi.HTTPHealthCheck{
+						URL:     "https://example.com/healthz",
+						Timeout: &metav1.Duration{Duration: time.Hour},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.StageSpec, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "spec.healthCheck.http.timeout",
+							BadValue: "1h0m0s",
+							Detail:   "must be positive and at most 1m0s",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "HTTP health check timeout not positive",
+			spec: &kargoapi.StageSpec{
+				Subscriptions: kargoapi.Subscriptions{
+					Warehouse: "test-warehouse",
+				},
+				HealthCheck: &kargoapi.HealthCheck{
+					HTTP: &kargoapi.HTTPHealthCheck{
+						URL:     "https://example.com/healthz",
+						Timeout: &metav1.Duration{},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.StageSpec, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "spec.healthCheck.http.timeout", errs[0].Field)
+			},
+		},
+
 		{
 			name: "valid",
 			spec: &kargoapi.StageSpec{
 				Subscriptions: kargoapi.Subscriptions{
 					Warehouse: "test-warehouse",
 				},
+				HealthCheck: &kargoapi.HealthCheck{
+					HTTP: &kargoapi.HTTPHealthCheck{
+						URL:     "https://example.com/healthz",
+						Timeout: &metav1.Duration{Duration: time.Minute},
+					},
+				},
 			},
 			assertions: func(t *testing.T, _ *kargoapi.StageSpec, errs field.ErrorList) {
 				require.Nil(t, errs)
@@ -1200,8 +1523,9 @@ func TestValidatePromotionMechanisms(t *testing.T) {
 							Type:     field.ErrorTypeInvalid,
 							Field:    "promotionMechanisms",
 							BadValue: promoMechs,
-							Detail: "at least one of promotionMechanisms.gitRepoUpdates or " +
-								"promotionMechanisms.argoCDAppUpdates must be non-empty",
+							Detail: "at least one of promotionMechanisms.gitRepoUpdates, " +
+								"promotionMechanisms.argoCDAppUpdates, or " +
+								"promotionMechanisms.jobs must be non-empty",
 						},
 					},
 					errs,
@@ -1209,6 +1533,40 @@ func TestValidatePromotionMechanisms(t *testing.T) {
 			},
 		},
 
+		{
+			name: "duplicate job names",
+			promoMechs: &kargoapi.PromotionMechanisms{
+				Jobs: []kargoapi.PromotionJob{
+					{Name: "deploy"},
+					{Name: "deploy"},
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.PromotionMechanisms, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						field.Duplicate(
+							field.NewPath("promotionMechanisms").Child("jobs").Index(1).Child("name"),
+							"deploy",
+						),
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "valid with only jobs",
+			promoMechs: &kargoapi.PromotionMechanisms{
+				Jobs: []kargoapi.PromotionJob{
+					{Name: "deploy"},
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.PromotionMechanisms, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+
 		{
 			name: "valid",
 			promoMechs: &kargoapi.PromotionMechanisms{
@@ -1238,6 +1596,124 @@ func TestValidatePromotionMechanisms(t *testing.T) {
 	}
 }
 
+func TestValidateStepDependencies(t *testing.T) {
+	f := field.NewPath("promotionMechanisms")
+	testCases := []struct {
+		name       string
+		promoMechs *kargoapi.PromotionMechanisms
+		assertions func(*testing.T, field.ErrorList)
+	}{
+		{
+			name: "no dependencies",
+			promoMechs: &kargoapi.PromotionMechanisms{
+				GitRepoUpdates:   []kargoapi.GitRepoUpdate{{}},
+				ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{{}},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+		{
+			name: "duplicate names across kinds",
+			promoMechs: &kargoapi.PromotionMechanisms{
+				GitRepoUpdates: []kargoapi.GitRepoUpdate{{Name: "deploy"}},
+				Jobs:           []kargoapi.PromotionJob{{Name: "deploy"}},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						field.Duplicate(f.Child("jobs").Index(0).Child("name"), "deploy"),
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "unknown dependency",
+			promoMechs: &kargoapi.PromotionMechanisms{
+				ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+					{DependsOn: []string{"manifests"}},
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						field.NotFound(
+							f.Child("argoCDAppUpdates").Index(0).Child("dependsOn").Index(0),
+							"manifests",
+						),
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "step depends on itself",
+			promoMechs: &kargoapi.PromotionMechanisms{
+				Jobs: []kargoapi.PromotionJob{
+					{Name: "deploy", DependsOn: []string{"deploy"}},
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, field.ErrorTypeInvalid, errs[0].Type)
+				require.Equal(t, "promotionMechanisms.jobs[0].dependsOn", errs[0].Field)
+				require.Equal(
+					t,
+					"promotion steps form a dependency cycle: deploy -> deploy",
+					errs[0].Detail,
+				)
+			},
+		},
+		{
+			name: "cycle between two steps",
+			promoMechs: &kargoapi.PromotionMechanisms{
+				GitRepoUpdates: []kargoapi.GitRepoUpdate{
+					{Name: "manifests", DependsOn: []string{"sync"}},
+				},
+				ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+					{Name: "sync", DependsOn: []string{"manifests"}},
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, field.ErrorTypeInvalid, errs[0].Type)
+				require.Equal(t, "promotionMechanisms.gitRepoUpdates[0].dependsOn", errs[0].Field)
+				require.Equal(
+					t,
+					"promotion steps form a dependency cycle: manifests -> sync -> manifests",
+					errs[0].Detail,
+				)
+			},
+		},
+		{
+			name: "valid dependencies",
+			promoMechs: &kargoapi.PromotionMechanisms{
+				GitRepoUpdates: []kargoapi.GitRepoUpdate{
+					{Name: "manifests", DependsOn: []string{"migrate"}},
+				},
+				Jobs: []kargoapi.PromotionJob{
+					{Name: "migrate"},
+				},
+				ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+					{DependsOn: []string{"manifests", "migrate"}},
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(t, w.validateStepDependencies(f, testCase.promoMechs))
+		})
+	}
+}
+
 func TestValidateGitRepoUpdates(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -1260,8 +1736,8 @@ func TestValidateGitRepoUpdates(t *testing.T) {
 							Field:    "gitRepoUpdates[0]",
 							BadValue: update,
 							Detail: "no more than one of gitRepoUpdates[0].render, or " +
-								"gitRepoUpdates[0].kustomize, or gitRepoUpdates[0].helm " +
-								"may be defined",
+								"gitRepoUpdates[0].kustomize, or gitRepoUpdates[0].helm, or " +
+								"gitRepoUpdates[0].yaml may be defined",
 						},
 					},
 					errs,
@@ -1318,8 +1794,8 @@ func TestValidateGitRepoUpdate(t *testing.T) {
 							Field:    "gitRepoUpdate",
 							BadValue: update,
 							Detail: "no more than one of gitRepoUpdate.render, or " +
-								"gitRepoUpdate.kustomize, or gitRepoUpdate.helm may be " +
-								"defined",
+								"gitRepoUpdate.kustomize, or gitRepoUpdate.helm, or " +
+								"gitRepoUpdate.yaml may be defined",
 						},
 					},
 					errs,
@@ -1352,6 +1828,136 @@ func TestValidateGitRepoUpdate(t *testing.T) {
 	}
 }
 
+func TestValidateKargoRenderPromotionMechanism(t *testing.T) {
+	testCases := []struct {
+		name       string
+		promoMech  *kargoapi.KargoRenderPromotionMechanism
+		assertions func(*testing.T, *kargoapi.KargoRenderPromotionMechanism, field.ErrorList)
+	}{
+		{
+			name: "nil",
+			assertions: func(t *testing.T, _ *kargoapi.KargoRenderPromotionMechanism, errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+		{
+			name: "useDigests and images both specified",
+			promoMech: &kargoapi.KargoRenderPromotionMechanism{
+				Images: []kargoapi.KargoRenderImageUpdate{
+					{Image: "fake-image"},
+				},
+				UseDigests: true,
+			},
+			assertions: func(
+				t *testing.T,
+				promoMech *kargoapi.KargoRenderPromotionMechanism,
+				errs field.ErrorList,
+			) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "render",
+							BadValue: promoMech,
+							Detail: "render.useDigests and render.images are mutually " +
+								"exclusive; use the useDigest field of individual images instead",
+						},
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "valid",
+			promoMech: &kargoapi.KargoRenderPromotionMechanism{
+				TargetBranch: "fake-branch",
+				UseDigests:   true,
+				AllowEmpty:   true,
+			},
+			assertions: func(t *testing.T, _ *kargoapi.KargoRenderPromotionMechanism, errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				testCase.promoMech,
+				w.validateKargoRenderPromotionMechanism(
+					field.NewPath("render"),
+					testCase.promoMech,
+				),
+			)
+		})
+	}
+}
+
+func TestValidateKustomizePromotionMechanism(t *testing.T) {
+	testCases := []struct {
+		name       string
+		promoMech  *kargoapi.KustomizePromotionMechanism
+		assertions func(*testing.T, field.ErrorList)
+	}{
+		{
+			name: "nil",
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+		{
+			name: "neither image nor name specified",
+			promoMech: &kargoapi.KustomizePromotionMechanism{
+				Images: []kargoapi.KustomizeImageUpdate{
+					{Image: "fake-image"},
+					{NewName: "fake-new-name"},
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "kustomize.images[1]",
+							BadValue: kargoapi.KustomizeImageUpdate{NewName: "fake-new-name"},
+							Detail: "at least one of kustomize.images[1].image or " +
+								"kustomize.images[1].name must be specified",
+						},
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "valid",
+			promoMech: &kargoapi.KustomizePromotionMechanism{
+				Images: []kargoapi.KustomizeImageUpdate{
+					{Image: "fake-image"},
+					{Name: "fake-name", NewName: "fake-new-name"},
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				w.validateKustomizePromotionMechanism(
+					field.NewPath("kustomize"),
+					testCase.promoMech,
+				),
+			)
+		})
+	}
+}
+
 func TestValidateHelmPromotionMechanism(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -1381,8 +1987,8 @@ func TestValidateHelmPromotionMechanism(t *testing.T) {
 							Type:     field.ErrorTypeInvalid,
 							Field:    "helm",
 							BadValue: promoMech,
-							Detail: "at least one of helm.images or helm.charts must be " +
-								"non-empty",
+							Detail: "at least one of helm.images, helm.charts, or " +
+								"helm.ociArtifacts must be non-empty",
 						},
 					},
 					errs,
@@ -1390,11 +1996,46 @@ func TestValidateHelmPromotionMechanism(t *testing.T) {
 			},
 		},
 
+		{
+			name: "unsupported image update value type",
+			promoMech: &kargoapi.HelmPromotionMechanism{
+				Images: []kargoapi.HelmImageUpdate{
+					{Value: kargoapi.ImageUpdateValueTypeTag},
+					{Value: "bogus"},
+				},
+				OCIArtifacts: []kargoapi.HelmOCIArtifactUpdate{
+					{Value: "bogus"},
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.HelmPromotionMechanism, errs field.ErrorList) {
+				validValues := []string{"ImageAndTag", "Tag", "ImageAndDigest", "Digest"}
+				require.Equal(
+					t,
+					field.ErrorList{
+						field.NotSupported(
+							field.NewPath("helm").Child("images").Index(1).Child("value"),
+							kargoapi.ImageUpdateValueType("bogus"),
+							validValues,
+						),
+						field.NotSupported(
+							field.NewPath("helm").Child("ociArtifacts").Index(0).Child("value"),
+							kargoapi.ImageUpdateValueType("bogus"),
+							validValues,
+						),
+					},
+					errs,
+				)
+			},
+		},
+
 		{
 			name: "valid",
 			promoMech: &kargoapi.HelmPromotionMechanism{
 				Images: []kargoapi.HelmImageUpdate{
-					{},
+					{Value: kargoapi.ImageUpdateValueTypeImageAndTag},
+					{Value: kargoapi.ImageUpdateValueTypeTag},
+					{Value: kargoapi.ImageUpdateValueTypeImageAndDigest},
+					{Value: kargoapi.ImageUpdateValueTypeDigest},
 				},
 			},
 			assertions: func(t *testing.T, _ *kargoapi.HelmPromotionMechanism, errs field.ErrorList) {
@@ -1416,3 +2057,212 @@ func TestValidateHelmPromotionMechanism(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateYAMLPromotionMechanism(t *testing.T) {
+	testCases := []struct {
+		name       string
+		promoMech  *kargoapi.YAMLPromotionMechanism
+		assertions func(*testing.T, field.ErrorList)
+	}{
+		{
+			name: "nil",
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+		{
+			name: "invalid value type",
+			promoMech: &kargoapi.YAMLPromotionMechanism{
+				Images: []kargoapi.YAMLImageUpdate{
+					{
+						Image:    "fake-image",
+						FilePath: "fake-file.yaml",
+						Key:      "fake-key",
+						Value:    "bogus",
+					},
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, field.ErrorTypeNotSupported, errs[0].Type)
+				require.Equal(t, "yaml.images[0].value", errs[0].Field)
+			},
+		},
+		{
+			name: "valid",
+			promoMech: &kargoapi.YAMLPromotionMechanism{
+				Images: []kargoapi.YAMLImageUpdate{
+					{
+						Image:    "fake-image",
+						FilePath: "fake-file.yaml",
+						Key:      "fake-key",
+						Value:    kargoapi.ImageUpdateValueTypeImageAndTag,
+					},
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				w.validateYAMLPromotionMechanism(
+					field.NewPath("yaml"),
+					testCase.promoMech,
+				),
+			)
+		})
+	}
+}
+
+func TestValidateArgoCDAppUpdates(t *testing.T) {
+	testCases := []struct {
+		name       string
+		updates    []kargoapi.ArgoCDAppUpdate
+		assertions func(*testing.T, field.ErrorList)
+	}{
+		{
+			name: "unsupported image update value type",
+			updates: []kargoapi.ArgoCDAppUpdate{
+				{
+					SourceUpdates: []kargoapi.ArgoCDSourceUpdate{
+						{},
+						{
+							Helm: &kargoapi.ArgoCDHelm{
+								Images: []kargoapi.ArgoCDHelmImageUpdate{
+									{Value: "bogus"},
+								},
+							},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						field.NotSupported(
+							field.NewPath("argoCDAppUpdates").Index(0).
+								Child("sourceUpdates").Index(1).
+								Child("helm").Child("images").Index(0).Child("value"),
+							kargoapi.ImageUpdateValueType("bogus"),
+							[]string{"ImageAndTag", "Tag", "ImageAndDigest", "Digest"},
+						),
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "malformed sync options",
+			updates: []kargoapi.ArgoCDAppUpdate{
+				{
+					Sync: &kargoapi.ArgoCDAppSync{
+						Options: []string{"PruneLast=true", "bogus", "=true"},
+					},
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				optionsPath := field.NewPath("argoCDAppUpdates").Index(0).
+					Child("sync").Child("options")
+				require.Equal(
+					t,
+					field.ErrorList{
+						field.Invalid(optionsPath.Index(1), "bogus", "must be of the form <key>=<value>"),
+						field.Invalid(optionsPath.Index(2), "=true", "must be of the form <key>=<value>"),
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "waiting for health without waiting for sync",
+			updates: []kargoapi.ArgoCDAppUpdate{
+				{
+					WaitForHealthy: true,
+					Timeout:        &metav1.Duration{Duration: time.Minute},
+					Sync: &kargoapi.ArgoCDAppSync{
+						Wait: ptr.To(false),
+					},
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						field.Invalid(
+							field.NewPath("argoCDAppUpdates").Index(0).Child("waitForHealthy"),
+							true,
+							"cannot wait for the Application to become healthy without waiting for it to sync",
+						),
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "waiting for health without a timeout",
+			updates: []kargoapi.ArgoCDAppUpdate{
+				{
+					WaitForHealthy: true,
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						field.Required(
+							field.NewPath("argoCDAppUpdates").Index(0).Child("timeout"),
+							"a timeout is required when waiting for the Application to become healthy",
+						),
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "valid",
+			updates: []kargoapi.ArgoCDAppUpdate{
+				{
+					WaitForHealthy: true,
+					Timeout:        &metav1.Duration{Duration: time.Minute},
+					Sync: &kargoapi.ArgoCDAppSync{
+						Prune:   true,
+						Options: []string{"PruneLast=true", "ServerSideApply=true"},
+					},
+					SourceUpdates: []kargoapi.ArgoCDSourceUpdate{
+						{
+							Helm: &kargoapi.ArgoCDHelm{
+								Images: []kargoapi.ArgoCDHelmImageUpdate{
+									{Value: kargoapi.ImageUpdateValueTypeImageAndTag},
+									{Value: kargoapi.ImageUpdateValueTypeTag},
+									{Value: kargoapi.ImageUpdateValueTypeImageAndDigest},
+									{Value: kargoapi.ImageUpdateValueTypeDigest},
+								},
+							},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				w.validateArgoCDAppUpdates(
+					field.NewPath("argoCDAppUpdates"),
+					testCase.updates,
+				),
+			)
+		})
+	}
+}
@@ -3,13 +3,17 @@ package warehouse
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/util/jsonpath"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -26,6 +30,11 @@ var warehouseGroupKind = schema.GroupKind{
 	Kind:  "Warehouse",
 }
 
+// minPollingInterval is the shortest interval at which a Warehouse may have
+// its subscriptions checked for new artifacts. Shorter intervals would have
+// the controller hammer repositories on every Warehouse's behalf.
+const minPollingInterval = 30 * time.Second
+
 type webhook struct {
 	client client.Client
 
@@ -128,7 +137,74 @@ func (w *webhook) validateSpec(
 	if spec == nil { // nil spec is caught by declarative validations
 		return nil
 	}
-	return w.validateSubs(f.Child("subscriptions"), spec.Subscriptions)
+	errs := w.validateSubs(f.Child("subscriptions"), spec.Subscriptions)
+	if spec.PollingInterval != nil &&
+		spec.PollingInterval.Duration < minPollingInterval {
+		errs = append(
+			errs,
+			field.Invalid(
+				f.Child("pollingInterval"),
+				spec.PollingInterval.Duration.String(),
+				fmt.Sprintf("must be at least %s", minPollingInterval),
+			),
+		)
+	}
+	if spec.RegistryTimeout != nil && spec.RegistryTimeout.Duration <= 0 {
+		errs = append(
+			errs,
+			field.Invalid(
+				f.Child("registryTimeout"),
+				spec.RegistryTimeout.Duration.String(),
+				"must be positive",
+			),
+		)
+	}
+	return append(
+		errs,
+		w.validateFreightMetadata(f.Child("freightMetadata"), spec.FreightMetadata)...,
+	)
+}
+
+func (w *webhook) validateFreightMetadata(
+	f *field.Path,
+	md *kargoapi.FreightMetadata,
+) field.ErrorList {
+	if md == nil {
+		return nil
+	}
+	var errs field.ErrorList
+	for _, k := range sortedKeys(md.Labels) {
+		if isReservedMetadataKey(k) {
+			errs = append(
+				errs,
+				field.Invalid(f.Child("labels").Key(k), k, reservedMetadataKeyMsg),
+			)
+		}
+	}
+	for _, k := range sortedKeys(md.Annotations) {
+		if isReservedMetadataKey(k) {
+			errs = append(
+				errs,
+				field.Invalid(f.Child("annotations").Key(k), k, reservedMetadataKeyMsg),
+			)
+		}
+	}
+	return errs
+}
+
+const reservedMetadataKeyMsg = "keys using the kargo.akuity.io/ prefix are reserved"
+
+func isReservedMetadataKey(key string) bool {
+	return strings.HasPrefix(key, "kargo.akuity.io/")
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
 }
 
 func (w *webhook) validateSubs(
@@ -165,6 +241,20 @@ func (w *webhook) validateSub(
 		repoTypes++
 		errs = append(errs, w.validateChartSub(f.Child("chart"), *sub.Chart, seen)...)
 	}
+	if sub.OCIArtifact != nil {
+		repoTypes++
+		errs = append(
+			errs,
+			w.validateOCIArtifactSub(f.Child("ociArtifact"), *sub.OCIArtifact, seen)...,
+		)
+	}
+	if sub.HTTPArtifact != nil {
+		repoTypes++
+		errs = append(
+			errs,
+			w.validateHTTPArtifactSub(f.Child("httpArtifact"), *sub.HTTPArtifact, seen)...,
+		)
+	}
 	if repoTypes != 1 {
 		errs = append(
 			errs,
@@ -172,7 +262,10 @@ func (w *webhook) validateSub(
 				f,
 				sub,
 				fmt.Sprintf(
-					"exactly one of %s.git, %s.image, or %s.chart must be non-empty",
+					"exactly one of %s.git, %s.image, %s.chart, %s.ociArtifact, "+
+						"or %s.httpArtifact must be non-empty",
+					f.String(),
+					f.String(),
 					f.String(),
 					f.String(),
 					f.String(),
@@ -195,6 +288,10 @@ func (w *webhook) validateGitSub(
 	); err != nil {
 		errs = append(errs, err)
 	}
+	if err := validateAllowTags(f.Child("allowTags"), sub.AllowTags); err != nil {
+		errs = append(errs, err)
+	}
+	errs = append(errs, validateIgnoreTags(f.Child("ignoreTags"), sub.IgnoreTags)...)
 	if err := seen.addGit(sub, f); err != nil {
 		errs = append(errs, field.Invalid(f, sub.RepoURL, err.Error()))
 	}
@@ -213,11 +310,75 @@ func (w *webhook) validateImageSub(
 	); err != nil {
 		errs = field.ErrorList{err}
 	}
+	if err := validateAllowTags(f.Child("allowTags"), sub.AllowTags); err != nil {
+		errs = append(errs, err)
+	}
+	errs = append(errs, validateIgnoreTags(f.Child("ignoreTags"), sub.IgnoreTags)...)
 	if sub.Platform != "" {
 		if !image.ValidatePlatformConstraint(sub.Platform) {
 			errs = append(errs, field.Invalid(f.Child("platform"), sub.Platform, ""))
 		}
 	}
+	if sub.ImageSelectionStrategy == kargoapi.ImageSelectionStrategyDigest &&
+		sub.SelectionMode == kargoapi.SelectionModeOldest {
+		errs = append(
+			errs,
+			field.Invalid(
+				f.Child("selectionMode"),
+				sub.SelectionMode,
+				"selectionMode Oldest is not supported with imageSelectionStrategy Digest",
+			),
+		)
+	}
+	if sub.Platform != "" && len(sub.Platforms) > 0 {
+		errs = append(
+			errs,
+			field.Invalid(
+				f.Child("platforms"),
+				sub.Platforms,
+				"platform and platforms are mutually exclusive",
+			),
+		)
+	}
+	for i, platform := range sub.Platforms {
+		if !image.ValidatePlatformConstraint(platform) {
+			errs = append(
+				errs,
+				field.Invalid(f.Child("platforms").Index(i), platform, ""),
+			)
+		}
+	}
+	for i, platform := range sub.ExcludePlatforms {
+		if !image.ValidatePlatformConstraint(platform) {
+			errs = append(
+				errs,
+				field.Invalid(f.Child("excludePlatforms").Index(i), platform, ""),
+			)
+		}
+	}
+	if sub.Digest != "" &&
+		sub.ImageSelectionStrategy != kargoapi.ImageSelectionStrategyDigest {
+		errs = append(
+			errs,
+			field.Invalid(
+				f.Child("digest"),
+				sub.Digest,
+				"digest may only be specified with imageSelectionStrategy Digest",
+			),
+		)
+	}
+	if sub.Discovery != nil && sub.Discovery.RepoPattern != "" {
+		if _, err := regexp.Compile(sub.Discovery.RepoPattern); err != nil {
+			errs = append(
+				errs,
+				field.Invalid(
+					f.Child("discovery", "repoPattern"),
+					sub.Discovery.RepoPattern,
+					err.Error(),
+				),
+			)
+		}
+	}
 	if err := seen.addImage(sub, f); err != nil {
 		errs = append(errs, field.Invalid(f, sub.RepoURL, err.Error()))
 	}
@@ -236,6 +397,15 @@ func (w *webhook) validateChartSub(
 	); err != nil {
 		errs = append(errs, err)
 	}
+	// Versions are filtered the same way tags are, so the same validation
+	// applies.
+	if err := validateAllowTags(f.Child("allowVersions"), sub.AllowVersions); err != nil {
+		errs = append(errs, err)
+	}
+	errs = append(
+		errs,
+		validateIgnoreVersions(f.Child("ignoreVersions"), sub.IgnoreVersions)...,
+	)
 	if strings.HasPrefix(sub.RepoURL, "oci://") && sub.Name != "" {
 		errs = append(
 			errs,
@@ -263,6 +433,34 @@ func (w *webhook) validateChartSub(
 	return errs
 }
 
+func (w *webhook) validateOCIArtifactSub(
+	f *field.Path,
+	sub kargoapi.OCIArtifactSubscription,
+	seen uniqueSubSet,
+) field.ErrorList {
+	if err := seen.addOCIArtifact(sub, f); err != nil {
+		return field.ErrorList{field.Invalid(f, sub.RepoURL, err.Error())}
+	}
+	return nil
+}
+
+func (w *webhook) validateHTTPArtifactSub(
+	f *field.Path,
+	sub kargoapi.HTTPArtifactSubscription,
+	seen uniqueSubSet,
+) field.ErrorList {
+	var errs field.ErrorList
+	if sub.JSONPath != "" {
+		if err := jsonpath.New("version").Parse(sub.JSONPath); err != nil {
+			errs = append(errs, field.Invalid(f.Child("jsonPath"), sub.JSONPath, err.Error()))
+		}
+	}
+	if err := seen.addHTTPArtifact(sub, f); err != nil {
+		errs = append(errs, field.Invalid(f, sub.URL, err.Error()))
+	}
+	return errs
+}
+
 func validateSemverConstraint(
 	f *field.Path,
 	semverConstraint string,
@@ -276,6 +474,49 @@ func validateSemverConstraint(
 	return nil
 }
 
+// validateAllowTags returns an error if the provided AllowTags value is not a
+// valid regular expression. Catching this at admission time spares operators
+// from discovering it only once a Warehouse stops producing Freight.
+func validateAllowTags(f *field.Path, allowTags string) *field.Error {
+	if allowTags == "" {
+		return nil
+	}
+	if _, err := regexp.Compile(allowTags); err != nil {
+		return field.Invalid(f, allowTags, err.Error())
+	}
+	return nil
+}
+
+// validateIgnoreTags returns errors for any empty entries in the provided
+// IgnoreTags list. Entries are matched literally, so an empty entry can never
+// match a tag and is almost certainly a mistake.
+func validateIgnoreTags(f *field.Path, ignoreTags []string) field.ErrorList {
+	var errs field.ErrorList
+	for i, tag := range ignoreTags {
+		if strings.TrimSpace(tag) == "" {
+			errs = append(errs, field.Invalid(f.Index(i), tag, "tag must not be empty"))
+		}
+	}
+	return errs
+}
+
+// validateIgnoreVersions returns errors for any entries in the provided
+// IgnoreVersions list that are empty or are not valid regular expressions. An
+// empty entry would match, and therefore ignore, every version.
+func validateIgnoreVersions(f *field.Path, ignoreVersions []string) field.ErrorList {
+	var errs field.ErrorList
+	for i, version := range ignoreVersions {
+		if strings.TrimSpace(version) == "" {
+			errs = append(errs, field.Invalid(f.Index(i), version, "expression must not be empty"))
+			continue
+		}
+		if _, err := regexp.Compile(version); err != nil {
+			errs = append(errs, field.Invalid(f.Index(i), version, err.Error()))
+		}
+	}
+	return errs
+}
+
 type subscriptionKey struct {
 	kind string
 	id   string
@@ -318,3 +559,23 @@ func (s uniqueSubSet) addChart(sub kargoapi.ChartSubscription, isHTTP bool, p *f
 	s[k] = p
 	return nil
 }
+
+func (s uniqueSubSet) addOCIArtifact(sub kargoapi.OCIArtifactSubscription, p *field.Path) error {
+	// As with images, the normalization of Helm chart repository URLs does the
+	// job of lower-casing, etc. without introducing unwanted side effects.
+	k := subscriptionKey{kind: "ociArtifact", id: helm.NormalizeChartRepositoryURL(sub.RepoURL)}
+	if _, exists := s[k]; exists {
+		return fmt.Errorf("subscription for OCI artifact repository already exists at %q", s[k])
+	}
+	s[k] = p
+	return nil
+}
+
+func (s uniqueSubSet) addHTTPArtifact(sub kargoapi.HTTPArtifactSubscription, p *field.Path) error {
+	k := subscriptionKey{kind: "httpArtifact", id: sub.URL}
+	if _, exists := s[k]; exists {
+		return fmt.Errorf("subscription for HTTP artifact already exists at %q", s[k])
+	}
+	s[k] = p
+	return nil
+}
@@ -3,7 +3,9 @@ package warehouse
 import (
 	"context"
 	"errors"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -65,6 +67,24 @@ func TestDefault(t *testing.T) {
 		_, ok := warehouse.Labels[kargoapi.ShardLabelKey]
 		require.False(t, ok)
 	})
+
+	t.Run("IgnoreTags are left as written", func(t *testing.T) {
+		ignoreTags := []string{"v1.1.0", "latest", "v1.0.0", "latest"}
+		warehouse := &kargoapi.Warehouse{
+			Spec: kargoapi.WarehouseSpec{
+				Subscriptions: []kargoapi.RepoSubscription{
+					{
+						Image: &kargoapi.ImageSubscription{
+							RepoURL:    "example/image",
+							IgnoreTags: slices.Clone(ignoreTags),
+						},
+					},
+				},
+			},
+		}
+		require.NoError(t, w.Default(context.Background(), warehouse))
+		require.Equal(t, ignoreTags, warehouse.Spec.Subscriptions[0].Image.IgnoreTags)
+	})
 }
 
 func TestValidateCreate(t *testing.T) {
@@ -301,8 +321,9 @@ func TestValidateSpec(t *testing.T) {
 							Field:    "spec.subscriptions[0]",
 							BadValue: spec.Subscriptions[0],
 							Detail: "exactly one of spec.subscriptions[0].git, " +
-								"spec.subscriptions[0].image, or spec.subscriptions[0].chart " +
-								"must be non-empty",
+								"spec.subscriptions[0].image, spec.subscriptions[0].chart, " +
+								"spec.subscriptions[0].ociArtifact, or " +
+								"spec.subscriptions[0].httpArtifact must be non-empty",
 						},
 						{
 							Type:     field.ErrorTypeInvalid,
@@ -315,6 +336,75 @@ func TestValidateSpec(t *testing.T) {
 				)
 			},
 		},
+		{
+			name: "polling interval not positive",
+			spec: kargoapi.WarehouseSpec{
+				PollingInterval: &metav1.Duration{Duration: -time.Minute},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.WarehouseSpec, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "spec.pollingInterval",
+							BadValue: "-1m0s",
+							Detail:   "must be at least 30s",
+						},
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "polling interval below minimum",
+			spec: kargoapi.WarehouseSpec{
+				PollingInterval: &metav1.Duration{Duration: 10 * time.Second},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.WarehouseSpec, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "spec.pollingInterval",
+							BadValue: "10s",
+							Detail:   "must be at least 30s",
+						},
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "polling interval at minimum",
+			spec: kargoapi.WarehouseSpec{
+				PollingInterval: &metav1.Duration{Duration: minPollingInterval},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.WarehouseSpec, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+		{
+			name: "registry timeout not positive",
+			spec: kargoapi.WarehouseSpec{
+				RegistryTimeout: &metav1.Duration{},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.WarehouseSpec, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "spec.registryTimeout",
+							BadValue: "0s",
+							Detail:   "must be positive",
+						},
+					},
+					errs,
+				)
+			},
+		},
 		{
 			name: "valid",
 			spec: kargoapi.WarehouseSpec{
@@ -400,8 +490,9 @@ func TestValidateSubs(t *testing.T) {
 							Type:     field.ErrorTypeInvalid,
 							Field:    "subs[0]",
 							BadValue: subs[0],
-							Detail: "exactly one of subs[0].git, subs[0].image, or " +
-								"subs[0].chart must be non-empty",
+							Detail: "exactly one of subs[0].git, subs[0].image, " +
+								"subs[0].chart, subs[0].ociArtifact, or subs[0].httpArtifact " +
+								"must be non-empty",
 						},
 						{
 							Type:     field.ErrorTypeInvalid,
@@ -493,7 +584,8 @@ func TestValidateSub(t *testing.T) {
 							Type:     field.ErrorTypeInvalid,
 							Field:    "sub",
 							BadValue: sub,
-							Detail:   "exactly one of sub.git, sub.image, or sub.chart must be non-empty",
+							Detail: "exactly one of sub.git, sub.image, sub.chart, " +
+								"sub.ociArtifact, or sub.httpArtifact must be non-empty",
 						},
 					},
 					errs,
@@ -563,6 +655,50 @@ func TestValidateGitSub(t *testing.T) {
 			},
 		},
 
+		{
+			name: "branch with tag-based selection strategy",
+			sub: kargoapi.GitSubscription{
+				RepoURL:                 "https://github.com/example/repo.git",
+				CommitSelectionStrategy: kargoapi.CommitSelectionStrategySemVer,
+				Branch:                  "main",
+			},
+			seen: uniqueSubSet{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+
+		{
+			name: "branch with branch selection strategy",
+			sub: kargoapi.GitSubscription{
+				RepoURL:                 "https://github.com/example/repo.git",
+				CommitSelectionStrategy: kargoapi.CommitSelectionStrategyNewestFromBranch,
+				Branch:                  "main",
+			},
+			seen: uniqueSubSet{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+
+		{
+			name: "invalid allowTags and ignoreTags",
+			sub: kargoapi.GitSubscription{
+				RepoURL:    "https://github.com/example/repo.git",
+				AllowTags:  "(",
+				IgnoreTags: []string{"v1.0.0", ""},
+			},
+			seen: uniqueSubSet{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 2)
+				require.Equal(t, "git.allowTags", errs[0].Field)
+				require.Equal(t, "(", errs[0].BadValue)
+				require.Contains(t, errs[0].Detail, "missing closing )")
+				require.Equal(t, "git.ignoreTags[1]", errs[1].Field)
+				require.Equal(t, "tag must not be empty", errs[1].Detail)
+			},
+		},
+
 		{
 			name: "valid",
 			seen: uniqueSubSet{},
@@ -599,6 +735,7 @@ func TestValidateImageSub(t *testing.T) {
 				RepoURL:          "bogus",
 				SemverConstraint: "bogus",
 				Platform:         "bogus",
+				ExcludePlatforms: []string{"linux/arm64", "bogus"},
 			},
 			seen: uniqueSubSet{
 				subscriptionKey{
@@ -620,6 +757,11 @@ func TestValidateImageSub(t *testing.T) {
 							Field:    "image.platform",
 							BadValue: "bogus",
 						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "image.excludePlatforms[1]",
+							BadValue: "bogus",
+						},
 						{
 							Type:     field.ErrorTypeInvalid,
 							Field:    "image",
@@ -632,6 +774,117 @@ func TestValidateImageSub(t *testing.T) {
 			},
 		},
 
+		{
+			name: "oldest selection mode with digest strategy",
+			sub: kargoapi.ImageSubscription{
+				RepoURL:                "example/image",
+				ImageSelectionStrategy: kargoapi.ImageSelectionStrategyDigest,
+				SelectionMode:          kargoapi.SelectionModeOldest,
+			},
+			seen: uniqueSubSet{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "image.selectionMode",
+							BadValue: kargoapi.SelectionModeOldest,
+							Detail:   "selectionMode Oldest is not supported with imageSelectionStrategy Digest",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "digest without digest strategy",
+			sub: kargoapi.ImageSubscription{
+				RepoURL:                "example/image",
+				ImageSelectionStrategy: kargoapi.ImageSelectionStrategySemVer,
+				Digest:                 "sha256:abc123",
+			},
+			seen: uniqueSubSet{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "image.digest",
+							BadValue: "sha256:abc123",
+							Detail:   "digest may only be specified with imageSelectionStrategy Digest",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "invalid allowTags and ignoreTags",
+			sub: kargoapi.ImageSubscription{
+				RepoURL:    "example/image",
+				AllowTags:  "^v[0-9+$",
+				IgnoreTags: []string{" "},
+			},
+			seen: uniqueSubSet{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 2)
+				require.Equal(t, "image.allowTags", errs[0].Field)
+				require.Equal(t, "^v[0-9+$", errs[0].BadValue)
+				require.Contains(t, errs[0].Detail, "missing closing ]")
+				require.Equal(t, "image.ignoreTags[0]", errs[1].Field)
+			},
+		},
+
+		{
+			name: "platform and platforms",
+			sub: kargoapi.ImageSubscription{
+				RepoURL:   "example/image",
+				Platform:  "linux/amd64",
+				Platforms: []string{"linux/amd64", "bogus"},
+			},
+			seen: uniqueSubSet{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "image.platforms",
+							BadValue: []string{"linux/amd64", "bogus"},
+							Detail:   "platform and platforms are mutually exclusive",
+						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "image.platforms[1]",
+							BadValue: "bogus",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "invalid discovery repo pattern",
+			sub: kargoapi.ImageSubscription{
+				RepoURL: "ghcr.io/example",
+				Discovery: &kargoapi.ImageRepositoryDiscovery{
+					RepoPattern: "(",
+				},
+			},
+			seen: uniqueSubSet{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, field.ErrorTypeInvalid, errs[0].Type)
+				require.Equal(t, "image.discovery.repoPattern", errs[0].Field)
+				require.Equal(t, "(", errs[0].BadValue)
+			},
+		},
+
 		{
 			name: "valid",
 			seen: uniqueSubSet{},
@@ -655,6 +908,136 @@ func TestValidateImageSub(t *testing.T) {
 	}
 }
 
+func TestValidateOCIArtifactSub(t *testing.T) {
+	testCases := []struct {
+		name       string
+		sub        kargoapi.OCIArtifactSubscription
+		seen       uniqueSubSet
+		assertions func(*testing.T, field.ErrorList)
+	}{
+		{
+			name: "invalid",
+			sub: kargoapi.OCIArtifactSubscription{
+				RepoURL: "bogus",
+			},
+			seen: uniqueSubSet{
+				subscriptionKey{
+					kind: "ociArtifact",
+					id:   "bogus",
+				}: field.NewPath("spec.subscriptions[0].ociArtifact"),
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "ociArtifact",
+							BadValue: "bogus",
+							Detail: "subscription for OCI artifact repository already exists at " +
+								"\"spec.subscriptions[0].ociArtifact\"",
+						},
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "valid",
+			sub: kargoapi.OCIArtifactSubscription{
+				RepoURL: "bogus",
+			},
+			seen: uniqueSubSet{
+				// An image subscription to the same repository does not conflict.
+				subscriptionKey{
+					kind: "image",
+					id:   "bogus",
+				}: field.NewPath("spec.subscriptions[0].image"),
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				w.validateOCIArtifactSub(
+					field.NewPath("ociArtifact"),
+					testCase.sub,
+					testCase.seen,
+				),
+			)
+		})
+	}
+}
+
+func TestValidateHTTPArtifactSub(t *testing.T) {
+	const testURL = "https://example.com/release.json"
+	testCases := []struct {
+		name       string
+		sub        kargoapi.HTTPArtifactSubscription
+		seen       uniqueSubSet
+		assertions func(*testing.T, field.ErrorList)
+	}{
+		{
+			name: "invalid",
+			sub: kargoapi.HTTPArtifactSubscription{
+				URL:      testURL,
+				JSONPath: "{.tag_name",
+			},
+			seen: uniqueSubSet{
+				subscriptionKey{
+					kind: "httpArtifact",
+					id:   testURL,
+				}: field.NewPath("spec.subscriptions[0].httpArtifact"),
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 2)
+				require.Equal(t, field.ErrorTypeInvalid, errs[0].Type)
+				require.Equal(t, "httpArtifact.jsonPath", errs[0].Field)
+				require.Equal(
+					t,
+					&field.Error{
+						Type:     field.ErrorTypeInvalid,
+						Field:    "httpArtifact",
+						BadValue: testURL,
+						Detail: "subscription for HTTP artifact already exists at " +
+							"\"spec.subscriptions[0].httpArtifact\"",
+					},
+					errs[1],
+				)
+			},
+		},
+		{
+			name: "valid",
+			sub: kargoapi.HTTPArtifactSubscription{
+				URL:      testURL,
+				JSONPath: "{.tag_name}",
+			},
+			seen: uniqueSubSet{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				w.validateHTTPArtifactSub(
+					field.NewPath("httpArtifact"),
+					testCase.sub,
+					testCase.seen,
+				),
+			)
+		})
+	}
+}
+
 func TestValidateChartSub(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -691,6 +1074,31 @@ func TestValidateChartSub(t *testing.T) {
 			},
 		},
 
+		{
+			name: "invalid version filters",
+			sub: kargoapi.ChartSubscription{
+				RepoURL:        "oci://fake-url",
+				AllowVersions:  "(",
+				IgnoreVersions: []string{`-rc\.\d+$`, "", "["},
+			},
+			seen: uniqueSubSet{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 3)
+				require.Equal(t, "chart.allowVersions", errs[0].Field)
+				require.Equal(
+					t,
+					&field.Error{
+						Type:     field.ErrorTypeInvalid,
+						Field:    "chart.ignoreVersions[1]",
+						BadValue: "",
+						Detail:   "expression must not be empty",
+					},
+					errs[1],
+				)
+				require.Equal(t, "chart.ignoreVersions[2]", errs[2].Field)
+			},
+		},
+
 		{
 			name: "https repoURL without name",
 			sub: kargoapi.ChartSubscription{
@@ -842,3 +1250,69 @@ func TestValidateSemverConstraint(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateFreightMetadata(t *testing.T) {
+	testCases := []struct {
+		name       string
+		md         *kargoapi.FreightMetadata
+		assertions func(*testing.T, field.ErrorList)
+	}{
+		{
+			name: "nil",
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+		{
+			name: "reserved keys",
+			md: &kargoapi.FreightMetadata{
+				Labels: map[string]string{
+					"kargo.akuity.io/alias": "bogus",
+					"example.com/foo":       "bar",
+				},
+				Annotations: map[string]string{
+					"kargo.akuity.io/refresh": "bogus",
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "freightMetadata.labels[kargo.akuity.io/alias]",
+							BadValue: "kargo.akuity.io/alias",
+							Detail:   "keys using the kargo.akuity.io/ prefix are reserved",
+						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "freightMetadata.annotations[kargo.akuity.io/refresh]",
+							BadValue: "kargo.akuity.io/refresh",
+							Detail:   "keys using the kargo.akuity.io/ prefix are reserved",
+						},
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "valid",
+			md: &kargoapi.FreightMetadata{
+				Labels:      map[string]string{"example.com/foo": "bar"},
+				Annotations: map[string]string{"example.com/bat": "baz"},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				w.validateFreightMetadata(field.NewPath("freightMetadata"), testCase.md),
+			)
+		})
+	}
+}
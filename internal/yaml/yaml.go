@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"fmt"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -13,11 +14,11 @@ import (
 
 // SetStringsInFile overwrites the specified file with the changes specified by
 // the changes map applied. The changes map maps keys to new values. Keys are of
-// the form <key 0>.<key 1>...<key n>. Integers may be used as keys in cases
-// where a specific node needs to be selected from a sequence. Individual
-// changes are ignored without error if their key is not found or if their key
-// is found not to address a scalar node. Importantly, all comments and style
-// choices in the input bytes are preserved in the output.
+// the form <key 0>.<key 1>...<key n>. Integers, optionally in brackets, may be
+// used as keys in cases where a specific node needs to be selected from a
+// sequence. Individual changes are ignored without error if their key is not
+// found or if their key is found not to address a scalar node. Importantly, all
+// comments and style choices in the input bytes are preserved in the output.
 func SetStringsInFile(file string, changes map[string]string) error {
 	inBytes, err := os.ReadFile(file)
 	if err != nil {
@@ -50,9 +51,13 @@ func SetStringsInFile(file string, changes map[string]string) error {
 // specified by the changes map applied. The changes map maps keys to new
 // values. Keys are of the form <key 0>.<key 1>...<key n>. Integers may be used
 // as keys in cases where a specific node needs to be selected from a sequence.
-// Individual changes are ignored without error if their key is not found or
-// if their key is found not to address a scalar node. Importantly, all comments
-// and style choices in the input bytes are preserved in the output.
+// Such indices may alternatively be written in brackets, as in
+// images[0].tag. Individual changes are ignored without error if their key is
+// not found or if their key is found not to address a scalar node.
+// Importantly, all comments and style choices in the input bytes are preserved
+// in the output, including comments following a changed value on the same
+// line. New values are written verbatim, so any quoting they require is the
+// caller's responsibility.
 func SetStringsInBytes(
 	inBytes []byte,
 	changes map[string]string,
@@ -63,17 +68,16 @@ func SetStringsInBytes(
 	}
 
 	type change struct {
-		col   int
+		node  *yaml.Node
 		value string
 	}
-	changesByLine := map[int]change{}
+	changesByLine := map[int][]change{}
 	for k, v := range changes {
-		keyPath := strings.Split(k, ".")
-		if found, line, col := findScalarNode(doc, keyPath); found {
-			changesByLine[line] = change{
-				col:   col,
-				value: v,
-			}
+		if node := findScalarNode(doc, parseKeyPath(k)); node != nil {
+			changesByLine[node.Line-1] = append(
+				changesByLine[node.Line-1],
+				change{node: node, value: v},
+			)
 		}
 	}
 
@@ -84,43 +88,119 @@ func SetStringsInBytes(
 	var line int
 	for scanner.Scan() {
 		const errMsg = "error writing to byte buffer"
-		change, found := changesByLine[line]
-		if !found {
-			if _, err := outBuf.WriteString(scanner.Text()); err != nil {
-				return nil, fmt.Errorf("%s: %w", errMsg, err)
+		text := scanner.Text()
+		lineChanges := changesByLine[line]
+		// Apply changes from right to left so that the columns of the changes
+		// still to be applied remain accurate.
+		sort.Slice(lineChanges, func(i, j int) bool {
+			return lineChanges[i].node.Column > lineChanges[j].node.Column
+		})
+		for _, change := range lineChanges {
+			text = replaceScalar(text, change.node, change.value)
+		}
+		if _, err := outBuf.WriteString(text); err != nil {
+			return nil, fmt.Errorf("%s: %w", errMsg, err)
+		}
+		if _, err := outBuf.WriteString("\n"); err != nil {
+			return nil, fmt.Errorf("%s: %w", errMsg, err)
+		}
+		line++
+	}
+
+	return outBuf.Bytes(), nil
+}
+
+// replaceScalar returns the provided line with the value of the provided
+// scalar node, which must start on that line, replaced by the provided value.
+// Anything following the original value on the same line (e.g. a comment) is
+// preserved, unless the original value continues on subsequent lines, in which
+// case the remainder of the line is replaced.
+func replaceScalar(line string, node *yaml.Node, value string) string {
+	col := node.Column - 1
+	unchanged := line[0:col]
+	if !strings.HasSuffix(unchanged, " ") && !strings.HasSuffix(unchanged, "{") &&
+		!strings.HasSuffix(unchanged, "[") {
+		unchanged += " "
+	}
+	var rest string
+	if end := scalarEnd(line, node); end >= 0 {
+		rest = line[end:]
+	}
+	return unchanged + value + rest
+}
+
+// scalarEnd returns the index in the provided line immediately following the
+// value of the provided scalar node, which must start on that line. If the
+// value does not end on the same line, -1 is returned.
+func scalarEnd(line string, node *yaml.Node) int {
+	col := node.Column - 1
+	switch node.Style {
+	case yaml.DoubleQuotedStyle:
+		for i := col + 1; i < len(line); i++ {
+			switch line[i] {
+			case '\\':
+				i++
+			case '"':
+				return i + 1
 			}
-			if _, err := outBuf.WriteString("\n"); err != nil {
-				return nil, fmt.Errorf("%s: %w", errMsg, err)
+		}
+	case yaml.SingleQuotedStyle:
+		for i := col + 1; i < len(line); i++ {
+			if line[i] != '\'' {
+				continue
 			}
-		} else {
-			unchanged := scanner.Text()[0:change.col]
-			if _, err := outBuf.WriteString(unchanged); err != nil {
-				return nil, fmt.Errorf("%s: %w", errMsg, err)
+			if i+1 < len(line) && line[i+1] == '\'' {
+				i++
+				continue
 			}
-			if !strings.HasSuffix(unchanged, " ") {
-				if _, err := outBuf.WriteString(" "); err != nil {
-					return nil, fmt.Errorf("%s: %w", errMsg, err)
-				}
+			return i + 1
+		}
+	default:
+		// A plain scalar that fits on one line appears exactly as its value.
+		// Block scalars never do, since they start with an indicator.
+		if end := col + len(node.Value); strings.HasPrefix(line[col:], node.Value) &&
+			(end == len(line) || strings.ContainsAny(line[end:end+1], " \t,]}")) {
+			return end
+		}
+	}
+	return -1
+}
+
+// parseKeyPath splits the provided key into the keys and sequence indices it
+// is composed of. Both a.0.b and a[0].b are parsed as [a 0 b].
+func parseKeyPath(key string) []string {
+	var keyPath []string
+	for _, part := range strings.Split(key, ".") {
+		for {
+			open := strings.Index(part, "[")
+			if open < 0 || !strings.HasSuffix(part, "]") {
+				break
 			}
-			if _, err := outBuf.WriteString(change.value); err != nil {
-				return nil, fmt.Errorf("%s: %w", errMsg, err)
+			closing := strings.Index(part[open:], "]") + open
+			if open > 0 {
+				keyPath = append(keyPath, part[:open])
 			}
-			if _, err := outBuf.WriteString("\n"); err != nil {
-				return nil, fmt.Errorf("%s: %w", errMsg, err)
+			keyPath = append(keyPath, part[open+1:closing])
+			part = part[closing+1:]
+			if part == "" {
+				break
 			}
 		}
-		line++
+		if part != "" {
+			keyPath = append(keyPath, part)
+		}
 	}
-
-	return outBuf.Bytes(), nil
+	return keyPath
 }
 
-func findScalarNode(node *yaml.Node, keyPath []string) (bool, int, int) {
+// findScalarNode returns the scalar node addressed by the provided key path or
+// nil if the key path does not address a scalar node.
+func findScalarNode(node *yaml.Node, keyPath []string) *yaml.Node {
 	if len(keyPath) == 0 {
 		if node.Kind == yaml.ScalarNode {
-			return true, node.Line - 1, node.Column - 1
+			return node
 		}
-		return false, 0, 0
+		return nil
 	}
 	switch node.Kind {
 	case yaml.DocumentNode:
@@ -133,10 +213,10 @@ func findScalarNode(node *yaml.Node, keyPath []string) (bool, int, int) {
 		}
 	case yaml.SequenceNode:
 		index, err := strconv.Atoi(keyPath[0])
-		if err != nil {
-			return false, 0, 0
+		if err != nil || index < 0 || index >= len(node.Content) {
+			return nil
 		}
 		return findScalarNode(node.Content[index], keyPath[1:])
 	}
-	return false, 0, 0
+	return nil
 }
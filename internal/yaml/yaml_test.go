@@ -28,6 +28,48 @@ characters:
 				require.Nil(t, bytes)
 			},
 		},
+		{
+			name: "nested keys and sequence indices in brackets",
+			inBytes: []byte(`
+# The main image
+image:
+  repository: nginx
+  tag: 1.25.0 # pinned
+sidecars:
+- name: proxy
+  image:
+    tag: "1.0.0"
+- name: exporter
+  image: {repository: exporter, tag: '0.1.0'}
+`),
+			changes: map[string]string{
+				"image.tag":                    "1.26.0",
+				"sidecars[0].image.tag":        `"1.1.0"`,
+				"sidecars[1].image.tag":        "'0.2.0'",
+				"sidecars[1].image.repository": "new-exporter",
+				"sidecars[2].image.tag":        "ignored",
+				"sidecars.-1.image.tag":        "ignored",
+			},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]byte(`
+# The main image
+image:
+  repository: nginx
+  tag: 1.26.0 # pinned
+sidecars:
+- name: proxy
+  image:
+    tag: "1.1.0"
+- name: exporter
+  image: {repository: new-exporter, tag: '0.2.0'}
+`),
+					bytes,
+				)
+			},
+		},
 		{
 			name: "success",
 			inBytes: []byte(`
@@ -69,15 +111,13 @@ characters:
 	testCases := []struct {
 		name       string
 		keyPath    string
-		assertions func(t *testing.T, found bool, line, col int)
+		assertions func(t *testing.T, node *yaml.Node)
 	}{
 		{
 			name:    "node not found",
 			keyPath: "characters.imperials",
-			assertions: func(t *testing.T, found bool, line, col int) {
-				require.False(t, found)
-				require.Zero(t, line)
-				require.Zero(t, col)
+			assertions: func(t *testing.T, node *yaml.Node) {
+				require.Nil(t, node)
 			},
 		},
 		{
@@ -85,28 +125,32 @@ characters:
 			// Really, this is a special case of a key that doesn't address a node,
 			// because there is alpha input where numeric input would be expected.
 			keyPath: "characters.rebels.first.name",
-			assertions: func(t *testing.T, found bool, line, col int) {
-				require.False(t, found)
-				require.Zero(t, line)
-				require.Zero(t, col)
+			assertions: func(t *testing.T, node *yaml.Node) {
+				require.Nil(t, node)
+			},
+		},
+		{
+			name:    "sequence index out of range",
+			keyPath: "characters.rebels.1.name",
+			assertions: func(t *testing.T, node *yaml.Node) {
+				require.Nil(t, node)
 			},
 		},
 		{
 			name:    "node found, but isn't a scalar node",
 			keyPath: "characters.rebels",
-			assertions: func(t *testing.T, found bool, line, col int) {
-				require.False(t, found)
-				require.Zero(t, line)
-				require.Zero(t, col)
+			assertions: func(t *testing.T, node *yaml.Node) {
+				require.Nil(t, node)
 			},
 		},
 		{
 			name:    "success",
 			keyPath: "characters.rebels.0.name",
-			assertions: func(t *testing.T, found bool, line, col int) {
-				require.True(t, found)
-				require.Equal(t, 3, line)
-				require.Equal(t, 10, col)
+			assertions: func(t *testing.T, node *yaml.Node) {
+				require.NotNil(t, node)
+				require.Equal(t, "Skywalker", node.Value)
+				require.Equal(t, 4, node.Line)
+				require.Equal(t, 11, node.Column)
 			},
 		},
 	}
@@ -115,8 +159,27 @@ characters:
 	require.NoError(t, err)
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			found, line, col := findScalarNode(doc, strings.Split(testCase.keyPath, "."))
-			testCase.assertions(t, found, line, col)
+			testCase.assertions(t, findScalarNode(doc, strings.Split(testCase.keyPath, ".")))
+		})
+	}
+}
+
+func TestParseKeyPath(t *testing.T) {
+	testCases := []struct {
+		key      string
+		expected []string
+	}{
+		{"image", []string{"image"}},
+		{"image.tag", []string{"image", "tag"}},
+		{"images.0.tag", []string{"images", "0", "tag"}},
+		{"images[0].tag", []string{"images", "0", "tag"}},
+		{"matrix[0][1]", []string{"matrix", "0", "1"}},
+		{"[0].tag", []string{"0", "tag"}},
+		{"weird[key", []string{"weird[key"}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.key, func(t *testing.T) {
+			require.Equal(t, testCase.expected, parseKeyPath(testCase.key))
 		})
 	}
 }
@@ -80,6 +80,12 @@ const (
 	// KargoServiceWatchPromotionProcedure is the fully-qualified name of the KargoService's
 	// WatchPromotion RPC.
 	KargoServiceWatchPromotionProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/WatchPromotion"
+	// KargoServiceListPromotionsByArgoCDApplicationProcedure is the fully-qualified name of the
+	// KargoService's ListPromotionsByArgoCDApplication RPC.
+	KargoServiceListPromotionsByArgoCDApplicationProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ListPromotionsByArgoCDApplication"
+	// KargoServiceRetryPromotionProcedure is the fully-qualified name of the KargoService's
+	// RetryPromotion RPC.
+	KargoServiceRetryPromotionProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/RetryPromotion"
 	// KargoServiceDeleteProjectProcedure is the fully-qualified name of the KargoService's
 	// DeleteProject RPC.
 	KargoServiceDeleteProjectProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/DeleteProject"
@@ -102,6 +108,9 @@ const (
 	// KargoServicePromoteToStageSubscribersProcedure is the fully-qualified name of the KargoService's
 	// PromoteToStageSubscribers RPC.
 	KargoServicePromoteToStageSubscribersProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/PromoteToStageSubscribers"
+	// KargoServiceRollbackStageProcedure is the fully-qualified name of the KargoService's
+	// RollbackStage RPC.
+	KargoServiceRollbackStageProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/RollbackStage"
 	// KargoServiceQueryFreightProcedure is the fully-qualified name of the KargoService's QueryFreight
 	// RPC.
 	KargoServiceQueryFreightProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/QueryFreight"
@@ -143,6 +152,9 @@ const (
 	// KargoServiceUpdateCredentialsProcedure is the fully-qualified name of the KargoService's
 	// UpdateCredentials RPC.
 	KargoServiceUpdateCredentialsProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/UpdateCredentials"
+	// KargoServiceValidateCredentialsProcedure is the fully-qualified name of the KargoService's
+	// ValidateCredentials RPC.
+	KargoServiceValidateCredentialsProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ValidateCredentials"
 	// KargoServiceListAnalysisTemplatesProcedure is the fully-qualified name of the KargoService's
 	// ListAnalysisTemplates RPC.
 	KargoServiceListAnalysisTemplatesProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ListAnalysisTemplates"
@@ -176,58 +188,62 @@ const (
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	kargoServiceServiceDescriptor                         = v1alpha1.File_service_v1alpha1_service_proto.Services().ByName("KargoService")
-	kargoServiceGetVersionInfoMethodDescriptor            = kargoServiceServiceDescriptor.Methods().ByName("GetVersionInfo")
-	kargoServiceGetConfigMethodDescriptor                 = kargoServiceServiceDescriptor.Methods().ByName("GetConfig")
-	kargoServiceGetPublicConfigMethodDescriptor           = kargoServiceServiceDescriptor.Methods().ByName("GetPublicConfig")
-	kargoServiceAdminLoginMethodDescriptor                = kargoServiceServiceDescriptor.Methods().ByName("AdminLogin")
-	kargoServiceCreateResourceMethodDescriptor            = kargoServiceServiceDescriptor.Methods().ByName("CreateResource")
-	kargoServiceCreateOrUpdateResourceMethodDescriptor    = kargoServiceServiceDescriptor.Methods().ByName("CreateOrUpdateResource")
-	kargoServiceUpdateResourceMethodDescriptor            = kargoServiceServiceDescriptor.Methods().ByName("UpdateResource")
-	kargoServiceDeleteResourceMethodDescriptor            = kargoServiceServiceDescriptor.Methods().ByName("DeleteResource")
-	kargoServiceListStagesMethodDescriptor                = kargoServiceServiceDescriptor.Methods().ByName("ListStages")
-	kargoServiceGetStageMethodDescriptor                  = kargoServiceServiceDescriptor.Methods().ByName("GetStage")
-	kargoServiceWatchStagesMethodDescriptor               = kargoServiceServiceDescriptor.Methods().ByName("WatchStages")
-	kargoServiceDeleteStageMethodDescriptor               = kargoServiceServiceDescriptor.Methods().ByName("DeleteStage")
-	kargoServiceRefreshStageMethodDescriptor              = kargoServiceServiceDescriptor.Methods().ByName("RefreshStage")
-	kargoServiceListPromotionsMethodDescriptor            = kargoServiceServiceDescriptor.Methods().ByName("ListPromotions")
-	kargoServiceWatchPromotionsMethodDescriptor           = kargoServiceServiceDescriptor.Methods().ByName("WatchPromotions")
-	kargoServiceGetPromotionMethodDescriptor              = kargoServiceServiceDescriptor.Methods().ByName("GetPromotion")
-	kargoServiceWatchPromotionMethodDescriptor            = kargoServiceServiceDescriptor.Methods().ByName("WatchPromotion")
-	kargoServiceDeleteProjectMethodDescriptor             = kargoServiceServiceDescriptor.Methods().ByName("DeleteProject")
-	kargoServiceGetProjectMethodDescriptor                = kargoServiceServiceDescriptor.Methods().ByName("GetProject")
-	kargoServiceListProjectsMethodDescriptor              = kargoServiceServiceDescriptor.Methods().ByName("ListProjects")
-	kargoServiceApproveFreightMethodDescriptor            = kargoServiceServiceDescriptor.Methods().ByName("ApproveFreight")
-	kargoServiceDeleteFreightMethodDescriptor             = kargoServiceServiceDescriptor.Methods().ByName("DeleteFreight")
-	kargoServiceGetFreightMethodDescriptor                = kargoServiceServiceDescriptor.Methods().ByName("GetFreight")
-	kargoServicePromoteToStageMethodDescriptor            = kargoServiceServiceDescriptor.Methods().ByName("PromoteToStage")
-	kargoServicePromoteToStageSubscribersMethodDescriptor = kargoServiceServiceDescriptor.Methods().ByName("PromoteToStageSubscribers")
-	kargoServiceQueryFreightMethodDescriptor              = kargoServiceServiceDescriptor.Methods().ByName("QueryFreight")
-	kargoServiceUpdateFreightAliasMethodDescriptor        = kargoServiceServiceDescriptor.Methods().ByName("UpdateFreightAlias")
-	kargoServiceReverifyMethodDescriptor                  = kargoServiceServiceDescriptor.Methods().ByName("Reverify")
-	kargoServiceAbortVerificationMethodDescriptor         = kargoServiceServiceDescriptor.Methods().ByName("AbortVerification")
-	kargoServiceListWarehousesMethodDescriptor            = kargoServiceServiceDescriptor.Methods().ByName("ListWarehouses")
-	kargoServiceGetWarehouseMethodDescriptor              = kargoServiceServiceDescriptor.Methods().ByName("GetWarehouse")
-	kargoServiceWatchWarehousesMethodDescriptor           = kargoServiceServiceDescriptor.Methods().ByName("WatchWarehouses")
-	kargoServiceDeleteWarehouseMethodDescriptor           = kargoServiceServiceDescriptor.Methods().ByName("DeleteWarehouse")
-	kargoServiceRefreshWarehouseMethodDescriptor          = kargoServiceServiceDescriptor.Methods().ByName("RefreshWarehouse")
-	kargoServiceCreateCredentialsMethodDescriptor         = kargoServiceServiceDescriptor.Methods().ByName("CreateCredentials")
-	kargoServiceDeleteCredentialsMethodDescriptor         = kargoServiceServiceDescriptor.Methods().ByName("DeleteCredentials")
-	kargoServiceGetCredentialsMethodDescriptor            = kargoServiceServiceDescriptor.Methods().ByName("GetCredentials")
-	kargoServiceListCredentialsMethodDescriptor           = kargoServiceServiceDescriptor.Methods().ByName("ListCredentials")
-	kargoServiceUpdateCredentialsMethodDescriptor         = kargoServiceServiceDescriptor.Methods().ByName("UpdateCredentials")
-	kargoServiceListAnalysisTemplatesMethodDescriptor     = kargoServiceServiceDescriptor.Methods().ByName("ListAnalysisTemplates")
-	kargoServiceGetAnalysisTemplateMethodDescriptor       = kargoServiceServiceDescriptor.Methods().ByName("GetAnalysisTemplate")
-	kargoServiceDeleteAnalysisTemplateMethodDescriptor    = kargoServiceServiceDescriptor.Methods().ByName("DeleteAnalysisTemplate")
-	kargoServiceGetAnalysisRunMethodDescriptor            = kargoServiceServiceDescriptor.Methods().ByName("GetAnalysisRun")
-	kargoServiceListProjectEventsMethodDescriptor         = kargoServiceServiceDescriptor.Methods().ByName("ListProjectEvents")
-	kargoServiceCreateRoleMethodDescriptor                = kargoServiceServiceDescriptor.Methods().ByName("CreateRole")
-	kargoServiceDeleteRoleMethodDescriptor                = kargoServiceServiceDescriptor.Methods().ByName("DeleteRole")
-	kargoServiceGetRoleMethodDescriptor                   = kargoServiceServiceDescriptor.Methods().ByName("GetRole")
-	kargoServiceGrantMethodDescriptor                     = kargoServiceServiceDescriptor.Methods().ByName("Grant")
-	kargoServiceListRolesMethodDescriptor                 = kargoServiceServiceDescriptor.Methods().ByName("ListRoles")
-	kargoServiceRevokeMethodDescriptor                    = kargoServiceServiceDescriptor.Methods().ByName("Revoke")
-	kargoServiceUpdateRoleMethodDescriptor                = kargoServiceServiceDescriptor.Methods().ByName("UpdateRole")
+	kargoServiceServiceDescriptor                                 = v1alpha1.File_service_v1alpha1_service_proto.Services().ByName("KargoService")
+	kargoServiceGetVersionInfoMethodDescriptor                    = kargoServiceServiceDescriptor.Methods().ByName("GetVersionInfo")
+	kargoServiceGetConfigMethodDescriptor                         = kargoServiceServiceDescriptor.Methods().ByName("GetConfig")
+	kargoServiceGetPublicConfigMethodDescriptor                   = kargoServiceServiceDescriptor.Methods().ByName("GetPublicConfig")
+	kargoServiceAdminLoginMethodDescriptor                        = kargoServiceServiceDescriptor.Methods().ByName("AdminLogin")
+	kargoServiceCreateResourceMethodDescriptor                    = kargoServiceServiceDescriptor.Methods().ByName("CreateResource")
+	kargoServiceCreateOrUpdateResourceMethodDescriptor            = kargoServiceServiceDescriptor.Methods().ByName("CreateOrUpdateResource")
+	kargoServiceUpdateResourceMethodDescriptor                    = kargoServiceServiceDescriptor.Methods().ByName("UpdateResource")
+	kargoServiceDeleteResourceMethodDescriptor                    = kargoServiceServiceDescriptor.Methods().ByName("DeleteResource")
+	kargoServiceListStagesMethodDescriptor                        = kargoServiceServiceDescriptor.Methods().ByName("ListStages")
+	kargoServiceGetStageMethodDescriptor                          = kargoServiceServiceDescriptor.Methods().ByName("GetStage")
+	kargoServiceWatchStagesMethodDescriptor                       = kargoServiceServiceDescriptor.Methods().ByName("WatchStages")
+	kargoServiceDeleteStageMethodDescriptor                       = kargoServiceServiceDescriptor.Methods().ByName("DeleteStage")
+	kargoServiceRefreshStageMethodDescriptor                      = kargoServiceServiceDescriptor.Methods().ByName("RefreshStage")
+	kargoServiceListPromotionsMethodDescriptor                    = kargoServiceServiceDescriptor.Methods().ByName("ListPromotions")
+	kargoServiceWatchPromotionsMethodDescriptor                   = kargoServiceServiceDescriptor.Methods().ByName("WatchPromotions")
+	kargoServiceGetPromotionMethodDescriptor                      = kargoServiceServiceDescriptor.Methods().ByName("GetPromotion")
+	kargoServiceWatchPromotionMethodDescriptor                    = kargoServiceServiceDescriptor.Methods().ByName("WatchPromotion")
+	kargoServiceListPromotionsByArgoCDApplicationMethodDescriptor = kargoServiceServiceDescriptor.Methods().ByName("ListPromotionsByArgoCDApplication")
+	kargoServiceRetryPromotionMethodDescriptor                    = kargoServiceServiceDescriptor.Methods().ByName("RetryPromotion")
+	kargoServiceDeleteProjectMethodDescriptor                     = kargoServiceServiceDescriptor.Methods().ByName("DeleteProject")
+	kargoServiceGetProjectMethodDescriptor                        = kargoServiceServiceDescriptor.Methods().ByName("GetProject")
+	kargoServiceListProjectsMethodDescriptor                      = kargoServiceServiceDescriptor.Methods().ByName("ListProjects")
+	kargoServiceApproveFreightMethodDescriptor                    = kargoServiceServiceDescriptor.Methods().ByName("ApproveFreight")
+	kargoServiceDeleteFreightMethodDescriptor                     = kargoServiceServiceDescriptor.Methods().ByName("DeleteFreight")
+	kargoServiceGetFreightMethodDescriptor                        = kargoServiceServiceDescriptor.Methods().ByName("GetFreight")
+	kargoServicePromoteToStageMethodDescriptor                    = kargoServiceServiceDescriptor.Methods().ByName("PromoteToStage")
+	kargoServicePromoteToStageSubscribersMethodDescriptor         = kargoServiceServiceDescriptor.Methods().ByName("PromoteToStageSubscribers")
+	kargoServiceRollbackStageMethodDescriptor                     = kargoServiceServiceDescriptor.Methods().ByName("RollbackStage")
+	kargoServiceQueryFreightMethodDescriptor                      = kargoServiceServiceDescriptor.Methods().ByName("QueryFreight")
+	kargoServiceUpdateFreightAliasMethodDescriptor                = kargoServiceServiceDescriptor.Methods().ByName("UpdateFreightAlias")
+	kargoServiceReverifyMethodDescriptor                          = kargoServiceServiceDescriptor.Methods().ByName("Reverify")
+	kargoServiceAbortVerificationMethodDescriptor                 = kargoServiceServiceDescriptor.Methods().ByName("AbortVerification")
+	kargoServiceListWarehousesMethodDescriptor                    = kargoServiceServiceDescriptor.Methods().ByName("ListWarehouses")
+	kargoServiceGetWarehouseMethodDescriptor                      = kargoServiceServiceDescriptor.Methods().ByName("GetWarehouse")
+	kargoServiceWatchWarehousesMethodDescriptor                   = kargoServiceServiceDescriptor.Methods().ByName("WatchWarehouses")
+	kargoServiceDeleteWarehouseMethodDescriptor                   = kargoServiceServiceDescriptor.Methods().ByName("DeleteWarehouse")
+	kargoServiceRefreshWarehouseMethodDescriptor                  = kargoServiceServiceDescriptor.Methods().ByName("RefreshWarehouse")
+	kargoServiceCreateCredentialsMethodDescriptor                 = kargoServiceServiceDescriptor.Methods().ByName("CreateCredentials")
+	kargoServiceDeleteCredentialsMethodDescriptor                 = kargoServiceServiceDescriptor.Methods().ByName("DeleteCredentials")
+	kargoServiceGetCredentialsMethodDescriptor                    = kargoServiceServiceDescriptor.Methods().ByName("GetCredentials")
+	kargoServiceListCredentialsMethodDescriptor                   = kargoServiceServiceDescriptor.Methods().ByName("ListCredentials")
+	kargoServiceUpdateCredentialsMethodDescriptor                 = kargoServiceServiceDescriptor.Methods().ByName("UpdateCredentials")
+	kargoServiceValidateCredentialsMethodDescriptor               = kargoServiceServiceDescriptor.Methods().ByName("ValidateCredentials")
+	kargoServiceListAnalysisTemplatesMethodDescriptor             = kargoServiceServiceDescriptor.Methods().ByName("ListAnalysisTemplates")
+	kargoServiceGetAnalysisTemplateMethodDescriptor               = kargoServiceServiceDescriptor.Methods().ByName("GetAnalysisTemplate")
+	kargoServiceDeleteAnalysisTemplateMethodDescriptor            = kargoServiceServiceDescriptor.Methods().ByName("DeleteAnalysisTemplate")
+	kargoServiceGetAnalysisRunMethodDescriptor                    = kargoServiceServiceDescriptor.Methods().ByName("GetAnalysisRun")
+	kargoServiceListProjectEventsMethodDescriptor                 = kargoServiceServiceDescriptor.Methods().ByName("ListProjectEvents")
+	kargoServiceCreateRoleMethodDescriptor                        = kargoServiceServiceDescriptor.Methods().ByName("CreateRole")
+	kargoServiceDeleteRoleMethodDescriptor                        = kargoServiceServiceDescriptor.Methods().ByName("DeleteRole")
+	kargoServiceGetRoleMethodDescriptor                           = kargoServiceServiceDescriptor.Methods().ByName("GetRole")
+	kargoServiceGrantMethodDescriptor                             = kargoServiceServiceDescriptor.Methods().ByName("Grant")
+	kargoServiceListRolesMethodDescriptor                         = kargoServiceServiceDescriptor.Methods().ByName("ListRoles")
+	kargoServiceRevokeMethodDescriptor                            = kargoServiceServiceDescriptor.Methods().ByName("Revoke")
+	kargoServiceUpdateRoleMethodDescriptor                        = kargoServiceServiceDescriptor.Methods().ByName("UpdateRole")
 )
 
 // KargoServiceClient is a client for the akuity.io.kargo.service.v1alpha1.KargoService service.
@@ -251,6 +267,8 @@ type KargoServiceClient interface {
 	WatchPromotions(context.Context, *connect.Request[v1alpha1.WatchPromotionsRequest]) (*connect.ServerStreamForClient[v1alpha1.WatchPromotionsResponse], error)
 	GetPromotion(context.Context, *connect.Request[v1alpha1.GetPromotionRequest]) (*connect.Response[v1alpha1.GetPromotionResponse], error)
 	WatchPromotion(context.Context, *connect.Request[v1alpha1.WatchPromotionRequest]) (*connect.ServerStreamForClient[v1alpha1.WatchPromotionResponse], error)
+	ListPromotionsByArgoCDApplication(context.Context, *connect.Request[v1alpha1.ListPromotionsByArgoCDApplicationRequest]) (*connect.Response[v1alpha1.ListPromotionsByArgoCDApplicationResponse], error)
+	RetryPromotion(context.Context, *connect.Request[v1alpha1.RetryPromotionRequest]) (*connect.Response[v1alpha1.RetryPromotionResponse], error)
 	DeleteProject(context.Context, *connect.Request[v1alpha1.DeleteProjectRequest]) (*connect.Response[v1alpha1.DeleteProjectResponse], error)
 	GetProject(context.Context, *connect.Request[v1alpha1.GetProjectRequest]) (*connect.Response[v1alpha1.GetProjectResponse], error)
 	ListProjects(context.Context, *connect.Request[v1alpha1.ListProjectsRequest]) (*connect.Response[v1alpha1.ListProjectsResponse], error)
@@ -259,6 +277,7 @@ type KargoServiceClient interface {
 	GetFreight(context.Context, *connect.Request[v1alpha1.GetFreightRequest]) (*connect.Response[v1alpha1.GetFreightResponse], error)
 	PromoteToStage(context.Context, *connect.Request[v1alpha1.PromoteToStageRequest]) (*connect.Response[v1alpha1.PromoteToStageResponse], error)
 	PromoteToStageSubscribers(context.Context, *connect.Request[v1alpha1.PromoteToStageSubscribersRequest]) (*connect.Response[v1alpha1.PromoteToStageSubscribersResponse], error)
+	RollbackStage(context.Context, *connect.Request[v1alpha1.RollbackStageRequest]) (*connect.Response[v1alpha1.RollbackStageResponse], error)
 	QueryFreight(context.Context, *connect.Request[v1alpha1.QueryFreightRequest]) (*connect.Response[v1alpha1.QueryFreightResponse], error)
 	UpdateFreightAlias(context.Context, *connect.Request[v1alpha1.UpdateFreightAliasRequest]) (*connect.Response[v1alpha1.UpdateFreightAliasResponse], error)
 	Reverify(context.Context, *connect.Request[v1alpha1.ReverifyRequest]) (*connect.Response[v1alpha1.ReverifyResponse], error)
@@ -273,6 +292,7 @@ type KargoServiceClient interface {
 	GetCredentials(context.Context, *connect.Request[v1alpha1.GetCredentialsRequest]) (*connect.Response[v1alpha1.GetCredentialsResponse], error)
 	ListCredentials(context.Context, *connect.Request[v1alpha1.ListCredentialsRequest]) (*connect.Response[v1alpha1.ListCredentialsResponse], error)
 	UpdateCredentials(context.Context, *connect.Request[v1alpha1.UpdateCredentialsRequest]) (*connect.Response[v1alpha1.UpdateCredentialsResponse], error)
+	ValidateCredentials(context.Context, *connect.Request[v1alpha1.ValidateCredentialsRequest]) (*connect.Response[v1alpha1.ValidateCredentialsResponse], error)
 	ListAnalysisTemplates(context.Context, *connect.Request[v1alpha1.ListAnalysisTemplatesRequest]) (*connect.Response[v1alpha1.ListAnalysisTemplatesResponse], error)
 	GetAnalysisTemplate(context.Context, *connect.Request[v1alpha1.GetAnalysisTemplateRequest]) (*connect.Response[v1alpha1.GetAnalysisTemplateResponse], error)
 	DeleteAnalysisTemplate(context.Context, *connect.Request[v1alpha1.DeleteAnalysisTemplateRequest]) (*connect.Response[v1alpha1.DeleteAnalysisTemplateResponse], error)
@@ -399,6 +419,18 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(kargoServiceWatchPromotionMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		listPromotionsByArgoCDApplication: connect.NewClient[v1alpha1.ListPromotionsByArgoCDApplicationRequest, v1alpha1.ListPromotionsByArgoCDApplicationResponse](
+			httpClient,
+			baseURL+KargoServiceListPromotionsByArgoCDApplicationProcedure,
+			connect.WithSchema(kargoServiceListPromotionsByArgoCDApplicationMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		retryPromotion: connect.NewClient[v1alpha1.RetryPromotionRequest, v1alpha1.RetryPromotionResponse](
+			httpClient,
+			baseURL+KargoServiceRetryPromotionProcedure,
+			connect.WithSchema(kargoServiceRetryPromotionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		deleteProject: connect.NewClient[v1alpha1.DeleteProjectRequest, v1alpha1.DeleteProjectResponse](
 			httpClient,
 			baseURL+KargoServiceDeleteProjectProcedure,
@@ -447,6 +479,12 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(kargoServicePromoteToStageSubscribersMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		rollbackStage: connect.NewClient[v1alpha1.RollbackStageRequest, v1alpha1.RollbackStageResponse](
+			httpClient,
+			baseURL+KargoServiceRollbackStageProcedure,
+			connect.WithSchema(kargoServiceRollbackStageMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		queryFreight: connect.NewClient[v1alpha1.QueryFreightRequest, v1alpha1.QueryFreightResponse](
 			httpClient,
 			baseURL+KargoServiceQueryFreightProcedure,
@@ -531,6 +569,12 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(kargoServiceUpdateCredentialsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		validateCredentials: connect.NewClient[v1alpha1.ValidateCredentialsRequest, v1alpha1.ValidateCredentialsResponse](
+			httpClient,
+			baseURL+KargoServiceValidateCredentialsProcedure,
+			connect.WithSchema(kargoServiceValidateCredentialsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		listAnalysisTemplates: connect.NewClient[v1alpha1.ListAnalysisTemplatesRequest, v1alpha1.ListAnalysisTemplatesResponse](
 			httpClient,
 			baseURL+KargoServiceListAnalysisTemplatesProcedure,
@@ -608,57 +652,61 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 
 // kargoServiceClient implements KargoServiceClient.
 type kargoServiceClient struct {
-	getVersionInfo            *connect.Client[v1alpha1.GetVersionInfoRequest, v1alpha1.GetVersionInfoResponse]
-	getConfig                 *connect.Client[v1alpha1.GetConfigRequest, v1alpha1.GetConfigResponse]
-	getPublicConfig           *connect.Client[v1alpha1.GetPublicConfigRequest, v1alpha1.GetPublicConfigResponse]
-	adminLogin                *connect.Client[v1alpha1.AdminLoginRequest, v1alpha1.AdminLoginResponse]
-	createResource            *connect.Client[v1alpha1.CreateResourceRequest, v1alpha1.CreateResourceResponse]
-	createOrUpdateResource    *connect.Client[v1alpha1.CreateOrUpdateResourceRequest, v1alpha1.CreateOrUpdateResourceResponse]
-	updateResource            *connect.Client[v1alpha1.UpdateResourceRequest, v1alpha1.UpdateResourceResponse]
-	deleteResource            *connect.Client[v1alpha1.DeleteResourceRequest, v1alpha1.DeleteResourceResponse]
-	listStages                *connect.Client[v1alpha1.ListStagesRequest, v1alpha1.ListStagesResponse]
-	getStage                  *connect.Client[v1alpha1.GetStageRequest, v1alpha1.GetStageResponse]
-	watchStages               *connect.Client[v1alpha1.WatchStagesRequest, v1alpha1.WatchStagesResponse]
-	deleteStage               *connect.Client[v1alpha1.DeleteStageRequest, v1alpha1.DeleteStageResponse]
-	refreshStage              *connect.Client[v1alpha1.RefreshStageRequest, v1alpha1.RefreshStageResponse]
-	listPromotions            *connect.Client[v1alpha1.ListPromotionsRequest, v1alpha1.ListPromotionsResponse]
-	watchPromotions           *connect.Client[v1alpha1.WatchPromotionsRequest, v1alpha1.WatchPromotionsResponse]
-	getPromotion              *connect.Client[v1alpha1.GetPromotionRequest, v1alpha1.GetPromotionResponse]
-	watchPromotion            *connect.Client[v1alpha1.WatchPromotionRequest, v1alpha1.WatchPromotionResponse]
-	deleteProject             *connect.Client[v1alpha1.DeleteProjectRequest, v1alpha1.DeleteProjectResponse]
-	getProject                *connect.Client[v1alpha1.GetProjectRequest, v1alpha1.GetProjectResponse]
-	listProjects              *connect.Client[v1alpha1.ListProjectsRequest, v1alpha1.ListProjectsResponse]
-	approveFreight            *connect.Client[v1alpha1.ApproveFreightRequest, v1alpha1.ApproveFreightResponse]
-	deleteFreight             *connect.Client[v1alpha1.DeleteFreightRequest, v1alpha1.DeleteFreightResponse]
-	getFreight                *connect.Client[v1alpha1.GetFreightRequest, v1alpha1.GetFreightResponse]
-	promoteToStage            *connect.Client[v1alpha1.PromoteToStageRequest, v1alpha1.PromoteToStageResponse]
-	promoteToStageSubscribers *connect.Client[v1alpha1.PromoteToStageSubscribersRequest, v1alpha1.PromoteToStageSubscribersResponse]
-	queryFreight              *connect.Client[v1alpha1.QueryFreightRequest, v1alpha1.QueryFreightResponse]
-	updateFreightAlias        *connect.Client[v1alpha1.UpdateFreightAliasRequest, v1alpha1.UpdateFreightAliasResponse]
-	reverify                  *connect.Client[v1alpha1.ReverifyRequest, v1alpha1.ReverifyResponse]
-	abortVerification         *connect.Client[v1alpha1.AbortVerificationRequest, v1alpha1.AbortVerificationResponse]
-	listWarehouses            *connect.Client[v1alpha1.ListWarehousesRequest, v1alpha1.ListWarehousesResponse]
-	getWarehouse              *connect.Client[v1alpha1.GetWarehouseRequest, v1alpha1.GetWarehouseResponse]
-	watchWarehouses           *connect.Client[v1alpha1.WatchWarehousesRequest, v1alpha1.WatchWarehousesResponse]
-	deleteWarehouse           *connect.Client[v1alpha1.DeleteWarehouseRequest, v1alpha1.DeleteWarehouseResponse]
-	refreshWarehouse          *connect.Client[v1alpha1.RefreshWarehouseRequest, v1alpha1.RefreshWarehouseResponse]
-	createCredentials         *connect.Client[v1alpha1.CreateCredentialsRequest, v1alpha1.CreateCredentialsResponse]
-	deleteCredentials         *connect.Client[v1alpha1.DeleteCredentialsRequest, v1alpha1.DeleteCredentialsResponse]
-	getCredentials            *connect.Client[v1alpha1.GetCredentialsRequest, v1alpha1.GetCredentialsResponse]
-	listCredentials           *connect.Client[v1alpha1.ListCredentialsRequest, v1alpha1.ListCredentialsResponse]
-	updateCredentials         *connect.Client[v1alpha1.UpdateCredentialsRequest, v1alpha1.UpdateCredentialsResponse]
-	listAnalysisTemplates     *connect.Client[v1alpha1.ListAnalysisTemplatesRequest, v1alpha1.ListAnalysisTemplatesResponse]
-	getAnalysisTemplate       *connect.Client[v1alpha1.GetAnalysisTemplateRequest, v1alpha1.GetAnalysisTemplateResponse]
-	deleteAnalysisTemplate    *connect.Client[v1alpha1.DeleteAnalysisTemplateRequest, v1alpha1.DeleteAnalysisTemplateResponse]
-	getAnalysisRun            *connect.Client[v1alpha1.GetAnalysisRunRequest, v1alpha1.GetAnalysisRunResponse]
-	listProjectEvents         *connect.Client[v1alpha1.ListProjectEventsRequest, v1alpha1.ListProjectEventsResponse]
-	createRole                *connect.Client[v1alpha1.CreateRoleRequest, v1alpha1.CreateRoleResponse]
-	deleteRole                *connect.Client[v1alpha1.DeleteRoleRequest, v1alpha1.DeleteRoleResponse]
-	getRole                   *connect.Client[v1alpha1.GetRoleRequest, v1alpha1.GetRoleResponse]
-	grant                     *connect.Client[v1alpha1.GrantRequest, v1alpha1.GrantResponse]
-	listRoles                 *connect.Client[v1alpha1.ListRolesRequest, v1alpha1.ListRolesResponse]
-	revoke                    *connect.Client[v1alpha1.RevokeRequest, v1alpha1.RevokeResponse]
-	updateRole                *connect.Client[v1alpha1.UpdateRoleRequest, v1alpha1.UpdateRoleResponse]
+	getVersionInfo                    *connect.Client[v1alpha1.GetVersionInfoRequest, v1alpha1.GetVersionInfoResponse]
+	getConfig                         *connect.Client[v1alpha1.GetConfigRequest, v1alpha1.GetConfigResponse]
+	getPublicConfig                   *connect.Client[v1alpha1.GetPublicConfigRequest, v1alpha1.GetPublicConfigResponse]
+	adminLogin                        *connect.Client[v1alpha1.AdminLoginRequest, v1alpha1.AdminLoginResponse]
+	createResource                    *connect.Client[v1alpha1.CreateResourceRequest, v1alpha1.CreateResourceResponse]
+	createOrUpdateResource            *connect.Client[v1alpha1.CreateOrUpdateResourceRequest, v1alpha1.CreateOrUpdateResourceResponse]
+	updateResource                    *connect.Client[v1alpha1.UpdateResourceRequest, v1alpha1.UpdateResourceResponse]
+	deleteResource                    *connect.Client[v1alpha1.DeleteResourceRequest, v1alpha1.DeleteResourceResponse]
+	listStages                        *connect.Client[v1alpha1.ListStagesRequest, v1alpha1.ListStagesResponse]
+	getStage                          *connect.Client[v1alpha1.GetStageRequest, v1alpha1.GetStageResponse]
+	watchStages                       *connect.Client[v1alpha1.WatchStagesRequest, v1alpha1.WatchStagesResponse]
+	deleteStage                       *connect.Client[v1alpha1.DeleteStageRequest, v1alpha1.DeleteStageResponse]
+	refreshStage                      *connect.Client[v1alpha1.RefreshStageRequest, v1alpha1.RefreshStageResponse]
+	listPromotions                    *connect.Client[v1alpha1.ListPromotionsRequest, v1alpha1.ListPromotionsResponse]
+	watchPromotions                   *connect.Client[v1alpha1.WatchPromotionsRequest, v1alpha1.WatchPromotionsResponse]
+	getPromotion                      *connect.Client[v1alpha1.GetPromotionRequest, v1alpha1.GetPromotionResponse]
+	watchPromotion                    *connect.Client[v1alpha1.WatchPromotionRequest, v1alpha1.WatchPromotionResponse]
+	listPromotionsByArgoCDApplication *connect.Client[v1alpha1.ListPromotionsByArgoCDApplicationRequest, v1alpha1.ListPromotionsByArgoCDApplicationResponse]
+	retryPromotion                    *connect.Client[v1alpha1.RetryPromotionRequest, v1alpha1.RetryPromotionResponse]
+	deleteProject                     *connect.Client[v1alpha1.DeleteProjectRequest, v1alpha1.DeleteProjectResponse]
+	getProject                        *connect.Client[v1alpha1.GetProjectRequest, v1alpha1.GetProjectResponse]
+	listProjects                      *connect.Client[v1alpha1.ListProjectsRequest, v1alpha1.ListProjectsResponse]
+	approveFreight                    *connect.Client[v1alpha1.ApproveFreightRequest, v1alpha1.ApproveFreightResponse]
+	deleteFreight                     *connect.Client[v1alpha1.DeleteFreightRequest, v1alpha1.DeleteFreightResponse]
+	getFreight                        *connect.Client[v1alpha1.GetFreightRequest, v1alpha1.GetFreightResponse]
+	promoteToStage                    *connect.Client[v1alpha1.PromoteToStageRequest, v1alpha1.PromoteToStageResponse]
+	promoteToStageSubscribers         *connect.Client[v1alpha1.PromoteToStageSubscribersRequest, v1alpha1.PromoteToStageSubscribersResponse]
+	rollbackStage                     *connect.Client[v1alpha1.RollbackStageRequest, v1alpha1.RollbackStageResponse]
+	queryFreight                      *connect.Client[v1alpha1.QueryFreightRequest, v1alpha1.QueryFreightResponse]
+	updateFreightAlias                *connect.Client[v1alpha1.UpdateFreightAliasRequest, v1alpha1.UpdateFreightAliasResponse]
+	reverify                          *connect.Client[v1alpha1.ReverifyRequest, v1alpha1.ReverifyResponse]
+	abortVerification                 *connect.Client[v1alpha1.AbortVerificationRequest, v1alpha1.AbortVerificationResponse]
+	listWarehouses                    *connect.Client[v1alpha1.ListWarehousesRequest, v1alpha1.ListWarehousesResponse]
+	getWarehouse                      *connect.Client[v1alpha1.GetWarehouseRequest, v1alpha1.GetWarehouseResponse]
+	watchWarehouses                   *connect.Client[v1alpha1.WatchWarehousesRequest, v1alpha1.WatchWarehousesResponse]
+	deleteWarehouse                   *connect.Client[v1alpha1.DeleteWarehouseRequest, v1alpha1.DeleteWarehouseResponse]
+	refreshWarehouse                  *connect.Client[v1alpha1.RefreshWarehouseRequest, v1alpha1.RefreshWarehouseResponse]
+	createCredentials                 *connect.Client[v1alpha1.CreateCredentialsRequest, v1alpha1.CreateCredentialsResponse]
+	deleteCredentials                 *connect.Client[v1alpha1.DeleteCredentialsRequest, v1alpha1.DeleteCredentialsResponse]
+	getCredentials                    *connect.Client[v1alpha1.GetCredentialsRequest, v1alpha1.GetCredentialsResponse]
+	listCredentials                   *connect.Client[v1alpha1.ListCredentialsRequest, v1alpha1.ListCredentialsResponse]
+	updateCredentials                 *connect.Client[v1alpha1.UpdateCredentialsRequest, v1alpha1.UpdateCredentialsResponse]
+	validateCredentials               *connect.Client[v1alpha1.ValidateCredentialsRequest, v1alpha1.ValidateCredentialsResponse]
+	listAnalysisTemplates             *connect.Client[v1alpha1.ListAnalysisTemplatesRequest, v1alpha1.ListAnalysisTemplatesResponse]
+	getAnalysisTemplate               *connect.Client[v1alpha1.GetAnalysisTemplateRequest, v1alpha1.GetAnalysisTemplateResponse]
+	deleteAnalysisTemplate            *connect.Client[v1alpha1.DeleteAnalysisTemplateRequest, v1alpha1.DeleteAnalysisTemplateResponse]
+	getAnalysisRun                    *connect.Client[v1alpha1.GetAnalysisRunRequest, v1alpha1.GetAnalysisRunResponse]
+	listProjectEvents                 *connect.Client[v1alpha1.ListProjectEventsRequest, v1alpha1.ListProjectEventsResponse]
+	createRole                        *connect.Client[v1alpha1.CreateRoleRequest, v1alpha1.CreateRoleResponse]
+	deleteRole                        *connect.Client[v1alpha1.DeleteRoleRequest, v1alpha1.DeleteRoleResponse]
+	getRole                           *connect.Client[v1alpha1.GetRoleRequest, v1alpha1.GetRoleResponse]
+	grant                             *connect.Client[v1alpha1.GrantRequest, v1alpha1.GrantResponse]
+	listRoles                         *connect.Client[v1alpha1.ListRolesRequest, v1alpha1.ListRolesResponse]
+	revoke                            *connect.Client[v1alpha1.RevokeRequest, v1alpha1.RevokeResponse]
+	updateRole                        *connect.Client[v1alpha1.UpdateRoleRequest, v1alpha1.UpdateRoleResponse]
 }
 
 // GetVersionInfo calls akuity.io.kargo.service.v1alpha1.KargoService.GetVersionInfo.
@@ -747,6 +795,17 @@ func (c *kargoServiceClient) WatchPromotion(ctx context.Context, req *connect.Re
 	return c.watchPromotion.CallServerStream(ctx, req)
 }
 
+// ListPromotionsByArgoCDApplication calls
+// akuity.io.kargo.service.v1alpha1.KargoService.ListPromotionsByArgoCDApplication.
+func (c *kargoServiceClient) ListPromotionsByArgoCDApplication(ctx context.Context, req *connect.Request[v1alpha1.ListPromotionsByArgoCDApplicationRequest]) (*connect.Response[v1alpha1.ListPromotionsByArgoCDApplicationResponse], error) {
+	return c.listPromotionsByArgoCDApplication.CallUnary(ctx, req)
+}
+
+// RetryPromotion calls akuity.io.kargo.service.v1alpha1.KargoService.RetryPromotion.
+func (c *kargoServiceClient) RetryPromotion(ctx context.Context, req *connect.Request[v1alpha1.RetryPromotionRequest]) (*connect.Response[v1alpha1.RetryPromotionResponse], error) {
+	return c.retryPromotion.CallUnary(ctx, req)
+}
+
 // DeleteProject calls akuity.io.kargo.service.v1alpha1.KargoService.DeleteProject.
 func (c *kargoServiceClient) DeleteProject(ctx context.Context, req *connect.Request[v1alpha1.DeleteProjectRequest]) (*connect.Response[v1alpha1.DeleteProjectResponse], error) {
 	return c.deleteProject.CallUnary(ctx, req)
@@ -788,6 +847,11 @@ func (c *kargoServiceClient) PromoteToStageSubscribers(ctx context.Context, req
 	return c.promoteToStageSubscribers.CallUnary(ctx, req)
 }
 
+// RollbackStage calls akuity.io.kargo.service.v1alpha1.KargoService.RollbackStage.
+func (c *kargoServiceClient) RollbackStage(ctx context.Context, req *connect.Request[v1alpha1.RollbackStageRequest]) (*connect.Response[v1alpha1.RollbackStageResponse], error) {
+	return c.rollbackStage.CallUnary(ctx, req)
+}
+
 // QueryFreight calls akuity.io.kargo.service.v1alpha1.KargoService.QueryFreight.
 func (c *kargoServiceClient) QueryFreight(ctx context.Context, req *connect.Request[v1alpha1.QueryFreightRequest]) (*connect.Response[v1alpha1.QueryFreightResponse], error) {
 	return c.queryFreight.CallUnary(ctx, req)
@@ -858,6 +922,11 @@ func (c *kargoServiceClient) UpdateCredentials(ctx context.Context, req *connect
 	return c.updateCredentials.CallUnary(ctx, req)
 }
 
+// ValidateCredentials calls akuity.io.kargo.service.v1alpha1.KargoService.ValidateCredentials.
+func (c *kargoServiceClient) ValidateCredentials(ctx context.Context, req *connect.Request[v1alpha1.ValidateCredentialsRequest]) (*connect.Response[v1alpha1.ValidateCredentialsResponse], error) {
+	return c.validateCredentials.CallUnary(ctx, req)
+}
+
 // ListAnalysisTemplates calls akuity.io.kargo.service.v1alpha1.KargoService.ListAnalysisTemplates.
 func (c *kargoServiceClient) ListAnalysisTemplates(ctx context.Context, req *connect.Request[v1alpha1.ListAnalysisTemplatesRequest]) (*connect.Response[v1alpha1.ListAnalysisTemplatesResponse], error) {
 	return c.listAnalysisTemplates.CallUnary(ctx, req)
@@ -941,6 +1010,8 @@ type KargoServiceHandler interface {
 	WatchPromotions(context.Context, *connect.Request[v1alpha1.WatchPromotionsRequest], *connect.ServerStream[v1alpha1.WatchPromotionsResponse]) error
 	GetPromotion(context.Context, *connect.Request[v1alpha1.GetPromotionRequest]) (*connect.Response[v1alpha1.GetPromotionResponse], error)
 	WatchPromotion(context.Context, *connect.Request[v1alpha1.WatchPromotionRequest], *connect.ServerStream[v1alpha1.WatchPromotionResponse]) error
+	ListPromotionsByArgoCDApplication(context.Context, *connect.Request[v1alpha1.ListPromotionsByArgoCDApplicationRequest]) (*connect.Response[v1alpha1.ListPromotionsByArgoCDApplicationResponse], error)
+	RetryPromotion(context.Context, *connect.Request[v1alpha1.RetryPromotionRequest]) (*connect.Response[v1alpha1.RetryPromotionResponse], error)
 	DeleteProject(context.Context, *connect.Request[v1alpha1.DeleteProjectRequest]) (*connect.Response[v1alpha1.DeleteProjectResponse], error)
 	GetProject(context.Context, *connect.Request[v1alpha1.GetProjectRequest]) (*connect.Response[v1alpha1.GetProjectResponse], error)
 	ListProjects(context.Context, *connect.Request[v1alpha1.ListProjectsRequest]) (*connect.Response[v1alpha1.ListProjectsResponse], error)
@@ -949,6 +1020,7 @@ type KargoServiceHandler interface {
 	GetFreight(context.Context, *connect.Request[v1alpha1.GetFreightRequest]) (*connect.Response[v1alpha1.GetFreightResponse], error)
 	PromoteToStage(context.Context, *connect.Request[v1alpha1.PromoteToStageRequest]) (*connect.Response[v1alpha1.PromoteToStageResponse], error)
 	PromoteToStageSubscribers(context.Context, *connect.Request[v1alpha1.PromoteToStageSubscribersRequest]) (*connect.Response[v1alpha1.PromoteToStageSubscribersResponse], error)
+	RollbackStage(context.Context, *connect.Request[v1alpha1.RollbackStageRequest]) (*connect.Response[v1alpha1.RollbackStageResponse], error)
 	QueryFreight(context.Context, *connect.Request[v1alpha1.QueryFreightRequest]) (*connect.Response[v1alpha1.QueryFreightResponse], error)
 	UpdateFreightAlias(context.Context, *connect.Request[v1alpha1.UpdateFreightAliasRequest]) (*connect.Response[v1alpha1.UpdateFreightAliasResponse], error)
 	Reverify(context.Context, *connect.Request[v1alpha1.ReverifyRequest]) (*connect.Response[v1alpha1.ReverifyResponse], error)
@@ -963,6 +1035,7 @@ type KargoServiceHandler interface {
 	GetCredentials(context.Context, *connect.Request[v1alpha1.GetCredentialsRequest]) (*connect.Response[v1alpha1.GetCredentialsResponse], error)
 	ListCredentials(context.Context, *connect.Request[v1alpha1.ListCredentialsRequest]) (*connect.Response[v1alpha1.ListCredentialsResponse], error)
 	UpdateCredentials(context.Context, *connect.Request[v1alpha1.UpdateCredentialsRequest]) (*connect.Response[v1alpha1.UpdateCredentialsResponse], error)
+	ValidateCredentials(context.Context, *connect.Request[v1alpha1.ValidateCredentialsRequest]) (*connect.Response[v1alpha1.ValidateCredentialsResponse], error)
 	ListAnalysisTemplates(context.Context, *connect.Request[v1alpha1.ListAnalysisTemplatesRequest]) (*connect.Response[v1alpha1.ListAnalysisTemplatesResponse], error)
 	GetAnalysisTemplate(context.Context, *connect.Request[v1alpha1.GetAnalysisTemplateRequest]) (*connect.Response[v1alpha1.GetAnalysisTemplateResponse], error)
 	DeleteAnalysisTemplate(context.Context, *connect.Request[v1alpha1.DeleteAnalysisTemplateRequest]) (*connect.Response[v1alpha1.DeleteAnalysisTemplateResponse], error)
@@ -1085,6 +1158,18 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(kargoServiceWatchPromotionMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	kargoServiceListPromotionsByArgoCDApplicationHandler := connect.NewUnaryHandler(
+		KargoServiceListPromotionsByArgoCDApplicationProcedure,
+		svc.ListPromotionsByArgoCDApplication,
+		connect.WithSchema(kargoServiceListPromotionsByArgoCDApplicationMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	kargoServiceRetryPromotionHandler := connect.NewUnaryHandler(
+		KargoServiceRetryPromotionProcedure,
+		svc.RetryPromotion,
+		connect.WithSchema(kargoServiceRetryPromotionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	kargoServiceDeleteProjectHandler := connect.NewUnaryHandler(
 		KargoServiceDeleteProjectProcedure,
 		svc.DeleteProject,
@@ -1133,6 +1218,12 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(kargoServicePromoteToStageSubscribersMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	kargoServiceRollbackStageHandler := connect.NewUnaryHandler(
+		KargoServiceRollbackStageProcedure,
+		svc.RollbackStage,
+		connect.WithSchema(kargoServiceRollbackStageMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	kargoServiceQueryFreightHandler := connect.NewUnaryHandler(
 		KargoServiceQueryFreightProcedure,
 		svc.QueryFreight,
@@ -1217,6 +1308,12 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(kargoServiceUpdateCredentialsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	kargoServiceValidateCredentialsHandler := connect.NewUnaryHandler(
+		KargoServiceValidateCredentialsProcedure,
+		svc.ValidateCredentials,
+		connect.WithSchema(kargoServiceValidateCredentialsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	kargoServiceListAnalysisTemplatesHandler := connect.NewUnaryHandler(
 		KargoServiceListAnalysisTemplatesProcedure,
 		svc.ListAnalysisTemplates,
@@ -1325,6 +1422,10 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 			kargoServiceGetPromotionHandler.ServeHTTP(w, r)
 		case KargoServiceWatchPromotionProcedure:
 			kargoServiceWatchPromotionHandler.ServeHTTP(w, r)
+		case KargoServiceListPromotionsByArgoCDApplicationProcedure:
+			kargoServiceListPromotionsByArgoCDApplicationHandler.ServeHTTP(w, r)
+		case KargoServiceRetryPromotionProcedure:
+			kargoServiceRetryPromotionHandler.ServeHTTP(w, r)
 		case KargoServiceDeleteProjectProcedure:
 			kargoServiceDeleteProjectHandler.ServeHTTP(w, r)
 		case KargoServiceGetProjectProcedure:
@@ -1341,6 +1442,8 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 			kargoServicePromoteToStageHandler.ServeHTTP(w, r)
 		case KargoServicePromoteToStageSubscribersProcedure:
 			kargoServicePromoteToStageSubscribersHandler.ServeHTTP(w, r)
+		case KargoServiceRollbackStageProcedure:
+			kargoServiceRollbackStageHandler.ServeHTTP(w, r)
 		case KargoServiceQueryFreightProcedure:
 			kargoServiceQueryFreightHandler.ServeHTTP(w, r)
 		case KargoServiceUpdateFreightAliasProcedure:
@@ -1369,6 +1472,8 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 			kargoServiceListCredentialsHandler.ServeHTTP(w, r)
 		case KargoServiceUpdateCredentialsProcedure:
 			kargoServiceUpdateCredentialsHandler.ServeHTTP(w, r)
+		case KargoServiceValidateCredentialsProcedure:
+			kargoServiceValidateCredentialsHandler.ServeHTTP(w, r)
 		case KargoServiceListAnalysisTemplatesProcedure:
 			kargoServiceListAnalysisTemplatesHandler.ServeHTTP(w, r)
 		case KargoServiceGetAnalysisTemplateProcedure:
@@ -1470,6 +1575,14 @@ func (UnimplementedKargoServiceHandler) WatchPromotion(context.Context, *connect
 	return connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.WatchPromotion is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) ListPromotionsByArgoCDApplication(context.Context, *connect.Request[v1alpha1.ListPromotionsByArgoCDApplicationRequest]) (*connect.Response[v1alpha1.ListPromotionsByArgoCDApplicationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.ListPromotionsByArgoCDApplication is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) RetryPromotion(context.Context, *connect.Request[v1alpha1.RetryPromotionRequest]) (*connect.Response[v1alpha1.RetryPromotionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.RetryPromotion is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) DeleteProject(context.Context, *connect.Request[v1alpha1.DeleteProjectRequest]) (*connect.Response[v1alpha1.DeleteProjectResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.DeleteProject is not implemented"))
 }
@@ -1502,6 +1615,10 @@ func (UnimplementedKargoServiceHandler) PromoteToStageSubscribers(context.Contex
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.PromoteToStageSubscribers is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) RollbackStage(context.Context, *connect.Request[v1alpha1.RollbackStageRequest]) (*connect.Response[v1alpha1.RollbackStageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.RollbackStage is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) QueryFreight(context.Context, *connect.Request[v1alpha1.QueryFreightRequest]) (*connect.Response[v1alpha1.QueryFreightResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.QueryFreight is not implemented"))
 }
@@ -1558,6 +1675,10 @@ func (UnimplementedKargoServiceHandler) UpdateCredentials(context.Context, *conn
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.UpdateCredentials is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) ValidateCredentials(context.Context, *connect.Request[v1alpha1.ValidateCredentialsRequest]) (*connect.Response[v1alpha1.ValidateCredentialsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.ValidateCredentials is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) ListAnalysisTemplates(context.Context, *connect.Request[v1alpha1.ListAnalysisTemplatesRequest]) (*connect.Response[v1alpha1.ListAnalysisTemplatesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.ListAnalysisTemplates is not implemented"))
 }